@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/service"
 )
 
@@ -40,11 +41,21 @@ Examples:
   bujo add -d "last monday" ". Backfill task"
   bujo add -d "next friday" -y ". Skip confirmation"
   bujo add --parent 123 ". Add as child of entry 123"
+  bujo add --every "mon,wed" ". Gym"
+  bujo add --every "2nd tue" "o Book club"
+  bujo add -e 15th ". Pay credit card"
+
+Recurrence (--every):
+  daily, weekdays, weekly, monthly
+  mon,wed,fri     specific weekdays
+  2 weeks         every N days/weeks/months
+  15th            day of the month
+  2nd tue         nth weekday of the month (or "last fri")
 
 `,
 	DisableFlagParsing: true,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		entries, addLocation, addDate, addFile, addParent, showHelp, skipConfirm := parseAddArgs(args)
+		entries, addLocation, addDate, addFile, addParent, addEvery, showHelp, skipConfirm := parseAddArgs(args)
 		if showHelp {
 			return cmd.Help()
 		}
@@ -87,6 +98,26 @@ Examples:
 			}
 		}
 
+		var rule domain.RecurrenceRule
+		if addEvery != "" {
+			rule, err = domain.ParseEvery(addEvery)
+			if err != nil {
+				return err
+			}
+			if addParent != "" {
+				return fmt.Errorf("--every cannot be combined with --parent")
+			}
+			parsed, err := bujoService.ParseEntries(input)
+			if err != nil {
+				return err
+			}
+			for _, entry := range parsed {
+				if entry.Depth > 0 {
+					return fmt.Errorf("recurring entries cannot have children")
+				}
+			}
+		}
+
 		opts := service.LogEntriesOptions{
 			Date: date,
 		}
@@ -110,6 +141,15 @@ Examples:
 
 		writeEntryIDs(os.Stdout, ids)
 		fmt.Fprintf(os.Stderr, "Added %d entry(s)\n", len(ids))
+
+		if addEvery != "" {
+			for _, id := range ids {
+				if _, err := recurrenceService.CreateFromEntry(cmd.Context(), id, addEvery); err != nil {
+					return fmt.Errorf("failed to create recurrence: %w", err)
+				}
+			}
+			fmt.Fprintf(os.Stderr, "Repeating %s\n", rule.Describe())
+		}
 		return nil
 	},
 }
@@ -119,6 +159,7 @@ func init() {
 	addCmd.Flags().StringP("date", "d", "", "Date to add entries (e.g., 'yesterday', '2026-01-01')")
 	addCmd.Flags().StringP("file", "f", "", "Read entries from file")
 	addCmd.Flags().StringP("parent", "p", "", "Add entries as children of specified entry ID")
+	addCmd.Flags().StringP("every", "e", "", "Repeat entries on a schedule (e.g., 'daily', 'mon,wed', '2nd tue')")
 	addCmd.Flags().BoolP("yes", "y", false, "Skip date confirmation prompt")
 	rootCmd.AddCommand(addCmd)
 }
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var apiCmd = &cobra.Command{
+	Use:   "api",
+	Short: "Manage access to the local HTTP API",
+	Long: `Manage access to the HTTP API served on 127.0.0.1:8743 while the desktop
+app is running.
+
+Every API request needs a bearer token:
+  curl -H "Authorization: Bearer <token>" http://127.0.0.1:8743/api/entries
+
+Browsers may only call the API from origins on the allowlist.`,
+}
+
+var apiTokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage API tokens",
+	Long: `Create, list and revoke API tokens.
+
+Scopes:
+  read     Read entries, habits, lists, goals, search and stats
+  write    Make any change, including capturing entries
+  capture  Only add new entries (used by the Gmail bookmarklet)`,
+}
+
+var apiOriginCmd = &cobra.Command{
+	Use:   "origin",
+	Short: "Manage the CORS origin allowlist",
+	Long: `Manage the web origins allowed to call the API from a browser.
+
+https://mail.google.com is allowed by default for the Gmail bookmarklet.`,
+}
+
+func init() {
+	apiCmd.AddCommand(apiTokenCmd)
+	apiCmd.AddCommand(apiOriginCmd)
+	rootCmd.AddCommand(apiCmd)
+}
@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var apiOriginListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List allowed origins",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		origins, err := apiAuthService.ListOrigins(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to list origins: %w", err)
+		}
+
+		if len(origins) == 0 {
+			fmt.Println("No allowed origins")
+			return nil
+		}
+		for _, origin := range origins {
+			fmt.Println(origin)
+		}
+		return nil
+	},
+}
+
+var apiOriginAddCmd = &cobra.Command{
+	Use:   "add <origin>",
+	Short: "Allow an origin",
+	Long: `Allow browser pages from an origin to call the API.
+
+Examples:
+  bujo api origin add https://outlook.office.com`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		origin, err := apiAuthService.AllowOrigin(cmd.Context(), args[0])
+		if err != nil {
+			return fmt.Errorf("failed to add origin: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Allowed %s\n", origin)
+		return nil
+	},
+}
+
+var apiOriginRemoveCmd = &cobra.Command{
+	Use:     "remove <origin>",
+	Aliases: []string{"rm"},
+	Short:   "Disallow an origin",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := apiAuthService.DisallowOrigin(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to remove origin: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Removed %s\n", args[0])
+		return nil
+	},
+}
+
+func init() {
+	apiOriginCmd.AddCommand(apiOriginListCmd)
+	apiOriginCmd.AddCommand(apiOriginAddCmd)
+	apiOriginCmd.AddCommand(apiOriginRemoveCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var apiTokenScopes string
+
+var apiTokenCreateCmd = &cobra.Command{
+	Use:   "create <name>",
+	Short: "Create an API token",
+	Long: `Create an API token and print it. The token is shown only once; bujo
+stores just a hash of it.
+
+Examples:
+  bujo api token create "Home Assistant"
+  bujo api token create scripts --scope read,write
+  bujo api token create inbox --scope capture`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		scopes, err := domain.ParseAPITokenScopes(apiTokenScopes)
+		if err != nil {
+			return err
+		}
+
+		secret, token, err := apiAuthService.CreateToken(cmd.Context(), strings.Join(args, " "), scopes)
+		if err != nil {
+			return fmt.Errorf("failed to create token: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Created token #%d %q with scopes %s. Copy it now, it will not be shown again:\n", token.ID, token.Name, token.ScopeString())
+		fmt.Println(secret)
+		return nil
+	},
+}
+
+func init() {
+	apiTokenCreateCmd.Flags().StringVar(&apiTokenScopes, "scope", "read", "Comma-separated scopes: read, write, capture")
+	apiTokenCmd.AddCommand(apiTokenCreateCmd)
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+)
+
+var apiTokenListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List API tokens",
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tokens, err := apiAuthService.ListTokens(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to list tokens: %w", err)
+		}
+
+		if len(tokens) == 0 {
+			fmt.Println("No API tokens. Create one with: bujo api token create <name>")
+			return nil
+		}
+
+		gray := color.New(color.FgHiBlack).SprintFunc()
+		for _, t := range tokens {
+			lastUsed := "never used"
+			if t.LastUsedAt != nil {
+				lastUsed = "last used " + t.LastUsedAt.Local().Format("2006-01-02 15:04")
+			}
+			line := fmt.Sprintf("  #%-3d %-24s %s…  %-14s %s", t.ID, t.Name, t.Prefix, t.ScopeString(), lastUsed)
+			if t.IsRevoked() {
+				fmt.Println(gray(line + "  (revoked)"))
+				continue
+			}
+			fmt.Println(line)
+		}
+		return nil
+	},
+}
+
+func init() {
+	apiTokenCmd.AddCommand(apiTokenListCmd)
+}
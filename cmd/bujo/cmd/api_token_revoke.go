@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var apiTokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <#id>",
+	Short: "Revoke an API token",
+	Long: `Revoke an API token. Requests using it are rejected immediately.
+
+Examples:
+  bujo api token revoke #2
+  bujo api token revoke 2`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseAPITokenID(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := apiAuthService.RevokeToken(cmd.Context(), id); err != nil {
+			return fmt.Errorf("failed to revoke token: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Revoked token #%d\n", id)
+		return nil
+	},
+}
+
+func parseAPITokenID(arg string) (int64, error) {
+	if len(arg) > 0 && arg[0] == '#' {
+		arg = arg[1:]
+	}
+
+	var id int64
+	_, err := fmt.Sscanf(arg, "%d", &id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid token ID: %s", arg)
+	}
+	return id, nil
+}
+
+func init() {
+	apiTokenCmd.AddCommand(apiTokenRevokeCmd)
+}
@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+)
+
+var futureCmd = &cobra.Command{
+	Use:   "future",
+	Short: "Display the future log",
+	Long: `Display the future log: entries scheduled for a month rather than a
+specific day, grouped by month, followed by those set aside for someday.
+
+On the first day of a month, that month's entries are shown by 'bujo today'.
+Migrate them to a day when you are ready to work on them.
+
+Examples:
+  bujo future
+  bujo future add 2026-03 ". Book flights"
+  bujo future add someday ". Learn piano"
+  bujo migrate 42 --to 2026-03
+  bujo migrate 42 --to someday`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		log, err := bujoService.GetFutureLog(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to get future log: %w", err)
+		}
+
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaFutureLog, cli.NewFutureLogJSON(log))
+		}
+
+		if len(log.Months) == 0 && len(log.Someday) == 0 {
+			fmt.Println("The future log is empty.")
+			return nil
+		}
+
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		fmt.Print(cli.RenderFutureLog(log, today))
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(futureCmd)
+}
@@ -0,0 +1,52 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/dateutil"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var futureAddCmd = &cobra.Command{
+	Use:   "add <month|someday> <entries...>",
+	Short: "Add entries to the future log",
+	Long: `Add entries to the future log for a month, or for someday.
+
+The month can be given as 2026-03, "March 2026" or just "march" for
+its next occurrence.
+
+Examples:
+  bujo future add 2026-03 ". Book flights"
+  bujo future add december ". Buy presents" "- Budget 300"
+  bujo future add someday ". Learn piano"`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		opts := service.LogEntriesOptions{Date: time.Now(), Granularity: domain.ScheduleSomeday}
+		if !strings.EqualFold(args[0], "someday") {
+			month, err := dateutil.ParseMonth(args[0])
+			if err != nil {
+				return err
+			}
+			opts.Date = month
+			opts.Granularity = domain.ScheduleMonth
+		}
+
+		ids, err := bujoService.LogEntries(cmd.Context(), strings.Join(args[1:], "\n"), opts)
+		if err != nil {
+			return fmt.Errorf("failed to add entries: %w", err)
+		}
+
+		writeEntryIDs(os.Stdout, ids)
+		fmt.Fprintf(os.Stderr, "Added %d entry(s) to the future log\n", len(ids))
+		return nil
+	},
+}
+
+func init() {
+	futureCmd.AddCommand(futureAddCmd)
+}
@@ -2,55 +2,152 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
 )
 
-var goalMonthFlag string
+var (
+	goalMonthFlag   string
+	goalQuarterFlag string
+	goalYearFlag    int
+)
 
 var goalCmd = &cobra.Command{
 	Use:   "goal",
-	Short: "Manage monthly goals",
-	Long: `Manage monthly goals - higher level objectives tracked by month.
+	Short: "Manage monthly, quarterly and yearly goals",
+	Long: `Manage goals - higher level objectives tracked by month, quarter or year.
 
-By default, shows goals for the current month. Use --month to specify a different month.
+By default, shows goals for the current month together with the quarterly and
+yearly goals they cascade from, as a tree. Use --month, --quarter or --year
+to show a different period. Parents show how many of their child goals are
+done.
 
 Examples:
   bujo goal                        # List current month's goals
   bujo goal --month 2026-02        # List February 2026 goals
+  bujo goal --quarter 2026Q3       # List Q3 2026 goals and their months
+  bujo goal --year 2026            # List the whole of 2026
   bujo goal add "Learn Go"         # Add goal to current month
   bujo goal done #1                # Mark goal #1 as done
   bujo goal undo #1                # Mark goal #1 as active again
-  bujo goal move #1 2026-02        # Move goal #1 to February`,
+  bujo goal move #1 2026-02        # Move goal #1 to February
+  bujo goal link #1 42             # Link task 42 to goal #1
+  bujo goal kr add #1 "Subscribers" --target 1000  # Add a key result`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		month, err := parseGoalMonth(goalMonthFlag)
+		horizon, period, err := parseGoalPeriod(goalMonthFlag, goalQuarterFlag, goalYearFlag)
 		if err != nil {
 			return err
 		}
+		label := domain.FormatGoalPeriod(horizon, period)
 
-		goals, err := goalService.GetGoalsForMonth(cmd.Context(), month)
+		tree, err := goalService.GetGoalTree(cmd.Context(), horizon, period)
 		if err != nil {
 			return fmt.Errorf("failed to get goals: %w", err)
 		}
 
-		if len(goals) == 0 {
-			fmt.Printf("No goals for %s\n", month.Format("January 2006"))
+		if len(tree) == 0 {
+			fmt.Printf("No goals for %s\n", label)
 			return nil
 		}
 
-		fmt.Printf("Goals for %s:\n\n", month.Format("January 2006"))
-		for _, goal := range goals {
-			status := "  "
-			if goal.IsDone() {
-				status = "x "
-			}
-			fmt.Printf("  %s#%-3d %s\n", status, goal.ID, goal.Content)
+		nodes := service.FlattenGoalTree(tree)
+		goals := make([]domain.Goal, len(nodes))
+		for i, node := range nodes {
+			goals[i] = node.Goal
+		}
+		tasks, err := goalService.GetGoalTasks(cmd.Context(), goals)
+		if err != nil {
+			return fmt.Errorf("failed to get goal tasks: %w", err)
+		}
+
+		keyResults, err := goalService.GetKeyResults(cmd.Context(), goals)
+		if err != nil {
+			return fmt.Errorf("failed to get key results: %w", err)
+		}
+
+		fmt.Printf("Goals for %s:\n\n", label)
+		for _, node := range nodes {
+			printGoalNode(node, horizon, tasks[node.Goal.EntityID], keyResults[node.Goal.EntityID])
 		}
 		return nil
 	},
 }
 
+// printGoalNode prints a goal indented under its parent, with its completion
+// measured by key results and the roll-up of its child goals and linked
+// tasks. Goals of another horizon than the one shown are labelled with their
+// period.
+func printGoalNode(node service.GoalNode, horizon domain.GoalHorizon, linked []domain.Entry, keyResults []domain.KeyResult) {
+	goal := node.Goal
+	indent := strings.Repeat("  ", node.Depth)
+	status := "  "
+	if goal.IsDone() {
+		status = "x "
+	}
+
+	line := fmt.Sprintf("  %s%s#%-3d %s", indent, status, goal.ID, goal.Content)
+	if goal.GetHorizon() != horizon {
+		line += fmt.Sprintf(" [%s]", goal.PeriodLabel())
+	}
+
+	var progress []string
+	if len(keyResults) > 0 {
+		progress = append(progress, domain.FormatPercent(domain.KeyResultsProgress(keyResults)))
+	}
+	if len(node.Children) > 0 {
+		rollup := node.Progress()
+		progress = append(progress, fmt.Sprintf("%d/%d goals", rollup.Done, rollup.Total))
+	}
+	if len(linked) > 0 {
+		done := domain.CalculateGoalProgress(linked)
+		progress = append(progress, fmt.Sprintf("%d/%d tasks", done.Done, done.Total))
+	}
+	if len(progress) > 0 {
+		line += fmt.Sprintf(" (%s)", strings.Join(progress, ", "))
+	}
+	fmt.Println(line)
+
+	for _, kr := range keyResults {
+		printKeyResult(kr, indent+"      ")
+	}
+	for _, task := range linked {
+		fmt.Printf("  %s       %s %s [%d]\n", indent, task.Type.Symbol(), task.Content, task.ID)
+	}
+}
+
+// parseGoalPeriod reads the --month, --quarter and --year flags, of which
+// at most one may be set, into a horizon and the start of its period. The
+// default is the current month.
+func parseGoalPeriod(monthStr, quarterStr string, year int) (domain.GoalHorizon, time.Time, error) {
+	set := 0
+	for _, given := range []bool{monthStr != "", quarterStr != "", year != 0} {
+		if given {
+			set++
+		}
+	}
+	if set > 1 {
+		return "", time.Time{}, fmt.Errorf("use only one of --month, --quarter and --year")
+	}
+
+	switch {
+	case quarterStr != "":
+		quarter, err := domain.ParseGoalQuarter(quarterStr)
+		return domain.GoalHorizonQuarter, quarter, err
+	case year != 0:
+		if year < 1 || year > 9999 {
+			return "", time.Time{}, fmt.Errorf("invalid year: %d", year)
+		}
+		return domain.GoalHorizonYear, time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC), nil
+	default:
+		month, err := parseGoalMonth(monthStr)
+		return domain.GoalHorizonMonth, month, err
+	}
+}
+
 func parseGoalMonth(monthStr string) (time.Time, error) {
 	if monthStr == "" {
 		now := time.Now()
@@ -79,5 +176,7 @@ func parseGoalID(arg string) (int64, error) {
 
 func init() {
 	goalCmd.Flags().StringVar(&goalMonthFlag, "month", "", "Month in YYYY-MM format (default: current month)")
+	goalCmd.Flags().StringVar(&goalQuarterFlag, "quarter", "", "Quarter in YYYYQN format, e.g. 2026Q3")
+	goalCmd.Flags().IntVar(&goalYearFlag, "year", 0, "Year, e.g. 2026")
 	rootCmd.AddCommand(goalCmd)
 }
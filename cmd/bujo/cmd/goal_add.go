@@ -6,39 +6,64 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
 )
 
-var goalAddMonthFlag string
+var (
+	goalAddMonthFlag   string
+	goalAddQuarterFlag string
+	goalAddYearFlag    int
+	goalAddParentFlag  string
+)
 
 var goalAddCmd = &cobra.Command{
 	Use:   "add <content>",
-	Short: "Add a new monthly goal",
-	Long: `Add a new goal to the specified month (default: current month).
+	Short: "Add a new goal",
+	Long: `Add a new goal to the specified month (default: current month), or to a
+quarter or year with --quarter or --year.
+
+Use --parent to cascade the goal from a longer-horizon goal, such as a
+monthly goal from a quarterly one. The parent's period must contain the
+goal's.
 
 Examples:
   bujo goal add "Learn Go"
   bujo goal add "Read 12 books" --month 2026-02
-  bujo goal add "Ship new feature"`,
+  bujo goal add "Launch v2" --quarter 2026Q3
+  bujo goal add "Grow revenue 20%" --year 2026
+  bujo goal add "Ship the beta" --month 2026-07 --parent #4`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		content := strings.Join(args, " ")
 
-		month, err := parseGoalMonth(goalAddMonthFlag)
+		horizon, period, err := parseGoalPeriod(goalAddMonthFlag, goalAddQuarterFlag, goalAddYearFlag)
 		if err != nil {
 			return err
 		}
 
-		id, err := goalService.CreateGoal(cmd.Context(), content, month)
+		opts := service.CreateGoalOptions{Horizon: horizon, Period: period}
+		if goalAddParentFlag != "" {
+			opts.ParentID, err = parseGoalID(goalAddParentFlag)
+			if err != nil {
+				return err
+			}
+		}
+
+		id, err := goalService.CreateGoalWithOptions(cmd.Context(), content, opts)
 		if err != nil {
 			return fmt.Errorf("failed to create goal: %w", err)
 		}
 
-		fmt.Fprintf(os.Stderr, "Created goal #%d for %s\n", id, month.Format("January 2006"))
+		fmt.Fprintf(os.Stderr, "Created goal #%d for %s\n", id, domain.FormatGoalPeriod(horizon, period))
 		return nil
 	},
 }
 
 func init() {
 	goalAddCmd.Flags().StringVar(&goalAddMonthFlag, "month", "", "Month in YYYY-MM format (default: current month)")
+	goalAddCmd.Flags().StringVar(&goalAddQuarterFlag, "quarter", "", "Quarter in YYYYQN format, e.g. 2026Q3")
+	goalAddCmd.Flags().IntVar(&goalAddYearFlag, "year", 0, "Year, e.g. 2026")
+	goalAddCmd.Flags().StringVar(&goalAddParentFlag, "parent", "", "ID of the quarterly or yearly goal this goal cascades from")
 	goalCmd.AddCommand(goalAddCmd)
 }
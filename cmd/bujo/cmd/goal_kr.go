@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var (
+	goalKRStart  float64
+	goalKRTarget float64
+	goalKRUnit   string
+	goalKRName   string
+)
+
+var goalKRCmd = &cobra.Command{
+	Use:     "kr <#goal-id>",
+	Aliases: []string{"key-results"},
+	Short:   "Show and manage the key results of a goal",
+	Long: `Show the key results of a goal with their progress.
+
+Key results are measurable outcomes such as "reach 1000 subscribers". Each
+moves from a start value towards a target, and the goal's completion is the
+average progress of its key results. Every update records a check-in, so the
+history of a key result can be reviewed later.
+
+Examples:
+  bujo goal kr #1
+  bujo goal kr add #1 "Newsletter subscribers" --start 200 --target 1000 --unit subscribers
+  bujo goal kr update 3 600
+  bujo goal kr history 3`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goalID, err := parseGoalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		goal, err := goalService.GetGoal(cmd.Context(), goalID)
+		if err != nil {
+			return fmt.Errorf("failed to get goal: %w", err)
+		}
+		if goal == nil {
+			return fmt.Errorf("goal not found: %d", goalID)
+		}
+
+		keyResults, err := goalService.GetKeyResults(cmd.Context(), []domain.Goal{*goal})
+		if err != nil {
+			return fmt.Errorf("failed to get key results: %w", err)
+		}
+		krs := keyResults[goal.EntityID]
+		if len(krs) == 0 {
+			fmt.Printf("No key results for goal #%d\n", goalID)
+			return nil
+		}
+
+		fmt.Printf("#%d %s (%s)\n\n", goal.ID, goal.Content, domain.FormatPercent(domain.KeyResultsProgress(krs)))
+		for _, kr := range krs {
+			printKeyResult(kr, "")
+		}
+		return nil
+	},
+}
+
+var goalKRAddCmd = &cobra.Command{
+	Use:   "add <#goal-id> <name>",
+	Short: "Add a key result to a goal",
+	Long: `Add a measurable key result to a goal. It starts at --start, which defaults
+to 0, and is complete once it reaches --target. A target below the start is
+a key result that should go down.
+
+Examples:
+  bujo goal kr add #1 "Newsletter subscribers" --target 1000 --unit subscribers
+  bujo goal kr add #1 "Weight" --start 90 --target 80 --unit kg`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goalID, err := parseGoalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		id, err := goalService.AddKeyResult(cmd.Context(), goalID, service.KeyResultOptions{
+			Name:   strings.Join(args[1:], " "),
+			Start:  goalKRStart,
+			Target: goalKRTarget,
+			Unit:   goalKRUnit,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to add key result: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Added key result %d to goal #%d\n", id, goalID)
+		return nil
+	},
+}
+
+var goalKRUpdateCmd = &cobra.Command{
+	Use:   "update <kr-id> [value]",
+	Short: "Check in a key result's current value",
+	Long: `Record the current value of a key result as a check-in. Use --target or
+--name to change the key result itself.
+
+Examples:
+  bujo goal kr update 3 600
+  bujo goal kr update 3 --target 1200
+  bujo goal kr update 3 650 --name "Paid subscribers"`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseKeyResultID(args[0])
+		if err != nil {
+			return err
+		}
+
+		var opts service.UpdateKeyResultOptions
+		if len(args) == 2 {
+			value, err := strconv.ParseFloat(args[1], 64)
+			if err != nil {
+				return fmt.Errorf("invalid value: %s", args[1])
+			}
+			opts.Current = &value
+		}
+		if cmd.Flags().Changed("target") {
+			opts.Target = &goalKRTarget
+		}
+		if cmd.Flags().Changed("name") {
+			opts.Name = &goalKRName
+		}
+		if opts.Current == nil && opts.Target == nil && opts.Name == nil {
+			return fmt.Errorf("give a value, --target or --name")
+		}
+
+		if err := goalService.UpdateKeyResult(cmd.Context(), id, opts); err != nil {
+			return fmt.Errorf("failed to update key result: %w", err)
+		}
+
+		kr, err := goalService.GetKeyResult(cmd.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to get key result: %w", err)
+		}
+		fmt.Fprintf(os.Stderr, "Updated key result %d: %s (%s)\n", id, kr.Describe(), domain.FormatPercent(kr.Progress()))
+		return nil
+	},
+}
+
+var goalKRDeleteCmd = &cobra.Command{
+	Use:     "delete <kr-id>",
+	Aliases: []string{"rm"},
+	Short:   "Delete a key result and its history",
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseKeyResultID(args[0])
+		if err != nil {
+			return err
+		}
+
+		if err := goalService.DeleteKeyResult(cmd.Context(), id); err != nil {
+			return fmt.Errorf("failed to delete key result: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Deleted key result %d\n", id)
+		return nil
+	},
+}
+
+var goalKRHistoryCmd = &cobra.Command{
+	Use:   "history <kr-id>",
+	Short: "Show the check-ins of a key result",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseKeyResultID(args[0])
+		if err != nil {
+			return err
+		}
+
+		kr, err := goalService.GetKeyResult(cmd.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to get key result: %w", err)
+		}
+		checkIns, err := goalService.GetKeyResultHistory(cmd.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to get key result history: %w", err)
+		}
+
+		fmt.Printf("%s: %s (started at %s)\n", kr.Name, kr.Describe(), domain.FormatHabitValue(kr.Start, kr.Unit))
+		if len(checkIns) == 0 {
+			fmt.Println("No check-ins yet")
+			return nil
+		}
+		for _, checkIn := range checkIns {
+			fmt.Printf("  %s  %s\n", checkIn.CheckedAt.Local().Format("2006-01-02 15:04"), domain.FormatHabitValue(checkIn.Value, kr.Unit))
+		}
+		return nil
+	},
+}
+
+// printKeyResult prints a key result with a bar of its progress.
+func printKeyResult(kr domain.KeyResult, indent string) {
+	fmt.Printf("  %s%s %4s  %s %s [kr %d]\n",
+		indent,
+		domain.ProgressBar(kr.Progress(), 10),
+		domain.FormatPercent(kr.Progress()),
+		kr.Name,
+		kr.Describe(),
+		kr.ID,
+	)
+}
+
+func parseKeyResultID(arg string) (int64, error) {
+	id, err := strconv.ParseInt(strings.TrimPrefix(arg, "kr"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid key result ID: %s", arg)
+	}
+	return id, nil
+}
+
+func init() {
+	goalKRAddCmd.Flags().Float64Var(&goalKRStart, "start", 0, "Value the key result starts from")
+	goalKRAddCmd.Flags().Float64Var(&goalKRTarget, "target", 0, "Value that completes the key result")
+	goalKRAddCmd.Flags().StringVar(&goalKRUnit, "unit", "", "Unit of the values, e.g. subscribers")
+	_ = goalKRAddCmd.MarkFlagRequired("target")
+
+	goalKRUpdateCmd.Flags().Float64Var(&goalKRTarget, "target", 0, "New target value")
+	goalKRUpdateCmd.Flags().StringVar(&goalKRName, "name", "", "New name")
+
+	goalKRCmd.AddCommand(goalKRAddCmd)
+	goalKRCmd.AddCommand(goalKRUpdateCmd)
+	goalKRCmd.AddCommand(goalKRDeleteCmd)
+	goalKRCmd.AddCommand(goalKRHistoryCmd)
+	goalCmd.AddCommand(goalKRCmd)
+}
@@ -0,0 +1,48 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var goalLinkCmd = &cobra.Command{
+	Use:   "link <#goal-id> <entry-id>",
+	Short: "Link a journal task to a goal",
+	Long: `Link a task from the journal to a goal. The goal's progress is computed
+from its linked tasks, and they are listed beneath it by 'bujo goal'.
+
+A task keeps its links when it is migrated, and a goal keeps its tasks when
+it is migrated to another month.
+
+Use 'bujo ls' to see entry IDs.
+
+Examples:
+  bujo goal link #1 42
+  bujo goal link 1 42`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goalID, err := parseGoalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		entryID, err := parseEntryID(args[1])
+		if err != nil {
+			return err
+		}
+
+		err = goalService.LinkEntry(cmd.Context(), goalID, entryID)
+		if err != nil {
+			return fmt.Errorf("failed to link task: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Linked entry %d to goal #%d\n", entryID, goalID)
+		return nil
+	},
+}
+
+func init() {
+	goalCmd.AddCommand(goalLinkCmd)
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var (
+	goalMigrateMonthFlag   string
+	goalMigrateQuarterFlag string
+	goalMigrateYearFlag    int
+)
+
+var goalMigrateCmd = &cobra.Command{
+	Use:   "migrate [#id]",
+	Short: "Carry unfinished goals over to the next period",
+	Long: `Migrate a goal to the period after its own: a monthly goal to the next
+month, a quarterly goal to the next quarter and a yearly goal to the next
+year. The original is marked as migrated and a new active goal is created,
+keeping its linked tasks and, while it still fits, its parent.
+
+Without an ID, every active goal of the period given by --month, --quarter
+or --year is migrated.
+
+Examples:
+  bujo goal migrate #4
+  bujo goal migrate --quarter 2026Q3
+  bujo goal migrate --month 2026-07`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 1 {
+			id, err := parseGoalID(args[0])
+			if err != nil {
+				return err
+			}
+
+			goal, err := goalService.GetGoal(cmd.Context(), id)
+			if err != nil {
+				return fmt.Errorf("failed to get goal: %w", err)
+			}
+			if goal == nil {
+				return fmt.Errorf("goal not found: %d", id)
+			}
+			if goal.Status != domain.GoalStatusActive {
+				return fmt.Errorf("only active goals can be migrated, goal #%d is %s", id, goal.Status)
+			}
+
+			newID, err := goalService.MigrateGoal(cmd.Context(), id, goal.PeriodEnd())
+			if err != nil {
+				return fmt.Errorf("failed to migrate goal: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "Migrated goal #%d → #%d (%s)\n", id, newID, domain.FormatGoalPeriod(goal.GetHorizon(), goal.PeriodEnd()))
+			return nil
+		}
+
+		if goalMigrateMonthFlag == "" && goalMigrateQuarterFlag == "" && goalMigrateYearFlag == 0 {
+			return fmt.Errorf("specify a goal ID or one of --month, --quarter and --year")
+		}
+		horizon, period, err := parseGoalPeriod(goalMigrateMonthFlag, goalMigrateQuarterFlag, goalMigrateYearFlag)
+		if err != nil {
+			return err
+		}
+
+		ids, err := goalService.MigrateUnfinishedGoals(cmd.Context(), horizon, period)
+		if err != nil {
+			return fmt.Errorf("failed to migrate goals: %w", err)
+		}
+
+		next := domain.GoalPeriodEnd(horizon, period)
+		fmt.Fprintf(os.Stderr, "Migrated %d goal(s) from %s to %s\n", len(ids),
+			domain.FormatGoalPeriod(horizon, period), domain.FormatGoalPeriod(horizon, next))
+		return nil
+	},
+}
+
+func init() {
+	goalMigrateCmd.Flags().StringVar(&goalMigrateMonthFlag, "month", "", "Migrate the unfinished goals of a month (YYYY-MM)")
+	goalMigrateCmd.Flags().StringVar(&goalMigrateQuarterFlag, "quarter", "", "Migrate the unfinished goals of a quarter (YYYYQN)")
+	goalMigrateCmd.Flags().IntVar(&goalMigrateYearFlag, "year", 0, "Migrate the unfinished goals of a year")
+	goalCmd.AddCommand(goalMigrateCmd)
+}
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var goalParentNoneFlag bool
+
+var goalParentCmd = &cobra.Command{
+	Use:   "parent <#id> [#parent-id]",
+	Short: "Cascade a goal from a quarterly or yearly goal",
+	Long: `Set the longer-horizon goal a goal cascades from. A monthly goal can have a
+quarterly or yearly parent, and a quarterly goal a yearly one. The parent's
+period must contain the goal's.
+
+Examples:
+  bujo goal parent #7 #4
+  bujo goal parent #7 --none`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseGoalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		if goalParentNoneFlag {
+			if len(args) > 1 {
+				return fmt.Errorf("cannot use --none with a parent ID")
+			}
+			if err := goalService.SetGoalParent(cmd.Context(), id, 0); err != nil {
+				return fmt.Errorf("failed to clear parent: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "Removed the parent of goal #%d\n", id)
+			return nil
+		}
+
+		if len(args) < 2 {
+			return fmt.Errorf("specify a parent goal ID or --none")
+		}
+		parentID, err := parseGoalID(args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := goalService.SetGoalParent(cmd.Context(), id, parentID); err != nil {
+			return fmt.Errorf("failed to set parent: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Goal #%d now cascades from goal #%d\n", id, parentID)
+		return nil
+	},
+}
+
+func init() {
+	goalParentCmd.Flags().BoolVar(&goalParentNoneFlag, "none", false, "Remove the goal's parent")
+	goalCmd.AddCommand(goalParentCmd)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var goalUnlinkCmd = &cobra.Command{
+	Use:   "unlink <#goal-id> <entry-id>",
+	Short: "Unlink a journal task from a goal",
+	Long: `Remove the link between a task and a goal. The task itself is kept.
+
+Examples:
+  bujo goal unlink #1 42`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		goalID, err := parseGoalID(args[0])
+		if err != nil {
+			return err
+		}
+
+		entryID, err := parseEntryID(args[1])
+		if err != nil {
+			return err
+		}
+
+		err = goalService.UnlinkEntry(cmd.Context(), goalID, entryID)
+		if err != nil {
+			return fmt.Errorf("failed to unlink task: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Unlinked entry %d from goal #%d\n", entryID, goalID)
+		return nil
+	},
+}
+
+func init() {
+	goalCmd.AddCommand(goalUnlinkCmd)
+}
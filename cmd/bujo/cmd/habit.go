@@ -8,25 +8,42 @@ import (
 	"github.com/typingincolor/bujo/internal/adapter/cli"
 )
 
-var habitMonth bool
+var (
+	habitMonth    bool
+	habitArchived bool
+)
 
 var habitCmd = &cobra.Command{
 	Use:   "habit",
 	Short: "Display habit tracker",
 	Long: `Display the habit tracker with streaks, completion rates, and history.
 
-By default shows a 7-day sparkline. Use --month for a calendar view.`,
+By default shows a 7-day sparkline. Use --month for a calendar view.
+Archived habits are hidden; use --archived to show them instead.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		days := 7
 		if habitMonth {
 			days = 30
 		}
 
-		status, err := habitService.GetTrackerStatus(cmd.Context(), time.Now(), days)
+		getStatus := habitService.GetTrackerStatus
+		if habitArchived {
+			getStatus = habitService.GetArchivedTrackerStatus
+		}
+		status, err := getStatus(cmd.Context(), time.Now(), days)
 		if err != nil {
 			return fmt.Errorf("failed to get habit status: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaTrackerStatus, cli.NewTrackerStatusJSON(status))
+		}
+
+		if habitArchived && len(status.Habits) == 0 {
+			fmt.Println("No archived habits")
+			return nil
+		}
+
 		if habitMonth {
 			fmt.Print(cli.RenderHabitMonth(status))
 		} else {
@@ -38,5 +55,6 @@ By default shows a 7-day sparkline. Use --month for a calendar view.`,
 
 func init() {
 	habitCmd.Flags().BoolVarP(&habitMonth, "month", "m", false, "Show month calendar view")
+	habitCmd.Flags().BoolVar(&habitArchived, "archived", false, "Show archived habits")
 	rootCmd.AddCommand(habitCmd)
 }
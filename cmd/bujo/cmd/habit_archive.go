@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var habitArchiveCmd = &cobra.Command{
+	Use:   "archive <habit-name|#id>",
+	Short: "Retire a habit, keeping its history",
+	Long: `Archive a habit you no longer track. Its logs are kept for stats and
+exports, but it no longer shows in the tracker and can't be logged until it
+is unarchived. Use 'bujo habit --archived' to list archived habits.
+
+Examples:
+  bujo habit archive Gym
+  bujo habit archive #1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		displayName := args[0]
+
+		if !isID && isPureNumber(args[0]) {
+			fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+			reader := bufio.NewReader(os.Stdin)
+			input, _ := reader.ReadString('\n')
+			confirm := strings.TrimSpace(strings.ToLower(input))
+
+			if confirm == "y" || confirm == "yes" {
+				id, _ = strconv.ParseInt(args[0], 10, 64)
+				isID = true
+				displayName = "#" + args[0]
+			}
+		}
+
+		if isID {
+			err = habitService.ArchiveHabitByID(cmd.Context(), id)
+		} else {
+			err = habitService.ArchiveHabit(cmd.Context(), name)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to archive habit: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "✓ Archived habit '%s'\n", displayName)
+		return nil
+	},
+}
+
+func init() {
+	habitCmd.AddCommand(habitArchiveCmd)
+}
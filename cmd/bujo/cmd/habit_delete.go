@@ -19,6 +19,7 @@ var habitDeleteCmd = &cobra.Command{
 
 This is a destructive action - all logs for this habit will be permanently deleted.
 You will be prompted to confirm unless --force is used.
+To retire a habit but keep its history, use 'bujo habit archive' instead.
 
 Examples:
   bujo habit delete Gym
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var (
+	habitHeatmapYear int
+	habitHeatmapSVG  bool
+)
+
+var habitHeatmapCmd = &cobra.Command{
+	Use:   "heatmap [habit-name|#id]",
+	Short: "Show a year-at-a-glance heatmap of a habit",
+	Long: `Show a year of a habit as a contribution graph: a column per week and a
+row per weekday, shaded by how much of the daily goal was met. The longest
+streak of the year is shown underneath.
+
+Without a habit, an overlay of all habits is shown first, followed by each
+habit. Use --svg to write the heatmap as an SVG image instead.
+
+Examples:
+  bujo habit heatmap Gym
+  bujo habit heatmap #1 --year 2025
+  bujo habit heatmap
+  bujo habit heatmap Run --svg > run.svg`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		today := time.Now()
+		year := habitHeatmapYear
+		if year == 0 {
+			year = today.Year()
+		}
+		if year < 1 || year > today.Year() {
+			return fmt.Errorf("invalid year: %d", year)
+		}
+
+		var heatmaps []service.HabitHeatmap
+		if len(args) == 0 {
+			all, err := habitService.GetHabitHeatmaps(cmd.Context(), year, today)
+			if err != nil {
+				return fmt.Errorf("failed to build heatmap: %w", err)
+			}
+			if len(all) == 0 {
+				return fmt.Errorf("no habits tracked yet")
+			}
+			heatmaps = append([]service.HabitHeatmap{service.OverlayHeatmaps(all, year)}, all...)
+		} else {
+			name, id, isID, err := parseHabitNameOrID(args[0])
+			if err != nil {
+				return err
+			}
+
+			if !isID && isPureNumber(args[0]) {
+				fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+				reader := bufio.NewReader(os.Stdin)
+				input, _ := reader.ReadString('\n')
+				confirm := strings.TrimSpace(strings.ToLower(input))
+
+				if confirm == "y" || confirm == "yes" {
+					id, _ = strconv.ParseInt(args[0], 10, 64)
+					isID = true
+				}
+			}
+
+			var heatmap *service.HabitHeatmap
+			if isID {
+				heatmap, err = habitService.GetHabitHeatmapByID(cmd.Context(), id, year, today)
+			} else {
+				heatmap, err = habitService.GetHabitHeatmap(cmd.Context(), name, year, today)
+			}
+			if err != nil {
+				return fmt.Errorf("failed to build heatmap: %w", err)
+			}
+			heatmaps = []service.HabitHeatmap{*heatmap}
+		}
+
+		if habitHeatmapSVG {
+			fmt.Print(cli.RenderHabitHeatmapsSVG(heatmaps))
+			return nil
+		}
+
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaHabitHeatmap, cli.NewHabitHeatmapsJSON(year, heatmaps))
+		}
+
+		fmt.Print(cli.RenderHabitHeatmaps(heatmaps))
+		return nil
+	},
+}
+
+func init() {
+	habitHeatmapCmd.Flags().IntVar(&habitHeatmapYear, "year", 0, "Year to show (default this year)")
+	habitHeatmapCmd.Flags().BoolVar(&habitHeatmapSVG, "svg", false, "Write the heatmap as an SVG image")
+	habitCmd.AddCommand(habitHeatmapCmd)
+}
@@ -8,13 +8,14 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
 )
 
 var habitLogDate string
 var habitLogYes bool
 
 var habitLogCmd = &cobra.Command{
-	Use:   "log <habit-name|#id> [count]",
+	Use:   "log <habit-name|#id> [count|amount]",
 	Short: "Log a habit completion",
 	Long: `Log a habit completion for today or a specific date.
 
@@ -23,12 +24,18 @@ Use --yes to skip the confirmation and create automatically.
 Count defaults to 1 if not specified.
 Use #<id> to log by habit ID (shown in bujo habit output).
 
+An amount with a decimal or a unit, such as 5.2km or "35 min", logs a
+measured value. A new habit logged with an amount is created as a measured
+habit in that unit.
+
 Examples:
   bujo habit log Gym
   bujo habit log Water 8
   bujo habit log "Morning Run"
   bujo habit log #1              (log by ID)
   bujo habit log #2 5            (log by ID with count)
+  bujo habit log Run 5.2km       (log a measured value)
+  bujo habit log Water 2.5L
   bujo habit log Gym --date yesterday
   bujo habit log Gym -d 2026-01-05
   bujo habit log NewHabit --yes  (create without prompting)`,
@@ -40,10 +47,17 @@ Examples:
 		}
 
 		count := 1
+		var value float64
+		var unit string
+		measured := false
 		if len(args) > 1 {
 			count, err = strconv.Atoi(args[1])
 			if err != nil {
-				return fmt.Errorf("invalid count: %s", args[1])
+				value, unit, err = domain.ParseHabitAmount(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid count: %s", args[1])
+				}
+				measured = true
 			}
 		}
 
@@ -74,7 +88,9 @@ Examples:
 			}
 		}
 
-		if isID {
+		if isID && measured {
+			err = habitService.LogHabitValueByIDForDate(cmd.Context(), id, value, unit, logDate)
+		} else if isID {
 			err = habitService.LogHabitByIDForDate(cmd.Context(), id, count, logDate)
 		} else {
 			var exists bool
@@ -95,16 +111,24 @@ Examples:
 				}
 			}
 
-			err = habitService.LogHabitForDate(cmd.Context(), name, count, logDate)
+			if measured {
+				err = habitService.LogHabitValueForDate(cmd.Context(), name, value, unit, logDate)
+			} else {
+				err = habitService.LogHabitForDate(cmd.Context(), name, count, logDate)
+			}
 		}
 
 		if err != nil {
 			return fmt.Errorf("failed to log habit: %w", err)
 		}
 
+		if measured {
+			displayName = fmt.Sprintf("%s (%s)", displayName, domain.FormatHabitValue(value, unit))
+		}
+
 		if habitLogDate != "" {
 			fmt.Fprintf(os.Stderr, "✓ Logged: %s for %s\n", displayName, habitLogDate)
-		} else if count == 1 {
+		} else if measured || count == 1 {
 			fmt.Fprintf(os.Stderr, "✓ Logged: %s\n", displayName)
 		} else {
 			fmt.Fprintf(os.Stderr, "✓ Logged: %s (x%d)\n", displayName, count)
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	habitPauseFrom string
+	habitPauseTo   string
+	habitPauseAll  bool
+)
+
+var habitPauseCmd = &cobra.Command{
+	Use:   "pause [habit-name|#id]",
+	Short: "Pause a habit for a range of days",
+	Long: `Pause a habit while you are ill or travelling.
+
+Paused days show as ~ in the tracker. They are not counted as missed, so a
+streak carries on across them, and weekly and monthly goals shrink by the
+share of the period that was paused. Use --all instead of a habit name to
+pause every habit (vacation mode). Undo a pause with 'bujo undo'.
+
+Examples:
+  bujo habit pause Gym --to friday
+  bujo habit pause #1 --from 2026-01-05 --to 2026-01-09
+  bujo habit pause --all --from "next monday" --to 2026-08-14`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if habitPauseAll == (len(args) == 1) {
+			return fmt.Errorf("give either a habit name or --all")
+		}
+
+		from := time.Now()
+		if habitPauseFrom != "" {
+			var err error
+			from, err = parseFutureDate(habitPauseFrom)
+			if err != nil {
+				return fmt.Errorf("invalid --from date: %w", err)
+			}
+		}
+		to, err := parseFutureDate(habitPauseTo)
+		if err != nil {
+			return fmt.Errorf("invalid --to date: %w", err)
+		}
+		if err := validateDateRange(from, to); err != nil {
+			return err
+		}
+
+		displayName := "all habits"
+		if habitPauseAll {
+			_, err = habitService.PauseAllHabits(cmd.Context(), from, to)
+		} else {
+			var name string
+			var id int64
+			var isID bool
+			name, id, isID, err = parseHabitNameOrID(args[0])
+			if err != nil {
+				return err
+			}
+			displayName = args[0]
+
+			if isID {
+				_, err = habitService.PauseHabitByID(cmd.Context(), id, from, to)
+			} else {
+				_, err = habitService.PauseHabit(cmd.Context(), name, from, to)
+			}
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to pause habit: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "✓ Paused %s from %s to %s\n", displayName, from.Format("2006-01-02"), to.Format("2006-01-02"))
+		return nil
+	},
+}
+
+func init() {
+	habitPauseCmd.Flags().StringVar(&habitPauseFrom, "from", "", "First paused day (default today)")
+	habitPauseCmd.Flags().StringVar(&habitPauseTo, "to", "", "Last paused day")
+	habitPauseCmd.Flags().BoolVar(&habitPauseAll, "all", false, "Pause every habit")
+	_ = habitPauseCmd.MarkFlagRequired("to")
+	habitCmd.AddCommand(habitPauseCmd)
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var habitSetLimitPer string
+
+var habitSetLimitCmd = &cobra.Command{
+	Use:   "set-limit <name|#id> <max|none>",
+	Short: "Limit a habit to at most a number of logs",
+	Long: `Turn a habit into a limit: something to do less of.
+
+The limit is a ceiling per day, week or month rather than a goal. A period
+is clean when the habit is logged no more than the limit, including not at
+all, and the streak counts clean periods. Days over the limit are shown
+separately from misses. Use a limit of 0 for habits to avoid entirely, and
+"none" to turn the habit back into one with a daily goal.
+
+Examples:
+  bujo habit set-limit Coffee 2
+  bujo habit set-limit Alcohol 0
+  bujo habit set-limit Takeaway 1 --per week
+  bujo habit set-limit Coffee none`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		clearLimit := strings.EqualFold(args[1], "none")
+		limit := 0
+		if !clearLimit {
+			var err error
+			limit, err = strconv.Atoi(args[1])
+			if err != nil || limit < 0 {
+				return fmt.Errorf("invalid limit: %s", args[1])
+			}
+		}
+
+		period := domain.StreakPeriod(strings.ToLower(habitSetLimitPer))
+		switch period {
+		case domain.StreakPeriodDay, domain.StreakPeriodWeek, domain.StreakPeriodMonth:
+		default:
+			return fmt.Errorf("invalid period: %s (use day, week or month)", habitSetLimitPer)
+		}
+
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		displayName := args[0]
+
+		if !isID && isPureNumber(args[0]) {
+			fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+			reader := bufio.NewReader(os.Stdin)
+			input, _ := reader.ReadString('\n')
+			confirm := strings.TrimSpace(strings.ToLower(input))
+
+			if confirm == "y" || confirm == "yes" {
+				id, _ = strconv.ParseInt(args[0], 10, 64)
+				isID = true
+				displayName = "#" + args[0]
+			}
+		}
+
+		switch {
+		case clearLimit && isID:
+			err = habitService.ClearHabitLimitByID(cmd.Context(), id)
+		case clearLimit:
+			err = habitService.ClearHabitLimit(cmd.Context(), name)
+		case isID:
+			err = habitService.SetHabitLimitByID(cmd.Context(), id, limit, period)
+		default:
+			err = habitService.SetHabitLimit(cmd.Context(), name, limit, period)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to set limit: %w", err)
+		}
+
+		if clearLimit {
+			fmt.Fprintf(os.Stderr, "Cleared limit for %s\n", displayName)
+		} else {
+			fmt.Fprintf(os.Stderr, "Set limit for %s to at most %d per %s\n", displayName, limit, period)
+		}
+		return nil
+	},
+}
+
+func init() {
+	habitSetLimitCmd.Flags().StringVar(&habitSetLimitPer, "per", "day", "Period of the limit (day, week or month)")
+	habitCmd.AddCommand(habitSetLimitCmd)
+}
@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var habitSetScheduleCmd = &cobra.Command{
+	Use:   "set-schedule <name|#id> <schedule>",
+	Short: "Set the days a habit is due",
+	Long: `Set which days a habit is due on.
+
+Days outside the schedule are rest days: they show as rest in the tracker
+and do not break a streak. A schedule can be a list of weekdays, "weekdays",
+"weekends", "every other day" or "every N days", optionally with
+"from YYYY-MM-DD" to choose the first day. Use "daily" to clear it.
+
+Examples:
+  bujo habit set-schedule Gym mon,wed,fri
+  bujo habit set-schedule "No screens" weekdays
+  bujo habit set-schedule #1 every 3 days
+  bujo habit set-schedule Stretch every 2 days from 2026-01-05
+  bujo habit set-schedule Gym daily`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		spec := strings.Join(args[1:], " ")
+		schedule, err := domain.ParseHabitSchedule(spec, time.Now())
+		if err != nil {
+			return err
+		}
+
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		displayName := args[0]
+
+		if !isID && isPureNumber(args[0]) {
+			fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+			reader := bufio.NewReader(os.Stdin)
+			input, _ := reader.ReadString('\n')
+			confirm := strings.TrimSpace(strings.ToLower(input))
+
+			if confirm == "y" || confirm == "yes" {
+				id, _ = strconv.ParseInt(args[0], 10, 64)
+				isID = true
+				displayName = "#" + args[0]
+			}
+		}
+
+		if isID {
+			err = habitService.SetHabitScheduleByID(cmd.Context(), id, spec)
+		} else {
+			err = habitService.SetHabitSchedule(cmd.Context(), name, spec)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to set schedule: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Set schedule for %s to %s\n", displayName, schedule.Describe())
+		return nil
+	},
+}
+
+func init() {
+	habitCmd.AddCommand(habitSetScheduleCmd)
+}
@@ -0,0 +1,80 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var habitSetTargetAtMost bool
+
+var habitSetTargetCmd = &cobra.Command{
+	Use:   "set-target <name|#id> <amount>",
+	Short: "Set a measured daily target for a habit",
+	Long: `Set a daily target with a unit, making the habit a measured habit.
+
+Measured habits log decimal values such as 5.2km or "35 min" and meet their
+target when the day's total reaches it. Use --at-most for limits, where the
+day's total must stay at or below the target. A target without a unit keeps
+the habit's current unit.
+
+Examples:
+  bujo habit set-target Run 5km
+  bujo habit set-target Reading "30 min"
+  bujo habit set-target #3 2.5L
+  bujo habit set-target "Screen time" 2h --at-most`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, unit, err := domain.ParseHabitAmount(args[1])
+		if err != nil {
+			return err
+		}
+
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		displayName := args[0]
+
+		if !isID && isPureNumber(args[0]) {
+			fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+			reader := bufio.NewReader(os.Stdin)
+			input, _ := reader.ReadString('\n')
+			confirm := strings.TrimSpace(strings.ToLower(input))
+
+			if confirm == "y" || confirm == "yes" {
+				id, _ = strconv.ParseInt(args[0], 10, 64)
+				isID = true
+				displayName = "#" + args[0]
+			}
+		}
+
+		if isID {
+			err = habitService.SetHabitTargetByID(cmd.Context(), id, args[1], habitSetTargetAtMost)
+		} else {
+			err = habitService.SetHabitTarget(cmd.Context(), name, args[1], habitSetTargetAtMost)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to set target: %w", err)
+		}
+
+		limit := "at least"
+		if habitSetTargetAtMost {
+			limit = "at most"
+		}
+		fmt.Fprintf(os.Stderr, "Set target for %s to %s %s per day\n", displayName, limit, domain.FormatHabitValue(value, unit))
+		return nil
+	},
+}
+
+func init() {
+	habitSetTargetCmd.Flags().BoolVar(&habitSetTargetAtMost, "at-most", false, "Treat the target as a daily maximum")
+	habitCmd.AddCommand(habitSetTargetCmd)
+}
@@ -87,6 +87,10 @@ Examples:
 			return fmt.Errorf("failed to show habit: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaHabitDetails, cli.NewHabitDetailsJSON(details))
+		}
+
 		fmt.Print(cli.RenderHabitInspect(details))
 		return nil
 	},
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var habitSkipDate string
+
+var habitSkipCmd = &cobra.Command{
+	Use:   "skip <habit-name|#id>",
+	Short: "Skip a habit for one day",
+	Long: `Skip a habit for a single day without breaking its streak.
+
+A skipped day shows as ~ in the tracker and is not counted as missed.
+Without --date, today is skipped. Undo a skip with 'bujo undo'.
+
+Examples:
+  bujo habit skip Gym
+  bujo habit skip #1 --date yesterday
+  bujo habit skip Gym -d 2026-01-05`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		date, err := parseDateOrToday(habitSkipDate)
+		if err != nil {
+			return err
+		}
+
+		if isID {
+			_, err = habitService.SkipHabitByID(cmd.Context(), id, date)
+		} else {
+			_, err = habitService.SkipHabit(cmd.Context(), name, date)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to skip habit: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "✓ Skipped %s on %s\n", args[0], date.Format("2006-01-02"))
+		return nil
+	},
+}
+
+func init() {
+	habitSkipCmd.Flags().StringVarP(&habitSkipDate, "date", "d", "", "Date to skip (default today)")
+	habitCmd.AddCommand(habitSkipCmd)
+}
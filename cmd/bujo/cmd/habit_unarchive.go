@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var habitUnarchiveCmd = &cobra.Command{
+	Use:   "unarchive <habit-name|#id>",
+	Short: "Bring an archived habit back into the tracker",
+	Long: `Unarchive a habit so it shows in the tracker and can be logged again.
+
+Examples:
+  bujo habit unarchive Gym
+  bujo habit unarchive #1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name, id, isID, err := parseHabitNameOrID(args[0])
+		if err != nil {
+			return err
+		}
+
+		displayName := args[0]
+
+		if !isID && isPureNumber(args[0]) {
+			fmt.Printf("'%s' looks like an ID. Did you mean to use #%s? [y/N]: ", args[0], args[0])
+			reader := bufio.NewReader(os.Stdin)
+			input, _ := reader.ReadString('\n')
+			confirm := strings.TrimSpace(strings.ToLower(input))
+
+			if confirm == "y" || confirm == "yes" {
+				id, _ = strconv.ParseInt(args[0], 10, 64)
+				isID = true
+				displayName = "#" + args[0]
+			}
+		}
+
+		if isID {
+			err = habitService.UnarchiveHabitByID(cmd.Context(), id)
+		} else {
+			err = habitService.UnarchiveHabit(cmd.Context(), name)
+		}
+
+		if err != nil {
+			return fmt.Errorf("failed to unarchive habit: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "✓ Unarchived habit '%s'\n", displayName)
+		return nil
+	},
+}
+
+func init() {
+	habitCmd.AddCommand(habitUnarchiveCmd)
+}
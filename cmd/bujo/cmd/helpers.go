@@ -9,9 +9,16 @@ import (
 	"strings"
 	"time"
 
+	"github.com/typingincolor/bujo/internal/adapter/cli"
 	"github.com/typingincolor/bujo/internal/dateutil"
 )
 
+// writeOutput prints data as JSON or NDJSON. Read commands call it instead
+// of their text renderer when --output asks for machine-readable output.
+func writeOutput(schema string, data any) error {
+	return cli.WriteOutput(os.Stdout, outputFormat, schema, data)
+}
+
 func parseEntryID(s string) (int64, error) {
 	id, err := strconv.ParseInt(s, 10, 64)
 	if err != nil {
@@ -83,7 +90,7 @@ func validateDateRange(from, to time.Time) error {
 	return nil
 }
 
-func parseAddArgs(args []string) (entries []string, location, date, file, parent string, help, yes bool) {
+func parseAddArgs(args []string) (entries []string, location, date, file, parent, every string, help, yes bool) {
 	for i := 0; i < len(args); i++ {
 		arg := args[i]
 		switch {
@@ -107,6 +114,11 @@ func parseAddArgs(args []string) (entries []string, location, date, file, parent
 				parent = args[i+1]
 				i++
 			}
+		case arg == "-e" || arg == "--every":
+			if i+1 < len(args) {
+				every = args[i+1]
+				i++
+			}
 		case arg == "-y" || arg == "--yes":
 			yes = true
 		case strings.HasPrefix(arg, "-a="):
@@ -125,6 +137,10 @@ func parseAddArgs(args []string) (entries []string, location, date, file, parent
 			parent = arg[3:]
 		case strings.HasPrefix(arg, "--parent="):
 			parent = arg[9:]
+		case strings.HasPrefix(arg, "-e="):
+			every = arg[3:]
+		case strings.HasPrefix(arg, "--every="):
+			every = arg[8:]
 		case arg == "-h" || arg == "--help":
 			help = true
 			return
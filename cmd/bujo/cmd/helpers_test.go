@@ -107,6 +107,7 @@ func TestParseAddArgs(t *testing.T) {
 		wantDate     string
 		wantFile     string
 		wantParent   string
+		wantEvery    string
 		wantHelp     bool
 		wantYes      bool
 	}{
@@ -247,11 +248,29 @@ func TestParseAddArgs(t *testing.T) {
 			wantParent:   "123",
 			wantLocation: "Home",
 		},
+		{
+			name:        "with every flag",
+			args:        []string{"--every", "mon,wed", ". Gym"},
+			wantEntries: []string{". Gym"},
+			wantEvery:   "mon,wed",
+		},
+		{
+			name:        "with short every flag",
+			args:        []string{"-e", "2nd tue", "o Book club"},
+			wantEntries: []string{"o Book club"},
+			wantEvery:   "2nd tue",
+		},
+		{
+			name:        "with every flag equals syntax",
+			args:        []string{"--every=daily", ". Water plants"},
+			wantEntries: []string{". Water plants"},
+			wantEvery:   "daily",
+		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			entries, location, date, file, parent, help, yes := parseAddArgs(tt.args)
+			entries, location, date, file, parent, every, help, yes := parseAddArgs(tt.args)
 
 			if help != tt.wantHelp {
 				t.Errorf("parseAddArgs() help = %v, want %v", help, tt.wantHelp)
@@ -271,6 +290,9 @@ func TestParseAddArgs(t *testing.T) {
 			if parent != tt.wantParent {
 				t.Errorf("parseAddArgs() parent = %q, want %q", parent, tt.wantParent)
 			}
+			if every != tt.wantEvery {
+				t.Errorf("parseAddArgs() every = %q, want %q", every, tt.wantEvery)
+			}
 			if len(entries) != len(tt.wantEntries) {
 				t.Errorf("parseAddArgs() entries = %v, want %v", entries, tt.wantEntries)
 				return
@@ -7,34 +7,48 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
 )
 
 var historyCmd = &cobra.Command{
 	Use:   "history",
 	Short: "View and manage version history",
-	Long: `View and manage version history for list items.
+	Long: `View and manage version history for entries, goals, habits and list items.
 
-The event sourcing system keeps track of all changes to list items,
-allowing you to see previous versions and restore them if needed.`,
+Every change is kept as a new version, up to the 50 most recent per item,
+so you can see what changed and restore an earlier version if needed.`,
 }
 
 var historyShowCmd = &cobra.Command{
-	Use:   "show <entity-id>",
+	Use:   "show <entity-id|entry-id>",
 	Short: "Show version history for an item",
-	Long: `Display all versions of a list item.
+	Long: `Display all versions of an entry, goal, habit or list item, with the
+fields that changed in each version.
 
-The entity-id is the UUID that identifies the item across versions.
-You can find entity IDs in the database or from list item details.`,
+The entity-id is the UUID that identifies the item across versions; it is
+shown as entity_id in --output json. Journal entries can also be given by
+their entry ID, even after they have been deleted.
+
+A list item scheduled into the journal shares its entity-id with the entry
+it became; use --kind entry or --kind item to choose which history to show.
+
+Examples:
+  bujo history show 42
+  bujo history show 5f0c6f4e-8a53-4c1e-9a87-1f4ad6f1d0b2
+  bujo history show 5f0c6f4e-8a53-4c1e-9a87-1f4ad6f1d0b2 --kind entry`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		entityID := domain.EntityID(args[0])
+		kind, entityID, err := resolveHistoryEntityID(cmd, args[0])
+		if err != nil {
+			return err
+		}
 
-		history, err := historyService.GetItemHistory(cmd.Context(), entityID)
+		history, err := historyService.GetHistory(cmd.Context(), kind, entityID)
 		if err != nil {
 			return fmt.Errorf("failed to get history: %w", err)
 		}
 
-		if len(history) == 0 {
+		if history == nil {
 			fmt.Println("No history found for this entity.")
 			return nil
 		}
@@ -43,24 +57,28 @@ You can find entity IDs in the database or from list item details.`,
 		gray := color.New(color.FgHiBlack).SprintFunc()
 		green := color.New(color.FgGreen).SprintFunc()
 		yellow := color.New(color.FgYellow).SprintFunc()
+		red := color.New(color.FgRed).SprintFunc()
 
-		fmt.Printf("History for %s\n", cyan(string(entityID)))
+		fmt.Printf("History for %s %s\n", history.Kind, cyan(string(entityID)))
 		fmt.Println(gray("---------------------------------------------------------"))
 
-		for _, item := range history {
+		for _, v := range history.Versions {
 			status := ""
-			if item.IsCurrent() {
-				status = green(" (current)")
-			} else if item.IsDeleted() {
+			if v.IsDeleted() {
 				status = yellow(" (deleted)")
+			} else if v.IsCurrent() {
+				status = green(" (current)")
 			}
 
 			fmt.Printf("v%d %s %s%s\n",
-				item.Version,
-				gray(item.ValidFrom.Format("2006-01-02 15:04:05")),
-				item.Content,
+				v.Version,
+				gray(v.ValidFrom.Local().Format("2006-01-02 15:04:05")),
+				v.Summary,
 				status,
 			)
+			for _, change := range v.Changes {
+				fmt.Printf("    %s: %s → %s\n", change.Field, red(change.Before), green(change.After))
+			}
 		}
 
 		return nil
@@ -68,33 +86,77 @@ You can find entity IDs in the database or from list item details.`,
 }
 
 var historyRestoreCmd = &cobra.Command{
-	Use:   "restore <entity-id> <version>",
+	Use:   "restore <entity-id|entry-id> [version]",
 	Short: "Restore an item to a previous version",
-	Long: `Restore a list item to a previous version.
+	Long: `Restore an entry, goal, habit or list item to a previous version.
 
 This creates a new version with the content from the specified version.
-The original history is preserved.`,
-	Args: cobra.ExactArgs(2),
-	RunE: func(cmd *cobra.Command, args []string) error {
-		entityID := domain.EntityID(args[0])
+The original history is preserved. Without a version, the item goes back
+to the version before its last change.
+
+Restoring a deleted entry brings it back under its old ID, together with
+the children that were deleted with it. Use --kind as with history show.
 
-		version, err := strconv.Atoi(args[1])
+Examples:
+  bujo history restore 42
+  bujo history restore 42 3
+  bujo history restore 5f0c6f4e-8a53-4c1e-9a87-1f4ad6f1d0b2 --kind item`,
+	Args: cobra.RangeArgs(1, 2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		kind, entityID, err := resolveHistoryEntityID(cmd, args[0])
 		if err != nil {
-			return fmt.Errorf("invalid version number: %s", args[1])
+			return err
+		}
+
+		version := 0
+		if len(args) == 2 {
+			version, err = strconv.Atoi(args[1])
+			if err != nil || version < 1 {
+				return fmt.Errorf("invalid version number: %s", args[1])
+			}
 		}
 
-		err = historyService.RestoreItem(cmd.Context(), entityID, version)
+		restored, err := historyService.Restore(cmd.Context(), kind, entityID, version)
 		if err != nil {
 			return fmt.Errorf("failed to restore: %w", err)
 		}
 
 		green := color.New(color.FgGreen).SprintFunc()
-		fmt.Printf("%s Restored to version %d\n", green("OK"), version)
+		fmt.Printf("%s Restored to version %d\n", green("OK"), restored)
 		return nil
 	},
 }
 
+// resolveHistoryEntityID accepts either an entity ID or a numeric entry ID,
+// along with the kind given by --kind. An entry ID always means an entry.
+func resolveHistoryEntityID(cmd *cobra.Command, arg string) (service.HistoryKind, domain.EntityID, error) {
+	var kind service.HistoryKind
+	if value, _ := cmd.Flags().GetString("kind"); value != "" {
+		parsed, err := service.ParseHistoryKind(value)
+		if err != nil {
+			return "", "", err
+		}
+		kind = parsed
+	}
+
+	if _, err := strconv.ParseInt(arg, 10, 64); err != nil {
+		return kind, domain.EntityID(arg), nil
+	}
+
+	if kind != "" && kind != service.HistoryKindEntry {
+		return "", "", fmt.Errorf("%s is an entry ID; give the entity-id of a %s instead", arg, kind)
+	}
+	id, err := parseEntryID(arg)
+	if err != nil {
+		return "", "", err
+	}
+	entityID, err := historyService.ResolveEntryEntityID(cmd.Context(), id)
+	return service.HistoryKindEntry, entityID, err
+}
+
 func init() {
+	historyShowCmd.Flags().String("kind", "", "Kind of item: entry, goal, habit or item")
+	historyRestoreCmd.Flags().String("kind", "", "Kind of item: entry, goal, habit or item")
 	historyCmd.AddCommand(historyShowCmd)
 	historyCmd.AddCommand(historyRestoreCmd)
 	rootCmd.AddCommand(historyCmd)
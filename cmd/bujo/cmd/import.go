@@ -70,6 +70,8 @@ func runImport(cmd *cobra.Command, args []string) error {
 	fmt.Fprintf(os.Stderr, "  Lists:       %d\n", len(data.Lists))
 	fmt.Fprintf(os.Stderr, "  List Items:  %d\n", len(data.ListItems))
 	fmt.Fprintf(os.Stderr, "  Goals:       %d\n", len(data.Goals))
+	fmt.Fprintf(os.Stderr, "  Key Results: %d\n", len(data.KeyResults))
+	fmt.Fprintf(os.Stderr, "  Templates:   %d\n", len(data.ListTemplates))
 
 	return nil
 }
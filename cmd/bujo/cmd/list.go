@@ -5,6 +5,7 @@ import (
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
 )
 
 var listCmd = &cobra.Command{
@@ -22,6 +23,18 @@ Without subcommands, shows all lists with their progress.`,
 			return fmt.Errorf("failed to get lists: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			summaries := make([]cli.ListSummaryJSON, 0, len(lists))
+			for _, list := range lists {
+				summary, err := listService.GetListSummary(cmd.Context(), list.ID)
+				if err != nil {
+					return err
+				}
+				summaries = append(summaries, cli.NewListSummaryJSON(summary))
+			}
+			return writeOutput(cli.SchemaListSummary, summaries)
+		}
+
 		if len(lists) == 0 {
 			fmt.Println("No lists yet. Create one with: bujo list create <name>")
 			return nil
@@ -43,6 +56,9 @@ Without subcommands, shows all lists with their progress.`,
 			if summary.TotalItems > 0 {
 				progress = fmt.Sprintf(" %d/%d done", summary.DoneItems, summary.TotalItems)
 			}
+			if list.IsSmart() {
+				progress += " (smart: " + list.Query + ")"
+			}
 
 			fmt.Printf("#%d %s%s\n", list.ID, cyan(list.Name), gray(progress))
 		}
@@ -6,6 +6,13 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var (
+	listAddParentFlag   string
+	listAddPriorityFlag string
+	listAddDueFlag      string
 )
 
 var listAddCmd = &cobra.Command{
@@ -21,7 +28,10 @@ Items are added as tasks by default. Use entry type symbols to specify type:
 Examples:
   bujo list add Shopping "Buy milk"
   bujo list add #1 ". Buy bread"
-  bujo list add Work "- Important note"`,
+  bujo list add Work "- Important note"
+  bujo list add Shopping "Semi-skimmed" --parent 42
+  bujo list add Work "Renew passport" --priority high
+  bujo list add Errands "Return library books" --due friday`,
 	Args: cobra.MinimumNArgs(2),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		ctx := cmd.Context()
@@ -39,7 +49,27 @@ Examples:
 			content = content[2:]
 		}
 
-		id, err := listService.AddItem(ctx, listID, entryType, content)
+		priority, err := domain.ParsePriority(listAddPriorityFlag)
+		if err != nil {
+			return err
+		}
+
+		opts := service.ListItemOptions{Priority: priority}
+		if listAddParentFlag != "" {
+			opts.ParentID, err = parseEntryID(listAddParentFlag)
+			if err != nil {
+				return err
+			}
+		}
+		if listAddDueFlag != "" {
+			due, err := parseFutureDate(listAddDueFlag)
+			if err != nil {
+				return err
+			}
+			opts.DueDate = &due
+		}
+
+		id, err := listService.AddItemWithOptions(ctx, listID, entryType, content, opts)
 		if err != nil {
 			return fmt.Errorf("failed to add item: %w", err)
 		}
@@ -50,5 +80,8 @@ Examples:
 }
 
 func init() {
+	listAddCmd.Flags().StringVar(&listAddParentFlag, "parent", "", "ID of the list item to nest this item under")
+	listAddCmd.Flags().StringVarP(&listAddPriorityFlag, "priority", "p", "", "Set priority (none, low, medium, high)")
+	listAddCmd.Flags().StringVar(&listAddDueFlag, "due", "", "Date the item is due (e.g. friday, next week, 2026-11-01)")
 	listCmd.AddCommand(listAddCmd)
 }
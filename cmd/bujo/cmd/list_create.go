@@ -5,20 +5,51 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var (
+	listCreateTemplate string
+	listCreateQuery    string
+	listCreateDate     string
+	listCreateVars     []string
 )
 
 var listCreateCmd = &cobra.Command{
-	Use:   "create <name>",
-	Short: "Create a new list",
-	Long: `Create a new list with the given name.
+	Use:     "create <name>",
+	Aliases: []string{"new"},
+	Short:   "Create a new list",
+	Long: `Create a new list with the given name, optionally filled with the items
+of a template (see "bujo list template"). Template variables such as
+{{date}} are filled in from --date, which defaults to today, and --var.
+
+With --query the list is a smart list: instead of items of its own, it
+shows the journal entries matching the query, kept up to date as the
+journal changes. The query uses the same language as "bujo search", with
+status:open|done|cancelled and priority:none|low|medium|high filters.
 
 Names can include spaces if quoted:
-  bujo list create "Shopping List"`,
+  bujo list create "Shopping List"
+  bujo list new "Release {{version}}" --from-template release --var version=1.4.0
+  bujo list create "Waiting on others" --query "#waiting status:open"`,
 	Args: cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		name := strings.Join(args, " ")
 
-		list, err := listService.CreateList(cmd.Context(), name)
+		if listCreateQuery != "" && listCreateTemplate != "" {
+			return fmt.Errorf("a list cannot have both --query and --from-template")
+		}
+
+		var list *domain.List
+		var err error
+		switch {
+		case listCreateQuery != "":
+			list, err = listService.CreateSmartList(cmd.Context(), name, listCreateQuery)
+		case listCreateTemplate != "":
+			list, err = createListFromTemplate(cmd, name)
+		default:
+			list, err = listService.CreateList(cmd.Context(), name)
+		}
 		if err != nil {
 			return fmt.Errorf("failed to create list: %w", err)
 		}
@@ -28,6 +59,30 @@ Names can include spaces if quoted:
 	},
 }
 
+func createListFromTemplate(cmd *cobra.Command, name string) (*domain.List, error) {
+	date, err := parseDateOrToday(listCreateDate)
+	if err != nil {
+		return nil, err
+	}
+
+	vars, err := templateVars(date, listCreateVars)
+	if err != nil {
+		return nil, err
+	}
+
+	// The list's name can use the template's variables too.
+	name, err = domain.ExpandTemplate(name, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	return listService.CreateListFromTemplate(cmd.Context(), name, listCreateTemplate, vars)
+}
+
 func init() {
+	listCreateCmd.Flags().StringVar(&listCreateTemplate, "from-template", "", "Fill the list with the items of a template")
+	listCreateCmd.Flags().StringVarP(&listCreateQuery, "query", "q", "", "Make a smart list of the journal entries matching a search query")
+	listCreateCmd.Flags().StringVarP(&listCreateDate, "date", "d", "", "Date for the template's date variables (default: today)")
+	listCreateCmd.Flags().StringArrayVar(&listCreateVars, "var", nil, "Template variable as name=value (repeatable)")
 	listCmd.AddCommand(listCreateCmd)
 }
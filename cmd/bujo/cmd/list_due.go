@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var listDueCmd = &cobra.Command{
+	Use:   "due <item-id> <date|none>",
+	Short: "Set the due date of a list item",
+	Long: `Set the day a list item is due, or clear it with "none".
+
+Open items past their due date are shown as overdue in the list and under
+the overdue section of "bujo today" and "bujo ls".
+
+Examples:
+  bujo list due 42 friday
+  bujo list due 42 "next week"
+  bujo list due 42 2026-11-01
+  bujo list due 42 none`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		itemID, err := parseEntryID(args[0])
+		if err != nil {
+			return err
+		}
+
+		dateStr := strings.Join(args[1:], " ")
+		var due *time.Time
+		if !strings.EqualFold(dateStr, "none") {
+			date, err := parseFutureDate(dateStr)
+			if err != nil {
+				return err
+			}
+			due = &date
+		}
+
+		if err := listService.SetItemDueDate(cmd.Context(), itemID, due); err != nil {
+			return fmt.Errorf("failed to set due date: %w", err)
+		}
+
+		if due == nil {
+			fmt.Println("Due date cleared")
+			return nil
+		}
+		fmt.Printf("Due %s\n", due.Format("Monday, Jan 2, 2006"))
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listDueCmd)
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	listMoveItemBefore   string
+	listMoveItemAfter    string
+	listMoveItemParent   string
+	listMoveItemTopLevel bool
+)
+
+var listMoveItemCmd = &cobra.Command{
+	Use:   "move-item <item-id>",
+	Short: "Reorder or nest an item within its list",
+	Long: `Move an item, together with its sub-items, within its list.
+
+--before and --after place the item next to another item, at that item's
+level. --parent nests it as the last sub-item of another item, and
+--top-level moves it to the end of the list's top-level items.
+
+Examples:
+  bujo list move-item 42 --before 40
+  bujo list move-item 42 --after 41
+  bujo list move-item 42 --parent 39
+  bujo list move-item 42 --top-level`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		itemID, err := parseEntryID(args[0])
+		if err != nil {
+			return err
+		}
+
+		set := 0
+		for _, changed := range []bool{listMoveItemBefore != "", listMoveItemAfter != "", listMoveItemParent != "", listMoveItemTopLevel} {
+			if changed {
+				set++
+			}
+		}
+		if set != 1 {
+			return fmt.Errorf("provide exactly one of --before, --after, --parent or --top-level")
+		}
+
+		switch {
+		case listMoveItemTopLevel:
+			err = listService.SetItemParent(ctx, itemID, 0)
+		case listMoveItemParent != "":
+			var parentID int64
+			if parentID, err = parseEntryID(listMoveItemParent); err != nil {
+				return err
+			}
+			err = listService.SetItemParent(ctx, itemID, parentID)
+		default:
+			var targetID int64
+			target := listMoveItemBefore + listMoveItemAfter
+			if targetID, err = parseEntryID(target); err != nil {
+				return err
+			}
+			if listMoveItemBefore != "" {
+				err = listService.MoveItemBefore(ctx, itemID, targetID)
+			} else {
+				err = listService.MoveItemAfter(ctx, itemID, targetID)
+			}
+		}
+		if err != nil {
+			return fmt.Errorf("failed to move item: %w", err)
+		}
+
+		fmt.Println("Item moved")
+		return nil
+	},
+}
+
+func init() {
+	listMoveItemCmd.Flags().StringVar(&listMoveItemBefore, "before", "", "ID of the item to place this item before")
+	listMoveItemCmd.Flags().StringVar(&listMoveItemAfter, "after", "", "ID of the item to place this item after")
+	listMoveItemCmd.Flags().StringVar(&listMoveItemParent, "parent", "", "ID of the item to nest this item under")
+	listMoveItemCmd.Flags().BoolVar(&listMoveItemTopLevel, "top-level", false, "Make the item a top-level item")
+	listCmd.AddCommand(listMoveItemCmd)
+}
@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+var listPriorityCmd = &cobra.Command{
+	Use:   "priority <item-id> <none|low|medium|high>",
+	Short: "Set the priority of a list item",
+	Long: `Set the priority of a list item.
+
+Examples:
+  bujo list priority 42 high
+  bujo list priority 42 none`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		itemID, err := parseEntryID(args[0])
+		if err != nil {
+			return err
+		}
+
+		priority, err := domain.ParsePriority(args[1])
+		if err != nil {
+			return err
+		}
+
+		if err := listService.SetItemPriority(cmd.Context(), itemID, priority); err != nil {
+			return fmt.Errorf("failed to set priority: %w", err)
+		}
+
+		fmt.Println("Priority updated")
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listPriorityCmd)
+}
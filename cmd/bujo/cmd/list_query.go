@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var listQueryCmd = &cobra.Command{
+	Use:   "query <list> <query>",
+	Short: "Change a smart list's query",
+	Long: `Change the search query a smart list's items come from.
+
+Examples:
+  bujo list query "Waiting on others" "#waiting @sam status:open"
+  bujo list query #3 priority:high status:open`,
+	Args: cobra.MinimumNArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		ctx := cmd.Context()
+
+		listID, err := resolveListID(ctx, args[0])
+		if err != nil {
+			return err
+		}
+
+		query := strings.Join(args[1:], " ")
+
+		if err := listService.SetSmartListQuery(ctx, listID, query); err != nil {
+			return fmt.Errorf("failed to change query: %w", err)
+		}
+
+		fmt.Printf("Smart list now shows: %s\n", query)
+		return nil
+	},
+}
+
+func init() {
+	listCmd.AddCommand(listQueryCmd)
+}
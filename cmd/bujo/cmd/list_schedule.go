@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+var listScheduleDate string
+var listScheduleYes bool
+
+var listScheduleCmd = &cobra.Command{
+	Use:   "schedule <item-id> [--date <date>]",
+	Short: "Schedule a list item onto a day",
+	Long: `Move a task from a list into the journal, for today or the day given
+with --date.
+
+The item stays in its list marked as scheduled (<), so you can see what
+has been taken from the list. The new task keeps the item's priority and
+history. Only open tasks can be scheduled.
+
+Examples:
+  bujo list schedule 42
+  bujo list schedule 42 --date tomorrow
+  bujo list schedule 42 --date "next monday"`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		itemID, err := parseEntryID(args[0])
+		if err != nil {
+			return err
+		}
+
+		date := time.Now()
+		if listScheduleDate != "" {
+			date, err = parseFutureDate(listScheduleDate)
+			if err != nil {
+				return err
+			}
+			date, err = confirmDate(listScheduleDate, date, listScheduleYes)
+			if err != nil {
+				return err
+			}
+		}
+
+		entryID, err := listService.ScheduleItem(cmd.Context(), itemID, date)
+		if err != nil {
+			return fmt.Errorf("failed to schedule item: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "✓ Scheduled item #%d → #%d (scheduled for %s)\n",
+			itemID, entryID, date.Format("Jan 2, 2006"))
+		return nil
+	},
+}
+
+func init() {
+	listScheduleCmd.Flags().StringVarP(&listScheduleDate, "date", "d", "", "Date to schedule the item for (default: today)")
+	listScheduleCmd.Flags().BoolVarP(&listScheduleYes, "yes", "y", false, "Skip date confirmation prompt")
+	listCmd.AddCommand(listScheduleCmd)
+}
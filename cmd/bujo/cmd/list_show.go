@@ -2,9 +2,12 @@ package cmd
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
 	"github.com/typingincolor/bujo/internal/domain"
 )
 
@@ -13,6 +16,9 @@ var listShowCmd = &cobra.Command{
 	Short: "Show items in a list",
 	Long: `Display all items in a list.
 
+Items with a due date show it, in red once they are overdue. A smart list
+shows the journal entries currently matching its query.
+
 Examples:
   bujo list show Shopping
   bujo list show #1`,
@@ -35,11 +41,24 @@ Examples:
 			return fmt.Errorf("failed to get list items: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			summary, err := listService.GetListSummary(ctx, listID)
+			if err != nil {
+				return err
+			}
+			return writeOutput(cli.SchemaList, cli.NewListJSON(summary, items))
+		}
+
 		cyan := color.New(color.FgCyan).SprintFunc()
 		gray := color.New(color.FgHiBlack).SprintFunc()
 		green := color.New(color.FgGreen).SprintFunc()
+		red := color.New(color.FgRed).SprintFunc()
 
 		fmt.Printf("#%d %s\n", list.ID, cyan(list.Name))
+		if list.IsSmart() {
+			fmt.Println(gray("Smart list: " + list.Query))
+			fmt.Println(gray("Items are journal entries; use their IDs with bujo done, bujo edit and so on."))
+		}
 		fmt.Println(gray("---------------------------------------------------------"))
 
 		if len(items) == 0 {
@@ -47,6 +66,7 @@ Examples:
 			return nil
 		}
 
+		today := time.Now()
 		for _, item := range items {
 			symbol := item.Type.Symbol()
 			content := item.Content
@@ -54,8 +74,23 @@ Examples:
 			if item.Type == domain.ListItemTypeDone {
 				content = green(content)
 			}
+			if item.IsScheduled() {
+				content = gray(content)
+			}
+			if priority := item.Priority.Symbol(); priority != "" {
+				content = red(priority) + " " + content
+			}
 
-			fmt.Printf("%s %s %s\n", gray(fmt.Sprintf("(%d)", item.RowID)), symbol, content)
+			if due := cli.ListItemDue(item, today); due != "" {
+				content += " " + due
+			}
+
+			id := item.RowID
+			if list.IsSmart() {
+				id = item.EntryID
+			}
+			indent := strings.Repeat("  ", item.Depth)
+			fmt.Printf("%s%s %s %s\n", indent, gray(fmt.Sprintf("(%d)", id)), symbol, content)
 		}
 
 		summary, _ := listService.GetListSummary(ctx, listID)
@@ -0,0 +1,181 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var (
+	listTemplateSaveName string
+	listTemplateLogDate  string
+	listTemplateLogVars  []string
+)
+
+var listTemplateCmd = &cobra.Command{
+	Use:     "template",
+	Aliases: []string{"templates"},
+	Short:   "Show and manage list templates",
+	Long: `Show saved list templates.
+
+A template is a reusable checklist, such as a release checklist or a packing
+list. Save an existing list as a template, then create a fresh list from it
+with "bujo list new <name> --from-template <template>", or log its items
+into the journal as a task tree with "bujo list template log <template>".
+
+Templates can contain variables, written {{name}}, that are filled in when
+the template is used: {{date}}, {{weekday}}, {{month}} and {{year}} come
+from the date, and any others are given with --var name=value.
+
+Examples:
+  bujo list template
+  bujo list template save Release
+  bujo list template show Release
+  bujo list template log Release --var version=1.4.0`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		templates, err := listService.GetTemplates(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to get templates: %w", err)
+		}
+
+		if len(templates) == 0 {
+			fmt.Println("No templates yet. Save a list as one with: bujo list template save <list>")
+			return nil
+		}
+
+		cyan := color.New(color.FgCyan).SprintFunc()
+		gray := color.New(color.FgHiBlack).SprintFunc()
+
+		fmt.Println("Templates")
+		fmt.Println(gray("---------------------------------------------------------"))
+		for _, template := range templates {
+			fmt.Printf("%s %s\n", cyan(template.Name), gray(fmt.Sprintf("%d items", template.ItemCount())))
+		}
+		return nil
+	},
+}
+
+var listTemplateSaveCmd = &cobra.Command{
+	Use:   "save <list>",
+	Short: "Save a list as a template",
+	Long: `Save a list's items, with their nesting and priorities, as a template.
+Every item is saved as an open task. The template is named after the list
+unless --name is given, and saving again replaces the template.
+
+Examples:
+  bujo list template save Release
+  bujo list template save #2 --name packing`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		listID, err := resolveListID(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		template, err := listService.SaveTemplate(cmd.Context(), listID, listTemplateSaveName)
+		if err != nil {
+			return fmt.Errorf("failed to save template: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Saved template %s\n", template.Name)
+		return nil
+	},
+}
+
+var listTemplateShowCmd = &cobra.Command{
+	Use:   "show <template>",
+	Short: "Show a template's items",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		template, err := listService.GetTemplate(cmd.Context(), args[0])
+		if err != nil {
+			return err
+		}
+
+		fmt.Print(template.Content)
+		return nil
+	},
+}
+
+var listTemplateDeleteCmd = &cobra.Command{
+	Use:   "delete <template>",
+	Short: "Delete a template",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if err := listService.DeleteTemplate(cmd.Context(), args[0]); err != nil {
+			return fmt.Errorf("failed to delete template: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Deleted template %s\n", args[0])
+		return nil
+	},
+}
+
+var listTemplateLogCmd = &cobra.Command{
+	Use:   "log <template>",
+	Short: "Log a template's items into the journal",
+	Long: `Add a template's items to the journal as a tree of tasks, for today or
+the day given with --date.
+
+Examples:
+  bujo list template log Release --var version=1.4.0
+  bujo list template log Onboarding --date 2026-11-02 --var name=Sam`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		date, err := parseDateOrToday(listTemplateLogDate)
+		if err != nil {
+			return err
+		}
+
+		vars, err := templateVars(date, listTemplateLogVars)
+		if err != nil {
+			return err
+		}
+
+		content, err := listService.ExpandTemplate(cmd.Context(), args[0], vars)
+		if err != nil {
+			return err
+		}
+
+		ids, err := bujoService.LogEntries(cmd.Context(), content, service.LogEntriesOptions{Date: date})
+		if err != nil {
+			return fmt.Errorf("failed to log template: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Logged %d entries from template %s for %s\n", len(ids), args[0], date.Format("2006-01-02"))
+		return nil
+	},
+}
+
+// templateVars returns the variables for using a template on date, with
+// any given as name=value added to or overriding the built-in ones.
+func templateVars(date time.Time, assignments []string) (map[string]string, error) {
+	vars := domain.TemplateVariables(date)
+	for _, assignment := range assignments {
+		name, value, ok := strings.Cut(assignment, "=")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid template variable %q: use name=value", assignment)
+		}
+		vars[strings.TrimSpace(name)] = value
+	}
+	return vars, nil
+}
+
+func init() {
+	listTemplateSaveCmd.Flags().StringVar(&listTemplateSaveName, "name", "", "Name of the template (default: the list's name)")
+	listTemplateLogCmd.Flags().StringVarP(&listTemplateLogDate, "date", "d", "", "Date to log the entries on (default: today)")
+	listTemplateLogCmd.Flags().StringArrayVar(&listTemplateLogVars, "var", nil, "Template variable as name=value (repeatable)")
+
+	listTemplateCmd.AddCommand(listTemplateSaveCmd)
+	listTemplateCmd.AddCommand(listTemplateShowCmd)
+	listTemplateCmd.AddCommand(listTemplateDeleteCmd)
+	listTemplateCmd.AddCommand(listTemplateLogCmd)
+	listCmd.AddCommand(listTemplateCmd)
+}
@@ -62,7 +62,16 @@ Examples:
 			return fmt.Errorf("failed to get overdue: %w", err)
 		}
 
-		fmt.Print(cli.RenderDaysWithOverdue(days, overdue, todayStart))
+		overdueItems, err := listService.GetOverdueItems(cmd.Context(), todayStart)
+		if err != nil {
+			return fmt.Errorf("failed to get overdue list items: %w", err)
+		}
+
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaDayEntries, cli.NewDayEntriesWithOverdueJSON(days, overdue, overdueItems, todayStart))
+		}
+
+		fmt.Print(cli.RenderDaysWithOverdue(days, overdue, overdueItems, todayStart))
 
 		currentMonth := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, today.Location())
 		goals, err := goalService.GetGoalsForMonth(cmd.Context(), currentMonth)
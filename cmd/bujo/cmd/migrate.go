@@ -3,27 +3,32 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/dateutil"
 )
 
 var migrateTo string
 var migrateYes bool
 
 var migrateCmd = &cobra.Command{
-	Use:   "migrate <id> --to <date>",
+	Use:   "migrate <id> --to <date|month|someday>",
 	Short: "Migrate a task to a future date",
-	Long: `Migrate a task to a future date.
+	Long: `Migrate a task to a future date, a month or someday.
 
 The original entry is marked as migrated (→) and a new task
-is created on the target date.
+is created on the target date. Migrating to a month or to
+someday puts the task in the future log (see 'bujo future').
 
 Only tasks can be migrated (not notes or events).
 
 Examples:
   bujo migrate 42 --to tomorrow
   bujo migrate 1 --to "next monday"
-  bujo migrate 5 --to 2026-01-15`,
+  bujo migrate 5 --to 2026-01-15
+  bujo migrate 7 --to 2026-03
+  bujo migrate 8 --to someday`,
 	Args: cobra.ExactArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if migrateTo == "" {
@@ -35,6 +40,25 @@ Examples:
 			return err
 		}
 
+		if strings.EqualFold(migrateTo, "someday") {
+			newID, err := bujoService.MigrateEntryToSomeday(cmd.Context(), id)
+			if err != nil {
+				return fmt.Errorf("failed to migrate entry: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "✓ Migrated entry #%d → #%d (someday)\n", id, newID)
+			return nil
+		}
+
+		if month, err := dateutil.ParseMonth(migrateTo); err == nil {
+			newID, err := bujoService.MigrateEntryToMonth(cmd.Context(), id, month)
+			if err != nil {
+				return fmt.Errorf("failed to migrate entry: %w", err)
+			}
+			fmt.Fprintf(os.Stderr, "✓ Migrated entry #%d → #%d (scheduled for %s)\n",
+				id, newID, month.Format("January 2006"))
+			return nil
+		}
+
 		toDate, err := parseFutureDate(migrateTo)
 		if err != nil {
 			return err
@@ -57,7 +81,7 @@ Examples:
 }
 
 func init() {
-	migrateCmd.Flags().StringVar(&migrateTo, "to", "", "Target date (e.g., 'tomorrow', 'next monday', '2026-01-15')")
+	migrateCmd.Flags().StringVar(&migrateTo, "to", "", "Target date, month or 'someday' (e.g., 'tomorrow', '2026-01-15', '2026-03')")
 	migrateCmd.Flags().BoolVarP(&migrateYes, "yes", "y", false, "Skip date confirmation prompt")
 	rootCmd.AddCommand(migrateCmd)
 }
@@ -29,7 +29,7 @@ Examples:
 			return fmt.Errorf("failed to get entries: %w", err)
 		}
 
-		fmt.Print(cli.RenderDaysWithOverdue(days, nil, today))
+		fmt.Print(cli.RenderDaysWithOverdue(days, nil, nil, today))
 		return nil
 	},
 }
@@ -52,6 +52,10 @@ Examples:
 			results = filtered
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaEntry, cli.NewEntriesJSON(results))
+		}
+
 		if len(results) == 0 {
 			if questionsAll {
 				fmt.Println("No questions found")
@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var recurringCmd = &cobra.Command{
+	Use:     "recurring",
+	Aliases: []string{"recur"},
+	Short:   "Manage recurring entries",
+	Long: `Manage recurring entries created with 'bujo add --every'.
+
+Recurring entries are added to the journal automatically on every day their
+schedule matches, the first time that day is viewed.
+
+Examples:
+  bujo recurring                   # List recurring entries
+  bujo recurring skip #1 friday    # Skip one occurrence
+  bujo recurring delete #1         # Stop repeating`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		recurrences, err := recurrenceService.GetAll(cmd.Context())
+		if err != nil {
+			return fmt.Errorf("failed to get recurring entries: %w", err)
+		}
+
+		if len(recurrences) == 0 {
+			fmt.Println("No recurring entries")
+			return nil
+		}
+
+		for _, r := range recurrences {
+			until := ""
+			if r.EndDate != nil {
+				until = " until " + r.EndDate.Format("2006-01-02")
+			}
+			fmt.Printf("  #%-3d %s %s  (%s from %s%s)\n",
+				r.ID, r.EntryType.Symbol(), r.Content, r.Rule.Describe(), r.StartDate.Format("2006-01-02"), until)
+		}
+		return nil
+	},
+}
+
+func parseRecurrenceID(arg string) (int64, error) {
+	if len(arg) > 0 && arg[0] == '#' {
+		arg = arg[1:]
+	}
+
+	var id int64
+	_, err := fmt.Sscanf(arg, "%d", &id)
+	if err != nil {
+		return 0, fmt.Errorf("invalid recurrence ID: %s", arg)
+	}
+	return id, nil
+}
+
+func init() {
+	rootCmd.AddCommand(recurringCmd)
+}
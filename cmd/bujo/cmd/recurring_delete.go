@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var recurringDeleteCmd = &cobra.Command{
+	Use:   "delete <#id>",
+	Short: "Stop a recurring entry",
+	Long: `Stop a recurring entry. Occurrences already in the journal are kept.
+
+Examples:
+  bujo recurring delete #1
+  bujo recurring delete 1`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseRecurrenceID(args[0])
+		if err != nil {
+			return err
+		}
+
+		err = recurrenceService.Delete(cmd.Context(), id)
+		if err != nil {
+			return fmt.Errorf("failed to delete recurring entry: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Deleted recurring entry #%d\n", id)
+		return nil
+	},
+}
+
+func init() {
+	recurringCmd.AddCommand(recurringDeleteCmd)
+}
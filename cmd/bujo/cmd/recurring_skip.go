@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var recurringSkipCmd = &cobra.Command{
+	Use:   "skip <#id> <date>",
+	Short: "Skip one occurrence of a recurring entry",
+	Long: `Skip one occurrence of a recurring entry so it is not added to the journal.
+
+Examples:
+  bujo recurring skip #1 friday
+  bujo recurring skip 1 2026-03-15`,
+	Args: cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		id, err := parseRecurrenceID(args[0])
+		if err != nil {
+			return err
+		}
+
+		date, err := parseFutureDate(args[1])
+		if err != nil {
+			return err
+		}
+
+		err = recurrenceService.SkipOccurrence(cmd.Context(), id, date)
+		if err != nil {
+			return fmt.Errorf("failed to skip occurrence: %w", err)
+		}
+
+		fmt.Fprintf(os.Stderr, "Skipping recurring entry #%d on %s\n", id, date.Format("Mon, Jan 2 2006"))
+		return nil
+	},
+}
+
+func init() {
+	recurringCmd.AddCommand(recurringSkipCmd)
+}
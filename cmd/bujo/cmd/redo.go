@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+)
+
+var redoCount int
+
+var redoCmd = &cobra.Command{
+	Use:   "redo",
+	Short: "Reapply changes reverted with 'bujo undo --last'",
+	Long: `Reapply the most recently undone operations, newest undo first.
+
+Making any new change clears the redo history.
+
+Examples:
+  bujo redo
+  bujo redo -n 2`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if redoCount < 1 {
+			return fmt.Errorf("--count must be at least 1")
+		}
+
+		for i := 0; i < redoCount; i++ {
+			op, err := operationJournal.Redo(cmd.Context())
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(os.Stderr, "✓ Redid: %s\n", op.Description)
+		}
+		return nil
+	},
+}
+
+func init() {
+	redoCmd.Flags().IntVarP(&redoCount, "count", "n", 1, "Number of operations to redo")
+	rootCmd.AddCommand(redoCmd)
+}
@@ -0,0 +1,20 @@
+package cmd
+
+import (
+	"github.com/spf13/cobra"
+)
+
+var reviewCmd = &cobra.Command{
+	Use:   "review",
+	Short: "Guided reviews of your journal",
+	Long: `Guided reviews that walk through what is still open and let you decide
+what happens to each item.
+
+Examples:
+  bujo review month
+  bujo review month --month 2026-01`,
+}
+
+func init() {
+	rootCmd.AddCommand(reviewCmd)
+}
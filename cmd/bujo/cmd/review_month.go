@@ -0,0 +1,201 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/dateutil"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var reviewMonthFlag string
+
+var errReviewQuit = errors.New("review abandoned")
+
+var reviewMonthCmd = &cobra.Command{
+	Use:   "month",
+	Short: "Review the open tasks and goals of a month",
+	Long: `Walk through every open task and active goal of a month and decide
+what happens to each one:
+
+  k  keep it where it is
+  m  migrate it to the first day of the next month (goals: to the next month)
+  f  schedule it to the future log, for a month or someday
+  l  move it to a list
+  c  cancel it
+  q  quit without changing anything
+
+Nothing changes until every item has been decided. The whole review is
+then applied as a single change, so 'bujo undo --last' reverts all of it.
+
+By default, reviews the current month.
+
+Examples:
+  bujo review month
+  bujo review month --month 2026-01`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		month, err := parseGoalMonth(reviewMonthFlag)
+		if err != nil {
+			return err
+		}
+
+		review, err := monthReviewService.GetReview(cmd.Context(), month)
+		if err != nil {
+			return fmt.Errorf("failed to get review: %w", err)
+		}
+
+		cyan := color.New(color.FgCyan).SprintFunc()
+		fmt.Printf("📋 Review %s\n", cyan(month.Format("January 2006")))
+
+		if len(review.Items) == 0 {
+			fmt.Println("Nothing left open this month.")
+			return nil
+		}
+
+		reader := bufio.NewReader(os.Stdin)
+		var decisions []service.MonthReviewDecision
+		for i, item := range review.Items {
+			fmt.Printf("\n[%d/%d] %s\n", i+1, len(review.Items), describeReviewItem(item))
+			decision, err := promptReviewDecision(cmd, reader, review, item)
+			if errors.Is(err, errReviewQuit) {
+				fmt.Fprintln(os.Stderr, "Review abandoned, nothing was changed")
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			decisions = append(decisions, decision)
+		}
+
+		summary, err := monthReviewService.Apply(cmd.Context(), month, decisions)
+		if err != nil {
+			return fmt.Errorf("failed to apply review: %w", err)
+		}
+
+		printReviewSummary(summary)
+		return nil
+	},
+}
+
+func describeReviewItem(item service.MonthReviewItem) string {
+	if item.Goal != nil {
+		return fmt.Sprintf("Goal #%d %s", item.Goal.ID, item.Goal.Content)
+	}
+	date := ""
+	if item.Entry.ScheduledDate != nil {
+		date = " (" + item.Entry.ScheduledDate.Format("Mon Jan 2") + ")"
+	}
+	return fmt.Sprintf("Task #%d %s%s", item.Entry.ID, item.Entry.Content, date)
+}
+
+var reviewActionPrompts = map[service.MonthReviewAction]string{
+	service.MonthReviewKeep:      "[k]eep",
+	service.MonthReviewMigrate:   "[m]igrate",
+	service.MonthReviewFutureLog: "[f]uture",
+	service.MonthReviewMoveList:  "[l]ist",
+	service.MonthReviewCancel:    "[c]ancel",
+}
+
+func promptReviewDecision(cmd *cobra.Command, reader *bufio.Reader, review *service.MonthReview, item service.MonthReviewItem) (service.MonthReviewDecision, error) {
+	actions := item.Actions()
+	byKey := make(map[string]service.MonthReviewAction, len(actions))
+	var prompts []string
+	for _, action := range actions {
+		byKey[string(reviewActionPrompts[action][1])] = action
+		prompts = append(prompts, reviewActionPrompts[action])
+	}
+	prompt := strings.Join(append(prompts, "[q]uit"), " ") + ": "
+
+	for {
+		answer, err := readReviewAnswer(reader, prompt)
+		if err != nil {
+			return service.MonthReviewDecision{}, err
+		}
+		answer = strings.ToLower(answer)
+		if answer == "q" {
+			return service.MonthReviewDecision{}, errReviewQuit
+		}
+		action, ok := byKey[answer]
+		if !ok {
+			continue
+		}
+
+		decision := service.MonthReviewDecision{Item: item, Action: action}
+		switch action {
+		case service.MonthReviewMigrate:
+			decision.Date = review.Month.AddDate(0, 1, 0)
+		case service.MonthReviewFutureLog:
+			target, err := readReviewAnswer(reader, "  Month (YYYY-MM) or someday: ")
+			if err != nil {
+				return service.MonthReviewDecision{}, err
+			}
+			if strings.EqualFold(target, "someday") {
+				decision.Granularity = domain.ScheduleSomeday
+				break
+			}
+			month, err := dateutil.ParseMonth(target)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "  %v\n", err)
+				continue
+			}
+			decision.Granularity = domain.ScheduleMonth
+			decision.Date = month
+		case service.MonthReviewMoveList:
+			name, err := readReviewAnswer(reader, "  List name or #ID: ")
+			if err != nil {
+				return service.MonthReviewDecision{}, err
+			}
+			listID, err := resolveListID(cmd.Context(), name)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "  %v\n", err)
+				continue
+			}
+			decision.ListID = listID
+		}
+		return decision, nil
+	}
+}
+
+// readReviewAnswer treats the end of input as quitting the review, so a
+// closed stdin never applies a half-finished review.
+func readReviewAnswer(reader *bufio.Reader, prompt string) (string, error) {
+	fmt.Print(prompt)
+	input, err := reader.ReadString('\n')
+	if err == io.EOF && input == "" {
+		fmt.Println()
+		return "", errReviewQuit
+	}
+	if err != nil && err != io.EOF {
+		return "", err
+	}
+	return strings.TrimSpace(input), nil
+}
+
+func printReviewSummary(summary *service.MonthReviewSummary) {
+	green := color.New(color.FgGreen).SprintFunc()
+	red := color.New(color.FgRed).SprintFunc()
+
+	fmt.Printf("\n%s Reviewed %d item(s)\n", green("✓"), summary.Total())
+	fmt.Printf("  Kept:          %d\n", summary.Kept)
+	fmt.Printf("  Migrated:      %d\n", summary.Migrated)
+	fmt.Printf("  Future log:    %d\n", summary.FutureLog)
+	fmt.Printf("  Moved to list: %d\n", summary.MovedToList)
+	fmt.Printf("  Cancelled:     %d\n", summary.Cancelled)
+	for _, failure := range summary.Failed {
+		fmt.Printf("  %s %s: %v\n", red("✗"), failure.Item.Content(), failure.Err)
+	}
+	fmt.Println("\nUse 'bujo undo --last' to revert the whole review.")
+}
+
+func init() {
+	reviewMonthCmd.Flags().StringVar(&reviewMonthFlag, "month", "", "Month to review (YYYY-MM)")
+	reviewCmd.AddCommand(reviewMonthCmd)
+}
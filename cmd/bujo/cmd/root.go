@@ -9,6 +9,7 @@ import (
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
 	"github.com/typingincolor/bujo/internal/app"
 	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/repository/sqlite"
@@ -20,8 +21,10 @@ var (
 	commit  = "none"
 	date    = "unknown"
 
-	dbPath  string
-	verbose bool
+	dbPath       string
+	verbose      bool
+	outputFlag   string
+	outputFormat = cli.OutputText
 
 	db                     *sql.DB
 	insightsDB             *sql.DB
@@ -37,6 +40,11 @@ var (
 	exportService          *service.ExportService
 	importService          *service.ImportService
 	historyService         *service.HistoryService
+	recurrenceService      *service.RecurrenceService
+	searchService          *service.SearchService
+	apiAuthService         *service.APIAuthService
+	operationJournal       *service.OperationJournal
+	monthReviewService     *service.MonthReviewService
 )
 
 var rootCmd = &cobra.Command{
@@ -50,6 +58,11 @@ var rootCmd = &cobra.Command{
 		}
 
 		var err error
+		outputFormat, err = cli.ParseOutputFormat(outputFlag)
+		if err != nil {
+			return err
+		}
+
 		db, err = sqlite.OpenAndMigrate(dbPath)
 		if err != nil {
 			return fmt.Errorf("failed to open database: %w", err)
@@ -69,16 +82,44 @@ var rootCmd = &cobra.Command{
 		dayCtxRepo := sqlite.NewDayContextRepository(db)
 		habitRepo := sqlite.NewHabitRepository(db)
 		habitLogRepo := sqlite.NewHabitLogRepository(db)
+		habitPauseRepo := sqlite.NewHabitPauseRepository(db)
 		listRepo := sqlite.NewListRepository(db)
 		listItemRepo := sqlite.NewListItemRepository(db)
 		goalRepo := sqlite.NewGoalRepository(db)
+		recurrenceRepo := sqlite.NewRecurrenceRepository(db)
+		tagRepo := sqlite.NewTagRepository(db)
+		mentionRepo := sqlite.NewMentionRepository(db)
+		goalLinkRepo := sqlite.NewGoalLinkRepository(db)
+		keyResultRepo := sqlite.NewKeyResultRepository(db)
+		listTemplateRepo := sqlite.NewListTemplateRepository(db)
 		parser := domain.NewTreeParser()
 
-		bujoService = service.NewBujoService(entryRepo, dayCtxRepo, parser)
-		habitService = service.NewHabitService(habitRepo, habitLogRepo)
-		listService = service.NewListService(listRepo, listItemRepo)
-		goalService = service.NewGoalService(goalRepo)
-		statsService = service.NewStatsService(entryRepo, habitRepo, habitLogRepo)
+		operationJournal = service.NewOperationJournal(sqlite.NewOperationLogRepository(db))
+		bujoService = service.NewBujoServiceWithLists(entryRepo, dayCtxRepo, parser, listRepo, listItemRepo, sqlite.NewEntryToListMover(db), tagRepo, mentionRepo).
+			WithRecurrences(recurrenceRepo).
+			WithGoalLinks(goalLinkRepo).
+			WithOperationJournal(operationJournal)
+		recurrenceService = service.NewRecurrenceService(recurrenceRepo, entryRepo)
+		searchService = service.NewSearchService(sqlite.NewSearchRepository(db), tagRepo, mentionRepo)
+		habitService = service.NewHabitService(habitRepo, habitLogRepo).
+			WithPauseRepository(habitPauseRepo).
+			WithOperationJournal(operationJournal)
+		listService = service.NewListService(listRepo, listItemRepo).
+			WithTemplates(listTemplateRepo).
+			WithEntryMover(sqlite.NewListItemToEntryMover(db)).
+			WithSmartLists(entryRepo).
+			WithOperationJournal(operationJournal)
+		goalService = service.NewGoalService(goalRepo).
+			WithEntryLinks(goalLinkRepo, entryRepo).
+			WithKeyResults(keyResultRepo).
+			WithOperationJournal(operationJournal)
+		monthReviewService = service.NewMonthReviewService(bujoService, goalService).
+			WithTransactor(sqlite.NewTransactor(db)).
+			WithOperationJournal(operationJournal)
+		statsService = service.NewStatsService(entryRepo, habitRepo, habitLogRepo).
+			WithPauseRepository(habitPauseRepo).
+			WithGoals(goalRepo, keyResultRepo)
+		apiAuthService = service.NewAPIAuthService(sqlite.NewAPITokenRepository(db), sqlite.NewAPIOriginRepository(db))
 
 		changeDetectors := []domain.ChangeDetector{
 			entryRepo,
@@ -92,16 +133,20 @@ var rootCmd = &cobra.Command{
 		changeDetectionService = service.NewChangeDetectionService(changeDetectors)
 
 		archiveService = service.NewArchiveService(listItemRepo)
-		historyService = service.NewHistoryService(listItemRepo)
+		historyService = service.NewHistoryService(listItemRepo).
+			WithEntries(entryRepo, sqlite.NewEntryHistoryRepository(db), tagRepo, mentionRepo).
+			WithGoals(goalRepo).
+			WithHabits(habitRepo).
+			WithOperationJournal(operationJournal)
 
 		exportService = service.NewExportService(
 			entryRepo, habitRepo, habitLogRepo, dayCtxRepo,
 			listRepo, listItemRepo, goalRepo,
-		)
+		).WithKeyResults(keyResultRepo).WithListTemplates(listTemplateRepo)
 		importService = service.NewImportService(
 			entryRepo, habitRepo, habitLogRepo, dayCtxRepo,
 			listRepo, listItemRepo, goalRepo,
-		)
+		).WithKeyResults(keyResultRepo).WithListTemplates(listTemplateRepo)
 
 		insightsDB, err = app.OpenInsightsDB(app.DefaultInsightsDBPath())
 		if err != nil {
@@ -135,6 +180,7 @@ func init() {
 
 	rootCmd.PersistentFlags().StringVar(&dbPath, "db-path", defaultDBPath, "Path to the database file")
 	rootCmd.PersistentFlags().BoolVarP(&verbose, "verbose", "v", false, "Enable verbose output")
+	rootCmd.PersistentFlags().StringVar(&outputFlag, "output", string(cli.OutputText), "Output format for read commands (text, json, ndjson)")
 }
 
 func getDefaultDBPath() string {
@@ -21,13 +21,29 @@ var (
 
 var searchCmd = &cobra.Command{
 	Use:   "search <query>",
-	Short: "Search through entries",
-	Long: `Search through entries by content, tags, or both.
+	Short: "Search through entries, list items and goals",
+	Long: `Search through entries, list items and goals. Results are ranked by
+relevance with matching words highlighted.
+
+Query syntax:
+  word              Words starting with "word"
+  "exact phrase"    Exact phrase
+  milk OR bread     Either term
+  -word             Exclude matches containing word
+  tag:name, #name   Entries with tag
+  @name             Entries mentioning name
+  type:task         Entries of a type
+  status:open       Open, done or cancelled entries
+  priority:high     Entries with a priority (none, low, medium, high)
+  after:2026-01-31  Scheduled after a date
+  before:2026-02-01 Scheduled before a date
 
 Supports optional filters for date range, entry type, and tags.
 
 Examples:
-  bujo search "groceries"                    # Search all entries
+  bujo search "groceries"                    # Search everything
+  bujo search '"weekly review" -draft'       # Phrase, excluding a word
+  bujo search "report #work type:task"       # Query syntax filters
   bujo search "meeting" --from "last month"  # With date range
   bujo search "project" --type task          # Filter by type
   bujo search "call" -f "last week" -t today # Date range filter
@@ -100,11 +116,15 @@ Examples:
 			opts = opts.WithLimit(searchLimit)
 		}
 
-		results, err := bujoService.SearchEntries(cmd.Context(), opts)
+		results, err := searchService.Search(cmd.Context(), opts)
 		if err != nil {
 			return fmt.Errorf("search failed: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaSearchResult, cli.NewSearchResultsJSON(results))
+		}
+
 		if len(results) == 0 {
 			if query != "" {
 				fmt.Printf("No results found for %q\n", query)
@@ -114,18 +134,23 @@ Examples:
 			return nil
 		}
 
-		ids := make([]int64, len(results))
-		for i, entry := range results {
-			ids[i] = entry.ID
+		var ids []int64
+		for _, result := range results {
+			if result.Entry != nil {
+				ids = append(ids, result.Entry.ID)
+			}
 		}
 		ancestorsMap, err := bujoService.GetEntriesAncestorsMap(cmd.Context(), ids)
 		if err != nil {
 			return fmt.Errorf("failed to fetch ancestors: %w", err)
 		}
 
-		for _, entry := range results {
-			ancestors := ancestorsMap[entry.ID]
-			fmt.Println(formatSearchResultWithContext(entry, ancestors, query))
+		for _, result := range results {
+			if result.Entry != nil {
+				fmt.Println(formatSearchResultWithContext(*result.Entry, ancestorsMap[result.Entry.ID], result.Snippet))
+			} else {
+				fmt.Println(formatSearchResult(result))
+			}
 		}
 		if query != "" {
 			fmt.Printf("\nFound %d result(s) for %q\n", len(results), query)
@@ -147,7 +172,7 @@ func init() {
 	rootCmd.AddCommand(searchCmd)
 }
 
-func formatSearchResultWithContext(entry domain.Entry, ancestors []domain.Entry, query string) string {
+func formatSearchResultWithContext(entry domain.Entry, ancestors []domain.Entry, snippet string) string {
 	var lines []string
 
 	if len(ancestors) > 0 {
@@ -168,7 +193,7 @@ func formatSearchResultWithContext(entry domain.Entry, ancestors []domain.Entry,
 	}
 
 	symbol := entry.Type.Symbol()
-	content := entry.Content
+	content := highlightSnippet(snippet)
 
 	switch entry.Type {
 	case domain.EntryTypeDone, domain.EntryTypeAnswered:
@@ -179,8 +204,6 @@ func formatSearchResultWithContext(entry domain.Entry, ancestors []domain.Entry,
 		content = cli.Dimmed(content)
 	}
 
-	content = highlightQuery(content, query)
-
 	parts = append(parts, symbol)
 	parts = append(parts, content)
 	parts = append(parts, cli.Dimmed(fmt.Sprintf("(%d)", entry.ID)))
@@ -190,17 +213,21 @@ func formatSearchResultWithContext(entry domain.Entry, ancestors []domain.Entry,
 	return strings.Join(lines, "\n")
 }
 
-func highlightQuery(content, query string) string {
-	lower := strings.ToLower(content)
-	queryLower := strings.ToLower(query)
-	idx := strings.Index(lower, queryLower)
-	if idx == -1 {
-		return content
+// formatSearchResult renders list item and goal matches, which have no
+// journal date, labelled with the list name or goal month.
+func formatSearchResult(result domain.SearchResult) string {
+	var label string
+	switch result.Source {
+	case domain.SearchSourceListItem:
+		label = "[list: " + result.Context + "]"
+	case domain.SearchSourceGoal:
+		label = "[goal: " + result.Context + "]"
 	}
+	return fmt.Sprintf("%s %s %s", cli.Dimmed(label), highlightSnippet(result.Snippet), cli.Dimmed(fmt.Sprintf("(%d)", result.ID)))
+}
 
-	before := content[:idx]
-	match := content[idx : idx+len(query)]
-	after := content[idx+len(query):]
-
-	return before + cli.Highlight(match) + after
+func highlightSnippet(snippet string) string {
+	return domain.HighlightSnippet(snippet, func(s string) string {
+		return cli.Highlight(s)
+	})
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/domain"
 )
 
 var (
@@ -19,7 +20,7 @@ var statsCmd = &cobra.Command{
 	Long: `Show summary statistics about your journal usage.
 
 Displays entry counts by type, task completion rate, productivity patterns,
-and habit tracking overview.
+habit tracking overview, and the progress of goals measured by key results.
 
 Examples:
   bujo stats                       # Stats for the last 30 days
@@ -59,6 +60,10 @@ func runStats(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("failed to get stats: %w", err)
 	}
 
+	if outputFormat.IsMachineReadable() {
+		return writeOutput(cli.SchemaStats, cli.NewStatsJSON(stats))
+	}
+
 	days := int(to.Sub(from).Hours()/24) + 1
 	fmt.Printf("%s Statistics (%s to %s)\n", cli.Bold("📊"), from.Format("Jan 2"), to.Format("Jan 2, 2006"))
 	fmt.Println(cli.Dimmed("───────────────────────────────"))
@@ -116,9 +121,9 @@ func runStats(cmd *cobra.Command, args []string) error {
 	if stats.HabitStats.Active > 0 {
 		fmt.Printf("\n%s %d active\n", cli.Bold("Habits:"), stats.HabitStats.Active)
 		if stats.HabitStats.BestStreak.Days > 0 {
-			fmt.Printf("  Best streak: %s (%d days)\n",
+			fmt.Printf("  Best streak: %s (%s)\n",
 				stats.HabitStats.BestStreak.HabitName,
-				stats.HabitStats.BestStreak.Days,
+				stats.HabitStats.BestStreak.Period.Format(stats.HabitStats.BestStreak.Days),
 			)
 		}
 		if stats.HabitStats.MostLogged.Count > 0 {
@@ -127,6 +132,23 @@ func runStats(cmd *cobra.Command, args []string) error {
 				stats.HabitStats.MostLogged.Count,
 			)
 		}
+		for _, m := range stats.HabitStats.Measured {
+			if m.Values.Days > 0 {
+				fmt.Printf("  %s: %s\n", m.HabitName, m.Values.Format(m.Unit))
+			}
+		}
+	}
+
+	if len(stats.Goals) > 0 {
+		fmt.Printf("\n%s %d with key results\n", cli.Bold("Goals:"), len(stats.Goals))
+		for _, g := range stats.Goals {
+			fmt.Printf("  %s %4s  %s [%s]\n",
+				domain.ProgressBar(g.Progress(), 10),
+				domain.FormatPercent(g.Progress()),
+				g.Content,
+				g.Period,
+			)
+		}
 	}
 
 	return nil
@@ -58,6 +58,10 @@ Examples:
 			return fmt.Errorf("failed to get tasks: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaEntry, cli.NewEntriesJSON(tasks))
+		}
+
 		if len(tasks) == 0 {
 			fmt.Println("No outstanding tasks")
 			return nil
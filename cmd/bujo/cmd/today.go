@@ -6,29 +6,54 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/service"
 )
 
 var todayCmd = &cobra.Command{
 	Use:   "today",
 	Short: "Display today's entries",
-	Long:  `Display today's entries, including overdue tasks, current location, and monthly goals.`,
+	Long: `Display today's entries, including overdue tasks and list items, current location, and monthly goals.
+
+On the first day of a month, the month's entries from the future log are shown too.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		now := time.Now()
 		todayStart := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
 
-		days, err := bujoService.GetDayEntries(cmd.Context(), todayStart, todayStart)
+		agenda, err := bujoService.GetDailyAgenda(cmd.Context(), todayStart)
 		if err != nil {
 			return fmt.Errorf("failed to get entries: %w", err)
 		}
+		days := []service.DayEntries{{
+			Date:     agenda.Date,
+			Location: agenda.Location,
+			Mood:     agenda.Mood,
+			Weather:  agenda.Weather,
+			Entries:  agenda.Today,
+		}}
 
 		overdue, err := bujoService.GetOverdue(cmd.Context())
 		if err != nil {
 			return fmt.Errorf("failed to get overdue: %w", err)
 		}
 
-		fmt.Print(cli.RenderDaysWithOverdue(days, overdue, todayStart))
+		overdueItems, err := listService.GetOverdueItems(cmd.Context(), todayStart)
+		if err != nil {
+			return fmt.Errorf("failed to get overdue list items: %w", err)
+		}
+
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaDayEntries, cli.NewDayEntriesWithOverdueJSON(days, overdue, overdueItems, todayStart))
+		}
+
+		fmt.Print(cli.RenderDaysWithOverdue(days, overdue, overdueItems, todayStart))
 
 		currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		if len(agenda.FutureLog) > 0 {
+			fmt.Print(cli.RenderFutureLog(&service.FutureLog{
+				Months: []service.FutureLogMonth{{Month: currentMonth, Entries: agenda.FutureLog}},
+			}, todayStart))
+		}
+
 		goals, err := goalService.GetGoalsForMonth(cmd.Context(), currentMonth)
 		if err != nil {
 			return fmt.Errorf("failed to get goals: %w", err)
@@ -14,17 +14,21 @@ var tuiCmd = &cobra.Command{
 	Long:  `Launch an interactive terminal UI for viewing and managing journal entries.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		model := tui.NewWithConfig(tui.Config{
-			BujoService:     bujoService,
-			HabitService:    habitService,
-			ListService:     listService,
-			GoalService:     goalService,
-			StatsService:    statsService,
-			ChangeDetection: changeDetectionService,
-			InsightsReader:  insightsRepo,
-			Version:         version,
-			Commit:          commit,
-			Date:            date,
-			DBPath:          dbPath,
+			BujoService:       bujoService,
+			HabitService:      habitService,
+			ListService:       listService,
+			GoalService:       goalService,
+			RecurrenceService: recurrenceService,
+			SearchService:     searchService,
+			StatsService:      statsService,
+			Journal:           operationJournal,
+			MonthReview:       monthReviewService,
+			ChangeDetection:   changeDetectionService,
+			InsightsReader:    insightsRepo,
+			Version:           version,
+			Commit:            commit,
+			Date:              date,
+			DBPath:            dbPath,
 		})
 		p := tea.NewProgram(model, tea.WithAltScreen())
 
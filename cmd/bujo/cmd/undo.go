@@ -7,18 +7,36 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var undoLast int
+
 var undoCmd = &cobra.Command{
-	Use:   "undo <id>",
-	Short: "Mark a completed entry as incomplete",
-	Long: `Mark a completed task back to incomplete by its ID.
+	Use:   "undo [id]",
+	Short: "Undo recent changes, or mark a completed entry as incomplete",
+	Long: `Undo recent changes, or mark a completed task back to incomplete by its ID.
+
+With --last, bujo steps back through the operation log, reverting whole
+changes such as edits, deletes (including children), migrations and moves
+made from the CLI, TUI or desktop app. Use 'bujo redo' to reapply them.
 
 Use 'bujo ls' to see entry IDs.
 
 Examples:
   bujo undo 42
-  bujo undo 15`,
-	Args: cobra.ExactArgs(1),
+  bujo undo --last
+  bujo undo --last=3`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("last") {
+			if len(args) > 0 {
+				return fmt.Errorf("cannot combine an entry ID with --last")
+			}
+			return undoOperations(cmd, undoLast)
+		}
+
+		if len(args) == 0 {
+			return fmt.Errorf("requires an entry ID or --last")
+		}
+
 		id, err := parseEntryID(args[0])
 		if err != nil {
 			return err
@@ -34,6 +52,23 @@ Examples:
 	},
 }
 
+func undoOperations(cmd *cobra.Command, count int) error {
+	if count < 1 {
+		return fmt.Errorf("--last must be at least 1")
+	}
+
+	for i := 0; i < count; i++ {
+		op, err := operationJournal.Undo(cmd.Context())
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stderr, "✓ Undid: %s\n", op.Description)
+	}
+	return nil
+}
+
 func init() {
+	undoCmd.Flags().IntVar(&undoLast, "last", 1, "Undo the last N operations")
+	undoCmd.Flags().Lookup("last").NoOptDefVal = "1"
 	rootCmd.AddCommand(undoCmd)
 }
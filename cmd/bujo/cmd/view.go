@@ -35,6 +35,10 @@ Examples:
 			return fmt.Errorf("failed to get entry: %w", err)
 		}
 
+		if outputFormat.IsMachineReadable() {
+			return writeOutput(cli.SchemaEntry, cli.NewEntriesJSON(entries))
+		}
+
 		fmt.Print(renderViewTree(entries, id))
 		return nil
 	},
@@ -21,6 +21,7 @@ vi.mock('./wailsjs/runtime/runtime', () => ({
 vi.mock('./wailsjs/go/wails/App', () => ({
   GetDayEntries: vi.fn().mockResolvedValue([{ Date: '2026-01-17T00:00:00Z', Entries: [], Location: '', Mood: '', Weather: '' }]),
   GetOverdue: vi.fn().mockResolvedValue([]),
+  GetOverdueListItems: vi.fn().mockResolvedValue([]),
   GetHabits: vi.fn().mockResolvedValue({ Habits: [] }),
   GetLists: vi.fn().mockResolvedValue([]),
   GetGoals: vi.fn().mockResolvedValue([]),
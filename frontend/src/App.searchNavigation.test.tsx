@@ -18,6 +18,7 @@ vi.mock('./wailsjs/runtime/runtime', () => ({
 vi.mock('./wailsjs/go/wails/App', () => ({
   GetDayEntries: vi.fn().mockResolvedValue([{ Date: '2026-01-17T00:00:00Z', Entries: [], Location: '', Mood: '', Weather: '' }]),
   GetOverdue: vi.fn().mockResolvedValue([]),
+  GetOverdueListItems: vi.fn().mockResolvedValue([]),
   GetHabits: vi.fn().mockResolvedValue({ Habits: [] }),
   GetLists: vi.fn().mockResolvedValue([]),
   GetGoals: vi.fn().mockResolvedValue([]),
@@ -62,6 +63,7 @@ vi.mock('./wailsjs/go/wails/App', () => ({
 }))
 
 import { Search, GetDayEntries } from './wailsjs/go/wails/App'
+import { asSearchResults } from './test/mocks'
 
 const createMockSearchResult = (overrides: Partial<{ ID: number; Content: string; Type: string; CreatedAt: string; ParentID: number | null; Priority: string }>) => ({
   ID: 1,
@@ -112,14 +114,14 @@ describe('App - Search Navigation', () => {
   })
 
   it('navigates to journal view when double-clicking a search result', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockSearchResult({
         ID: 42,
         Content: 'Test entry from January 15th',
         Type: 'task',
         CreatedAt: '2026-01-15T10:00:00Z',
       }),
-    ] as never)
+    ]) as never)
 
     vi.mocked(GetDayEntries).mockResolvedValue([
       { Date: '2026-01-15T00:00:00Z', Entries: [], Location: '', Mood: '', Weather: '' },
@@ -171,14 +173,14 @@ describe('App - Search Navigation', () => {
   })
 
   it('shows back button on journal view after navigating from search', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockSearchResult({
         ID: 42,
         Content: 'Test entry',
         Type: 'task',
         CreatedAt: '2026-01-15T10:00:00Z',
       }),
-    ] as never)
+    ]) as never)
 
     vi.mocked(GetDayEntries).mockResolvedValue([
       { Date: '2026-01-15T00:00:00Z', Entries: [], Location: '', Mood: '', Weather: '' },
@@ -221,14 +223,14 @@ describe('App - Search Navigation', () => {
   })
 
   it('back button returns to search view after double-click navigation', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockSearchResult({
         ID: 42,
         Content: 'Test entry',
         Type: 'task',
         CreatedAt: '2026-01-15T10:00:00Z',
       }),
-    ] as never)
+    ]) as never)
 
     vi.mocked(GetDayEntries).mockResolvedValue([
       { Date: '2026-01-15T00:00:00Z', Entries: [], Location: '', Mood: '', Weather: '' },
@@ -4,7 +4,7 @@ import { useSettings } from '@/contexts/SettingsContext'
 import { EventsOn } from './wailsjs/runtime/runtime'
 import { ChevronLeft, ChevronRight } from 'lucide-react'
 import { DateNavigator } from '@/components/bujo/DateNavigator'
-import { GetDayEntries, GetOverdue, GetHabits, GetLists, GetGoals, GetOutstandingQuestions, MarkEntryDone, MarkEntryUndone, EditEntry, DeleteEntry, HasChildren, MigrateEntry, MoveEntryToList, GetEntryContext, CyclePriority, RetypeEntry, CancelEntry, UncancelEntry, GetPlatformCapabilities, ListRemarkableDocuments, IsRemarkableRegistered } from './wailsjs/go/wails/App'
+import { GetDayEntries, GetOverdue, GetOverdueListItems, GetHabits, GetLists, GetGoals, GetOutstandingQuestions, MarkEntryDone, MarkEntryUndone, EditEntry, DeleteEntry, HasChildren, MigrateEntry, MoveEntryToList, GetEntryContext, CyclePriority, RetypeEntry, CancelEntry, UncancelEntry, GetPlatformCapabilities, ListRemarkableDocuments, IsRemarkableRegistered } from './wailsjs/go/wails/App'
 import { Sidebar, ViewType } from '@/components/bujo/Sidebar'
 import { HabitTracker } from '@/components/bujo/HabitTrackerView'
 import { ListsView } from '@/components/bujo/ListsView'
@@ -27,9 +27,9 @@ import { buildTree } from '@/lib/buildTree'
 import { JournalView } from '@/components/bujo/JournalView'
 import { InsightsView } from '@/components/bujo/InsightsView'
 import { RemarkableView } from '@/components/bujo/RemarkableView'
-import { DayEntries, Habit, BujoList, Goal, Entry } from '@/types/bujo'
+import { DayEntries, Habit, BujoList, Goal, Entry, OverdueListItem } from '@/types/bujo'
 import { remarkable } from './wailsjs/go/models'
-import { transformDayEntries, transformEntry, transformHabit, transformList, transformGoal } from '@/lib/transforms'
+import { transformDayEntries, transformEntry, transformHabit, transformList, transformOverdueListItem, transformGoal } from '@/lib/transforms'
 import { startOfDay } from '@/lib/utils'
 import { toWailsTime } from '@/lib/wailsTime'
 import { startOfWeek, endOfWeek, isSameWeek } from 'date-fns'
@@ -64,6 +64,7 @@ function App() {
   const [lists, setLists] = useState<BujoList[]>([])
   const [goals, setGoals] = useState<Goal[]>([])
   const [overdueEntries, setOverdueEntries] = useState<Entry[]>([])
+  const [overdueListItems, setOverdueListItems] = useState<OverdueListItem[]>([])
   const [outstandingQuestions, setOutstandingQuestions] = useState<Entry[]>([])
   const [loading, setLoading] = useState(true)
   const [error, setError] = useState<string | null>(null)
@@ -144,9 +145,10 @@ function App() {
       const reviewStart = startOfWeek(reviewAnchorDate, { weekStartsOn: 1 })
       const reviewEnd = endOfWeek(reviewAnchorDate, { weekStartsOn: 1 })
 
-      const [daysData, overdueData, reviewDaysData, habitsData, listsData, goalsData, questionsData] = await Promise.all([
+      const [daysData, overdueData, overdueItemsData, reviewDaysData, habitsData, listsData, goalsData, questionsData] = await Promise.all([
         GetDayEntries(toWailsTime(currentDate), toWailsTime(weekLater)),
         GetOverdue(),
+        GetOverdueListItems(),
         GetDayEntries(toWailsTime(reviewStart), toWailsTime(reviewEnd)),
         GetHabits(habitDays),
         GetLists(),
@@ -160,6 +162,7 @@ function App() {
       setReviewDays(transformedReviewDays)
       const transformedOverdue = (overdueData || []).map(transformEntry)
       setOverdueEntries(transformedOverdue)
+      setOverdueListItems((overdueItemsData || []).map(transformOverdueListItem))
       setHabits((habitsData?.Habits || []).map(transformHabit))
       setLists((listsData || []).map(transformList))
       setGoals((goalsData || []).map(transformGoal))
@@ -748,6 +751,7 @@ function App() {
           {view === 'pending' && (
             <PendingTasksView
               overdueEntries={overdueEntries}
+              overdueListItems={overdueListItems}
               callbacks={sidebarCallbacks}
               selectedEntry={pendingSelectedEntry ?? undefined}
               onSelectEntry={(entry) => setPendingSelectedEntry(entry)}
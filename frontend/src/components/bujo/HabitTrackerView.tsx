@@ -1,6 +1,6 @@
 import { useState, useRef, useEffect, useCallback, useMemo } from 'react';
 import { Habit } from '@/types/bujo';
-import { cn } from '@/lib/utils';
+import { cn, formatStreak } from '@/lib/utils';
 import { Flame, Plus, X, Trash2, Target, ChevronDown } from 'lucide-react';
 import { CreateHabit, DeleteHabit, UndoHabitLogForDate, SetHabitGoal, SetHabitWeeklyGoal, SetHabitMonthlyGoal, LogHabitForDate } from '@/wailsjs/go/wails/App';
 import { ConfirmDialog } from './ConfirmDialog';
@@ -145,12 +145,17 @@ function HabitRow({
         <div className="flex items-center gap-2">
           <span className="font-medium text-sm truncate">{habit.name}</span>
           {habit.streak > 0 && (
-            <span className={cn(
-              'flex items-center gap-0.5 text-xs font-semibold text-bujo-streak',
-              habit.streak >= 7 && 'animate-streak-glow'
-            )}>
+            <span
+              title={`${formatStreak(habit.streak, habit.streakPeriod)} streak`}
+              className={cn(
+                'flex items-center gap-0.5 text-xs font-semibold text-bujo-streak',
+                habit.streak >= (habit.streakPeriod ? 4 : 7) && 'animate-streak-glow'
+              )}
+            >
               <Flame className="w-3.5 h-3.5" />
               {habit.streak}
+              {habit.streakPeriod === 'week' && 'w'}
+              {habit.streakPeriod === 'month' && 'mo'}
             </span>
           )}
         </div>
@@ -7,18 +7,22 @@ import { BujoList } from '@/types/bujo'
 vi.mock('@/wailsjs/go/wails/App', () => ({
   MarkListItemDone: vi.fn().mockResolvedValue(undefined),
   MarkListItemUndone: vi.fn().mockResolvedValue(undefined),
+  MarkEntryDone: vi.fn().mockResolvedValue(undefined),
+  MarkEntryUndone: vi.fn().mockResolvedValue(undefined),
   AddListItem: vi.fn().mockResolvedValue(1),
   RemoveListItem: vi.fn().mockResolvedValue(undefined),
   CreateList: vi.fn().mockResolvedValue(1),
+  CreateSmartList: vi.fn().mockResolvedValue(1),
   DeleteList: vi.fn().mockResolvedValue(undefined),
   RenameList: vi.fn().mockResolvedValue(undefined),
   EditListItem: vi.fn().mockResolvedValue(undefined),
   CancelListItem: vi.fn().mockResolvedValue(undefined),
   UncancelListItem: vi.fn().mockResolvedValue(undefined),
   MoveListItem: vi.fn().mockResolvedValue(undefined),
+  ScheduleListItem: vi.fn().mockResolvedValue(1),
 }))
 
-import { AddListItem, RemoveListItem, CreateList, DeleteList, RenameList, EditListItem, CancelListItem, UncancelListItem, MoveListItem } from '@/wailsjs/go/wails/App'
+import { MarkListItemDone, MarkEntryDone, MarkEntryUndone, AddListItem, RemoveListItem, CreateList, CreateSmartList, DeleteList, RenameList, EditListItem, CancelListItem, UncancelListItem, MoveListItem, ScheduleListItem } from '@/wailsjs/go/wails/App'
 
 const createTestList = (overrides: Partial<BujoList> = {}): BujoList => ({
   id: 1,
@@ -803,4 +807,123 @@ describe('ListsView - Visual Styling', () => {
     expect(content).toHaveClass('line-through')
     expect(content).toHaveClass('text-muted-foreground')
   })
+
+  it('shows when items are due and flags overdue ones', () => {
+    render(<ListsView lists={[createTestList({
+      name: 'Errands',
+      items: [
+        createTestItem({ id: 1, content: 'Renew passport', dueDate: '2020-01-06' }),
+        createTestItem({ id: 2, content: 'Return books', dueDate: '2999-03-14' }),
+      ]
+    })]} />)
+
+    const [overdue, upcoming] = screen.getAllByTestId('list-item-due')
+    expect(overdue).toHaveTextContent('overdue, due Jan 6')
+    expect(overdue).toHaveClass('text-orange-700')
+    expect(upcoming).toHaveTextContent('due Mar 14')
+    expect(upcoming).toHaveClass('text-muted-foreground')
+  })
+})
+
+describe('ListsView - Schedule List Item', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+  })
+
+  it('calls ScheduleListItem binding when schedule button is clicked', async () => {
+    const user = userEvent.setup()
+    const onListChanged = vi.fn()
+    render(<ListsView lists={[createTestList({
+      name: 'Backlog',
+      items: [createTestItem({ id: 42, content: 'Write release notes', type: 'task' })]
+    })]} onListChanged={onListChanged} />)
+
+    await user.click(screen.getByTitle('Schedule for today'))
+
+    await waitFor(() => {
+      expect(ScheduleListItem).toHaveBeenCalledWith(42, expect.anything())
+      expect(onListChanged).toHaveBeenCalled()
+    })
+  })
+
+  it('marks scheduled items and does not offer to schedule them again', () => {
+    render(<ListsView lists={[createTestList({
+      name: 'Backlog',
+      items: [createTestItem({ content: 'Write release notes', type: 'scheduled' })]
+    })]} />)
+
+    expect(screen.getByTitle('Scheduled in the journal')).toBeInTheDocument()
+    expect(screen.queryByTitle('Schedule for today')).not.toBeInTheDocument()
+  })
+})
+
+describe('ListsView - Smart Lists', () => {
+  beforeEach(() => {
+    vi.clearAllMocks()
+  })
+
+  const smartList = () => createTestList({
+    name: 'Waiting',
+    query: '#waiting status:open',
+    items: [
+      createTestItem({ id: 0, entryId: 7, content: 'Chase invoice #waiting' }),
+      createTestItem({ id: 0, entryId: 8, content: 'Hear back from Sam #waiting', type: 'done', done: true }),
+    ],
+  })
+
+  it('shows the query of a smart list', () => {
+    render(<ListsView lists={[smartList()]} />)
+
+    expect(screen.getByTitle('Smart list query')).toHaveTextContent('#waiting status:open')
+  })
+
+  it('ticks off the journal entry behind a smart list item', async () => {
+    const user = userEvent.setup()
+    const onListChanged = vi.fn()
+    render(<ListsView lists={[smartList()]} onListChanged={onListChanged} />)
+
+    await user.click(screen.getByTitle('Mark as done'))
+
+    await waitFor(() => {
+      expect(MarkEntryDone).toHaveBeenCalledWith(7)
+      expect(onListChanged).toHaveBeenCalled()
+    })
+    expect(MarkListItemDone).not.toHaveBeenCalled()
+  })
+
+  it('reopens a done entry from a smart list', async () => {
+    const user = userEvent.setup()
+    render(<ListsView lists={[smartList()]} />)
+
+    await user.click(screen.getByRole('checkbox', { name: /show completed/i }))
+    await user.click(screen.getByTitle('Mark as not done'))
+
+    await waitFor(() => {
+      expect(MarkEntryUndone).toHaveBeenCalledWith(8)
+    })
+  })
+
+  it('does not offer to add, edit or move items of a smart list', () => {
+    render(<ListsView lists={[smartList(), createTestList({ id: 2, name: 'Inbox' })]} />)
+
+    expect(screen.queryByPlaceholderText(/add item/i)).not.toBeInTheDocument()
+    expect(screen.queryByTitle('Edit item')).not.toBeInTheDocument()
+    expect(screen.queryByTitle('Delete item')).not.toBeInTheDocument()
+    expect(screen.queryByTitle('Move item')).not.toBeInTheDocument()
+    expect(screen.queryByTitle('Schedule for today')).not.toBeInTheDocument()
+  })
+
+  it('creates a smart list when a query is given', async () => {
+    const user = userEvent.setup()
+    render(<ListsView lists={[]} />)
+
+    await user.click(screen.getByRole('button', { name: /new list/i }))
+    await user.type(screen.getByPlaceholderText(/list name/i), 'Urgent')
+    await user.type(screen.getByLabelText('Smart list query'), 'priority:high status:open{Enter}')
+
+    await waitFor(() => {
+      expect(CreateSmartList).toHaveBeenCalledWith('Urgent', 'priority:high status:open')
+    })
+    expect(CreateList).not.toHaveBeenCalled()
+  })
 })
@@ -1,8 +1,10 @@
-import { BujoList } from '@/types/bujo'
+import { BujoList, ListItem } from '@/types/bujo'
 import { cn } from '@/lib/utils'
-import { List, CheckCircle2, Circle, ChevronRight, Plus, Trash2, Pencil, X, Ban, RotateCcw, MoveRight } from 'lucide-react'
+import { List, CheckCircle2, Circle, ChevronRight, Plus, Trash2, Pencil, X, Ban, RotateCcw, MoveRight, CalendarPlus, CalendarCheck, Search } from 'lucide-react'
 import { useState, useRef, useEffect } from 'react'
-import { MarkListItemDone, MarkListItemUndone, AddListItem, RemoveListItem, CreateList, DeleteList, RenameList, EditListItem, CancelListItem, UncancelListItem, MoveListItem } from '@/wailsjs/go/wails/App'
+import { MarkListItemDone, MarkListItemUndone, MarkEntryDone, MarkEntryUndone, AddListItem, RemoveListItem, CreateList, CreateSmartList, DeleteList, RenameList, EditListItem, CancelListItem, UncancelListItem, MoveListItem, ScheduleListItem } from '@/wailsjs/go/wails/App'
+import { toWailsTime } from '@/lib/wailsTime'
+import { format, parseISO } from 'date-fns'
 import { ConfirmDialog } from './ConfirmDialog'
 
 interface ListsViewProps {
@@ -25,9 +27,10 @@ interface ListCardProps {
   onCancelItem: (itemId: number) => void
   onUncancelItem: (itemId: number) => void
   onMoveItem: (itemId: number, targetListId: number) => void
+  onScheduleItem: (itemId: number) => void
 }
 
-function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onToggleItem, onAddItem, onDeleteItem, onDeleteList, onRenameList, onEditItem, onCancelItem, onUncancelItem, onMoveItem }: ListCardProps) {
+function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onToggleItem, onAddItem, onDeleteItem, onDeleteList, onRenameList, onEditItem, onCancelItem, onUncancelItem, onMoveItem, onScheduleItem }: ListCardProps) {
   const [newItemContent, setNewItemContent] = useState('')
   const [isRenaming, setIsRenaming] = useState(false)
   const [movingItemId, setMovingItemId] = useState<number | null>(null)
@@ -38,6 +41,13 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
   const renameInputRef = useRef<HTMLInputElement>(null)
   const editInputRef = useRef<HTMLInputElement>(null)
 
+  // A smart list's items are journal entries picked out by its query, so
+  // they can be ticked off but not edited, moved or removed from here.
+  const isSmart = !!list.query
+  // Smart list items are ticked off by the ID of the entry they show.
+  const itemId = (item: ListItem) => (isSmart ? item.entryId ?? 0 : item.id)
+  const today = format(new Date(), 'yyyy-MM-dd')
+
   const progress = list.totalCount > 0
     ? Math.round((list.doneCount / list.totalCount) * 100)
     : 0
@@ -122,6 +132,11 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
     onUncancelItem(itemId)
   }
 
+  const handleScheduleItem = (e: React.MouseEvent, itemId: number) => {
+    e.stopPropagation()
+    onScheduleItem(itemId)
+  }
+
   const handleTickItem = (e: React.MouseEvent, itemId: number, done: boolean) => {
     e.stopPropagation()
     onToggleItem(itemId, done)
@@ -173,6 +188,12 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
               onClick={(e) => e.stopPropagation()}
               className="font-medium flex-1 text-left bg-transparent border-b border-primary focus:outline-none"
             />
+          ) : isSmart ? (
+            <span className="flex-1 flex items-center gap-2 text-left">
+              <Search className="w-3.5 h-3.5 text-muted-foreground" aria-label="Smart list" />
+              <span className="font-medium">{list.name}</span>
+              <span className="text-xs font-mono text-muted-foreground" title="Smart list query">{list.query}</span>
+            </span>
           ) : (
             <span className="font-medium flex-1 text-left">{list.name}</span>
           )}
@@ -211,12 +232,12 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
             .filter((item) => showCompleted || item.type !== 'done')
             .map((item) => (
             <div
-              key={item.id}
+              key={isSmart ? item.entryId : item.id}
               className="flex items-center gap-3 py-1.5 group hover:bg-secondary/20 rounded px-2 -mx-2"
             >
               {item.type === 'done' ? (
                 <button
-                  onClick={(e) => handleTickItem(e, item.id, item.done)}
+                  onClick={(e) => handleTickItem(e, itemId(item), item.done)}
                   title="Mark as not done"
                   className="flex-shrink-0 hover:opacity-70 transition-opacity"
                 >
@@ -224,9 +245,13 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
                 </button>
               ) : item.type === 'cancelled' ? (
                 <Ban className="w-4 h-4 text-muted-foreground flex-shrink-0" />
+              ) : item.type === 'scheduled' ? (
+                <span title="Scheduled in the journal" className="flex-shrink-0">
+                  <CalendarCheck className="w-4 h-4 text-muted-foreground" />
+                </span>
               ) : (
                 <button
-                  onClick={(e) => handleTickItem(e, item.id, item.done)}
+                  onClick={(e) => handleTickItem(e, itemId(item), item.done)}
                   title="Mark as done"
                   className="flex-shrink-0 hover:text-green-500 transition-colors text-muted-foreground"
                 >
@@ -248,12 +273,36 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
                 <span className={cn(
                   'text-sm flex-1',
                   item.type === 'done' && 'text-bujo-done',
-                  item.type === 'cancelled' && 'line-through text-muted-foreground'
+                  item.type === 'cancelled' && 'line-through text-muted-foreground',
+                  item.type === 'scheduled' && 'text-muted-foreground'
                 )}>
                   {item.content}
                 </span>
               )}
-              {item.type === 'task' && (
+              {item.dueDate && (
+                <span
+                  data-testid="list-item-due"
+                  className={cn(
+                    'text-xs px-1.5 py-0.5 rounded flex-shrink-0',
+                    item.type === 'task' && item.dueDate < today
+                      ? 'bg-orange-100 text-orange-700 dark:bg-orange-900/30 dark:text-orange-400'
+                      : 'text-muted-foreground'
+                  )}
+                >
+                  {item.type === 'task' && item.dueDate < today ? 'overdue, ' : ''}
+                  {item.dueDate === today ? 'due today' : `due ${format(parseISO(item.dueDate), 'MMM d')}`}
+                </span>
+              )}
+              {!isSmart && item.type === 'task' && (
+                <button
+                  onClick={(e) => handleScheduleItem(e, item.id)}
+                  title="Schedule for today"
+                  className="p-1 rounded text-muted-foreground hover:text-primary hover:bg-primary/10 transition-colors opacity-0 group-hover:opacity-100"
+                >
+                  <CalendarPlus className="w-3.5 h-3.5" />
+                </button>
+              )}
+              {!isSmart && item.type === 'task' && (
                 <button
                   onClick={(e) => handleCancelItem(e, item.id)}
                   title="Cancel item"
@@ -262,7 +311,7 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
                   <Ban className="w-3.5 h-3.5" />
                 </button>
               )}
-              {item.type === 'cancelled' && (
+              {!isSmart && item.type === 'cancelled' && (
                 <button
                   onClick={(e) => handleUncancelItem(e, item.id)}
                   title="Uncancel item"
@@ -271,7 +320,7 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
                   <RotateCcw className="w-3.5 h-3.5" />
                 </button>
               )}
-              {otherLists.length > 0 && (
+              {!isSmart && otherLists.length > 0 && (
                 <div className="relative" ref={movingItemId === item.id ? moveMenuRef : undefined}>
                   <button
                     onClick={(e) => handleMoveClick(e, item.id)}
@@ -295,34 +344,44 @@ function ListCard({ list, otherLists, isExpanded, showCompleted, onToggle, onTog
                   )}
                 </div>
               )}
-              <button
-                onClick={(e) => handleEditItemClick(e, item.id, item.content)}
-                title="Edit item"
-                className="p-1 rounded text-muted-foreground hover:text-primary hover:bg-primary/10 transition-colors opacity-0 group-hover:opacity-100"
-              >
-                <Pencil className="w-3.5 h-3.5" />
-              </button>
-              <button
-                onClick={(e) => handleDeleteItem(e, item.id)}
-                title="Delete item"
-                className="p-1 rounded text-muted-foreground hover:text-destructive hover:bg-destructive/10 transition-colors opacity-0 group-hover:opacity-100"
-              >
-                <Trash2 className="w-3.5 h-3.5" />
-              </button>
+              {!isSmart && (
+                <button
+                  onClick={(e) => handleEditItemClick(e, item.id, item.content)}
+                  title="Edit item"
+                  className="p-1 rounded text-muted-foreground hover:text-primary hover:bg-primary/10 transition-colors opacity-0 group-hover:opacity-100"
+                >
+                  <Pencil className="w-3.5 h-3.5" />
+                </button>
+              )}
+              {!isSmart && (
+                <button
+                  onClick={(e) => handleDeleteItem(e, item.id)}
+                  title="Delete item"
+                  className="p-1 rounded text-muted-foreground hover:text-destructive hover:bg-destructive/10 transition-colors opacity-0 group-hover:opacity-100"
+                >
+                  <Trash2 className="w-3.5 h-3.5" />
+                </button>
+              )}
             </div>
           ))}
-          {/* Add item input */}
-          <div className="flex items-center gap-2 py-1.5 px-2 -mx-2">
-            <Plus className="w-4 h-4 text-muted-foreground flex-shrink-0" />
-            <input
-              type="text"
-              value={newItemContent}
-              onChange={(e) => setNewItemContent(e.target.value)}
-              onKeyDown={handleKeyDown}
-              placeholder="Add item..."
-              className="flex-1 text-sm bg-transparent border-none focus:outline-none placeholder:text-muted-foreground"
-            />
-          </div>
+          {isSmart ? (
+            list.items.length === 0 && (
+              <p className="py-1.5 text-sm text-muted-foreground">No entries match this list's query.</p>
+            )
+          ) : (
+            /* Add item input */
+            <div className="flex items-center gap-2 py-1.5 px-2 -mx-2">
+              <Plus className="w-4 h-4 text-muted-foreground flex-shrink-0" />
+              <input
+                type="text"
+                value={newItemContent}
+                onChange={(e) => setNewItemContent(e.target.value)}
+                onKeyDown={handleKeyDown}
+                placeholder="Add item..."
+                className="flex-1 text-sm bg-transparent border-none focus:outline-none placeholder:text-muted-foreground"
+              />
+            </div>
+          )}
         </div>
       )}
     </div>
@@ -335,6 +394,7 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
   )
   const [isCreatingList, setIsCreatingList] = useState(false)
   const [newListName, setNewListName] = useState('')
+  const [newListQuery, setNewListQuery] = useState('')
   const [listToDelete, setListToDelete] = useState<BujoList | null>(null)
   const [showCompleted, setShowCompleted] = useState(false)
   const createInputRef = useRef<HTMLInputElement>(null)
@@ -370,6 +430,19 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
     }
   }
 
+  const handleToggleEntry = async (entryId: number, currentlyDone: boolean) => {
+    try {
+      if (currentlyDone) {
+        await MarkEntryUndone(entryId)
+      } else {
+        await MarkEntryDone(entryId)
+      }
+      onListChanged?.()
+    } catch (error) {
+      console.error('Failed to toggle entry:', error)
+    }
+  }
+
   const handleAddItem = async (listId: number, content: string) => {
     try {
       await AddListItem(listId, content)
@@ -392,9 +465,15 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
     const trimmed = newListName.trim()
     if (!trimmed) return
 
+    const query = newListQuery.trim()
     try {
-      await CreateList(trimmed)
+      if (query) {
+        await CreateSmartList(trimmed, query)
+      } else {
+        await CreateList(trimmed)
+      }
       setNewListName('')
+      setNewListQuery('')
       setIsCreatingList(false)
       onListChanged?.()
     } catch (error) {
@@ -408,6 +487,7 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
     } else if (e.key === 'Escape') {
       setIsCreatingList(false)
       setNewListName('')
+      setNewListQuery('')
     }
   }
 
@@ -468,6 +548,15 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
     }
   }
 
+  const handleScheduleItem = async (itemId: number) => {
+    try {
+      await ScheduleListItem(itemId, toWailsTime(new Date()))
+      onListChanged?.()
+    } catch (error) {
+      console.error('Failed to schedule list item:', error)
+    }
+  }
+
   const handleRequestDeleteList = (listId: number) => {
     const list = lists.find(l => l.id === listId)
     if (list) {
@@ -510,8 +599,17 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
             placeholder="List name"
             className="flex-1 px-2 py-1.5 text-sm rounded-md border border-border bg-background focus:outline-none focus:ring-2 focus:ring-primary/50"
           />
+          <input
+            type="text"
+            value={newListQuery}
+            onChange={(e) => setNewListQuery(e.target.value)}
+            onKeyDown={handleCreateKeyDown}
+            placeholder="Smart list query (optional), e.g. #waiting status:open"
+            aria-label="Smart list query"
+            className="flex-1 px-2 py-1.5 text-sm font-mono rounded-md border border-border bg-background focus:outline-none focus:ring-2 focus:ring-primary/50"
+          />
           <button
-            onClick={() => { setIsCreatingList(false); setNewListName('') }}
+            onClick={() => { setIsCreatingList(false); setNewListName(''); setNewListQuery('') }}
             className="p-1.5 rounded-md hover:bg-secondary transition-colors"
             aria-label="Cancel"
           >
@@ -525,11 +623,11 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
           <ListCard
             key={list.id}
             list={list}
-            otherLists={lists.filter(l => l.id !== list.id)}
+            otherLists={lists.filter(l => l.id !== list.id && !l.query)}
             isExpanded={expandedIds.has(list.id)}
             showCompleted={showCompleted}
             onToggle={() => toggleExpanded(list.id)}
-            onToggleItem={handleToggleItem}
+            onToggleItem={list.query ? handleToggleEntry : handleToggleItem}
             onAddItem={handleAddItem}
             onDeleteItem={handleDeleteItem}
             onDeleteList={handleRequestDeleteList}
@@ -538,6 +636,7 @@ export function ListsView({ lists, onListChanged }: ListsViewProps) {
             onCancelItem={handleCancelItem}
             onUncancelItem={handleUncancelItem}
             onMoveItem={handleMoveItem}
+            onScheduleItem={handleScheduleItem}
           />
         ))}
       </div>
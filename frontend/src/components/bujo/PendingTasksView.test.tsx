@@ -249,4 +249,22 @@ describe('PendingTasksView', () => {
       expect(screen.getByText('migrated')).toBeInTheDocument();
     })
   });
+
+  it('shows overdue list items with their list', () => {
+    const items = [
+      { id: 4, content: 'Renew passport', type: 'task' as const, done: false, dueDate: '2026-10-10', listName: 'Errands' },
+    ];
+    render(<PendingTasksView {...defaultProps} overdueListItems={items} />);
+
+    const section = screen.getByTestId('overdue-list-items');
+    expect(section).toHaveTextContent('Overdue List Items (1)');
+    expect(section).toHaveTextContent('Renew passport');
+    expect(section).toHaveTextContent('Errands');
+    expect(section).toHaveTextContent('overdue, due Oct 10');
+  });
+
+  it('hides the overdue list items section when there are none', () => {
+    render(<PendingTasksView {...defaultProps} />);
+    expect(screen.queryByTestId('overdue-list-items')).not.toBeInTheDocument();
+  });
 });
@@ -1,9 +1,10 @@
 import { useCallback, useEffect, useMemo, useRef, useState } from 'react';
-import { Entry, ENTRY_SYMBOLS, PRIORITY_SYMBOLS } from '@/types/bujo';
+import { Entry, ENTRY_SYMBOLS, OverdueListItem, PRIORITY_SYMBOLS } from '@/types/bujo';
 import { EntryActionBar } from './EntryActions/EntryActionBar';
 import { cn } from '@/lib/utils';
 import { useAttentionScores, AttentionScore } from '@/hooks/useAttentionScores';
 import { RefreshCw } from 'lucide-react';
+import { format, parseISO } from 'date-fns';
 
 export interface EntryCallbacks {
   onMarkDone?: (entry: Entry) => void;
@@ -20,6 +21,7 @@ export interface EntryCallbacks {
 
 interface PendingTasksViewProps {
   overdueEntries: Entry[];
+  overdueListItems?: OverdueListItem[];
   callbacks: EntryCallbacks;
   selectedEntry?: Entry;
   onSelectEntry: (entry: Entry) => void;
@@ -29,6 +31,7 @@ interface PendingTasksViewProps {
 
 export function PendingTasksView({
   overdueEntries,
+  overdueListItems = [],
   callbacks,
   selectedEntry,
   onSelectEntry,
@@ -181,6 +184,24 @@ export function PendingTasksView({
             />
           ))
         )}
+
+        {overdueListItems.length > 0 && (
+          <div className="pt-4" data-testid="overdue-list-items">
+            <h3 className="text-sm font-semibold text-muted-foreground mb-2">
+              Overdue List Items ({overdueListItems.length})
+            </h3>
+            {overdueListItems.map((item) => (
+              <div key={item.id} className="flex items-center gap-2 px-3 py-2 rounded-lg text-sm">
+                <span className="text-muted-foreground flex-shrink-0">{ENTRY_SYMBOLS.task}</span>
+                <span className="flex-1 truncate">{item.content}</span>
+                <span className="text-xs text-muted-foreground flex-shrink-0">{item.listName}</span>
+                <span className="text-xs px-1.5 py-0.5 rounded flex-shrink-0 bg-orange-100 text-orange-700 dark:bg-orange-900/30 dark:text-orange-400">
+                  overdue{item.dueDate && `, due ${format(parseISO(item.dueDate), 'MMM d')}`}
+                </span>
+              </div>
+            ))}
+          </div>
+        )}
       </div>
     </div>
   );
@@ -1,4 +1,4 @@
-import { cn } from '@/lib/utils';
+import { cn, longestStreakHabit } from '@/lib/utils';
 import { Calendar, CheckCircle2, AlertCircle } from 'lucide-react';
 import { format } from 'date-fns';
 import { DayEntries, Habit, Goal } from '@/types/bujo';
@@ -16,7 +16,7 @@ export function QuickStats({ days, habits, goals, overdueCount }: QuickStatsProp
   const tasksDone = todayEntries.filter(e => e.type === 'done').length;
   
   const habitsLoggedToday = habits.filter(h => h.todayLogged).length;
-  const longestStreak = Math.max(...habits.map(h => h.streak), 0);
+  const longestStreak = longestStreakHabit(habits);
   
   const currentMonth = format(new Date(), 'yyyy-MM');
   const monthGoals = goals.filter(g => g.month === currentMonth);
@@ -42,7 +42,7 @@ export function QuickStats({ days, habits, goals, overdueCount }: QuickStatsProp
         icon={Calendar}
         label="Habits Today"
         value={`${habitsLoggedToday}/${habits.length}`}
-        subtext={`${longestStreak} day streak`}
+        subtext={`${longestStreak?.streak ?? 0} ${longestStreak?.streakPeriod ?? 'day'} streak`}
         color="text-bujo-streak"
       />
       <StatCard
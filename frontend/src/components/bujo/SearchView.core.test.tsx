@@ -22,6 +22,7 @@ vi.mock('@/wailsjs/go/wails/App', () => ({
 }))
 
 import { Search } from '@/wailsjs/go/wails/App'
+import { asSearchResults } from '@/test/mocks'
 
 const createMockEntry = (overrides: Partial<{ ID: number; Content: string; Type: string; CreatedAt: string; ParentID: number | null }>) => ({
   ID: 1,
@@ -65,10 +66,10 @@ describe('SearchView', () => {
   })
 
   it('displays search results', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
       createMockEntry({ ID: 2, Content: 'Another entry', Type: 'note', CreatedAt: '2024-01-14T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -83,9 +84,9 @@ describe('SearchView', () => {
   })
 
   it('shows entry type symbols in results', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Task entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -99,9 +100,9 @@ describe('SearchView', () => {
   })
 
   it('shows date in results', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -134,9 +135,9 @@ describe('SearchView', () => {
   })
 
   it('clears results when search input is cleared', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -157,9 +158,9 @@ describe('SearchView', () => {
   })
 
   it('shows entry ID on hover', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -179,9 +180,9 @@ describe('SearchView simplified UI', () => {
   })
 
   it('does not render context popover', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Test', Type: 'task', ParentID: 2, CreatedAt: '2026-01-25T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -194,9 +195,9 @@ describe('SearchView simplified UI', () => {
   })
 
   it('shows context dot for entries with parents', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Child entry', Type: 'task', ParentID: 99, CreatedAt: '2026-01-25T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -209,9 +210,9 @@ describe('SearchView simplified UI', () => {
   })
 
   it('does not show context dot for root entries', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Root entry', Type: 'task', ParentID: null, CreatedAt: '2026-01-25T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -225,9 +226,9 @@ describe('SearchView simplified UI', () => {
   })
 
   it('does not show ContextPill', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Test', Type: 'task', ParentID: 2, CreatedAt: '2026-01-25T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -23,6 +23,7 @@ vi.mock('@/wailsjs/go/wails/App', () => ({
 }))
 
 import { Search, MarkEntryDone, MarkEntryUndone } from '@/wailsjs/go/wails/App'
+import { asSearchResults } from '@/test/mocks'
 
 const createMockEntry = (overrides: Partial<{ ID: number; Content: string; Type: string; CreatedAt: string; ParentID: number | null }>) => ({
   ID: 1,
@@ -43,9 +44,9 @@ describe('SearchView - Double Click Navigation', () => {
   })
 
   it('calls onNavigateToEntry when double-clicking a search result', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const onNavigateToEntry = vi.fn()
     const user = userEvent.setup()
@@ -74,9 +75,9 @@ describe('SearchView - Double Click Navigation', () => {
   })
 
   it('does not call onNavigateToEntry on single click', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const onNavigateToEntry = vi.fn()
     const user = userEvent.setup()
@@ -102,9 +103,9 @@ describe('SearchView - Symbol Click Toggle', () => {
   })
 
   it('calls MarkEntryDone when clicking symbol for task entry', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Task to complete', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -124,9 +125,9 @@ describe('SearchView - Symbol Click Toggle', () => {
   })
 
   it('calls MarkEntryUndone when clicking symbol for done entry', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Completed task', Type: 'done', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -146,9 +147,9 @@ describe('SearchView - Symbol Click Toggle', () => {
   })
 
   it('symbol shows task bullet for task entries', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Task entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -163,9 +164,9 @@ describe('SearchView - Symbol Click Toggle', () => {
   })
 
   it('symbol shows checkmark for done entries', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Done entry', Type: 'done', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -180,9 +181,9 @@ describe('SearchView - Symbol Click Toggle', () => {
   })
 
   it('symbol is not clickable for cancelled entries', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Cancelled entry', Type: 'cancelled', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -206,9 +207,9 @@ describe('SearchView - Entry Selection', () => {
   })
 
   it('calls onSelectEntry when clicking a search result', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 42, Content: 'Test entry', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const onSelectEntry = vi.fn()
     const user = userEvent.setup()
@@ -22,6 +22,7 @@ vi.mock('@/wailsjs/go/wails/App', () => ({
 }))
 
 import { Search, SearchByMentions, GetAllMentions } from '@/wailsjs/go/wails/App'
+import { asSearchResults } from '@/test/mocks'
 
 const createMockEntry = (overrides: Partial<{ ID: number; Content: string; Type: string; CreatedAt: string; ParentID: number | null }>) => ({
   ID: 1,
@@ -42,9 +43,9 @@ describe('SearchView - Mention Content Display', () => {
   })
 
   it('renders @mentions in search results as styled spans', async () => {
-    vi.mocked(Search).mockResolvedValue([
+    vi.mocked(Search).mockResolvedValue(asSearchResults([
       createMockEntry({ ID: 1, Content: 'Met with @john today', Type: 'task', CreatedAt: '2024-01-15T10:00:00Z' }),
-    ] as never)
+    ]) as never)
 
     const user = userEvent.setup()
     render(<SearchView />)
@@ -7,6 +7,31 @@ import { cn } from '@/lib/utils';
 import { ENTRY_SYMBOLS, EntryType, Priority, PRIORITY_SYMBOLS } from '@/types/bujo';
 import { AnswerQuestionModal } from './AnswerQuestionModal';
 
+const SNIPPET_MARK_START = '\u0002';
+const SNIPPET_MARK_END = '\u0003';
+
+function hasSnippetMarks(snippet: string | undefined): snippet is string {
+  return !!snippet && snippet.includes(SNIPPET_MARK_START);
+}
+
+function SnippetContent({ snippet }: { snippet: string }) {
+  const parts = snippet.split(SNIPPET_MARK_START);
+  return (
+    <span>
+      {parts[0]}
+      {parts.slice(1).map((part, i) => {
+        const [match, rest = ''] = part.split(SNIPPET_MARK_END);
+        return (
+          <span key={i}>
+            <mark className="bg-yellow-200 dark:bg-yellow-800 rounded-sm">{match}</mark>
+            {rest}
+          </span>
+        );
+      })}
+    </span>
+  );
+}
+
 export interface SearchResult {
   id: number;
   content: string;
@@ -29,6 +54,7 @@ interface SearchViewProps {
 export function SearchView({ initialTagFilter, initialMentionFilter, onMigrate, onNavigateToEntry, onSelectEntry, onTagClick, onMentionClick }: SearchViewProps) {
   const [query, setQuery] = useState('');
   const [results, setResults] = useState<SearchResult[]>([]);
+  const [snippets, setSnippets] = useState<Record<number, string>>({});
   const [hasSearched, setHasSearched] = useState(false);
   const [selectedIndex, setSelectedIndex] = useState(-1);
   const [answerModalOpen, setAnswerModalOpen] = useState(false);
@@ -54,6 +80,7 @@ export function SearchView({ initialTagFilter, initialMentionFilter, onMigrate,
           date: (entry.CreatedAt as unknown as string) || '',
           parentId: entry.ParentID ?? null,
         }));
+        setSnippets({});
         setResults(mapped);
         setHasSearched(true);
       }).catch(() => {});
@@ -71,6 +98,7 @@ export function SearchView({ initialTagFilter, initialMentionFilter, onMigrate,
           date: (entry.CreatedAt as unknown as string) || '',
           parentId: entry.ParentID ?? null,
         }));
+        setSnippets({});
         setResults(mapped);
         setHasSearched(true);
       }).catch(() => {});
@@ -111,14 +139,16 @@ export function SearchView({ initialTagFilter, initialMentionFilter, onMigrate,
 
     try {
       const searchResults = await Search(searchQuery);
-      const mappedResults = (searchResults || []).map(entry => ({
-        id: entry.ID,
-        content: entry.Content,
-        type: (entry.Type as string).toLowerCase() as EntryType,
-        priority: ((entry.Priority as string)?.toLowerCase() || 'none') as Priority,
-        date: (entry.CreatedAt as unknown as string) || '',
-        parentId: entry.ParentID ?? null,
+      const entryResults = (searchResults || []).filter(result => result.Entry);
+      const mappedResults = entryResults.map(({ Entry: entry }) => ({
+        id: entry!.ID,
+        content: entry!.Content,
+        type: (entry!.Type as string).toLowerCase() as EntryType,
+        priority: ((entry!.Priority as string)?.toLowerCase() || 'none') as Priority,
+        date: (entry!.CreatedAt as unknown as string) || '',
+        parentId: entry!.ParentID ?? null,
       }));
+      setSnippets(Object.fromEntries(entryResults.map(result => [result.ID, result.Snippet])));
       setResults(mappedResults);
       setHasSearched(true);
     } catch (error) {
@@ -463,7 +493,11 @@ export function SearchView({ initialTagFilter, initialMentionFilter, onMigrate,
                     result.type === 'done' && 'text-bujo-done',
                     result.type === 'cancelled' && 'line-through text-muted-foreground'
                   )}>
-                    <TagContent content={result.content} onTagClick={onTagClick} onMentionClick={onMentionClick} />
+                    {hasSnippetMarks(snippets[result.id]) ? (
+                      <SnippetContent snippet={snippets[result.id]} />
+                    ) : (
+                      <TagContent content={result.content} onTagClick={onTagClick} onMentionClick={onMentionClick} />
+                    )}
                   </p>
                   <p className="text-xs text-muted-foreground mt-1">
                     {formatDate(result.date)}
@@ -1,6 +1,6 @@
 import { useState, useEffect, useCallback } from 'react';
 import { BarChart3, CheckCircle2, Circle, FileText, Calendar, Flame, Target } from 'lucide-react';
-import { cn } from '@/lib/utils';
+import { cn, formatStreak, longestStreakHabit } from '@/lib/utils';
 import { Habit, Goal, Entry, DayEntries } from '@/types/bujo';
 import { format } from 'date-fns';
 import { ActivityHeatmap } from './ActivityHeatmap';
@@ -58,7 +58,7 @@ export function StatsView({ habits, goals }: StatsViewProps) {
   const taskPercentage = totalEntries > 0 ? Math.round((taskCount / totalEntries) * 100) : 0;
   const completionRate = taskCount > 0 ? Math.round((doneCount / taskCount) * 100) : 0;
 
-  const bestStreak = Math.max(...habits.map(h => h.streak), 0);
+  const bestStreak = longestStreakHabit(habits);
 
   const currentMonth = format(new Date(), 'yyyy-MM');
   const monthGoals = goals.filter(g => g.month === currentMonth);
@@ -130,8 +130,8 @@ export function StatsView({ habits, goals }: StatsViewProps) {
           <StatCard
             icon={Flame}
             label="Best Streak"
-            value={bestStreak}
-            subtext={`${bestStreak} days`}
+            value={bestStreak?.streak ?? 0}
+            subtext={formatStreak(bestStreak?.streak ?? 0, bestStreak?.streakPeriod)}
             color="text-bujo-streak"
           />
         </div>
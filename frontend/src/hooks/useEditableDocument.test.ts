@@ -138,7 +138,7 @@ describe('useEditableDocument', () => {
         await vi.advanceTimersByTimeAsync(500)
       })
 
-      expect(mockValidateEditableDocument).toHaveBeenCalledWith('. Changed content')
+      expect(mockValidateEditableDocument).toHaveBeenCalledWith('. Changed content', expect.anything())
 
       vi.useRealTimers()
     })
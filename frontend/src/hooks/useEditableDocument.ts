@@ -13,9 +13,20 @@ export interface ValidationError {
   quickFixes?: string[]
 }
 
+export interface DocumentChange {
+  kind: string
+  entryId: number
+  lineNumber: number
+  before: string
+  after: string
+}
+
 export interface ApplyResult {
   inserted: number
+  updated: number
+  reparented: number
   deleted: number
+  changes: DocumentChange[]
 }
 
 export interface SaveResult {
@@ -37,6 +48,7 @@ export interface EditableDocumentState {
   error: string | null
   isDirty: boolean
   validationErrors: ValidationError[]
+  preview: ApplyResult | null
   reload: () => Promise<void>
   save: () => Promise<SaveResult>
   saveWithActions: (actions: SaveActions) => Promise<SaveResult>
@@ -66,6 +78,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
   const [isLoading, setIsLoading] = useState(true)
   const [error, setError] = useState<string | null>(null)
   const [validationErrors, setValidationErrors] = useState<ValidationError[]>([])
+  const [preview, setPreview] = useState<ApplyResult | null>(null)
   const [lastSaved, setLastSaved] = useState<Date | null>(null)
   const [hasDraft, setHasDraft] = useState(false)
 
@@ -109,6 +122,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
       setIsLoading(true)
       setError(null)
       setValidationErrors([])
+      setPreview(null)
 
       try {
         const result = await GetEditableDocument(toWailsTime(date))
@@ -141,6 +155,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
     setIsLoading(true)
     setError(null)
     setValidationErrors([])
+    setPreview(null)
 
     try {
       const result = await GetEditableDocument(toWailsTime(date))
@@ -168,12 +183,13 @@ export function useEditableDocument(date: Date): EditableDocumentState {
 
   const validateDocument = useCallback(async (doc: string) => {
     try {
-      const result = await ValidateEditableDocument(doc)
+      const result = await ValidateEditableDocument(doc, toWailsTime(date))
       setValidationErrors(result.errors || [])
+      setPreview(result.preview ?? null)
     } catch {
       // Validation failures are not critical errors
     }
-  }, [])
+  }, [date])
 
   const setDocument = useCallback(
     (newDoc: string) => {
@@ -197,7 +213,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
 
   const save = useCallback(async (): Promise<SaveResult> => {
     try {
-      const validation = await ValidateEditableDocument(document)
+      const validation = await ValidateEditableDocument(document, toWailsTime(date))
       if (!validation.isValid) {
         setValidationErrors(validation.errors || [])
         return { success: false, error: 'Validation failed' }
@@ -209,6 +225,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
       setDocumentState(reloaded)
       setOriginalDocument(reloaded)
       setLastSaved(new Date())
+      setPreview(null)
       clearDraft()
 
       return { success: true, result }
@@ -220,7 +237,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
 
   const saveWithActions = useCallback(async (actions: SaveActions): Promise<SaveResult> => {
     try {
-      const validation = await ValidateEditableDocument(document)
+      const validation = await ValidateEditableDocument(document, toWailsTime(date))
       if (!validation.isValid) {
         setValidationErrors(validation.errors || [])
         return { success: false, error: 'Validation failed' }
@@ -240,6 +257,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
       setDocumentState(reloaded)
       setOriginalDocument(reloaded)
       setLastSaved(new Date())
+      setPreview(null)
       clearDraft()
 
       return { success: true, result }
@@ -252,6 +270,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
   const discardChanges = useCallback(() => {
     setDocumentState(originalDocument)
     setValidationErrors([])
+    setPreview(null)
   }, [originalDocument])
 
   const restoreDraft = useCallback(() => {
@@ -289,6 +308,7 @@ export function useEditableDocument(date: Date): EditableDocumentState {
     error,
     isDirty,
     validationErrors,
+    preview,
     reload,
     save,
     saveWithActions,
@@ -4,6 +4,7 @@ import {
   transformDayEntries,
   transformHabit,
   transformList,
+  transformOverdueListItem,
   transformGoal,
 } from './transforms'
 import { domain, service, wails } from '../wailsjs/go/models'
@@ -318,6 +319,29 @@ describe('transformHabit', () => {
     expect(result.todayLogged).toBe(false)
   })
 
+  it('maps the streak period of weekly and monthly habits', () => {
+    const weekly = transformHabit({
+      ID: 1,
+      Name: 'Gym',
+      GoalPerDay: 1,
+      GoalPerWeek: 3,
+      CurrentStreak: 4,
+      StreakPeriod: 'week',
+      DayHistory: [],
+    } as unknown as service.HabitStatus)
+    const daily = transformHabit({
+      ID: 2,
+      Name: 'Read',
+      GoalPerDay: 1,
+      CurrentStreak: 2,
+      StreakPeriod: 'day',
+      DayHistory: [],
+    } as unknown as service.HabitStatus)
+
+    expect(weekly.streakPeriod).toBe('week')
+    expect(daily.streakPeriod).toBeUndefined()
+  })
+
   it('maps weekly and monthly goal fields', () => {
     const input = {
       ID: 1,
@@ -458,6 +482,36 @@ describe('transformList', () => {
     expect(result.totalCount).toBe(3)
   })
 
+  it('keeps the date an item is due', () => {
+    const input = {
+      ID: 1,
+      Name: 'Errands',
+      Items: [
+        { RowID: 1, Content: 'Renew passport', Type: 'task', DueDate: '2026-10-23T00:00:00Z' },
+        { RowID: 2, Content: 'Call mum', Type: 'task' },
+      ],
+    } as unknown as wails.ListWithItems
+
+    const result = transformList(input)
+
+    expect(result.items[0].dueDate).toBe('2026-10-23')
+    expect(result.items[1].dueDate).toBeUndefined()
+  })
+
+  it('keeps the entry ID of smart list items', () => {
+    const input = {
+      ID: 1,
+      Name: 'Waiting',
+      Query: '#waiting',
+      Items: [{ RowID: 0, EntryID: 7, Content: 'Chase invoice', Type: 'task' }],
+    } as unknown as wails.ListWithItems
+
+    const result = transformList(input)
+
+    expect(result.items[0].id).toBe(0)
+    expect(result.items[0].entryId).toBe(7)
+  })
+
   it('correctly identifies done items', () => {
     const input = {
       ID: 2,
@@ -502,6 +556,44 @@ describe('transformList', () => {
     expect(result.doneCount).toBe(0)
     expect(result.totalCount).toBe(0)
   })
+
+  it('keeps the query of a smart list', () => {
+    const input = {
+      ID: 5,
+      Name: 'Waiting',
+      Query: '#waiting status:open',
+      Items: [{ RowID: 12, Content: 'Chase invoice #waiting', Type: 'task' }],
+    } as unknown as wails.ListWithItems
+
+    const result = transformList(input)
+
+    expect(result.query).toBe('#waiting status:open')
+    expect(result.items[0].id).toBe(12)
+  })
+
+  it('leaves query unset for a plain list', () => {
+    const input = { ID: 6, Name: 'Shopping', Query: '', Items: [] } as unknown as wails.ListWithItems
+
+    expect(transformList(input).query).toBeUndefined()
+  })
+})
+
+describe('transformOverdueListItem', () => {
+  it('keeps the name of the list the item is on', () => {
+    const input = {
+      RowID: 4,
+      Content: 'Renew passport',
+      Type: 'task',
+      DueDate: '2026-10-10T00:00:00Z',
+      ListName: 'Errands',
+    } as unknown as service.OverdueListItem
+
+    const result = transformOverdueListItem(input)
+
+    expect(result.id).toBe(4)
+    expect(result.dueDate).toBe('2026-10-10')
+    expect(result.listName).toBe('Errands')
+  })
 })
 
 describe('transformGoal', () => {
@@ -1,6 +1,6 @@
 import { format } from 'date-fns'
 import { service, domain, wails } from '../wailsjs/go/models'
-import { DayEntries, Entry, Habit, BujoList, Goal, EntryType, Priority } from '@/types/bujo'
+import { DayEntries, Entry, Habit, BujoList, Goal, EntryType, ListItem, ListItemType, OverdueListItem, Priority } from '@/types/bujo'
 
 export function transformEntry(e: domain.Entry): Entry {
   const loggedDate = e.ScheduledDate
@@ -40,38 +40,61 @@ export function transformHabit(h: service.HabitStatus): Habit {
     id: h.ID,
     name: h.Name,
     streak: h.CurrentStreak,
+    streakPeriod: h.StreakPeriod === 'week' || h.StreakPeriod === 'month' ? h.StreakPeriod : undefined,
     completionRate: Math.round(h.CompletionPercent * 10) / 10,
     goal: h.GoalPerDay,
     goalPerWeek: h.GoalPerWeek > 0 ? h.GoalPerWeek : undefined,
     goalPerMonth: h.GoalPerMonth > 0 ? h.GoalPerMonth : undefined,
+    schedule: h.Schedule && h.Schedule !== 'daily' ? h.Schedule : undefined,
+    measure: h.Kind === 'measured'
+      ? { unit: h.Unit, target: h.Target, atMost: h.TargetType === 'at_most', todayValue: h.TodayValue }
+      : undefined,
+    limit: h.TargetType === 'at_most'
+      ? { ceiling: h.Ceiling, used: h.PeriodTotal, over: h.OverLimit }
+      : undefined,
     weeklyProgress: h.WeeklyProgress > 0 ? Math.round(h.WeeklyProgress * 10) / 10 : undefined,
     monthlyProgress: h.MonthlyProgress > 0 ? Math.round(h.MonthlyProgress * 10) / 10 : undefined,
     dayHistory: (h.DayHistory || []).map(d => ({
       date: d.Date ? String(d.Date).split('T')[0] : '',
       completed: d.Completed,
       count: d.Count,
+      value: h.Kind === 'measured' ? d.Value : undefined,
+      rest: d.Rest || undefined,
+      paused: d.Paused || undefined,
+      over: d.Over || undefined,
     })),
     todayLogged: h.TodayCount > 0,
     todayCount: h.TodayCount,
   }
 }
 
-export function transformList(l: wails.ListWithItems): BujoList {
-  const items = (l.Items || []).map(item => ({
+function transformListItem(item: domain.ListItem | service.OverdueListItem): ListItem {
+  return {
     id: item.RowID,
     content: item.Content,
-    type: item.Type.toLowerCase() as EntryType,
+    type: item.Type.toLowerCase() as ListItemType,
     done: item.Type.toLowerCase() === 'done',
-  }))
+    dueDate: item.DueDate ? String(item.DueDate).split('T')[0] : undefined,
+    entryId: item.EntryID || undefined,
+  }
+}
+
+export function transformList(l: wails.ListWithItems): BujoList {
+  const items = (l.Items || []).map(transformListItem)
   return {
     id: l.ID,
     name: l.Name,
+    query: l.Query || undefined,
     items,
     doneCount: items.filter(i => i.done).length,
     totalCount: items.length,
   }
 }
 
+export function transformOverdueListItem(item: service.OverdueListItem): OverdueListItem {
+  return { ...transformListItem(item), listName: item.ListName }
+}
+
 export function transformGoal(g: domain.Goal): Goal {
   const monthStr = g.Month ? String(g.Month).slice(0, 7) : format(new Date(), 'yyyy-MM')
   return {
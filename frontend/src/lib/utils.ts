@@ -1,10 +1,30 @@
 import { clsx, type ClassValue } from "clsx";
 import { twMerge } from "tailwind-merge";
+import type { Habit } from "@/types/bujo";
 
 export function cn(...inputs: ClassValue[]) {
   return twMerge(clsx(inputs));
 }
 
+export function formatStreak(streak: number, period: 'day' | 'week' | 'month' = 'day'): string {
+  return `${streak} ${period}${streak === 1 ? '' : 's'}`
+}
+
+const streakSpanDays = { day: 1, week: 7, month: 30 }
+
+// Streaks are kept in days, weeks or months, so compare them by roughly how
+// long they have lasted.
+export function longestStreakHabit(habits: Habit[]): Habit | undefined {
+  let best: Habit | undefined
+  for (const habit of habits) {
+    const span = habit.streak * streakSpanDays[habit.streakPeriod ?? 'day']
+    if (span > 0 && (!best || span > best.streak * streakSpanDays[best.streakPeriod ?? 'day'])) {
+      best = habit
+    }
+  }
+  return best
+}
+
 export function startOfDay(date: Date): Date {
   const result = new Date(date)
   result.setHours(0, 0, 0, 0)
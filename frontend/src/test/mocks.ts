@@ -45,3 +45,15 @@ export function createMockDays(days: service.DayEntries[] = [createMockDayEntrie
 export function createMockOverdue(entries: domain.Entry[] = []): domain.Entry[] {
   return entries
 }
+
+export function asSearchResults(entries: Array<{ ID: number; Content: string }>): domain.SearchResult[] {
+  return entries.map(entry => ({
+    Source: 'entry',
+    ID: entry.ID,
+    Content: entry.Content,
+    Snippet: entry.Content,
+    Rank: 0,
+    Context: '',
+    Entry: entry,
+  }) as unknown as domain.SearchResult)
+}
@@ -32,16 +32,46 @@ export interface HabitDayStatus {
   date: string;
   completed: boolean;
   count: number;
+  // The day's total for measured habits.
+  value?: number;
+  // Set for days outside the habit's schedule, which are not missed.
+  rest?: boolean;
+  // Set for days covered by a pause or skip, which are not missed either.
+  paused?: boolean;
+  // Set for days a limit habit had gone over its ceiling.
+  over?: boolean;
+}
+
+export interface HabitMeasure {
+  unit: string;
+  target: number;
+  atMost: boolean;
+  todayValue: number;
+}
+
+export interface HabitLimit {
+  ceiling: number;
+  // What has been logged so far in the limit's day, week or month.
+  used: number;
+  over: boolean;
 }
 
 export interface Habit {
   id: number;
   name: string;
   streak: number;
+  // Set for habits whose streak is kept per week or month rather than per day.
+  streakPeriod?: 'week' | 'month';
   completionRate: number;
   goal?: number;
   goalPerWeek?: number;
   goalPerMonth?: number;
+  // Set for habits due only on some days, e.g. "mon,wed,fri".
+  schedule?: string;
+  // Set for measured habits, which log decimal values such as "5.2 km".
+  measure?: HabitMeasure;
+  // Set for habits to do less of, whose goal is a ceiling.
+  limit?: HabitLimit;
   weeklyProgress?: number;
   monthlyProgress?: number;
   dayHistory: HabitDayStatus[];
@@ -49,16 +79,25 @@ export interface Habit {
   todayCount: number;
 }
 
+export type ListItemType = 'task' | 'done' | 'cancelled' | 'scheduled';
+
 export interface ListItem {
   id: number;
   content: string;
-  type: EntryType;
+  type: ListItemType;
   done: boolean;
+  dueDate?: string;
+  entryId?: number;
+}
+
+export interface OverdueListItem extends ListItem {
+  listName: string;
 }
 
 export interface BujoList {
   id: number;
   name: string;
+  query?: string;
   items: ListItem[];
   doneCount: number;
   totalCount: number;
@@ -27,6 +27,7 @@ export namespace domain {
 	    Depth: number;
 	    Location?: string;
 	    ScheduledDate?: time.Time;
+	    Granularity: string;
 	    CreatedAt: time.Time;
 	    SortOrder: number;
 	    MigrationCount: number;
@@ -34,6 +35,7 @@ export namespace domain {
 	    OriginalCreatedAt?: time.Time;
 	    Tags: string[];
 	    Mentions: string[];
+	    Projected: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new Entry(source);
@@ -51,6 +53,7 @@ export namespace domain {
 	        this.Depth = source["Depth"];
 	        this.Location = source["Location"];
 	        this.ScheduledDate = this.convertValues(source["ScheduledDate"], time.Time);
+	        this.Granularity = source["Granularity"];
 	        this.CreatedAt = this.convertValues(source["CreatedAt"], time.Time);
 	        this.SortOrder = source["SortOrder"];
 	        this.MigrationCount = source["MigrationCount"];
@@ -58,6 +61,7 @@ export namespace domain {
 	        this.OriginalCreatedAt = this.convertValues(source["OriginalCreatedAt"], time.Time);
 	        this.Tags = source["Tags"];
 	        this.Mentions = source["Mentions"];
+	        this.Projected = source["Projected"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -83,6 +87,8 @@ export namespace domain {
 	    EntityID: string;
 	    Content: string;
 	    Month: time.Time;
+	    Horizon: string;
+	    ParentEntityID?: string;
 	    Status: string;
 	    MigratedTo?: time.Time;
 	    CreatedAt: time.Time;
@@ -97,6 +103,8 @@ export namespace domain {
 	        this.EntityID = source["EntityID"];
 	        this.Content = source["Content"];
 	        this.Month = this.convertValues(source["Month"], time.Time);
+	        this.Horizon = source["Horizon"];
+	        this.ParentEntityID = source["ParentEntityID"];
 	        this.Status = source["Status"];
 	        this.MigratedTo = this.convertValues(source["MigratedTo"], time.Time);
 	        this.CreatedAt = this.convertValues(source["CreatedAt"], time.Time);
@@ -460,9 +468,17 @@ export namespace domain {
 	    ValidTo?: time.Time;
 	    OpType: string;
 	    ListEntityID: string;
+	    ParentEntityID?: string;
+	    SortOrder: number;
+	    Priority: string;
 	    Type: string;
 	    Content: string;
 	    CreatedAt: time.Time;
+	    DueDate?: time.Time;
+	    Tags: string[];
+	    Mentions: string[];
+	    Depth: number;
+	    EntryID: number;
 	
 	    static createFrom(source: any = {}) {
 	        return new ListItem(source);
@@ -477,9 +493,99 @@ export namespace domain {
 	        this.ValidTo = this.convertValues(source["ValidTo"], time.Time);
 	        this.OpType = source["OpType"];
 	        this.ListEntityID = source["ListEntityID"];
+	        this.ParentEntityID = source["ParentEntityID"];
+	        this.SortOrder = source["SortOrder"];
+	        this.Priority = source["Priority"];
 	        this.Type = source["Type"];
 	        this.Content = source["Content"];
 	        this.CreatedAt = this.convertValues(source["CreatedAt"], time.Time);
+	        this.DueDate = this.convertValues(source["DueDate"], time.Time);
+	        this.Tags = source["Tags"];
+	        this.Mentions = source["Mentions"];
+	        this.Depth = source["Depth"];
+	        this.EntryID = source["EntryID"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class Operation {
+	    ID: number;
+	    Description: string;
+	    CreatedAt: time.Time;
+	    UndoneAt?: time.Time;
+	    Changes: number;
+	
+	    static createFrom(source: any = {}) {
+	        return new Operation(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.ID = source["ID"];
+	        this.Description = source["Description"];
+	        this.CreatedAt = this.convertValues(source["CreatedAt"], time.Time);
+	        this.UndoneAt = this.convertValues(source["UndoneAt"], time.Time);
+	        this.Changes = source["Changes"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class SearchResult {
+	    Source: string;
+	    ID: number;
+	    Content: string;
+	    Snippet: string;
+	    Rank: number;
+	    Date?: time.Time;
+	    Context: string;
+	    Entry?: Entry;
+	
+	    static createFrom(source: any = {}) {
+	        return new SearchResult(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.Source = source["Source"];
+	        this.ID = source["ID"];
+	        this.Content = source["Content"];
+	        this.Snippet = source["Snippet"];
+	        this.Rank = source["Rank"];
+	        this.Date = this.convertValues(source["Date"], time.Time);
+	        this.Context = source["Context"];
+	        this.Entry = this.convertValues(source["Entry"], Entry);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -630,6 +736,10 @@ export namespace service {
 	    Date: time.Time;
 	    Completed: boolean;
 	    Count: number;
+	    Value: number;
+	    Rest: boolean;
+	    Paused: boolean;
+	    Over: boolean;
 	
 	    static createFrom(source: any = {}) {
 	        return new DayStatus(source);
@@ -640,6 +750,10 @@ export namespace service {
 	        this.Date = this.convertValues(source["Date"], time.Time);
 	        this.Completed = source["Completed"];
 	        this.Count = source["Count"];
+	        this.Value = source["Value"];
+	        this.Rest = source["Rest"];
+	        this.Paused = source["Paused"];
+	        this.Over = source["Over"];
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
@@ -666,11 +780,22 @@ export namespace service {
 	    GoalPerDay: number;
 	    GoalPerWeek: number;
 	    GoalPerMonth: number;
+	    Kind: string;
+	    Unit: string;
+	    Target: number;
+	    TargetType: string;
+	    Schedule: string;
 	    CurrentStreak: number;
+	    StreakPeriod: string;
 	    CompletionPercent: number;
 	    WeeklyProgress: number;
 	    MonthlyProgress: number;
 	    TodayCount: number;
+	    TodayValue: number;
+	    Ceiling: number;
+	    PeriodTotal: number;
+	    OverLimit: boolean;
+	    ArchivedAt?: time.Time;
 	    DayHistory: DayStatus[];
 	
 	    static createFrom(source: any = {}) {
@@ -684,11 +809,22 @@ export namespace service {
 	        this.GoalPerDay = source["GoalPerDay"];
 	        this.GoalPerWeek = source["GoalPerWeek"];
 	        this.GoalPerMonth = source["GoalPerMonth"];
+	        this.Kind = source["Kind"];
+	        this.Unit = source["Unit"];
+	        this.Target = source["Target"];
+	        this.TargetType = source["TargetType"];
+	        this.Schedule = source["Schedule"];
 	        this.CurrentStreak = source["CurrentStreak"];
+	        this.StreakPeriod = source["StreakPeriod"];
 	        this.CompletionPercent = source["CompletionPercent"];
 	        this.WeeklyProgress = source["WeeklyProgress"];
 	        this.MonthlyProgress = source["MonthlyProgress"];
 	        this.TodayCount = source["TodayCount"];
+	        this.TodayValue = source["TodayValue"];
+	        this.Ceiling = source["Ceiling"];
+	        this.PeriodTotal = source["PeriodTotal"];
+	        this.OverLimit = source["OverLimit"];
+	        this.ArchivedAt = this.convertValues(source["ArchivedAt"], time.Time);
 	        this.DayHistory = this.convertValues(source["DayHistory"], DayStatus);
 	    }
 	
@@ -710,6 +846,72 @@ export namespace service {
 		    return a;
 		}
 	}
+	export class OverdueListItem {
+	    RowID: number;
+	    EntityID: string;
+	    Version: number;
+	    ValidFrom: time.Time;
+	    ValidTo?: time.Time;
+	    OpType: string;
+	    ListEntityID: string;
+	    ParentEntityID?: string;
+	    SortOrder: number;
+	    Priority: string;
+	    Type: string;
+	    Content: string;
+	    CreatedAt: time.Time;
+	    DueDate?: time.Time;
+	    Tags: string[];
+	    Mentions: string[];
+	    Depth: number;
+	    EntryID: number;
+	    ListName: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new OverdueListItem(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.RowID = source["RowID"];
+	        this.EntityID = source["EntityID"];
+	        this.Version = source["Version"];
+	        this.ValidFrom = this.convertValues(source["ValidFrom"], time.Time);
+	        this.ValidTo = this.convertValues(source["ValidTo"], time.Time);
+	        this.OpType = source["OpType"];
+	        this.ListEntityID = source["ListEntityID"];
+	        this.ParentEntityID = source["ParentEntityID"];
+	        this.SortOrder = source["SortOrder"];
+	        this.Priority = source["Priority"];
+	        this.Type = source["Type"];
+	        this.Content = source["Content"];
+	        this.CreatedAt = this.convertValues(source["CreatedAt"], time.Time);
+	        this.DueDate = this.convertValues(source["DueDate"], time.Time);
+	        this.Tags = source["Tags"];
+	        this.Mentions = source["Mentions"];
+	        this.Depth = source["Depth"];
+	        this.EntryID = source["EntryID"];
+	        this.ListName = source["ListName"];
+	    }
+	
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
 	export class TrackerStatus {
 	    Habits: HabitStatus[];
 	
@@ -764,7 +966,10 @@ export namespace wails {
 	
 	export class ApplyResult {
 	    inserted: number;
+	    updated: number;
+	    reparented: number;
 	    deleted: number;
+	    changes: DocumentChange[];
 	
 	    static createFrom(source: any = {}) {
 	        return new ApplyResult(source);
@@ -773,7 +978,48 @@ export namespace wails {
 	    constructor(source: any = {}) {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.inserted = source["inserted"];
+	        this.updated = source["updated"];
+	        this.reparented = source["reparented"];
 	        this.deleted = source["deleted"];
+	        this.changes = this.convertValues(source["changes"], DocumentChange);
+	    }
+
+		convertValues(a: any, classs: any, asMap: boolean = false): any {
+		    if (!a) {
+		        return a;
+		    }
+		    if (a.slice && a.map) {
+		        return (a as any[]).map(elem => this.convertValues(elem, classs));
+		    } else if ("object" === typeof a) {
+		        if (asMap) {
+		            for (const key of Object.keys(a)) {
+		                a[key] = new classs(a[key]);
+		            }
+		            return a;
+		        }
+		        return new classs(a);
+		    }
+		    return a;
+		}
+	}
+	export class DocumentChange {
+	    kind: string;
+	    entryId: number;
+	    lineNumber: number;
+	    before: string;
+	    after: string;
+	
+	    static createFrom(source: any = {}) {
+	        return new DocumentChange(source);
+	    }
+	
+	    constructor(source: any = {}) {
+	        if ('string' === typeof source) source = JSON.parse(source);
+	        this.kind = source["kind"];
+	        this.entryId = source["entryId"];
+	        this.lineNumber = source["lineNumber"];
+	        this.before = source["before"];
+	        this.after = source["after"];
 	    }
 	}
 	export class ImportedPage {
@@ -856,6 +1102,7 @@ export namespace wails {
 	export class ListWithItems {
 	    ID: number;
 	    Name: string;
+	    Query: string;
 	    Items: domain.ListItem[];
 	
 	    static createFrom(source: any = {}) {
@@ -866,6 +1113,7 @@ export namespace wails {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.ID = source["ID"];
 	        this.Name = source["Name"];
+	        this.Query = source["Query"];
 	        this.Items = this.convertValues(source["Items"], domain.ListItem);
 	    }
 	
@@ -932,6 +1180,7 @@ export namespace wails {
 	export class ValidationResult {
 	    isValid: boolean;
 	    errors: ValidationError[];
+	    preview?: ApplyResult;
 	
 	    static createFrom(source: any = {}) {
 	        return new ValidationResult(source);
@@ -941,6 +1190,7 @@ export namespace wails {
 	        if ('string' === typeof source) source = JSON.parse(source);
 	        this.isValid = source["isValid"];
 	        this.errors = this.convertValues(source["errors"], ValidationError);
+	        this.preview = this.convertValues(source["preview"], ApplyResult);
 	    }
 	
 		convertValues(a: any, classs: any, asMap: boolean = false): any {
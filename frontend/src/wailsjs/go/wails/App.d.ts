@@ -19,18 +19,24 @@ export function ApplyEditableDocument(arg1:string,arg2:time.Time):Promise<wails.
 
 export function ApplyEditableDocumentWithActions(arg1:string,arg2:time.Time,arg3:time.Time,arg4:any):Promise<wails.ApplyResult>;
 
+export function ArchiveHabit(arg1:number):Promise<void>;
+
 export function CancelEntry(arg1:number):Promise<void>;
 
 export function CancelGoal(arg1:number):Promise<void>;
 
 export function CancelListItem(arg1:number):Promise<void>;
 
+export function ClearHabitLimit(arg1:number):Promise<void>;
+
 export function CreateGoal(arg1:string,arg2:time.Time):Promise<number>;
 
 export function CreateHabit(arg1:string):Promise<number>;
 
 export function CreateList(arg1:string):Promise<number>;
 
+export function CreateSmartList(arg1:string,arg2:string):Promise<number>;
+
 export function CyclePriority(arg1:number):Promise<void>;
 
 export function DeleteEntry(arg1:number):Promise<void>;
@@ -95,10 +101,14 @@ export function GetLists():Promise<Array<wails.ListWithItems>>;
 
 export function GetLocationHistory():Promise<Array<string>>;
 
+export function GetOperationHistory(arg1:number):Promise<Array<domain.Operation>>;
+
 export function GetOutstandingQuestions():Promise<Array<domain.Entry>>;
 
 export function GetOverdue():Promise<Array<domain.Entry>>;
 
+export function GetOverdueListItems():Promise<Array<service.OverdueListItem>>;
+
 export function GetPlatformCapabilities():Promise<wails.PlatformCapabilities>;
 
 export function GetVersion():Promise<string>;
@@ -121,6 +131,8 @@ export function LogHabit(arg1:number,arg2:number):Promise<void>;
 
 export function LogHabitForDate(arg1:number,arg2:number,arg3:time.Time):Promise<void>;
 
+export function LogHabitValueForDate(arg1:number,arg2:number,arg3:string,arg4:time.Time):Promise<void>;
+
 export function MarkEntryDone(arg1:number):Promise<void>;
 
 export function MarkEntryUndone(arg1:number):Promise<void>;
@@ -147,6 +159,8 @@ export function OpenFileDialog():Promise<string>;
 
 export function ReadFile(arg1:string):Promise<string>;
 
+export function Redo():Promise<domain.Operation>;
+
 export function RegisterRemarkableDevice(arg1:string):Promise<void>;
 
 export function RemoveListItem(arg1:number):Promise<void>;
@@ -157,7 +171,9 @@ export function ResolveDate(arg1:string):Promise<wails.ResolvedDate>;
 
 export function RetypeEntry(arg1:number,arg2:string):Promise<void>;
 
-export function Search(arg1:string):Promise<Array<domain.Entry>>;
+export function ScheduleListItem(arg1:number,arg2:time.Time):Promise<number>;
+
+export function Search(arg1:string):Promise<Array<domain.SearchResult>>;
 
 export function SearchByMentions(arg1:Array<string>):Promise<Array<domain.Entry>>;
 
@@ -165,8 +181,14 @@ export function SearchByTags(arg1:Array<string>):Promise<Array<domain.Entry>>;
 
 export function SetHabitGoal(arg1:number,arg2:number):Promise<void>;
 
+export function SetHabitLimit(arg1:number,arg2:number,arg3:string):Promise<void>;
+
 export function SetHabitMonthlyGoal(arg1:number,arg2:number):Promise<void>;
 
+export function SetHabitSchedule(arg1:number,arg2:string):Promise<void>;
+
+export function SetHabitTarget(arg1:number,arg2:string,arg3:boolean):Promise<void>;
+
 export function SetHabitWeeklyGoal(arg1:number,arg2:number):Promise<void>;
 
 export function SetLocation(arg1:time.Time,arg2:string):Promise<void>;
@@ -175,20 +197,26 @@ export function SetMood(arg1:time.Time,arg2:string):Promise<void>;
 
 export function SetPriority(arg1:number,arg2:string):Promise<void>;
 
+export function SetSmartListQuery(arg1:number,arg2:string):Promise<void>;
+
 export function SetWeather(arg1:time.Time,arg2:string):Promise<void>;
 
 export function Shutdown(arg1:context.Context):Promise<void>;
 
+export function UnarchiveHabit(arg1:number):Promise<void>;
+
 export function UncancelEntry(arg1:number):Promise<void>;
 
 export function UncancelGoal(arg1:number):Promise<void>;
 
 export function UncancelListItem(arg1:number):Promise<void>;
 
+export function Undo():Promise<domain.Operation>;
+
 export function UndoHabitLog(arg1:number):Promise<void>;
 
 export function UndoHabitLogForDate(arg1:number,arg2:time.Time):Promise<void>;
 
 export function UpdateGoal(arg1:number,arg2:string):Promise<void>;
 
-export function ValidateEditableDocument(arg1:string):Promise<wails.ValidationResult>;
+export function ValidateEditableDocument(arg1:string,arg2:time.Time):Promise<wails.ValidationResult>;
@@ -26,6 +26,10 @@ export function ApplyEditableDocumentWithActions(arg1, arg2, arg3, arg4) {
   return window['go']['wails']['App']['ApplyEditableDocumentWithActions'](arg1, arg2, arg3, arg4);
 }
 
+export function ArchiveHabit(arg1) {
+  return window['go']['wails']['App']['ArchiveHabit'](arg1);
+}
+
 export function CancelEntry(arg1) {
   return window['go']['wails']['App']['CancelEntry'](arg1);
 }
@@ -38,6 +42,10 @@ export function CancelListItem(arg1) {
   return window['go']['wails']['App']['CancelListItem'](arg1);
 }
 
+export function ClearHabitLimit(arg1) {
+  return window['go']['wails']['App']['ClearHabitLimit'](arg1);
+}
+
 export function CreateGoal(arg1, arg2) {
   return window['go']['wails']['App']['CreateGoal'](arg1, arg2);
 }
@@ -50,6 +58,10 @@ export function CreateList(arg1) {
   return window['go']['wails']['App']['CreateList'](arg1);
 }
 
+export function CreateSmartList(arg1, arg2) {
+  return window['go']['wails']['App']['CreateSmartList'](arg1, arg2);
+}
+
 export function CyclePriority(arg1) {
   return window['go']['wails']['App']['CyclePriority'](arg1);
 }
@@ -178,6 +190,10 @@ export function GetLocationHistory() {
   return window['go']['wails']['App']['GetLocationHistory']();
 }
 
+export function GetOperationHistory(arg1) {
+  return window['go']['wails']['App']['GetOperationHistory'](arg1);
+}
+
 export function GetOutstandingQuestions() {
   return window['go']['wails']['App']['GetOutstandingQuestions']();
 }
@@ -186,6 +202,10 @@ export function GetOverdue() {
   return window['go']['wails']['App']['GetOverdue']();
 }
 
+export function GetOverdueListItems() {
+  return window['go']['wails']['App']['GetOverdueListItems']();
+}
+
 export function GetPlatformCapabilities() {
   return window['go']['wails']['App']['GetPlatformCapabilities']();
 }
@@ -230,6 +250,10 @@ export function LogHabitForDate(arg1, arg2, arg3) {
   return window['go']['wails']['App']['LogHabitForDate'](arg1, arg2, arg3);
 }
 
+export function LogHabitValueForDate(arg1, arg2, arg3, arg4) {
+  return window['go']['wails']['App']['LogHabitValueForDate'](arg1, arg2, arg3, arg4);
+}
+
 export function MarkEntryDone(arg1) {
   return window['go']['wails']['App']['MarkEntryDone'](arg1);
 }
@@ -282,6 +306,10 @@ export function ReadFile(arg1) {
   return window['go']['wails']['App']['ReadFile'](arg1);
 }
 
+export function Redo() {
+  return window['go']['wails']['App']['Redo']();
+}
+
 export function RegisterRemarkableDevice(arg1) {
   return window['go']['wails']['App']['RegisterRemarkableDevice'](arg1);
 }
@@ -302,6 +330,10 @@ export function RetypeEntry(arg1, arg2) {
   return window['go']['wails']['App']['RetypeEntry'](arg1, arg2);
 }
 
+export function ScheduleListItem(arg1, arg2) {
+  return window['go']['wails']['App']['ScheduleListItem'](arg1, arg2);
+}
+
 export function Search(arg1) {
   return window['go']['wails']['App']['Search'](arg1);
 }
@@ -318,10 +350,22 @@ export function SetHabitGoal(arg1, arg2) {
   return window['go']['wails']['App']['SetHabitGoal'](arg1, arg2);
 }
 
+export function SetHabitLimit(arg1, arg2, arg3) {
+  return window['go']['wails']['App']['SetHabitLimit'](arg1, arg2, arg3);
+}
+
 export function SetHabitMonthlyGoal(arg1, arg2) {
   return window['go']['wails']['App']['SetHabitMonthlyGoal'](arg1, arg2);
 }
 
+export function SetHabitSchedule(arg1, arg2) {
+  return window['go']['wails']['App']['SetHabitSchedule'](arg1, arg2);
+}
+
+export function SetHabitTarget(arg1, arg2, arg3) {
+  return window['go']['wails']['App']['SetHabitTarget'](arg1, arg2, arg3);
+}
+
 export function SetHabitWeeklyGoal(arg1, arg2) {
   return window['go']['wails']['App']['SetHabitWeeklyGoal'](arg1, arg2);
 }
@@ -338,6 +382,10 @@ export function SetPriority(arg1, arg2) {
   return window['go']['wails']['App']['SetPriority'](arg1, arg2);
 }
 
+export function SetSmartListQuery(arg1, arg2) {
+  return window['go']['wails']['App']['SetSmartListQuery'](arg1, arg2);
+}
+
 export function SetWeather(arg1, arg2) {
   return window['go']['wails']['App']['SetWeather'](arg1, arg2);
 }
@@ -346,6 +394,10 @@ export function Shutdown(arg1) {
   return window['go']['wails']['App']['Shutdown'](arg1);
 }
 
+export function UnarchiveHabit(arg1) {
+  return window['go']['wails']['App']['UnarchiveHabit'](arg1);
+}
+
 export function UncancelEntry(arg1) {
   return window['go']['wails']['App']['UncancelEntry'](arg1);
 }
@@ -358,6 +410,10 @@ export function UncancelListItem(arg1) {
   return window['go']['wails']['App']['UncancelListItem'](arg1);
 }
 
+export function Undo() {
+  return window['go']['wails']['App']['Undo']();
+}
+
 export function UndoHabitLog(arg1) {
   return window['go']['wails']['App']['UndoHabitLog'](arg1);
 }
@@ -370,6 +426,6 @@ export function UpdateGoal(arg1, arg2) {
   return window['go']['wails']['App']['UpdateGoal'](arg1, arg2);
 }
 
-export function ValidateEditableDocument(arg1) {
-  return window['go']['wails']['App']['ValidateEditableDocument'](arg1);
+export function ValidateEditableDocument(arg1, arg2) {
+  return window['go']['wails']['App']['ValidateEditableDocument'](arg1, arg2);
 }
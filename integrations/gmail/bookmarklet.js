@@ -1,6 +1,7 @@
 // Gmail → Bujo Bookmarklet
 // Readable source for the bookmarklet embedded in install.html.
 // This file is not executed directly — it's minified into a javascript: URL.
+// The install page replaces __BUJO_TOKEN__ with a capture-only API token.
 
 void (function () {
   try {
@@ -36,7 +37,10 @@ void (function () {
 
     fetch("http://127.0.0.1:8743/api/entries", {
       method: "POST",
-      headers: { "Content-Type": "application/json" },
+      headers: {
+        "Content-Type": "application/json",
+        Authorization: "Bearer __BUJO_TOKEN__",
+      },
       body: JSON.stringify({
         entries: [
           {
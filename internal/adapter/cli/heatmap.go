@@ -0,0 +1,175 @@
+package cli
+
+import (
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+var heatmapShades = []string{"·", "░", "▒", "▓", "█"}
+
+// RenderHabitHeatmaps renders year heatmaps one after another, as a
+// contribution graph of 7 weekday rows and a column per week.
+func RenderHabitHeatmaps(heatmaps []service.HabitHeatmap) string {
+	var sb strings.Builder
+	for i, heatmap := range heatmaps {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(renderHabitHeatmap(heatmap))
+	}
+	return sb.String()
+}
+
+func renderHabitHeatmap(heatmap service.HabitHeatmap) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "%s %s\n", Cyan(Bold(heatmap.Name)), Dimmed(fmt.Sprintf("%d", heatmap.Year)))
+
+	weeks := heatmap.Weeks()
+	fmt.Fprintf(&sb, "    %s\n", Dimmed(heatmap.MonthLabels()))
+
+	for row, label := range []string{"Mon", "", "Wed", "", "Fri", "", "Sun"} {
+		fmt.Fprintf(&sb, "%-4s", label)
+		for _, week := range weeks {
+			sb.WriteString(heatmapCell(week[row]))
+		}
+		sb.WriteString("\n")
+	}
+
+	fmt.Fprintf(&sb, "    %s %s %s  %s over  %s rest  %s paused\n",
+		Dimmed("Less"), Green(strings.Join(heatmapShades, "")), Dimmed("More"),
+		Red("✗"), Dimmed("-"), Dimmed("~"))
+	sb.WriteString(renderLongestStreak(heatmap) + "\n")
+
+	return sb.String()
+}
+
+func heatmapCell(day service.HeatmapDay) string {
+	switch {
+	case day.Date.IsZero() || day.Inactive:
+		return " "
+	case day.Over:
+		return Red("✗")
+	case day.Level() > 0:
+		return Green(heatmapShades[day.Level()])
+	case day.Paused:
+		return Dimmed("~")
+	case day.Rest:
+		return Dimmed("-")
+	default:
+		return Dimmed(heatmapShades[0])
+	}
+}
+
+func renderLongestStreak(heatmap service.HabitHeatmap) string {
+	if heatmap.LongestStreak == 0 {
+		return Dimmed("No streak this year")
+	}
+	return fmt.Sprintf("Longest streak: %s (%s – %s)",
+		Green(domain.StreakPeriodDay.Format(heatmap.LongestStreak)),
+		heatmap.LongestStreakStart.Format("Jan 2"),
+		heatmap.LongestStreakEnd.Format("Jan 2"))
+}
+
+const (
+	svgCell   = 11
+	svgStep   = 13
+	svgLeft   = 32
+	svgWidth  = svgLeft + 54*svgStep
+	svgHeight = 150
+)
+
+var (
+	svgShades = []string{"#ebedf0", "#9be9a8", "#40c463", "#30a14e", "#216e39"}
+	svgOver   = "#e5534b"
+	svgRest   = "#f6f8fa"
+)
+
+// RenderHabitHeatmapsSVG draws the heatmaps as a standalone SVG image,
+// stacked one under another.
+func RenderHabitHeatmapsSVG(heatmaps []service.HabitHeatmap) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" font-family="sans-serif" font-size="10">`+"\n",
+		svgWidth, svgHeight*len(heatmaps))
+	for i, heatmap := range heatmaps {
+		fmt.Fprintf(&sb, `<g transform="translate(0,%d)">`+"\n", i*svgHeight)
+		renderHeatmapSVG(&sb, heatmap)
+		sb.WriteString("</g>\n")
+	}
+	sb.WriteString("</svg>\n")
+
+	return sb.String()
+}
+
+func renderHeatmapSVG(sb *strings.Builder, heatmap service.HabitHeatmap) {
+	fmt.Fprintf(sb, `<text x="0" y="14" font-size="13" font-weight="bold">%s %d</text>`+"\n",
+		html.EscapeString(heatmap.Name), heatmap.Year)
+
+	weeks := heatmap.Weeks()
+	for col, week := range weeks {
+		for _, day := range week {
+			if !day.Date.IsZero() && day.Date.Day() == 1 {
+				fmt.Fprintf(sb, `<text x="%d" y="32">%s</text>`+"\n", svgLeft+col*svgStep, day.Date.Format("Jan"))
+			}
+		}
+	}
+	for row, label := range []string{"Mon", "", "Wed", "", "Fri", "", "Sun"} {
+		if label != "" {
+			fmt.Fprintf(sb, `<text x="0" y="%d">%s</text>`+"\n", 38+row*svgStep+svgCell-2, label)
+		}
+	}
+
+	for col, week := range weeks {
+		for row, day := range week {
+			if day.Date.IsZero() || day.Inactive {
+				continue
+			}
+			fmt.Fprintf(sb, `<rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"><title>%s</title></rect>`+"\n",
+				svgLeft+col*svgStep, 38+row*svgStep, svgCell, svgCell, heatmapFill(day), heatmapTitle(heatmap, day))
+		}
+	}
+
+	streak := "No streak this year"
+	if heatmap.LongestStreak > 0 {
+		streak = fmt.Sprintf("Longest streak: %s (%s – %s)",
+			domain.StreakPeriodDay.Format(heatmap.LongestStreak),
+			heatmap.LongestStreakStart.Format("Jan 2"),
+			heatmap.LongestStreakEnd.Format("Jan 2"))
+	}
+	fmt.Fprintf(sb, `<text x="%d" y="%d">%s</text>`+"\n", svgLeft, 38+7*svgStep+14, streak)
+}
+
+func heatmapFill(day service.HeatmapDay) string {
+	switch {
+	case day.Over:
+		return svgOver
+	case day.Level() == 0 && (day.Rest || day.Paused):
+		return svgRest
+	default:
+		return svgShades[day.Level()]
+	}
+}
+
+func heatmapTitle(heatmap service.HabitHeatmap, day service.HeatmapDay) string {
+	date := day.Date.Format(time.DateOnly)
+	switch {
+	case day.Over:
+		return date + ": over limit"
+	case day.Value > 0:
+		return fmt.Sprintf("%s: %s", date, html.EscapeString(domain.FormatHabitValue(day.Value, heatmap.Unit)))
+	case day.Count > 0:
+		return fmt.Sprintf("%s: %d", date, day.Count)
+	case day.Paused:
+		return date + ": paused"
+	case day.Rest:
+		return date + ": rest day"
+	default:
+		return date + ": 0"
+	}
+}
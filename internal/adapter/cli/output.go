@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+)
+
+type OutputFormat string
+
+const (
+	OutputText   OutputFormat = "text"
+	OutputJSON   OutputFormat = "json"
+	OutputNDJSON OutputFormat = "ndjson"
+)
+
+func ParseOutputFormat(s string) (OutputFormat, error) {
+	switch OutputFormat(s) {
+	case OutputText, OutputJSON, OutputNDJSON:
+		return OutputFormat(s), nil
+	}
+	return "", fmt.Errorf("invalid output format: %s (valid formats: text, json, ndjson)", s)
+}
+
+func (f OutputFormat) IsMachineReadable() bool {
+	return f == OutputJSON || f == OutputNDJSON
+}
+
+// Envelope wraps every machine-readable document so consumers can check the
+// schema before reading data. Schemas are only ever extended with new fields;
+// renaming or removing a field bumps the version suffix.
+type Envelope struct {
+	Schema string `json:"schema"`
+	Data   any    `json:"data"`
+}
+
+// WriteOutput writes data as a single JSON envelope, or as NDJSON with one
+// envelope per element when data is a slice.
+func WriteOutput(w io.Writer, format OutputFormat, schema string, data any) error {
+	switch format {
+	case OutputJSON:
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(Envelope{Schema: schema, Data: data})
+	case OutputNDJSON:
+		enc := json.NewEncoder(w)
+		v := reflect.ValueOf(data)
+		if v.Kind() != reflect.Slice {
+			return enc.Encode(Envelope{Schema: schema, Data: data})
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := enc.Encode(Envelope{Schema: schema, Data: v.Index(i).Interface()}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	return fmt.Errorf("output format %s is not machine-readable", format)
+}
@@ -0,0 +1,161 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+func TestParseOutputFormat(t *testing.T) {
+	for _, valid := range []string{"text", "json", "ndjson"} {
+		format, err := ParseOutputFormat(valid)
+		require.NoError(t, err)
+		assert.Equal(t, OutputFormat(valid), format)
+	}
+
+	_, err := ParseOutputFormat("yaml")
+	assert.Error(t, err)
+}
+
+func TestWriteOutput_JSONWrapsDataInEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	err := WriteOutput(&buf, OutputJSON, SchemaEntry, NewEntriesJSON(nil))
+	require.NoError(t, err)
+
+	var doc map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	assert.Equal(t, SchemaEntry, doc["schema"])
+	assert.Equal(t, []any{}, doc["data"])
+}
+
+func TestWriteOutput_NDJSONWritesOneLinePerElement(t *testing.T) {
+	entries := []domain.Entry{
+		{ID: 1, Type: domain.EntryTypeTask, Content: "Buy milk"},
+		{ID: 2, Type: domain.EntryTypeNote, Content: "Milk is expensive"},
+	}
+
+	var buf bytes.Buffer
+	err := WriteOutput(&buf, OutputNDJSON, SchemaEntry, NewEntriesJSON(entries))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	var doc struct {
+		Schema string    `json:"schema"`
+		Data   EntryJSON `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &doc))
+	assert.Equal(t, SchemaEntry, doc.Schema)
+	assert.Equal(t, int64(2), doc.Data.ID)
+	assert.Equal(t, "note", doc.Data.Type)
+}
+
+func TestWriteOutput_TextIsNotMachineReadable(t *testing.T) {
+	var buf bytes.Buffer
+	assert.Error(t, WriteOutput(&buf, OutputText, SchemaEntry, nil))
+}
+
+func TestNewDayEntriesJSON_FormatsDates(t *testing.T) {
+	date := time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)
+	mood := "happy"
+	days := []service.DayEntries{{
+		Date: date,
+		Mood: &mood,
+		Entries: []domain.Entry{
+			{ID: 1, Type: domain.EntryTypeTask, Content: "Task", ScheduledDate: &date, Priority: domain.PriorityHigh},
+		},
+	}}
+
+	result := NewDayEntriesJSON(days)
+
+	require.Len(t, result, 1)
+	assert.Equal(t, "2026-01-08", result[0].Date)
+	assert.Equal(t, &mood, result[0].Mood)
+	require.Len(t, result[0].Entries, 1)
+	assert.Equal(t, "2026-01-08", *result[0].Entries[0].ScheduledDate)
+	assert.Equal(t, "high", result[0].Entries[0].Priority)
+	assert.Equal(t, []string{}, result[0].Entries[0].Tags)
+}
+
+func TestNewDayEntriesWithOverdueJSON_AttachesOverdueToToday(t *testing.T) {
+	yesterday := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 1, 8, 0, 0, 0, 0, time.UTC)
+	days := []service.DayEntries{{Date: yesterday}, {Date: today}}
+	overdue := []domain.Entry{{ID: 1, Type: domain.EntryTypeTask, Content: "Call bank", ScheduledDate: &yesterday}}
+	item := service.OverdueListItem{ListItem: domain.ListItem{Type: domain.ListItemTypeTask, Content: "Renew passport", DueDate: &yesterday}, ListName: "Errands"}
+	item.RowID = 3
+
+	result := NewDayEntriesWithOverdueJSON(days, overdue, []service.OverdueListItem{item}, today)
+
+	require.Len(t, result, 2)
+	assert.Nil(t, result[0].Overdue)
+	require.NotNil(t, result[1].Overdue)
+	require.Len(t, result[1].Overdue.Entries, 1)
+	assert.Equal(t, "Call bank", result[1].Overdue.Entries[0].Content)
+	require.Len(t, result[1].Overdue.ListItems, 1)
+	assert.Equal(t, int64(3), result[1].Overdue.ListItems[0].ID)
+	assert.Equal(t, "Errands", result[1].Overdue.ListItems[0].ListName)
+	assert.Equal(t, "2026-01-07", *result[1].Overdue.ListItems[0].DueDate)
+}
+
+func TestNewFutureLogJSON_GroupsByMonth(t *testing.T) {
+	month := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	log := &service.FutureLog{
+		Months: []service.FutureLogMonth{{
+			Month:   month,
+			Entries: []domain.Entry{{ID: 1, Type: domain.EntryTypeTask, Content: "Book flights", ScheduledDate: &month, Granularity: domain.ScheduleMonth}},
+		}},
+	}
+
+	result := NewFutureLogJSON(log)
+
+	require.Len(t, result.Months, 1)
+	assert.Equal(t, "2026-03", result.Months[0].Month)
+	assert.Equal(t, "month", result.Months[0].Entries[0].Granularity)
+	assert.Equal(t, []EntryJSON{}, result.Someday)
+}
+
+func TestNewStatsJSON_UsesWeekdayNames(t *testing.T) {
+	stats := &domain.Stats{
+		Period: domain.StatsPeriod{
+			From: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			To:   time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		Productivity: domain.Productivity{
+			MostProductive: domain.Weekday{Day: time.Tuesday, Average: 4.5},
+			EntriesByDay:   map[time.Weekday]int{time.Tuesday: 9},
+		},
+	}
+
+	result := NewStatsJSON(stats)
+
+	assert.Equal(t, "2026-01-01", result.From)
+	assert.Equal(t, "Tuesday", result.Productivity.MostProductive.Day)
+	assert.Equal(t, 9, result.Productivity.EntriesByDay["Tuesday"])
+}
+
+func TestNewStatsJSON_IncludesGoalKeyResults(t *testing.T) {
+	stats := &domain.Stats{
+		Goals: []domain.GoalStat{{
+			Content:    "Grow the newsletter",
+			Period:     "Q3 2026",
+			KeyResults: []domain.KeyResult{{Name: "Subscribers", Start: 200, Target: 1000, Current: 600, Unit: "subscribers"}},
+		}},
+	}
+
+	result := NewStatsJSON(stats)
+
+	require.Len(t, result.Goals, 1)
+	assert.Equal(t, "Q3 2026", result.Goals[0].Period)
+	assert.InDelta(t, 0.5, result.Goals[0].Progress, 0.0001)
+	require.Len(t, result.Goals[0].KeyResults, 1)
+	assert.Equal(t, "subscribers", result.Goals[0].KeyResults[0].Unit)
+}
@@ -21,7 +21,9 @@ var (
 	Strikethrough = color.New(color.CrossedOut).SprintFunc()
 )
 
-func RenderDaysWithOverdue(days []service.DayEntries, overdue []domain.Entry, today time.Time) string {
+// RenderDaysWithOverdue renders days of entries, preceded by the overdue
+// entries and then the overdue list items, each in a section of their own.
+func RenderDaysWithOverdue(days []service.DayEntries, overdue []domain.Entry, overdueItems []service.OverdueListItem, today time.Time) string {
 	var sb strings.Builder
 
 	if len(overdue) > 0 {
@@ -30,6 +32,14 @@ func RenderDaysWithOverdue(days []service.DayEntries, overdue []domain.Entry, to
 		sb.WriteString("\n")
 	}
 
+	if len(overdueItems) > 0 {
+		fmt.Fprintf(&sb, "📋 %s\n", Red(Bold("OVERDUE LIST ITEMS")))
+		for _, item := range overdueItems {
+			sb.WriteString(renderOverdueListItem(item, today))
+		}
+		sb.WriteString("\n")
+	}
+
 	for _, day := range days {
 		dateStr := day.Date.Format("Monday, Jan 2")
 		header := fmt.Sprintf("📅 %s", Cyan(Bold(dateStr)))
@@ -57,6 +67,51 @@ func RenderDaysWithOverdue(days []service.DayEntries, overdue []domain.Entry, to
 	return sb.String()
 }
 
+func renderOverdueListItem(item service.OverdueListItem, today time.Time) string {
+	content := Red(item.Content)
+	if prioritySymbol := item.Priority.Symbol(); prioritySymbol != "" {
+		content = prioritySymbol + " " + content
+	}
+	return fmt.Sprintf("%s %s %s %s\n", item.Type.Symbol(), content, Red(fmt.Sprintf("(%d)", item.RowID)),
+		Dimmed(item.ListName+" ·")+" "+ListItemDue(item.ListItem, today))
+}
+
+// ListItemDue describes when a list item is due: dimmed if due later, in
+// yellow if due today and in red once overdue. It is empty for items
+// without a due date.
+func ListItemDue(item domain.ListItem, today time.Time) string {
+	if item.DueDate == nil {
+		return ""
+	}
+	due := "due " + item.DueDate.Format("Jan 2")
+	switch {
+	case item.IsOverdue(today):
+		return Red("overdue, " + due)
+	case item.IsDue(today):
+		return Yellow("due today")
+	default:
+		return Dimmed(due)
+	}
+}
+
+func RenderFutureLog(log *service.FutureLog, today time.Time) string {
+	var sb strings.Builder
+
+	for _, month := range log.Months {
+		fmt.Fprintf(&sb, "📆 %s\n", Cyan(Bold(month.Month.Format("January 2006"))))
+		renderEntryTreeWithOverdue(&sb, month.Entries, 0, false, today)
+		sb.WriteString("\n")
+	}
+
+	if len(log.Someday) > 0 {
+		fmt.Fprintf(&sb, "💭 %s\n", Cyan(Bold("Someday")))
+		renderEntryTreeWithOverdue(&sb, log.Someday, 0, false, today)
+		sb.WriteString("\n")
+	}
+
+	return sb.String()
+}
+
 func renderEntryTreeWithOverdue(sb *strings.Builder, entries []domain.Entry, depth int, forceOverdue bool, today time.Time) {
 	children := make(map[int64][]domain.Entry)
 	var roots []domain.Entry
@@ -89,6 +144,10 @@ func renderEntry(entry domain.Entry, depth int, forceOverdue bool, today time.Ti
 	prioritySymbol := entry.Priority.Symbol()
 	content := entry.Content
 	idStr := fmt.Sprintf("(%d)", entry.ID)
+	if entry.Projected {
+		// A recurrence projected into a future day has no entry yet.
+		idStr = "(upcoming)"
+	}
 
 	switch entry.Type {
 	case domain.EntryTypeDone, domain.EntryTypeAnswered:
@@ -135,7 +194,7 @@ func RenderHabitTracker(status *service.TrackerStatus) string {
 			streakColor = Red
 		}
 
-		fmt.Fprintf(&sb, "%s %s\n", Bold(habit.Name), streakColor(fmt.Sprintf("(%d day streak)", habit.CurrentStreak)))
+		fmt.Fprintf(&sb, "%s %s%s\n", Bold(habit.Name), streakColor(fmt.Sprintf("(%d %s streak)", habit.CurrentStreak, streakPeriod(habit.StreakPeriod))), archivedLabel(habit.ArchivedAt))
 
 		sparkline := renderSparkline(habit.DayHistory)
 		fmt.Fprintf(&sb, "  %s\n", sparkline)
@@ -147,8 +206,21 @@ func RenderHabitTracker(status *service.TrackerStatus) string {
 }
 
 func renderHabitProgress(habit service.HabitStatus, suffix string) string {
+	today := fmt.Sprintf("%d/%d today", habit.TodayCount, habit.GoalPerDay)
 	todayColor := Green
-	if habit.TodayCount < habit.GoalPerDay {
+	switch {
+	case habit.TargetType == domain.HabitTargetAtMost:
+		today = fmt.Sprintf("%s/%s %s", domain.FormatHabitValue(habit.PeriodTotal, ""),
+			domain.FormatHabitValue(habit.Ceiling, habit.Unit), limitPeriod(habit.StreakPeriod))
+		if habit.OverLimit {
+			todayColor = Red
+		}
+	case habit.Kind == domain.HabitKindMeasured:
+		today = fmt.Sprintf("%s/%s today", domain.FormatHabitValue(habit.TodayValue, ""), domain.FormatHabitValue(habit.Target, habit.Unit))
+		if habit.TodayValue < habit.Target {
+			todayColor = Yellow
+		}
+	case habit.TodayCount < habit.GoalPerDay:
 		todayColor = Yellow
 	}
 	completionColor := Green
@@ -157,12 +229,29 @@ func renderHabitProgress(habit service.HabitStatus, suffix string) string {
 	} else if habit.CompletionPercent < 80 {
 		completionColor = Yellow
 	}
-	return fmt.Sprintf("  %s today | %s completion%s\n\n",
-		todayColor(fmt.Sprintf("%d/%d", habit.TodayCount, habit.GoalPerDay)),
+	completion := "completion"
+	if habit.TargetType == domain.HabitTargetAtMost {
+		completion = "clean"
+	}
+	return fmt.Sprintf("  %s | %s %s%s\n\n",
+		todayColor(today),
 		completionColor(fmt.Sprintf("%.0f%%", habit.CompletionPercent)),
+		completion,
 		suffix)
 }
 
+// limitPeriod names the period a limit's usage so far covers.
+func limitPeriod(period domain.StreakPeriod) string {
+	switch period {
+	case domain.StreakPeriodWeek:
+		return "this week"
+	case domain.StreakPeriodMonth:
+		return "this month"
+	default:
+		return "today"
+	}
+}
+
 func renderSparkline(days []service.DayStatus) string {
 	var sb strings.Builder
 
@@ -172,8 +261,14 @@ func renderSparkline(days []service.DayStatus) string {
 	}
 	for i := start; i >= 0; i-- {
 		day := days[i]
-		if day.Completed {
+		if day.Over {
+			sb.WriteString(Red("✗"))
+		} else if day.Completed {
 			sb.WriteString(Green("●"))
+		} else if day.Paused {
+			sb.WriteString(Dimmed("~"))
+		} else if day.Rest {
+			sb.WriteString(Dimmed("-"))
 		} else {
 			sb.WriteString(Dimmed("○"))
 		}
@@ -191,6 +286,13 @@ func renderSparkline(days []service.DayStatus) string {
 	return sb.String()
 }
 
+func archivedLabel(archivedAt *time.Time) string {
+	if archivedAt == nil {
+		return ""
+	}
+	return Dimmed(" archived " + archivedAt.Format("Jan 2, 2006"))
+}
+
 func RenderHabitMonth(status *service.TrackerStatus) string {
 	var sb strings.Builder
 
@@ -207,7 +309,7 @@ func RenderHabitMonth(status *service.TrackerStatus) string {
 			streakColor = Red
 		}
 
-		fmt.Fprintf(&sb, "%s %s\n", Bold(habit.Name), streakColor(fmt.Sprintf("(%d day streak)", habit.CurrentStreak)))
+		fmt.Fprintf(&sb, "%s %s%s\n", Bold(habit.Name), streakColor(fmt.Sprintf("(%d %s streak)", habit.CurrentStreak, streakPeriod(habit.StreakPeriod))), archivedLabel(habit.ArchivedAt))
 
 		sb.WriteString(renderMonthCalendar(habit.DayHistory))
 
@@ -227,9 +329,15 @@ func renderMonthCalendar(days []service.DayStatus) string {
 	sb.WriteString("\n")
 
 	completed := make(map[string]bool)
+	rest := make(map[string]bool)
+	paused := make(map[string]bool)
+	over := make(map[string]bool)
 	for _, day := range days {
 		key := day.Date.Format("2006-01-02")
 		completed[key] = day.Completed
+		rest[key] = day.Rest
+		paused[key] = day.Paused
+		over[key] = day.Over
 	}
 
 	if len(days) == 0 {
@@ -251,8 +359,14 @@ func renderMonthCalendar(days []service.DayStatus) string {
 
 		if current.Before(oldest) || current.After(newest) {
 			sb.WriteString(Dimmed("·") + " ")
+		} else if over[key] {
+			sb.WriteString(Red("✗") + " ")
 		} else if completed[key] {
 			sb.WriteString(Green("●") + " ")
+		} else if paused[key] {
+			sb.WriteString(Dimmed("~") + " ")
+		} else if rest[key] {
+			sb.WriteString(Dimmed("-") + " ")
 		} else {
 			sb.WriteString(Dimmed("○") + " ")
 		}
@@ -272,6 +386,13 @@ func FormatDate(t time.Time) string {
 	return t.Format("2006-01-02")
 }
 
+func streakPeriod(period domain.StreakPeriod) domain.StreakPeriod {
+	if period == "" {
+		return domain.StreakPeriodDay
+	}
+	return period
+}
+
 func RenderHabitInspect(details *service.HabitDetails) string {
 	var sb strings.Builder
 
@@ -281,24 +402,47 @@ func RenderHabitInspect(details *service.HabitDetails) string {
 	if details.CurrentStreak == 0 {
 		streakColor = Red
 	}
-	fmt.Fprintf(&sb, "Streak: %s\n", streakColor(fmt.Sprintf("%d days", details.CurrentStreak)))
-
-	sb.WriteString("\nGoals:\n")
-	if details.GoalPerDay > 0 {
-		fmt.Fprintf(&sb, "  Daily:   %d/day\n", details.GoalPerDay)
+	fmt.Fprintf(&sb, "Streak: %s\n", streakColor(details.StreakPeriod.Format(details.CurrentStreak)))
+	if details.Schedule != "" && details.Schedule != "daily" {
+		fmt.Fprintf(&sb, "Schedule: %s\n", details.Schedule)
 	}
-	if details.GoalPerWeek > 0 {
-		fmt.Fprintf(&sb, "  Weekly:  %d/week  %s\n",
-			details.GoalPerWeek,
-			formatProgress(details.WeeklyProgress))
+	if details.ArchivedAt != nil {
+		fmt.Fprintf(&sb, "Archived: %s\n", Dimmed(details.ArchivedAt.Format("Jan 2, 2006")))
 	}
-	if details.GoalPerMonth > 0 {
-		fmt.Fprintf(&sb, "  Monthly: %d/month %s\n",
-			details.GoalPerMonth,
-			formatProgress(details.MonthlyProgress))
+
+	sb.WriteString("\nGoals:\n")
+	switch {
+	case details.TargetType == domain.HabitTargetAtMost:
+		fmt.Fprintf(&sb, "  Limit:   at most %s/%s\n", domain.FormatHabitValue(details.Ceiling, details.Unit), streakPeriod(details.StreakPeriod))
+		if len(details.OverLimitDays) > 0 {
+			fmt.Fprintf(&sb, "  %s\n", Red("Over limit on "+domain.StreakPeriodDay.Format(len(details.OverLimitDays))))
+		}
+	case details.Kind == domain.HabitKindMeasured:
+		fmt.Fprintf(&sb, "  Target:  at least %s/day\n", domain.FormatHabitValue(details.Target, details.Unit))
+	default:
+		if details.GoalPerDay > 0 {
+			fmt.Fprintf(&sb, "  Daily:   %d/day\n", details.GoalPerDay)
+		}
+		if details.GoalPerWeek > 0 {
+			fmt.Fprintf(&sb, "  Weekly:  %d/week  %s\n",
+				details.GoalPerWeek,
+				formatProgress(details.WeeklyProgress))
+		}
+		if details.GoalPerMonth > 0 {
+			fmt.Fprintf(&sb, "  Monthly: %d/month %s\n",
+				details.GoalPerMonth,
+				formatProgress(details.MonthlyProgress))
+		}
+		if details.GoalPerDay == 0 && details.GoalPerWeek == 0 && details.GoalPerMonth == 0 {
+			sb.WriteString(Dimmed("  No goals set\n"))
+		}
 	}
-	if details.GoalPerDay == 0 && details.GoalPerWeek == 0 && details.GoalPerMonth == 0 {
-		sb.WriteString(Dimmed("  No goals set\n"))
+
+	if details.Kind == domain.HabitKindMeasured && details.Values.Days > 0 {
+		sb.WriteString("\nValues:\n")
+		fmt.Fprintf(&sb, "  Total:   %s\n", domain.FormatHabitValue(details.Values.Total, details.Unit))
+		fmt.Fprintf(&sb, "  Average: %s/day\n", domain.FormatHabitValue(details.Values.Average, details.Unit))
+		fmt.Fprintf(&sb, "  Best:    %s\n", domain.FormatHabitValue(details.Values.Max, details.Unit))
 	}
 
 	sb.WriteString("\n")
@@ -306,12 +450,28 @@ func RenderHabitInspect(details *service.HabitDetails) string {
 		sb.WriteString(Dimmed("No logs in this period\n"))
 	} else {
 		sb.WriteString(Bold("Logs:\n"))
-		sb.WriteString(Dimmed("  ID      Date         Count\n"))
+		if details.Kind == domain.HabitKindMeasured {
+			sb.WriteString(Dimmed("  ID      Date         Value\n"))
+		} else {
+			sb.WriteString(Dimmed("  ID      Date         Count\n"))
+		}
+		overLimit := make(map[string]bool)
+		for _, day := range details.OverLimitDays {
+			overLimit[day.Format("2006-01-02")] = true
+		}
 		for _, log := range details.Logs {
-			fmt.Fprintf(&sb, "  %-6d  %-11s  %d\n",
+			amount := fmt.Sprintf("%d", log.Count)
+			if details.Kind == domain.HabitKindMeasured {
+				amount = domain.FormatHabitValue(log.Value, details.Unit)
+			}
+			line := fmt.Sprintf("  %-6d  %-11s  %s",
 				log.ID,
 				log.LoggedAt.Format("Jan 2, 2006"),
-				log.Count)
+				amount)
+			if overLimit[log.LoggedAt.Format("2006-01-02")] {
+				line = Red(line + "  over limit")
+			}
+			sb.WriteString(line + "\n")
 		}
 	}
 
@@ -39,7 +39,7 @@ func TestRenderDaysWithOverdue_OverdueTasksInDaySectionAreRed(t *testing.T) {
 		},
 	}
 
-	result := RenderDaysWithOverdue(days, nil, today)
+	result := RenderDaysWithOverdue(days, nil, nil, today)
 
 	// The overdue task in yesterday's section should be red
 	// We check that Red() was applied to "Overdue task" by looking for ANSI codes
@@ -80,7 +80,7 @@ func TestRenderDaysWithOverdue_CancelledEntriesHaveStrikethrough(t *testing.T) {
 		},
 	}
 
-	result := RenderDaysWithOverdue(days, nil, today)
+	result := RenderDaysWithOverdue(days, nil, nil, today)
 
 	// Find lines
 	lines := strings.Split(result, "\n")
@@ -159,7 +159,7 @@ func TestRenderDaysWithOverdue_SingleDayWithMoodAndWeather(t *testing.T) {
 		},
 	}
 
-	result := RenderDaysWithOverdue(days, nil, today)
+	result := RenderDaysWithOverdue(days, nil, nil, today)
 	stripped := testutil.StripAnsi(result)
 
 	assert.Contains(t, stripped, "Home Office")
@@ -182,7 +182,7 @@ func TestRenderDaysWithOverdue_ShowsOverdueSectionWhenProvided(t *testing.T) {
 		{ID: 1, Type: domain.EntryTypeTask, Content: "Overdue task from yesterday", ScheduledDate: &yesterday, Depth: 0},
 	}
 
-	result := RenderDaysWithOverdue(days, overdue, today)
+	result := RenderDaysWithOverdue(days, overdue, nil, today)
 	stripped := testutil.StripAnsi(result)
 
 	assert.Contains(t, stripped, "OVERDUE")
@@ -199,7 +199,7 @@ func TestRenderDaysWithOverdue_NoOverdueSectionWhenEmpty(t *testing.T) {
 		},
 	}
 
-	result := RenderDaysWithOverdue(days, nil, today)
+	result := RenderDaysWithOverdue(days, nil, nil, today)
 	stripped := testutil.StripAnsi(result)
 
 	assert.NotContains(t, stripped, "OVERDUE")
@@ -235,7 +235,7 @@ func TestRenderDaysWithOverdue_MultiDayWithMoodAndWeather(t *testing.T) {
 		},
 	}
 
-	result := RenderDaysWithOverdue(days, nil, today)
+	result := RenderDaysWithOverdue(days, nil, nil, today)
 	stripped := testutil.StripAnsi(result)
 
 	assert.Contains(t, stripped, "Home")
@@ -246,3 +246,103 @@ func TestRenderDaysWithOverdue_MultiDayWithMoodAndWeather(t *testing.T) {
 	assert.Contains(t, stripped, "Calm")
 	assert.Contains(t, stripped, "Rainy")
 }
+
+func TestRenderFutureLog_ShowsMonthsThenSomeday(t *testing.T) {
+	today := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	log := &service.FutureLog{
+		Months: []service.FutureLogMonth{{
+			Month:   march,
+			Entries: []domain.Entry{{ID: 1, Type: domain.EntryTypeTask, Content: "Book flights", ScheduledDate: &march, Granularity: domain.ScheduleMonth}},
+		}},
+		Someday: []domain.Entry{{ID: 2, Type: domain.EntryTypeTask, Content: "Learn piano", ScheduledDate: &today, Granularity: domain.ScheduleSomeday}},
+	}
+
+	stripped := testutil.StripAnsi(RenderFutureLog(log, today))
+
+	assert.Contains(t, stripped, "March 2026")
+	assert.Contains(t, stripped, "Book flights (1)")
+	assert.Contains(t, stripped, "Someday")
+	assert.Less(t, strings.Index(stripped, "Book flights"), strings.Index(stripped, "Learn piano"))
+}
+
+func TestRenderHabitHeatmaps(t *testing.T) {
+	jan := func(day int) time.Time { return time.Date(2026, time.January, day, 0, 0, 0, 0, time.UTC) }
+	heatmap := service.HabitHeatmap{
+		Name: "Gym",
+		Year: 2026,
+		Days: []service.HeatmapDay{
+			{Date: jan(1), Intensity: 1},
+			{Date: jan(2), Intensity: 1},
+			{Date: jan(3), Intensity: 0.5},
+			{Date: jan(4), Over: true},
+			{Date: jan(5), Rest: true},
+			{Date: jan(6), Inactive: true},
+		},
+		LongestStreak:      2,
+		LongestStreakStart: jan(1),
+		LongestStreakEnd:   jan(2),
+	}
+
+	output := testutil.StripAnsi(RenderHabitHeatmaps([]service.HabitHeatmap{heatmap}))
+
+	assert.Contains(t, output, "Gym 2026")
+	assert.Contains(t, output, "Jan")
+	assert.Contains(t, output, "Mon")
+	assert.Contains(t, output, "█")
+	assert.Contains(t, output, "▒")
+	assert.Contains(t, output, "✗")
+	assert.Contains(t, output, "Longest streak: 2 days (Jan 1 – Jan 2)")
+}
+
+func TestRenderHabitHeatmapsSVG(t *testing.T) {
+	day := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	heatmap := service.HabitHeatmap{
+		Name: "Tea & Coffee",
+		Year: 2026,
+		Days: []service.HeatmapDay{{Date: day, Intensity: 1, Count: 1}},
+	}
+
+	output := RenderHabitHeatmapsSVG([]service.HabitHeatmap{heatmap})
+
+	assert.True(t, strings.HasPrefix(output, "<svg"))
+	assert.Contains(t, output, "Tea &amp; Coffee 2026")
+	assert.Contains(t, output, `fill="#216e39"`)
+	assert.Contains(t, output, "<title>")
+	assert.True(t, strings.HasSuffix(output, "</svg>\n"))
+}
+
+func TestRenderDaysWithOverdue_ShowsOverdueListItemsSection(t *testing.T) {
+	today := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+	lastWeek := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	days := []service.DayEntries{{Date: today}}
+	item := domain.ListItem{Type: domain.ListItemTypeTask, Content: "Renew passport", DueDate: &lastWeek}
+	item.RowID = 9
+	overdueItems := []service.OverdueListItem{{ListItem: item, ListName: "Errands"}}
+
+	result := RenderDaysWithOverdue(days, nil, overdueItems, today)
+	stripped := testutil.StripAnsi(result)
+
+	assert.Contains(t, stripped, "OVERDUE LIST ITEMS")
+	assert.Contains(t, stripped, "Renew passport (9) Errands · overdue, due Jan 6")
+	assert.Less(t, strings.Index(stripped, "OVERDUE LIST ITEMS"), strings.Index(stripped, "Tuesday, Jan 13"))
+}
+
+func TestListItemDue(t *testing.T) {
+	today := time.Date(2026, 1, 13, 0, 0, 0, 0, time.UTC)
+	yesterday := today.AddDate(0, 0, -1)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	item := domain.ListItem{Type: domain.ListItemTypeTask}
+	assert.Empty(t, ListItemDue(item, today))
+
+	item.DueDate = &tomorrow
+	assert.Equal(t, "due Jan 14", testutil.StripAnsi(ListItemDue(item, today)))
+	item.DueDate = &today
+	assert.Equal(t, "due today", testutil.StripAnsi(ListItemDue(item, today)))
+	item.DueDate = &yesterday
+	assert.Equal(t, "overdue, due Jan 12", testutil.StripAnsi(ListItemDue(item, today)))
+	item.Type = domain.ListItemTypeDone
+	assert.Equal(t, "due Jan 12", testutil.StripAnsi(ListItemDue(item, today)))
+}
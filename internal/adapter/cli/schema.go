@@ -0,0 +1,739 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+const (
+	SchemaEntry         = "bujo.entry.v1"
+	SchemaDayEntries    = "bujo.day_entries.v1"
+	SchemaFutureLog     = "bujo.future_log.v1"
+	SchemaTrackerStatus = "bujo.tracker_status.v2"
+	SchemaHabitDetails  = "bujo.habit_details.v2"
+	SchemaHabitHeatmap  = "bujo.habit_heatmap.v1"
+	SchemaListSummary   = "bujo.list_summary.v1"
+	SchemaList          = "bujo.list.v1"
+	SchemaStats         = "bujo.stats.v2"
+	SchemaGoal          = "bujo.goal.v1"
+	SchemaDayContext    = "bujo.day_context.v1"
+	SchemaSearchResult  = "bujo.search_result.v1"
+	SchemaOverdue       = "bujo.overdue.v1"
+)
+
+const jsonDate = "2006-01-02"
+
+type EntryJSON struct {
+	ID            int64    `json:"id"`
+	EntityID      string   `json:"entity_id"`
+	Type          string   `json:"type"`
+	Symbol        string   `json:"symbol"`
+	Content       string   `json:"content"`
+	Priority      string   `json:"priority"`
+	ParentID      *int64   `json:"parent_id"`
+	Depth         int      `json:"depth"`
+	Location      *string  `json:"location"`
+	ScheduledDate *string  `json:"scheduled_date"`
+	Granularity   string   `json:"schedule_granularity"`
+	CreatedAt     string   `json:"created_at"`
+	CompletedAt   *string  `json:"completed_at"`
+	Tags          []string `json:"tags"`
+	Mentions      []string `json:"mentions"`
+	Projected     bool     `json:"projected,omitempty"`
+}
+
+func NewEntryJSON(e domain.Entry) EntryJSON {
+	priority := string(e.Priority)
+	if priority == "" {
+		priority = string(domain.PriorityNone)
+	}
+	granularity := string(e.Granularity)
+	if granularity == "" {
+		granularity = string(domain.ScheduleDay)
+	}
+	return EntryJSON{
+		ID:            e.ID,
+		EntityID:      e.EntityID.String(),
+		Type:          string(e.Type),
+		Symbol:        e.Type.Symbol(),
+		Content:       e.Content,
+		Priority:      priority,
+		ParentID:      e.ParentID,
+		Depth:         e.Depth,
+		Location:      e.Location,
+		ScheduledDate: formatOptionalTime(e.ScheduledDate, jsonDate),
+		Granularity:   granularity,
+		CreatedAt:     e.CreatedAt.Format(time.RFC3339),
+		CompletedAt:   formatOptionalTime(e.CompletedAt, time.RFC3339),
+		Tags:          nonNil(e.Tags),
+		Mentions:      nonNil(e.Mentions),
+		Projected:     e.Projected,
+	}
+}
+
+func NewEntriesJSON(entries []domain.Entry) []EntryJSON {
+	result := make([]EntryJSON, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, NewEntryJSON(e))
+	}
+	return result
+}
+
+type DayEntriesJSON struct {
+	Date     string       `json:"date"`
+	Location *string      `json:"location"`
+	Mood     *string      `json:"mood"`
+	Weather  *string      `json:"weather"`
+	Entries  []EntryJSON  `json:"entries"`
+	Overdue  *OverdueJSON `json:"overdue,omitempty"`
+}
+
+func NewDayEntriesJSON(days []service.DayEntries) []DayEntriesJSON {
+	result := make([]DayEntriesJSON, 0, len(days))
+	for _, day := range days {
+		result = append(result, DayEntriesJSON{
+			Date:     day.Date.Format(jsonDate),
+			Location: day.Location,
+			Mood:     day.Mood,
+			Weather:  day.Weather,
+			Entries:  NewEntriesJSON(day.Entries),
+		})
+	}
+	return result
+}
+
+// NewDayEntriesWithOverdueJSON is NewDayEntriesJSON with what is overdue
+// attached to today, as RenderDaysWithOverdue shows it.
+func NewDayEntriesWithOverdueJSON(days []service.DayEntries, overdue []domain.Entry, overdueItems []service.OverdueListItem, today time.Time) []DayEntriesJSON {
+	result := NewDayEntriesJSON(days)
+	for i := range result {
+		if result[i].Date == today.Format(jsonDate) {
+			o := NewOverdueJSON(overdue, overdueItems)
+			result[i].Overdue = &o
+		}
+	}
+	return result
+}
+
+type OverdueListItemJSON struct {
+	ListItemJSON
+	ListName string `json:"list_name"`
+}
+
+type OverdueJSON struct {
+	Entries   []EntryJSON           `json:"entries"`
+	ListItems []OverdueListItemJSON `json:"list_items"`
+}
+
+func NewOverdueJSON(entries []domain.Entry, items []service.OverdueListItem) OverdueJSON {
+	listItems := make([]OverdueListItemJSON, 0, len(items))
+	for _, item := range items {
+		listItems = append(listItems, OverdueListItemJSON{ListItemJSON: NewListItemJSON(item.ListItem), ListName: item.ListName})
+	}
+	return OverdueJSON{Entries: NewEntriesJSON(entries), ListItems: listItems}
+}
+
+type FutureLogMonthJSON struct {
+	Month   string      `json:"month"`
+	Entries []EntryJSON `json:"entries"`
+}
+
+type FutureLogJSON struct {
+	Months  []FutureLogMonthJSON `json:"months"`
+	Someday []EntryJSON          `json:"someday"`
+}
+
+func NewFutureLogJSON(log *service.FutureLog) FutureLogJSON {
+	result := FutureLogJSON{
+		Months:  make([]FutureLogMonthJSON, 0, len(log.Months)),
+		Someday: NewEntriesJSON(log.Someday),
+	}
+	for _, month := range log.Months {
+		result.Months = append(result.Months, FutureLogMonthJSON{
+			Month:   month.Month.Format("2006-01"),
+			Entries: NewEntriesJSON(month.Entries),
+		})
+	}
+	return result
+}
+
+type DayStatusJSON struct {
+	Date      string  `json:"date"`
+	Completed bool    `json:"completed"`
+	Count     int     `json:"count"`
+	Value     float64 `json:"value"`
+	Rest      bool    `json:"rest"`
+	Paused    bool    `json:"paused"`
+	Over      bool    `json:"over"`
+}
+
+type HabitStatusJSON struct {
+	ID                int64           `json:"id"`
+	Name              string          `json:"name"`
+	GoalPerDay        int             `json:"goal_per_day"`
+	GoalPerWeek       int             `json:"goal_per_week"`
+	GoalPerMonth      int             `json:"goal_per_month"`
+	Kind              string          `json:"kind"`
+	Unit              string          `json:"unit"`
+	Target            float64         `json:"target"`
+	TargetType        string          `json:"target_type"`
+	Schedule          string          `json:"schedule"`
+	CurrentStreak     int             `json:"current_streak"`
+	StreakPeriod      string          `json:"streak_period"`
+	CompletionPercent float64         `json:"completion_percent"`
+	WeeklyProgress    float64         `json:"weekly_progress"`
+	MonthlyProgress   float64         `json:"monthly_progress"`
+	TodayCount        int             `json:"today_count"`
+	TodayValue        float64         `json:"today_value"`
+	Ceiling           float64         `json:"ceiling"`
+	PeriodTotal       float64         `json:"period_total"`
+	OverLimit         bool            `json:"over_limit"`
+	ArchivedAt        *string         `json:"archived_at"`
+	History           []DayStatusJSON `json:"history"`
+}
+
+type TrackerStatusJSON struct {
+	Habits []HabitStatusJSON `json:"habits"`
+}
+
+func NewTrackerStatusJSON(status *service.TrackerStatus) TrackerStatusJSON {
+	result := TrackerStatusJSON{Habits: make([]HabitStatusJSON, 0, len(status.Habits))}
+	for _, h := range status.Habits {
+		history := make([]DayStatusJSON, 0, len(h.DayHistory))
+		for _, d := range h.DayHistory {
+			history = append(history, DayStatusJSON{
+				Date:      d.Date.Format(jsonDate),
+				Completed: d.Completed,
+				Count:     d.Count,
+				Value:     d.Value,
+				Rest:      d.Rest,
+				Paused:    d.Paused,
+				Over:      d.Over,
+			})
+		}
+		result.Habits = append(result.Habits, HabitStatusJSON{
+			ID:                h.ID,
+			Name:              h.Name,
+			GoalPerDay:        h.GoalPerDay,
+			GoalPerWeek:       h.GoalPerWeek,
+			GoalPerMonth:      h.GoalPerMonth,
+			Kind:              string(h.Kind),
+			Unit:              h.Unit,
+			Target:            h.Target,
+			TargetType:        string(h.TargetType),
+			Schedule:          h.Schedule,
+			CurrentStreak:     h.CurrentStreak,
+			StreakPeriod:      string(h.StreakPeriod),
+			CompletionPercent: h.CompletionPercent,
+			WeeklyProgress:    h.WeeklyProgress,
+			MonthlyProgress:   h.MonthlyProgress,
+			TodayCount:        h.TodayCount,
+			TodayValue:        h.TodayValue,
+			Ceiling:           h.Ceiling,
+			PeriodTotal:       h.PeriodTotal,
+			OverLimit:         h.OverLimit,
+			ArchivedAt:        formatOptionalTime(h.ArchivedAt, time.RFC3339),
+			History:           history,
+		})
+	}
+	return result
+}
+
+type HabitLogJSON struct {
+	ID       int64   `json:"id"`
+	Count    int     `json:"count"`
+	Value    float64 `json:"value"`
+	LoggedAt string  `json:"logged_at"`
+}
+
+type HabitValuesJSON struct {
+	Total   float64 `json:"total"`
+	Average float64 `json:"average"`
+	Max     float64 `json:"max"`
+	Days    int     `json:"days"`
+}
+
+func newHabitValuesJSON(values domain.HabitValueSummary) HabitValuesJSON {
+	return HabitValuesJSON{
+		Total:   values.Total,
+		Average: values.Average,
+		Max:     values.Max,
+		Days:    values.Days,
+	}
+}
+
+type HabitDetailsJSON struct {
+	ID                int64           `json:"id"`
+	Name              string          `json:"name"`
+	GoalPerDay        int             `json:"goal_per_day"`
+	GoalPerWeek       int             `json:"goal_per_week"`
+	GoalPerMonth      int             `json:"goal_per_month"`
+	Kind              string          `json:"kind"`
+	Unit              string          `json:"unit"`
+	Target            float64         `json:"target"`
+	TargetType        string          `json:"target_type"`
+	Schedule          string          `json:"schedule"`
+	CurrentStreak     int             `json:"current_streak"`
+	StreakPeriod      string          `json:"streak_period"`
+	CompletionPercent float64         `json:"completion_percent"`
+	WeeklyProgress    float64         `json:"weekly_progress"`
+	MonthlyProgress   float64         `json:"monthly_progress"`
+	Values            HabitValuesJSON `json:"values"`
+	Ceiling           float64         `json:"ceiling"`
+	OverLimitDays     []string        `json:"over_limit_days"`
+	ArchivedAt        *string         `json:"archived_at"`
+	Logs              []HabitLogJSON  `json:"logs"`
+}
+
+func NewHabitDetailsJSON(details *service.HabitDetails) HabitDetailsJSON {
+	logs := make([]HabitLogJSON, 0, len(details.Logs))
+	for _, l := range details.Logs {
+		logs = append(logs, HabitLogJSON{
+			ID:       l.ID,
+			Count:    l.Count,
+			Value:    l.Value,
+			LoggedAt: l.LoggedAt.Format(time.RFC3339),
+		})
+	}
+	overLimitDays := make([]string, 0, len(details.OverLimitDays))
+	for _, day := range details.OverLimitDays {
+		overLimitDays = append(overLimitDays, day.Format(jsonDate))
+	}
+	return HabitDetailsJSON{
+		ID:                details.ID,
+		Name:              details.Name,
+		GoalPerDay:        details.GoalPerDay,
+		GoalPerWeek:       details.GoalPerWeek,
+		GoalPerMonth:      details.GoalPerMonth,
+		Kind:              string(details.Kind),
+		Unit:              details.Unit,
+		Target:            details.Target,
+		TargetType:        string(details.TargetType),
+		Schedule:          details.Schedule,
+		CurrentStreak:     details.CurrentStreak,
+		StreakPeriod:      string(details.StreakPeriod),
+		CompletionPercent: details.CompletionPercent,
+		WeeklyProgress:    details.WeeklyProgress,
+		MonthlyProgress:   details.MonthlyProgress,
+		Values:            newHabitValuesJSON(details.Values),
+		Ceiling:           details.Ceiling,
+		OverLimitDays:     overLimitDays,
+		ArchivedAt:        formatOptionalTime(details.ArchivedAt, time.RFC3339),
+		Logs:              logs,
+	}
+}
+
+type HeatmapDayJSON struct {
+	Date      string  `json:"date"`
+	Intensity float64 `json:"intensity"`
+	Count     int     `json:"count"`
+	Value     float64 `json:"value"`
+	Rest      bool    `json:"rest"`
+	Paused    bool    `json:"paused"`
+	Over      bool    `json:"over"`
+	Inactive  bool    `json:"inactive"`
+}
+
+type HabitHeatmapJSON struct {
+	HabitID            *int64           `json:"habit_id"`
+	Name               string           `json:"name"`
+	Year               int              `json:"year"`
+	LongestStreak      int              `json:"longest_streak"`
+	LongestStreakStart *string          `json:"longest_streak_start"`
+	LongestStreakEnd   *string          `json:"longest_streak_end"`
+	Days               []HeatmapDayJSON `json:"days"`
+}
+
+type HabitHeatmapsJSON struct {
+	Year     int                `json:"year"`
+	Heatmaps []HabitHeatmapJSON `json:"heatmaps"`
+}
+
+func NewHabitHeatmapsJSON(year int, heatmaps []service.HabitHeatmap) HabitHeatmapsJSON {
+	result := HabitHeatmapsJSON{Year: year, Heatmaps: make([]HabitHeatmapJSON, 0, len(heatmaps))}
+	for _, h := range heatmaps {
+		days := make([]HeatmapDayJSON, 0, len(h.Days))
+		for _, d := range h.Days {
+			days = append(days, HeatmapDayJSON{
+				Date:      d.Date.Format(jsonDate),
+				Intensity: d.Intensity,
+				Count:     d.Count,
+				Value:     d.Value,
+				Rest:      d.Rest,
+				Paused:    d.Paused,
+				Over:      d.Over,
+				Inactive:  d.Inactive,
+			})
+		}
+
+		heatmap := HabitHeatmapJSON{
+			Name:          h.Name,
+			Year:          h.Year,
+			LongestStreak: h.LongestStreak,
+			Days:          days,
+		}
+		if h.HabitID != 0 {
+			id := h.HabitID
+			heatmap.HabitID = &id
+		}
+		if h.LongestStreak > 0 {
+			heatmap.LongestStreakStart = formatOptionalTime(&h.LongestStreakStart, jsonDate)
+			heatmap.LongestStreakEnd = formatOptionalTime(&h.LongestStreakEnd, jsonDate)
+		}
+		result.Heatmaps = append(result.Heatmaps, heatmap)
+	}
+	return result
+}
+
+type ListSummaryJSON struct {
+	ID         int64  `json:"id"`
+	Name       string `json:"name"`
+	Query      string `json:"query,omitempty"`
+	TotalItems int    `json:"total_items"`
+	DoneItems  int    `json:"done_items"`
+}
+
+func NewListSummaryJSON(summary *service.ListSummary) ListSummaryJSON {
+	return ListSummaryJSON{
+		ID:         summary.ID,
+		Name:       summary.Name,
+		Query:      summary.Query,
+		TotalItems: summary.TotalItems,
+		DoneItems:  summary.DoneItems,
+	}
+}
+
+type ListItemJSON struct {
+	ID             int64    `json:"id"`
+	EntityID       string   `json:"entity_id"`
+	ParentEntityID *string  `json:"parent_entity_id"`
+	Depth          int      `json:"depth"`
+	SortOrder      int      `json:"sort_order"`
+	Type           string   `json:"type"`
+	Content        string   `json:"content"`
+	Priority       string   `json:"priority"`
+	DueDate        *string  `json:"due_date"`
+	CreatedAt      string   `json:"created_at"`
+	Tags           []string `json:"tags"`
+	Mentions       []string `json:"mentions"`
+	EntryID        int64    `json:"entry_id,omitempty"`
+}
+
+func NewListItemJSON(item domain.ListItem) ListItemJSON {
+	var parentEntityID *string
+	if item.ParentEntityID != nil {
+		id := item.ParentEntityID.String()
+		parentEntityID = &id
+	}
+	priority := string(item.Priority)
+	if priority == "" {
+		priority = string(domain.PriorityNone)
+	}
+	var dueDate *string
+	if item.DueDate != nil {
+		date := item.DueDate.Format("2006-01-02")
+		dueDate = &date
+	}
+	return ListItemJSON{
+		ID:             item.RowID,
+		EntityID:       item.EntityID.String(),
+		ParentEntityID: parentEntityID,
+		Depth:          item.Depth,
+		SortOrder:      item.SortOrder,
+		Type:           string(item.Type),
+		Content:        item.Content,
+		Priority:       priority,
+		DueDate:        dueDate,
+		CreatedAt:      item.CreatedAt.Format(time.RFC3339),
+		Tags:           nonNil(item.Tags),
+		Mentions:       nonNil(item.Mentions),
+		EntryID:        item.EntryID,
+	}
+}
+
+// ListJSON is a list summary together with its items.
+type ListJSON struct {
+	ListSummaryJSON
+	Items []ListItemJSON `json:"items"`
+}
+
+func NewListJSON(summary *service.ListSummary, items []domain.ListItem) ListJSON {
+	result := ListJSON{
+		ListSummaryJSON: NewListSummaryJSON(summary),
+		Items:           make([]ListItemJSON, 0, len(items)),
+	}
+	for _, item := range items {
+		result.Items = append(result.Items, NewListItemJSON(item))
+	}
+	return result
+}
+
+type StatsJSON struct {
+	From           string             `json:"from"`
+	To             string             `json:"to"`
+	TotalDays      int                `json:"total_days"`
+	EntryCounts    EntryCountsJSON    `json:"entry_counts"`
+	TaskCompletion TaskCompletionJSON `json:"task_completion"`
+	Productivity   ProductivityJSON   `json:"productivity"`
+	Habits         HabitStatsJSON     `json:"habits"`
+	Goals          []GoalStatJSON     `json:"goals"`
+}
+
+type EntryCountsJSON struct {
+	Total     int `json:"total"`
+	Tasks     int `json:"tasks"`
+	Notes     int `json:"notes"`
+	Events    int `json:"events"`
+	Done      int `json:"done"`
+	Migrated  int `json:"migrated"`
+	Cancelled int `json:"cancelled"`
+}
+
+type TaskCompletionJSON struct {
+	Total     int     `json:"total"`
+	Completed int     `json:"completed"`
+	Rate      float64 `json:"rate"`
+}
+
+type WeekdayJSON struct {
+	Day     string  `json:"day"`
+	Average float64 `json:"average"`
+}
+
+type ProductivityJSON struct {
+	AveragePerDay   float64        `json:"average_per_day"`
+	MostProductive  WeekdayJSON    `json:"most_productive"`
+	LeastProductive WeekdayJSON    `json:"least_productive"`
+	EntriesByDay    map[string]int `json:"entries_by_day"`
+}
+
+type HabitStatsJSON struct {
+	Active           int                `json:"active"`
+	BestStreak       string             `json:"best_streak_habit"`
+	BestStreakLength int                `json:"best_streak_length"`
+	BestStreakPeriod string             `json:"best_streak_period"`
+	MostLogged       string             `json:"most_logged_habit"`
+	MostLoggedLogs   int                `json:"most_logged_count"`
+	TotalLogs        int                `json:"total_logs"`
+	Measured         []HabitMeasureJSON `json:"measured"`
+}
+
+type GoalStatJSON struct {
+	Goal       string          `json:"goal"`
+	Period     string          `json:"period"`
+	Progress   float64         `json:"progress"`
+	KeyResults []KeyResultJSON `json:"key_results"`
+}
+
+type HabitMeasureJSON struct {
+	Habit  string          `json:"habit"`
+	Unit   string          `json:"unit"`
+	Values HabitValuesJSON `json:"values"`
+}
+
+func NewStatsJSON(stats *domain.Stats) StatsJSON {
+	measured := make([]HabitMeasureJSON, 0, len(stats.HabitStats.Measured))
+	for _, m := range stats.HabitStats.Measured {
+		measured = append(measured, HabitMeasureJSON{
+			Habit:  m.HabitName,
+			Unit:   m.Unit,
+			Values: newHabitValuesJSON(m.Values),
+		})
+	}
+
+	goals := make([]GoalStatJSON, 0, len(stats.Goals))
+	for _, g := range stats.Goals {
+		goals = append(goals, GoalStatJSON{
+			Goal:       g.Content,
+			Period:     g.Period,
+			Progress:   g.Progress(),
+			KeyResults: NewKeyResultsJSON(g.KeyResults),
+		})
+	}
+
+	entriesByDay := make(map[string]int, len(stats.Productivity.EntriesByDay))
+	for day, count := range stats.Productivity.EntriesByDay {
+		entriesByDay[day.String()] = count
+	}
+
+	return StatsJSON{
+		From:      stats.Period.From.Format(jsonDate),
+		To:        stats.Period.To.Format(jsonDate),
+		TotalDays: stats.TotalDays,
+		EntryCounts: EntryCountsJSON{
+			Total:     stats.EntryCounts.Total,
+			Tasks:     stats.EntryCounts.Tasks,
+			Notes:     stats.EntryCounts.Notes,
+			Events:    stats.EntryCounts.Events,
+			Done:      stats.EntryCounts.Done,
+			Migrated:  stats.EntryCounts.Migrated,
+			Cancelled: stats.EntryCounts.Cancelled,
+		},
+		TaskCompletion: TaskCompletionJSON{
+			Total:     stats.TaskCompletion.Total,
+			Completed: stats.TaskCompletion.Completed,
+			Rate:      stats.TaskCompletion.Rate,
+		},
+		Productivity: ProductivityJSON{
+			AveragePerDay: stats.Productivity.AveragePerDay,
+			MostProductive: WeekdayJSON{
+				Day:     stats.Productivity.MostProductive.Day.String(),
+				Average: stats.Productivity.MostProductive.Average,
+			},
+			LeastProductive: WeekdayJSON{
+				Day:     stats.Productivity.LeastProductive.Day.String(),
+				Average: stats.Productivity.LeastProductive.Average,
+			},
+			EntriesByDay: entriesByDay,
+		},
+		Habits: HabitStatsJSON{
+			Active:           stats.HabitStats.Active,
+			BestStreak:       stats.HabitStats.BestStreak.HabitName,
+			BestStreakLength: stats.HabitStats.BestStreak.Days,
+			BestStreakPeriod: string(stats.HabitStats.BestStreak.Period),
+			MostLogged:       stats.HabitStats.MostLogged.HabitName,
+			MostLoggedLogs:   stats.HabitStats.MostLogged.Count,
+			TotalLogs:        stats.HabitStats.TotalLogs,
+			Measured:         measured,
+		},
+		Goals: goals,
+	}
+}
+
+type GoalJSON struct {
+	ID             int64   `json:"id"`
+	EntityID       string  `json:"entity_id"`
+	Content        string  `json:"content"`
+	Month          string  `json:"month"`
+	Horizon        string  `json:"horizon"`
+	ParentEntityID *string `json:"parent_entity_id"`
+	Status         string  `json:"status"`
+	MigratedTo     *string `json:"migrated_to"`
+	CreatedAt      string  `json:"created_at"`
+}
+
+func NewGoalJSON(g domain.Goal) GoalJSON {
+	var parentEntityID *string
+	if g.ParentEntityID != nil {
+		id := g.ParentEntityID.String()
+		parentEntityID = &id
+	}
+	return GoalJSON{
+		ID:             g.ID,
+		EntityID:       g.EntityID.String(),
+		Content:        g.Content,
+		Month:          g.Month.Format("2006-01"),
+		Horizon:        string(g.GetHorizon()),
+		ParentEntityID: parentEntityID,
+		Status:         string(g.Status),
+		MigratedTo:     formatOptionalTime(g.MigratedTo, "2006-01"),
+		CreatedAt:      g.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func NewGoalsJSON(goals []domain.Goal) []GoalJSON {
+	result := make([]GoalJSON, 0, len(goals))
+	for _, g := range goals {
+		result = append(result, NewGoalJSON(g))
+	}
+	return result
+}
+
+type KeyResultJSON struct {
+	ID           int64   `json:"id"`
+	EntityID     string  `json:"entity_id"`
+	GoalEntityID string  `json:"goal_entity_id"`
+	Name         string  `json:"name"`
+	Start        float64 `json:"start"`
+	Target       float64 `json:"target"`
+	Current      float64 `json:"current"`
+	Unit         string  `json:"unit"`
+	Progress     float64 `json:"progress"`
+}
+
+func NewKeyResultJSON(kr domain.KeyResult) KeyResultJSON {
+	return KeyResultJSON{
+		ID:           kr.ID,
+		EntityID:     kr.EntityID.String(),
+		GoalEntityID: kr.GoalEntityID.String(),
+		Name:         kr.Name,
+		Start:        kr.Start,
+		Target:       kr.Target,
+		Current:      kr.Current,
+		Unit:         kr.Unit,
+		Progress:     kr.Progress(),
+	}
+}
+
+func NewKeyResultsJSON(krs []domain.KeyResult) []KeyResultJSON {
+	result := make([]KeyResultJSON, 0, len(krs))
+	for _, kr := range krs {
+		result = append(result, NewKeyResultJSON(kr))
+	}
+	return result
+}
+
+type DayContextJSON struct {
+	Date     string  `json:"date"`
+	Location *string `json:"location"`
+	Mood     *string `json:"mood"`
+	Weather  *string `json:"weather"`
+}
+
+func NewDayContextJSON(date time.Time, location, mood, weather *string) DayContextJSON {
+	return DayContextJSON{
+		Date:     date.Format(jsonDate),
+		Location: location,
+		Mood:     mood,
+		Weather:  weather,
+	}
+}
+
+type SearchResultJSON struct {
+	Source  string     `json:"source"`
+	ID      int64      `json:"id"`
+	Content string     `json:"content"`
+	Snippet string     `json:"snippet"`
+	Rank    float64    `json:"rank"`
+	Context string     `json:"context"`
+	Entry   *EntryJSON `json:"entry"`
+}
+
+// NewSearchResultsJSON converts ranked results. Snippet highlight markers are
+// rendered as ** so that snippets remain printable.
+func NewSearchResultsJSON(results []domain.SearchResult) []SearchResultJSON {
+	out := make([]SearchResultJSON, 0, len(results))
+	for _, r := range results {
+		result := SearchResultJSON{
+			Source:  string(r.Source),
+			ID:      r.ID,
+			Content: r.Content,
+			Snippet: domain.HighlightSnippet(r.Snippet, func(s string) string { return "**" + s + "**" }),
+			Rank:    r.Rank,
+			Context: r.Context,
+		}
+		if r.Entry != nil {
+			entry := NewEntryJSON(*r.Entry)
+			result.Entry = &entry
+		}
+		out = append(out, result)
+	}
+	return out
+}
+
+func formatOptionalTime(t *time.Time, layout string) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(layout)
+	return &s
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
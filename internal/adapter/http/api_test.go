@@ -0,0 +1,470 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/app"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/service"
+)
+
+func setupAPI(t *testing.T) (*httptest.Server, *app.Services) {
+	t.Helper()
+	services := setupTestServices(t)
+	server := httptest.NewServer(withToken(t, services, NewHandler(services).Routes()))
+	t.Cleanup(server.Close)
+	return server, services
+}
+
+func doRequest(t *testing.T, method, url string, body any) *http.Response {
+	t.Helper()
+	var reader *bytes.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		require.NoError(t, err)
+		reader = bytes.NewReader(data)
+	} else {
+		reader = bytes.NewReader(nil)
+	}
+
+	req, err := http.NewRequest(method, url, reader)
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+func decodeEnvelope[T any](t *testing.T, resp *http.Response, schema string) T {
+	t.Helper()
+	var envelope struct {
+		Schema string `json:"schema"`
+		Data   T      `json:"data"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&envelope))
+	assert.Equal(t, schema, envelope.Schema)
+	return envelope.Data
+}
+
+func decodeError(t *testing.T, resp *http.Response) errorResponse {
+	t.Helper()
+	var body errorResponse
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&body))
+	return body
+}
+
+func addTestEntry(t *testing.T, services *app.Services, input string) int64 {
+	t.Helper()
+	ids, err := services.Bujo.LogEntries(context.Background(), input, service.LogEntriesOptions{Date: today()})
+	require.NoError(t, err)
+	require.NotEmpty(t, ids)
+	return ids[0]
+}
+
+func TestAPI_GetEntries_ReturnsToday(t *testing.T) {
+	server, services := setupAPI(t)
+	addTestEntry(t, services, ". Write report")
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/entries", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	days := decodeEnvelope[[]cli.DayEntriesJSON](t, resp, cli.SchemaDayEntries)
+	require.Len(t, days, 1)
+	assert.Equal(t, today().Format("2006-01-02"), days[0].Date)
+	require.Len(t, days[0].Entries, 1)
+	assert.Equal(t, "Write report", days[0].Entries[0].Content)
+}
+
+func TestAPI_GetEntries_InvalidDate(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/entries?date=tomorrow", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	body := decodeError(t, resp)
+	assert.False(t, body.Success)
+	assert.Contains(t, body.Error, "invalid date")
+}
+
+func TestAPI_GetEntries_MarksProjectedRecurrences(t *testing.T) {
+	server, services := setupAPI(t)
+	_, err := services.Recurrence.Create(context.Background(), service.CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Water plants",
+		Every:     "daily",
+		StartDate: today(),
+	})
+	require.NoError(t, err)
+
+	tomorrow := today().AddDate(0, 0, 1).Format("2006-01-02")
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/entries?from="+today().Format("2006-01-02")+"&to="+tomorrow, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	days := decodeEnvelope[[]cli.DayEntriesJSON](t, resp, cli.SchemaDayEntries)
+	require.Len(t, days, 2)
+	require.Len(t, days[0].Entries, 1)
+	assert.NotZero(t, days[0].Entries[0].ID)
+	assert.False(t, days[0].Entries[0].Projected)
+	require.Len(t, days[1].Entries, 1)
+	assert.Zero(t, days[1].Entries[0].ID)
+	assert.True(t, days[1].Entries[0].Projected)
+}
+
+func TestAPI_GetOverdue_IncludesListItems(t *testing.T) {
+	server, services := setupAPI(t)
+	ctx := context.Background()
+	lastWeek := today().AddDate(0, 0, -7)
+
+	_, err := services.Bujo.LogEntries(ctx, ". Call bank", service.LogEntriesOptions{Date: lastWeek})
+	require.NoError(t, err)
+	list, err := services.List.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	_, err = services.List.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", service.ListItemOptions{DueDate: &lastWeek})
+	require.NoError(t, err)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/entries/overdue", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	overdue := decodeEnvelope[cli.OverdueJSON](t, resp, cli.SchemaOverdue)
+	require.Len(t, overdue.Entries, 1)
+	assert.Equal(t, "Call bank", overdue.Entries[0].Content)
+	require.Len(t, overdue.ListItems, 1)
+	assert.Equal(t, "Renew passport", overdue.ListItems[0].Content)
+	assert.Equal(t, "Errands", overdue.ListItems[0].ListName)
+}
+
+func TestAPI_UpdateEntry(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Draft")
+
+	resp := doRequest(t, http.MethodPatch, server.URL+"/api/entries/"+itoa(id), map[string]string{
+		"content":  "Final",
+		"priority": "high",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	entry := decodeEnvelope[cli.EntryJSON](t, resp, cli.SchemaEntry)
+	assert.Equal(t, "Final", entry.Content)
+	assert.Equal(t, "high", entry.Priority)
+}
+
+func TestAPI_UpdateEntry_IsOneOperation(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Draft")
+
+	resp := doRequest(t, http.MethodPatch, server.URL+"/api/entries/"+itoa(id), map[string]string{
+		"content":  "Final",
+		"type":     "note",
+		"priority": "high",
+	})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err := services.Journal.Undo(context.Background())
+	require.NoError(t, err)
+
+	entry, err := services.Bujo.GetEntry(context.Background(), id)
+	require.NoError(t, err)
+	assert.Equal(t, "Draft", entry.Content)
+	assert.Equal(t, domain.EntryTypeTask, entry.Type)
+	assert.Equal(t, domain.PriorityNone, entry.Priority)
+}
+
+func TestAPI_UpdateEntry_RejectedFieldChangesNothing(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Draft")
+
+	for _, body := range []map[string]string{
+		{"content": "Final", "priority": "urgent"},
+		{"content": "Final", "type": "done"},
+	} {
+		resp := doRequest(t, http.MethodPatch, server.URL+"/api/entries/"+itoa(id), body)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		entry, err := services.Bujo.GetEntry(context.Background(), id)
+		require.NoError(t, err)
+		assert.Equal(t, "Draft", entry.Content)
+	}
+}
+
+func TestAPI_EntryActions(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Ship it")
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/entries/"+itoa(id)+"/done", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "done", decodeEnvelope[cli.EntryJSON](t, resp, cli.SchemaEntry).Type)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/entries/"+itoa(id)+"/undone", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "task", decodeEnvelope[cli.EntryJSON](t, resp, cli.SchemaEntry).Type)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/entries/"+itoa(id)+"/cancel", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "cancelled", decodeEnvelope[cli.EntryJSON](t, resp, cli.SchemaEntry).Type)
+}
+
+func TestAPI_MigrateEntry(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Move me")
+	tomorrow := today().AddDate(0, 0, 1).Format("2006-01-02")
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/entries/"+itoa(id)+"/migrate", map[string]string{"date": tomorrow})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	entry := decodeEnvelope[cli.EntryJSON](t, resp, cli.SchemaEntry)
+	assert.NotEqual(t, id, entry.ID)
+	assert.Equal(t, "Move me", entry.Content)
+	require.NotNil(t, entry.ScheduledDate)
+	assert.Equal(t, tomorrow, *entry.ScheduledDate)
+}
+
+func TestAPI_MoveEntryToList(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Buy milk")
+	list, err := services.List.CreateList(context.Background(), "Shopping")
+	require.NoError(t, err)
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/entries/"+itoa(id)+"/move-to-list", map[string]int64{"list_id": list.ID})
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	items, err := services.List.GetListItems(context.Background(), list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Buy milk", items[0].Content)
+}
+
+func TestAPI_DeleteEntry_NotFound(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodDelete, server.URL+"/api/entries/999", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+
+	body := decodeError(t, resp)
+	assert.False(t, body.Success)
+	assert.Contains(t, body.Error, "not found")
+}
+
+func TestAPI_InvalidID(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/entries/abc", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	assert.Contains(t, decodeError(t, resp).Error, "invalid id")
+}
+
+func TestAPI_HabitLifecycle(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/habits", map[string]string{"name": "Gym"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	habit := decodeEnvelope[cli.HabitDetailsJSON](t, resp, cli.SchemaHabitDetails)
+	assert.Equal(t, "Gym", habit.Name)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/habits/"+itoa(habit.ID)+"/logs", map[string]int{"count": 2})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	habit = decodeEnvelope[cli.HabitDetailsJSON](t, resp, cli.SchemaHabitDetails)
+	require.Len(t, habit.Logs, 1)
+	assert.Equal(t, 2, habit.Logs[0].Count)
+
+	resp = doRequest(t, http.MethodDelete, server.URL+"/api/habits/"+itoa(habit.ID)+"/logs/last", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Empty(t, decodeEnvelope[cli.HabitDetailsJSON](t, resp, cli.SchemaHabitDetails).Logs)
+
+	resp = doRequest(t, http.MethodPatch, server.URL+"/api/habits/"+itoa(habit.ID), map[string]any{"name": "Exercise", "goal_per_week": 3})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	habit = decodeEnvelope[cli.HabitDetailsJSON](t, resp, cli.SchemaHabitDetails)
+	assert.Equal(t, "Exercise", habit.Name)
+	assert.Equal(t, 3, habit.GoalPerWeek)
+
+	resp = doRequest(t, http.MethodGet, server.URL+"/api/habits", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	status := decodeEnvelope[cli.TrackerStatusJSON](t, resp, cli.SchemaTrackerStatus)
+	require.Len(t, status.Habits, 1)
+
+	resp = doRequest(t, http.MethodDelete, server.URL+"/api/habits/"+itoa(habit.ID), nil)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodGet, server.URL+"/api/habits/"+itoa(habit.ID), nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAPI_ListLifecycle(t *testing.T) {
+	server, services := setupAPI(t)
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/lists", map[string]string{"name": "Groceries"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	list := decodeEnvelope[cli.ListJSON](t, resp, cli.SchemaList)
+	assert.Equal(t, "Groceries", list.Name)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/lists/"+itoa(list.ID)+"/items", map[string]string{"content": "Eggs"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	list = decodeEnvelope[cli.ListJSON](t, resp, cli.SchemaList)
+	require.Len(t, list.Items, 1)
+	itemID := list.Items[0].ID
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/list-items/"+itoa(itemID)+"/done", nil)
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodGet, server.URL+"/api/lists", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	summaries := decodeEnvelope[[]cli.ListSummaryJSON](t, resp, cli.SchemaListSummary)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, 1, summaries[0].DoneItems)
+
+	other, err := services.List.CreateList(context.Background(), "Later")
+	require.NoError(t, err)
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/list-items/"+itoa(itemID)+"/move", map[string]int64{"list_id": other.ID})
+	require.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodDelete, server.URL+"/api/lists/"+itoa(other.ID), nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodDelete, server.URL+"/api/lists/"+itoa(other.ID)+"?force=true", nil)
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+}
+
+func TestAPI_SmartListItemsCarryEntryIDs(t *testing.T) {
+	server, services := setupAPI(t)
+	ctx := context.Background()
+
+	ids, err := services.Bujo.LogEntries(ctx, ". Chase invoice #waiting", service.LogEntriesOptions{})
+	require.NoError(t, err)
+	list, err := services.List.CreateSmartList(ctx, "Waiting", "#waiting")
+	require.NoError(t, err)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/lists/"+itoa(list.ID), nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	got := decodeEnvelope[cli.ListJSON](t, resp, cli.SchemaList)
+	require.Len(t, got.Items, 1)
+	assert.Zero(t, got.Items[0].ID)
+	assert.Equal(t, ids[0], got.Items[0].EntryID)
+}
+
+func TestAPI_GoalLifecycle(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodPost, server.URL+"/api/goals", map[string]string{"content": "Read 4 books", "month": "2026-04"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	goal := decodeEnvelope[cli.GoalJSON](t, resp, cli.SchemaGoal)
+	assert.Equal(t, "2026-04", goal.Month)
+	assert.Equal(t, "active", goal.Status)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/goals/"+itoa(goal.ID)+"/done", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "done", decodeEnvelope[cli.GoalJSON](t, resp, cli.SchemaGoal).Status)
+
+	resp = doRequest(t, http.MethodGet, server.URL+"/api/goals?month=2026-04", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Len(t, decodeEnvelope[[]cli.GoalJSON](t, resp, cli.SchemaGoal), 1)
+
+	resp = doRequest(t, http.MethodPost, server.URL+"/api/goals/"+itoa(goal.ID)+"/migrate", map[string]string{"month": "2026-05"})
+	require.Equal(t, http.StatusCreated, resp.StatusCode)
+	migrated := decodeEnvelope[cli.GoalJSON](t, resp, cli.SchemaGoal)
+	assert.Equal(t, "2026-05", migrated.Month)
+
+	resp = doRequest(t, http.MethodGet, server.URL+"/api/goals/999", nil)
+	assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+}
+
+func TestAPI_DayContext(t *testing.T) {
+	server, _ := setupAPI(t)
+	url := server.URL + "/api/days/2026-03-14/context"
+
+	resp := doRequest(t, http.MethodPut, url, map[string]string{"mood": "happy", "weather": "sunny"})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	dayCtx := decodeEnvelope[cli.DayContextJSON](t, resp, cli.SchemaDayContext)
+	assert.Equal(t, "2026-03-14", dayCtx.Date)
+	require.NotNil(t, dayCtx.Mood)
+	assert.Equal(t, "Happy", *dayCtx.Mood)
+	assert.Nil(t, dayCtx.Location)
+
+	resp = doRequest(t, http.MethodPut, url, map[string]string{"weather": ""})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = doRequest(t, http.MethodGet, url, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	dayCtx = decodeEnvelope[cli.DayContextJSON](t, resp, cli.SchemaDayContext)
+	require.NotNil(t, dayCtx.Mood)
+	assert.Nil(t, dayCtx.Weather)
+}
+
+func TestAPI_Search(t *testing.T) {
+	server, services := setupAPI(t)
+	addTestEntry(t, services, ". Quarterly report draft")
+	addTestEntry(t, services, "- Lunch with Sam")
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/search?q=report", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	results := decodeEnvelope[[]cli.SearchResultJSON](t, resp, cli.SchemaSearchResult)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Quarterly report draft", results[0].Content)
+	assert.Contains(t, results[0].Snippet, "**report**")
+}
+
+func TestAPI_Search_RequiresQuery(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/search", nil)
+	assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+}
+
+func TestAPI_Stats(t *testing.T) {
+	server, services := setupAPI(t)
+	id := addTestEntry(t, services, ". Task")
+	require.NoError(t, services.Bujo.MarkDone(context.Background(), id))
+
+	date := today().Format("2006-01-02")
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/stats?from="+date+"&to="+date, nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	stats := decodeEnvelope[cli.StatsJSON](t, resp, cli.SchemaStats)
+	assert.Equal(t, 1, stats.TaskCompletion.Completed)
+}
+
+func TestAPI_OpenAPISpec(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	resp := doRequest(t, http.MethodGet, server.URL+"/api/openapi.json", nil)
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	var spec struct {
+		OpenAPI string                    `json:"openapi"`
+		Paths   map[string]map[string]any `json:"paths"`
+	}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&spec))
+	assert.Equal(t, "3.0.3", spec.OpenAPI)
+
+	for _, path := range []string{"/api/entries/{id}", "/api/habits", "/api/lists/{id}", "/api/goals", "/api/days/{date}/context", "/api/search", "/api/stats"} {
+		assert.Contains(t, spec.Paths, path)
+	}
+}
+
+func TestAPI_CORSAllowsWriteMethods(t *testing.T) {
+	server, _ := setupAPI(t)
+
+	req, err := http.NewRequest(http.MethodOptions, server.URL+"/api/entries/1", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://mail.google.com")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Methods"), "PATCH")
+}
+
+func itoa(id int64) string {
+	return strconv.FormatInt(id, 10)
+}
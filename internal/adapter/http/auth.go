@@ -0,0 +1,166 @@
+package http
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+const (
+	bookmarkletTokenName        = "Gmail bookmarklet"
+	bookmarkletTokenPlaceholder = "__BUJO_TOKEN__"
+	bookmarkletStartMarker      = "<!-- bookmarklet -->"
+	bookmarkletEndMarker        = "<!-- /bookmarklet -->"
+)
+
+// installForm stands in for the bookmarklet on the install page until the
+// visitor asks for one, so that loading the page creates no token.
+const installForm = `<form method="post" action="/install">
+            <p>Create a bookmarklet with its own capture-only token. Creating it again revokes the previous one.</p>
+            <button class="bookmarklet" type="submit">Create bookmarklet</button>
+        </form>`
+
+// publicRoutes need no token. Everything else requires a bearer token whose
+// scopes cover the route, see requiredScope.
+var publicRoutes = map[string]bool{
+	"GET /api/health":       true,
+	"GET /api/openapi.json": true,
+	"GET /install":          true,
+	"POST /install":         true,
+}
+
+// requiredScope maps a request onto the scope it needs: reads need read,
+// capturing entries needs capture, and every other change needs write.
+func requiredScope(r *http.Request) domain.APITokenScope {
+	switch {
+	case r.Method == http.MethodGet:
+		return domain.APITokenScopeRead
+	case r.Method == http.MethodPost && r.URL.Path == "/api/entries":
+		return domain.APITokenScopeCapture
+	default:
+		return domain.APITokenScopeWrite
+	}
+}
+
+func (h *Handler) authMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The server only listens on loopback, but a DNS-rebound page would
+		// still arrive here with a foreign Host header.
+		if !isLoopbackHost(r.Host) {
+			writeError(w, http.StatusForbidden, "requests must be addressed to 127.0.0.1 or localhost")
+			return
+		}
+
+		if publicRoutes[r.Method+" "+r.URL.Path] {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		secret, ok := bearerToken(r)
+		if !ok {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, "missing API token (create one with 'bujo api token create')")
+			return
+		}
+
+		token, err := h.services.APIAuth.Authenticate(r.Context(), secret)
+		if err != nil {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, err.Error())
+			return
+		}
+
+		scope := requiredScope(r)
+		if !token.Allows(scope) {
+			writeError(w, http.StatusForbidden, "token does not have the "+string(scope)+" scope")
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// corsMiddleware answers browsers only for origins on the allowlist managed
+// with 'bujo api origin'.
+func (h *Handler) corsMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		allowed, err := h.services.APIAuth.IsOriginAllowed(r.Context(), origin)
+		if err != nil {
+			writeError(w, http.StatusInternalServerError, "failed to check origin")
+			return
+		}
+		if allowed {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, PATCH, DELETE, OPTIONS")
+			w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
+			w.Header().Set("Vary", "Origin")
+		}
+
+		if r.Method == "OPTIONS" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// handleInstallPage shows the install page with a form to create the
+// bookmarklet. It has no side effects, so reloads and crawlers are harmless.
+func (h *Handler) handleInstallPage(w http.ResponseWriter, r *http.Request) {
+	before, rest, _ := bytes.Cut(installPage, []byte(bookmarkletStartMarker))
+	_, after, _ := bytes.Cut(rest, []byte(bookmarkletEndMarker))
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write(before)
+	_, _ = w.Write([]byte(installForm))
+	_, _ = w.Write(after)
+}
+
+// handleInstall replaces the bookmarklet's capture-only token and embeds it
+// in the bookmarklet, so the bookmarklet can add entries but not read the
+// journal. Only the install page itself may ask, not another site's form.
+func (h *Handler) handleInstall(w http.ResponseWriter, r *http.Request) {
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if u, err := url.Parse(origin); err != nil || !isLoopbackHost(u.Host) {
+			writeError(w, http.StatusForbidden, "the bookmarklet can only be created from the install page")
+			return
+		}
+	}
+
+	secret, _, err := h.services.APIAuth.ReplaceToken(r.Context(), bookmarkletTokenName, []domain.APITokenScope{domain.APITokenScopeCapture})
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, "failed to create bookmarklet token")
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	_, _ = w.Write(bytes.ReplaceAll(installPage, []byte(bookmarkletTokenPlaceholder), []byte(secret)))
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	scheme, token, found := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", false
+	}
+	return strings.TrimSpace(token), true
+}
+
+func isLoopbackHost(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		host = hostport
+	}
+	host = strings.Trim(host, "[]")
+	if strings.EqualFold(host, "localhost") {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
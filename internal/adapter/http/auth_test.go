@@ -0,0 +1,226 @@
+package http
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/app"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func setupUnauthenticatedAPI(t *testing.T) (*httptest.Server, *app.Services) {
+	t.Helper()
+	services := setupTestServices(t)
+	server := httptest.NewServer(NewHandler(services).Routes())
+	t.Cleanup(server.Close)
+	return server, services
+}
+
+func createTestToken(t *testing.T, services *app.Services, scopes ...domain.APITokenScope) string {
+	t.Helper()
+	secret, _, err := services.APIAuth.CreateToken(context.Background(), "test", scopes)
+	require.NoError(t, err)
+	return secret
+}
+
+func requestWithToken(t *testing.T, method, url, token, body string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(method, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+	return resp
+}
+
+const captureBody = `{"entries":[{"type":"task","content":"From the bookmarklet"}]}`
+
+func TestAuth_MissingTokenIsRejected(t *testing.T) {
+	server, _ := setupUnauthenticatedAPI(t)
+
+	resp := requestWithToken(t, http.MethodPost, server.URL+"/api/entries", "", captureBody)
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	assert.Equal(t, "Bearer", resp.Header.Get("WWW-Authenticate"))
+	assert.False(t, decodeError(t, resp).Success)
+}
+
+func TestAuth_UnknownTokenIsRejected(t *testing.T) {
+	server, _ := setupUnauthenticatedAPI(t)
+
+	resp := requestWithToken(t, http.MethodGet, server.URL+"/api/entries", "bujo_notarealtoken", "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAuth_PublicRoutesNeedNoToken(t *testing.T) {
+	server, _ := setupUnauthenticatedAPI(t)
+
+	for _, path := range []string{"/api/health", "/api/openapi.json", "/install"} {
+		resp := requestWithToken(t, http.MethodGet, server.URL+path, "", "")
+		assert.Equal(t, http.StatusOK, resp.StatusCode, path)
+	}
+}
+
+func TestAuth_CaptureScopeCanOnlyAddEntries(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	token := createTestToken(t, services, domain.APITokenScopeCapture)
+
+	resp := requestWithToken(t, http.MethodPost, server.URL+"/api/entries", token, captureBody)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+
+	resp = requestWithToken(t, http.MethodGet, server.URL+"/api/entries", token, "")
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	assert.Contains(t, decodeError(t, resp).Error, "read")
+
+	resp = requestWithToken(t, http.MethodPost, server.URL+"/api/habits", token, `{"name":"Gym"}`)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestAuth_ReadScopeCannotWrite(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	token := createTestToken(t, services, domain.APITokenScopeRead)
+
+	resp := requestWithToken(t, http.MethodGet, server.URL+"/api/habits", token, "")
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	resp = requestWithToken(t, http.MethodPost, server.URL+"/api/entries", token, captureBody)
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func TestAuth_WriteScopeCanCapture(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	token := createTestToken(t, services, domain.APITokenScopeWrite)
+
+	resp := requestWithToken(t, http.MethodPost, server.URL+"/api/entries", token, captureBody)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode)
+}
+
+func TestAuth_RevokedTokenIsRejected(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	secret, token, err := services.APIAuth.CreateToken(context.Background(), "old", []domain.APITokenScope{domain.APITokenScopeRead})
+	require.NoError(t, err)
+	require.NoError(t, services.APIAuth.RevokeToken(context.Background(), token.ID))
+
+	resp := requestWithToken(t, http.MethodGet, server.URL+"/api/entries", secret, "")
+	assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestAuth_RejectsForeignHost(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	token := createTestToken(t, services, domain.APITokenScopeRead)
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/api/entries", nil)
+	require.NoError(t, err)
+	req.Host = "attacker.example:8743"
+	req.Header.Set("Authorization", "Bearer "+token)
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+}
+
+func installBookmarkletToken(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+	resp := requestWithToken(t, http.MethodPost, server.URL+"/install", "", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	page, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.NotContains(t, string(page), bookmarkletTokenPlaceholder)
+	secret := regexp.MustCompile(`Bearer (bujo_[0-9a-f]+)`).FindStringSubmatch(string(page))
+	require.Len(t, secret, 2)
+	return secret[1]
+}
+
+func TestInstall_EmbedsCaptureOnlyToken(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+
+	secret := installBookmarkletToken(t, server)
+
+	token, err := services.APIAuth.Authenticate(context.Background(), secret)
+	require.NoError(t, err)
+	assert.Equal(t, bookmarkletTokenName, token.Name)
+	assert.Equal(t, []domain.APITokenScope{domain.APITokenScopeCapture}, token.Scopes)
+}
+
+func TestInstall_PageCreatesNoToken(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+
+	resp := requestWithToken(t, http.MethodGet, server.URL+"/install", "", "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	page, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(page), `<form method="post" action="/install">`)
+	assert.NotContains(t, string(page), bookmarkletTokenPlaceholder)
+	tokens, err := services.APIAuth.ListTokens(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestInstall_ReplacesPreviousToken(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+	ctx := context.Background()
+
+	first := installBookmarkletToken(t, server)
+	second := installBookmarkletToken(t, server)
+
+	_, err := services.APIAuth.Authenticate(ctx, first)
+	assert.Error(t, err)
+	_, err = services.APIAuth.Authenticate(ctx, second)
+	assert.NoError(t, err)
+}
+
+func TestInstall_RejectsOtherOrigins(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+
+	req, err := http.NewRequest(http.MethodPost, server.URL+"/install", nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://evil.example")
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() { _ = resp.Body.Close() }()
+
+	assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+	tokens, err := services.APIAuth.ListTokens(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, tokens)
+}
+
+func TestCORS_UsesOriginAllowlist(t *testing.T) {
+	server, services := setupUnauthenticatedAPI(t)
+
+	preflight := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodOptions, server.URL+"/api/entries", nil)
+		require.NoError(t, err)
+		req.Header.Set("Origin", origin)
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		t.Cleanup(func() { _ = resp.Body.Close() })
+		return resp
+	}
+
+	resp := preflight("https://mail.google.com")
+	assert.Equal(t, "https://mail.google.com", resp.Header.Get("Access-Control-Allow-Origin"))
+	assert.Contains(t, resp.Header.Get("Access-Control-Allow-Headers"), "Authorization")
+
+	resp = preflight("https://outlook.office.com")
+	assert.Empty(t, resp.Header.Get("Access-Control-Allow-Origin"))
+
+	_, err := services.APIAuth.AllowOrigin(context.Background(), "https://outlook.office.com/mail/")
+	require.NoError(t, err)
+
+	resp = preflight("https://outlook.office.com")
+	assert.Equal(t, "https://outlook.office.com", resp.Header.Get("Access-Control-Allow-Origin"))
+}
@@ -0,0 +1,105 @@
+package http
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+)
+
+func (h *Handler) dayRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/days/{date}/context", h.handleGetDayContext)
+	mux.HandleFunc("PUT /api/days/{date}/context", h.handlePutDayContext)
+}
+
+func (h *Handler) handleGetDayContext(w http.ResponseWriter, r *http.Request) {
+	date, ok := pathDate(w, r)
+	if !ok {
+		return
+	}
+	h.writeDayContext(w, r, date)
+}
+
+// dayContextRequest fields are optional: an omitted field is left alone and
+// an empty string clears it.
+type dayContextRequest struct {
+	Location *string `json:"location"`
+	Mood     *string `json:"mood"`
+	Weather  *string `json:"weather"`
+}
+
+func (h *Handler) handlePutDayContext(w http.ResponseWriter, r *http.Request) {
+	date, ok := pathDate(w, r)
+	if !ok {
+		return
+	}
+
+	var req dayContextRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	bujo := h.services.Bujo
+	var err error
+	if req.Location != nil {
+		if *req.Location == "" {
+			err = bujo.ClearLocation(ctx, date)
+		} else {
+			err = bujo.SetLocation(ctx, date, *req.Location)
+		}
+	}
+	if err == nil && req.Mood != nil {
+		if *req.Mood == "" {
+			err = bujo.ClearMood(ctx, date)
+		} else {
+			err = bujo.SetMood(ctx, date, *req.Mood)
+		}
+	}
+	if err == nil && req.Weather != nil {
+		if *req.Weather == "" {
+			err = bujo.ClearWeather(ctx, date)
+		} else {
+			err = bujo.SetWeather(ctx, date, *req.Weather)
+		}
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	h.writeDayContext(w, r, date)
+}
+
+func (h *Handler) writeDayContext(w http.ResponseWriter, r *http.Request, date time.Time) {
+	ctx := r.Context()
+	bujo := h.services.Bujo
+
+	location, err := bujo.GetLocation(ctx, date)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	mood, err := bujo.GetMood(ctx, date)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	weather, err := bujo.GetWeather(ctx, date)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	writeData(w, http.StatusOK, cli.SchemaDayContext, cli.NewDayContextJSON(date, location, mood, weather))
+}
+
+func pathDate(w http.ResponseWriter, r *http.Request) (time.Time, bool) {
+	value := r.PathValue("date")
+	date, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid date: "+value+" (use YYYY-MM-DD)")
+		return time.Time{}, false
+	}
+	return date, true
+}
@@ -0,0 +1,225 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func (h *Handler) entryRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/entries", h.handleGetEntries)
+	mux.HandleFunc("GET /api/entries/overdue", h.handleGetOverdue)
+	mux.HandleFunc("GET /api/entries/{id}", h.handleGetEntry)
+	mux.HandleFunc("PATCH /api/entries/{id}", h.handleUpdateEntry)
+	mux.HandleFunc("DELETE /api/entries/{id}", h.handleDeleteEntry)
+	mux.HandleFunc("POST /api/entries/{id}/done", h.entryAction(h.services.Bujo.MarkDone))
+	mux.HandleFunc("POST /api/entries/{id}/undone", h.entryAction(h.services.Bujo.Undo))
+	mux.HandleFunc("POST /api/entries/{id}/cancel", h.entryAction(h.services.Bujo.CancelEntry))
+	mux.HandleFunc("POST /api/entries/{id}/uncancel", h.entryAction(h.services.Bujo.UncancelEntry))
+	mux.HandleFunc("POST /api/entries/{id}/migrate", h.handleMigrateEntry)
+	mux.HandleFunc("POST /api/entries/{id}/move-to-list", h.handleMoveEntryToList)
+}
+
+// handleGetEntries returns the journal for ?date= or the ?from=&to= range,
+// defaulting to today.
+func (h *Handler) handleGetEntries(w http.ResponseWriter, r *http.Request) {
+	date, ok := queryDate(w, r, "date", today())
+	if !ok {
+		return
+	}
+	from, ok := queryDate(w, r, "from", date)
+	if !ok {
+		return
+	}
+	to, ok := queryDate(w, r, "to", from)
+	if !ok {
+		return
+	}
+	if to.Before(from) {
+		writeError(w, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+
+	days, err := h.services.Bujo.GetDayEntries(r.Context(), from, to)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaDayEntries, cli.NewDayEntriesJSON(days))
+}
+
+func (h *Handler) handleGetOverdue(w http.ResponseWriter, r *http.Request) {
+	entries, err := h.services.Bujo.GetOverdue(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	items, err := h.services.List.GetOverdueItems(r.Context(), today())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaOverdue, cli.NewOverdueJSON(entries, items))
+}
+
+func (h *Handler) handleGetEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	h.writeEntry(w, r, id, http.StatusOK)
+}
+
+type updateEntryRequest struct {
+	Content  *string `json:"content"`
+	Type     *string `json:"type"`
+	Priority *string `json:"priority"`
+}
+
+// handleUpdateEntry parses every field before changing anything, then
+// applies them as one undoable operation that is rolled back as a whole if
+// any of them is rejected.
+func (h *Handler) handleUpdateEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateEntryRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	var entryType domain.EntryType
+	if req.Type != nil {
+		parsed, err := domain.ParseEntryTypeFromString(*req.Type)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid entry type: "+*req.Type)
+			return
+		}
+		entryType = parsed
+	}
+	var priority domain.Priority
+	if req.Priority != nil {
+		parsed, err := domain.ParsePriority(*req.Priority)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		priority = parsed
+	}
+
+	ctx, end := h.services.Journal.Begin(r.Context(), fmt.Sprintf("Update entry %d", id))
+	err := h.services.Transactor.InTransaction(ctx, func(ctx context.Context) error {
+		if req.Content != nil {
+			if err := h.services.Bujo.EditEntry(ctx, id, *req.Content); err != nil {
+				return err
+			}
+		}
+		if req.Type != nil {
+			if err := h.services.Bujo.RetypeEntry(ctx, id, entryType); err != nil {
+				return err
+			}
+		}
+		if req.Priority != nil {
+			return h.services.Bujo.EditEntryPriority(ctx, id, priority)
+		}
+		return nil
+	})
+	end()
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	h.writeEntry(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleDeleteEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.Bujo.DeleteEntry(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) entryAction(action func(ctx context.Context, id int64) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		if err := action(r.Context(), id); err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		h.writeEntry(w, r, id, http.StatusOK)
+	}
+}
+
+type migrateEntryRequest struct {
+	Date string `json:"date"`
+}
+
+func (h *Handler) handleMigrateEntry(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req migrateEntryRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	toDate, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid date: "+req.Date+" (use YYYY-MM-DD)")
+		return
+	}
+
+	newID, err := h.services.Bujo.MigrateEntry(r.Context(), id, toDate)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeEntry(w, r, newID, http.StatusCreated)
+}
+
+type moveEntryToListRequest struct {
+	ListID int64 `json:"list_id"`
+}
+
+func (h *Handler) handleMoveEntryToList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req moveEntryToListRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := h.services.Bujo.MoveEntryToList(r.Context(), id, req.ListID); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeEntry(w http.ResponseWriter, r *http.Request, id int64, status int) {
+	entry, err := h.services.Bujo.GetEntry(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, status, cli.SchemaEntry, cli.NewEntryJSON(*entry))
+}
@@ -0,0 +1,179 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+)
+
+func (h *Handler) goalRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/goals", h.handleGetGoals)
+	mux.HandleFunc("POST /api/goals", h.handleCreateGoal)
+	mux.HandleFunc("GET /api/goals/{id}", h.handleGetGoal)
+	mux.HandleFunc("PATCH /api/goals/{id}", h.handleUpdateGoal)
+	mux.HandleFunc("DELETE /api/goals/{id}", h.handleDeleteGoal)
+	mux.HandleFunc("POST /api/goals/{id}/done", h.goalAction(h.services.Goal.MarkDone))
+	mux.HandleFunc("POST /api/goals/{id}/undone", h.goalAction(h.services.Goal.MarkActive))
+	mux.HandleFunc("POST /api/goals/{id}/cancel", h.goalAction(h.services.Goal.CancelGoal))
+	mux.HandleFunc("POST /api/goals/{id}/uncancel", h.goalAction(h.services.Goal.UncancelGoal))
+	mux.HandleFunc("POST /api/goals/{id}/migrate", h.handleMigrateGoal)
+}
+
+// handleGetGoals returns the goals for ?month=YYYY-MM, or every goal when no
+// month is given.
+func (h *Handler) handleGetGoals(w http.ResponseWriter, r *http.Request) {
+	var (
+		goals = h.services.Goal
+		month = r.URL.Query().Get("month")
+	)
+
+	if month == "" {
+		all, err := goals.GetAllGoals(r.Context())
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		writeData(w, http.StatusOK, cli.SchemaGoal, cli.NewGoalsJSON(all))
+		return
+	}
+
+	m, ok := parseMonth(w, month)
+	if !ok {
+		return
+	}
+	forMonth, err := goals.GetGoalsForMonth(r.Context(), m)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaGoal, cli.NewGoalsJSON(forMonth))
+}
+
+type goalRequest struct {
+	Content string `json:"content"`
+	Month   string `json:"month"`
+}
+
+func (h *Handler) handleCreateGoal(w http.ResponseWriter, r *http.Request) {
+	var req goalRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	now := today()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+	if req.Month != "" {
+		m, ok := parseMonth(w, req.Month)
+		if !ok {
+			return
+		}
+		month = m
+	}
+
+	id, err := h.services.Goal.CreateGoal(r.Context(), req.Content, month)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeGoal(w, r, id, http.StatusCreated)
+}
+
+func (h *Handler) handleGetGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	h.writeGoal(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleUpdateGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req goalRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := h.services.Goal.UpdateGoal(r.Context(), id, req.Content); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeGoal(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleDeleteGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.Goal.DeleteGoal(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) goalAction(action func(ctx context.Context, id int64) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		if err := action(r.Context(), id); err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		h.writeGoal(w, r, id, http.StatusOK)
+	}
+}
+
+func (h *Handler) handleMigrateGoal(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req goalRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	month, ok := parseMonth(w, req.Month)
+	if !ok {
+		return
+	}
+
+	newID, err := h.services.Goal.MigrateGoal(r.Context(), id, month)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeGoal(w, r, newID, http.StatusCreated)
+}
+
+func (h *Handler) writeGoal(w http.ResponseWriter, r *http.Request, id int64, status int) {
+	goal, err := h.services.Goal.GetGoal(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	if goal == nil {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("goal not found: %d", id))
+		return
+	}
+	writeData(w, status, cli.SchemaGoal, cli.NewGoalJSON(*goal))
+}
+
+func parseMonth(w http.ResponseWriter, value string) (time.Time, bool) {
+	month, err := time.ParseInLocation("2006-01", value, time.Local)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid month: "+value+" (use YYYY-MM)")
+		return time.Time{}, false
+	}
+	return month, true
+}
@@ -0,0 +1,238 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func (h *Handler) habitRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/habits", h.handleGetHabits)
+	mux.HandleFunc("POST /api/habits", h.handleCreateHabit)
+	mux.HandleFunc("GET /api/habits/{id}", h.handleGetHabit)
+	mux.HandleFunc("PATCH /api/habits/{id}", h.handleUpdateHabit)
+	mux.HandleFunc("DELETE /api/habits/{id}", h.handleDeleteHabit)
+	mux.HandleFunc("POST /api/habits/{id}/logs", h.handleLogHabit)
+	mux.HandleFunc("DELETE /api/habits/{id}/logs/last", h.handleUndoHabitLog)
+	mux.HandleFunc("DELETE /api/habit-logs/{id}", h.handleDeleteHabitLog)
+}
+
+func (h *Handler) handleGetHabits(w http.ResponseWriter, r *http.Request) {
+	days := 7
+	if value := r.URL.Query().Get("days"); value != "" {
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 1 {
+			writeError(w, http.StatusBadRequest, "invalid days: "+value)
+			return
+		}
+		days = n
+	}
+
+	getStatus := h.services.Habit.GetTrackerStatus
+	if r.URL.Query().Get("archived") == "true" {
+		getStatus = h.services.Habit.GetArchivedTrackerStatus
+	}
+	status, err := getStatus(r.Context(), time.Now(), days)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaTrackerStatus, cli.NewTrackerStatusJSON(status))
+}
+
+type createHabitRequest struct {
+	Name string `json:"name"`
+}
+
+func (h *Handler) handleCreateHabit(w http.ResponseWriter, r *http.Request) {
+	var req createHabitRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	id, err := h.services.Habit.CreateHabit(r.Context(), req.Name)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeHabit(w, r, id, http.StatusCreated)
+}
+
+func (h *Handler) handleGetHabit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	h.writeHabit(w, r, id, http.StatusOK)
+}
+
+type updateHabitRequest struct {
+	Name         *string `json:"name"`
+	GoalPerDay   *int    `json:"goal_per_day"`
+	GoalPerWeek  *int    `json:"goal_per_week"`
+	GoalPerMonth *int    `json:"goal_per_month"`
+	Schedule     *string `json:"schedule"`
+	Target       *string `json:"target"`
+	TargetAtMost bool    `json:"target_at_most"`
+	Limit        *int    `json:"limit"`
+	LimitPer     string  `json:"limit_per"`
+	ClearLimit   bool    `json:"clear_limit"`
+	Archived     *bool   `json:"archived"`
+}
+
+func (h *Handler) handleUpdateHabit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req updateHabitRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	ctx := r.Context()
+	habits := h.services.Habit
+	var err error
+	if req.Name != nil {
+		err = habits.RenameHabitByID(ctx, id, *req.Name)
+	}
+	if err == nil && req.GoalPerDay != nil {
+		err = habits.SetHabitGoalByID(ctx, id, *req.GoalPerDay)
+	}
+	if err == nil && req.GoalPerWeek != nil {
+		err = habits.SetHabitWeeklyGoalByID(ctx, id, *req.GoalPerWeek)
+	}
+	if err == nil && req.GoalPerMonth != nil {
+		err = habits.SetHabitMonthlyGoalByID(ctx, id, *req.GoalPerMonth)
+	}
+	if err == nil && req.Schedule != nil {
+		err = habits.SetHabitScheduleByID(ctx, id, *req.Schedule)
+	}
+	if err == nil && req.Target != nil {
+		err = habits.SetHabitTargetByID(ctx, id, *req.Target, req.TargetAtMost)
+	}
+	if err == nil && req.Limit != nil {
+		err = habits.SetHabitLimitByID(ctx, id, *req.Limit, domain.StreakPeriod(req.LimitPer))
+	}
+	if err == nil && req.ClearLimit {
+		err = habits.ClearHabitLimitByID(ctx, id)
+	}
+	if err == nil && req.Archived != nil {
+		if *req.Archived {
+			err = habits.ArchiveHabitByID(ctx, id)
+		} else {
+			err = habits.UnarchiveHabitByID(ctx, id)
+		}
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	h.writeHabit(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleDeleteHabit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.Habit.DeleteHabitByID(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type logHabitRequest struct {
+	Count int      `json:"count"`
+	Value *float64 `json:"value"`
+	Unit  string   `json:"unit"`
+	Date  string   `json:"date"`
+}
+
+func (h *Handler) handleLogHabit(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req logHabitRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+	if req.Count == 0 {
+		req.Count = 1
+	}
+
+	date := time.Now()
+	if req.Date != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", req.Date, time.Local)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid date: "+req.Date+" (use YYYY-MM-DD)")
+			return
+		}
+		date = parsed
+	}
+
+	var err error
+	if req.Value != nil {
+		err = h.services.Habit.LogHabitValueByIDForDate(r.Context(), id, *req.Value, req.Unit, date)
+	} else {
+		err = h.services.Habit.LogHabitByIDForDate(r.Context(), id, req.Count, date)
+	}
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeHabit(w, r, id, http.StatusCreated)
+}
+
+func (h *Handler) handleUndoHabitLog(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.Habit.UndoLastLogByID(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeHabit(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleDeleteHabitLog(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.Habit.DeleteLog(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// writeHabit responds with the habit's details over ?from=&to=, defaulting
+// to the last 30 days.
+func (h *Handler) writeHabit(w http.ResponseWriter, r *http.Request, id int64, status int) {
+	now := today()
+	from, ok := queryDate(w, r, "from", now.AddDate(0, 0, -30))
+	if !ok {
+		return
+	}
+	to, ok := queryDate(w, r, "to", now)
+	if !ok {
+		return
+	}
+
+	details, err := h.services.Habit.InspectHabitByID(r.Context(), id, from, to, now)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, status, cli.SchemaHabitDetails, cli.NewHabitDetailsJSON(details))
+}
@@ -3,11 +3,15 @@ package http
 import (
 	_ "embed"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/app"
 	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/service"
 )
@@ -15,47 +19,36 @@ import (
 //go:embed install.html
 var installPage []byte
 
-type Handler struct {
-	bujo *service.BujoService
-}
+//go:embed openapi.json
+var openAPISpec []byte
 
-func NewHandler(bujo *service.BujoService) *Handler {
-	return &Handler{bujo: bujo}
+type Handler struct {
+	services *app.Services
 }
 
-var allowedOrigins = map[string]bool{
-	"https://mail.google.com": true,
+func NewHandler(services *app.Services) *Handler {
+	return &Handler{services: services}
 }
 
 func (h *Handler) Routes() http.Handler {
 	mux := http.NewServeMux()
 	mux.HandleFunc("GET /api/health", h.handleHealth)
+	mux.HandleFunc("GET /api/openapi.json", h.handleOpenAPI)
 	mux.HandleFunc("POST /api/entries", h.handleCreateEntries)
-	mux.HandleFunc("GET /install", h.handleInstall)
-	return corsMiddleware(mux)
-}
-
-func (h *Handler) handleInstall(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "text/html; charset=utf-8")
-	_, _ = w.Write(installPage)
+	mux.HandleFunc("GET /install", h.handleInstallPage)
+	mux.HandleFunc("POST /install", h.handleInstall)
+	h.entryRoutes(mux)
+	h.habitRoutes(mux)
+	h.listRoutes(mux)
+	h.goalRoutes(mux)
+	h.dayRoutes(mux)
+	h.searchRoutes(mux)
+	return h.corsMiddleware(h.authMiddleware(mux))
 }
 
-func corsMiddleware(next http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		origin := r.Header.Get("Origin")
-		if allowedOrigins[origin] {
-			w.Header().Set("Access-Control-Allow-Origin", origin)
-			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, OPTIONS")
-			w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
-		}
-
-		if r.Method == "OPTIONS" {
-			w.WriteHeader(http.StatusNoContent)
-			return
-		}
-
-		next.ServeHTTP(w, r)
-	})
+func (h *Handler) handleOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(openAPISpec)
 }
 
 func (h *Handler) handleHealth(w http.ResponseWriter, r *http.Request) {
@@ -107,7 +100,7 @@ func (h *Handler) handleCreateEntries(w http.ResponseWriter, r *http.Request) {
 	}
 
 	today := time.Now()
-	ids, err := h.bujo.LogEntries(r.Context(), input, service.LogEntriesOptions{
+	ids, err := h.services.Bujo.LogEntries(r.Context(), input, service.LogEntriesOptions{
 		Date: today,
 	})
 	if err != nil {
@@ -211,11 +204,75 @@ func symbolForType(et domain.EntryType) string {
 	}
 }
 
+type errorResponse struct {
+	Success bool   `json:"success"`
+	Error   string `json:"error"`
+}
+
 func writeError(w http.ResponseWriter, status int, message string) {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(status)
-	_ = json.NewEncoder(w).Encode(createEntriesResponse{
+	_ = json.NewEncoder(w).Encode(errorResponse{
 		Success: false,
 		Error:   message,
 	})
 }
+
+// writeServiceError maps service errors onto HTTP statuses. Services wrap
+// domain.ErrNotFound for missing records and domain.ErrInvalid for rejected
+// requests; any other error is a failure on our side, so its details are
+// not passed on to the client.
+func writeServiceError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, domain.ErrNotFound):
+		writeError(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, domain.ErrInvalid):
+		writeError(w, http.StatusBadRequest, err.Error())
+	default:
+		writeError(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
+func writeData(w http.ResponseWriter, status int, schema string, data any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(cli.Envelope{Schema: schema, Data: data})
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v any) bool {
+	r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		writeError(w, http.StatusBadRequest, "invalid JSON")
+		return false
+	}
+	return true
+}
+
+func pathID(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	id, err := strconv.ParseInt(r.PathValue("id"), 10, 64)
+	if err != nil || id <= 0 {
+		writeError(w, http.StatusBadRequest, "invalid id: "+r.PathValue("id"))
+		return 0, false
+	}
+	return id, true
+}
+
+// queryDate parses an optional YYYY-MM-DD query parameter, falling back to
+// def when it is absent.
+func queryDate(w http.ResponseWriter, r *http.Request, name string, def time.Time) (time.Time, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return def, true
+	}
+	d, err := time.ParseInLocation("2006-01-02", value, time.Local)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid %s: %s (use YYYY-MM-DD)", name, value))
+		return time.Time{}, false
+	}
+	return d, true
+}
+
+func today() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+}
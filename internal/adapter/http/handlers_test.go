@@ -2,7 +2,10 @@ package http
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"io"
 	"net/http"
 	"net/http/httptest"
@@ -11,30 +14,37 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/app"
 	"github.com/typingincolor/bujo/internal/domain"
-	"github.com/typingincolor/bujo/internal/repository/sqlite"
-	"github.com/typingincolor/bujo/internal/service"
 )
 
-func setupTestServer(t *testing.T) *httptest.Server {
+func setupTestServices(t *testing.T) *app.Services {
 	t.Helper()
-	db, err := sqlite.OpenAndMigrate(":memory:")
+	services, cleanup, err := app.NewServiceFactory().Create(context.Background(), ":memory:", app.WithBackupDir(""))
 	require.NoError(t, err)
-	t.Cleanup(func() { _ = db.Close() })
-
-	entryRepo := sqlite.NewEntryRepository(db)
-	dayCtxRepo := sqlite.NewDayContextRepository(db)
-	tagRepo := sqlite.NewTagRepository(db)
-	mentionRepo := sqlite.NewMentionRepository(db)
-	parser := domain.NewTreeParser()
+	t.Cleanup(cleanup)
+	return services
+}
 
-	bujoService := service.NewBujoServiceWithLists(entryRepo, dayCtxRepo, parser, nil, nil, nil, tagRepo, mentionRepo)
+func setupTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server, _ := setupAPI(t)
+	return server
+}
 
-	handler := NewHandler(bujoService)
-	server := httptest.NewServer(handler.Routes())
-	t.Cleanup(server.Close)
+// withToken authenticates every request that doesn't carry its own
+// Authorization header, so tests can use plain http.Get and http.Post.
+func withToken(t *testing.T, services *app.Services, next http.Handler) http.Handler {
+	t.Helper()
+	secret, _, err := services.APIAuth.CreateToken(context.Background(), "test", []domain.APITokenScope{domain.APITokenScopeRead, domain.APITokenScopeWrite})
+	require.NoError(t, err)
 
-	return server
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			r.Header.Set("Authorization", "Bearer "+secret)
+		}
+		next.ServeHTTP(w, r)
+	})
 }
 
 func TestHealthEndpoint(t *testing.T) {
@@ -264,3 +274,20 @@ func TestInstallPage(t *testing.T) {
 	assert.Contains(t, string(body), "Gmail")
 	assert.Contains(t, string(body), "Bujo")
 }
+
+func TestWriteServiceError(t *testing.T) {
+	rec := httptest.NewRecorder()
+	writeServiceError(rec, fmt.Errorf("goal %w: %d", domain.ErrNotFound, 7))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Body.String(), "goal not found: 7")
+
+	rec = httptest.NewRecorder()
+	writeServiceError(rec, domain.Invalidf(`invalid query: tag "not found" is reserved`))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Body.String(), "is reserved")
+
+	rec = httptest.NewRecorder()
+	writeServiceError(rec, errors.New("database is locked"))
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "database is locked")
+}
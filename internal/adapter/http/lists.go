@@ -0,0 +1,219 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func (h *Handler) listRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/lists", h.handleGetLists)
+	mux.HandleFunc("POST /api/lists", h.handleCreateList)
+	mux.HandleFunc("GET /api/lists/{id}", h.handleGetList)
+	mux.HandleFunc("PATCH /api/lists/{id}", h.handleRenameList)
+	mux.HandleFunc("DELETE /api/lists/{id}", h.handleDeleteList)
+	mux.HandleFunc("POST /api/lists/{id}/items", h.handleAddListItem)
+	mux.HandleFunc("PATCH /api/list-items/{id}", h.handleEditListItem)
+	mux.HandleFunc("DELETE /api/list-items/{id}", h.handleRemoveListItem)
+	mux.HandleFunc("POST /api/list-items/{id}/done", h.listItemAction(h.services.List.MarkDone))
+	mux.HandleFunc("POST /api/list-items/{id}/undone", h.listItemAction(h.services.List.MarkUndone))
+	mux.HandleFunc("POST /api/list-items/{id}/cancel", h.listItemAction(h.services.List.Cancel))
+	mux.HandleFunc("POST /api/list-items/{id}/uncancel", h.listItemAction(h.services.List.Uncancel))
+	mux.HandleFunc("POST /api/list-items/{id}/move", h.handleMoveListItem)
+}
+
+func (h *Handler) handleGetLists(w http.ResponseWriter, r *http.Request) {
+	lists, err := h.services.List.GetAllLists(r.Context())
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+
+	summaries := make([]cli.ListSummaryJSON, 0, len(lists))
+	for _, list := range lists {
+		summary, err := h.services.List.GetListSummary(r.Context(), list.ID)
+		if err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		summaries = append(summaries, cli.NewListSummaryJSON(summary))
+	}
+	writeData(w, http.StatusOK, cli.SchemaListSummary, summaries)
+}
+
+type listRequest struct {
+	Name string `json:"name"`
+}
+
+func (h *Handler) handleCreateList(w http.ResponseWriter, r *http.Request) {
+	var req listRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	list, err := h.services.List.CreateList(r.Context(), req.Name)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeList(w, r, list.ID, http.StatusCreated)
+}
+
+func (h *Handler) handleGetList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	h.writeList(w, r, id, http.StatusOK)
+}
+
+func (h *Handler) handleRenameList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req listRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := h.services.List.RenameList(r.Context(), id, req.Name); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeList(w, r, id, http.StatusOK)
+}
+
+// handleDeleteList refuses to delete a list that still has items unless
+// ?force=true is given.
+func (h *Handler) handleDeleteList(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	force := r.URL.Query().Get("force") == "true"
+	if err := h.services.List.DeleteList(r.Context(), id, force); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+type listItemRequest struct {
+	Type    string `json:"type"`
+	Content string `json:"content"`
+}
+
+func (h *Handler) handleAddListItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req listItemRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	entryType := domain.EntryTypeTask
+	if req.Type != "" {
+		parsed, err := domain.ParseEntryTypeFromString(req.Type)
+		if err != nil {
+			writeError(w, http.StatusBadRequest, "invalid entry type: "+req.Type)
+			return
+		}
+		entryType = parsed
+	}
+
+	if _, err := h.services.List.AddItem(r.Context(), id, entryType, req.Content); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	h.writeList(w, r, id, http.StatusCreated)
+}
+
+func (h *Handler) handleEditListItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req listItemRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := h.services.List.EditItem(r.Context(), id, req.Content); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) handleRemoveListItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+	if err := h.services.List.RemoveItem(r.Context(), id); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// listItemAction wraps a list item state change. Items are only exposed as
+// part of their list, so clients re-read the list to see the change.
+func (h *Handler) listItemAction(action func(ctx context.Context, id int64) error) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		id, ok := pathID(w, r)
+		if !ok {
+			return
+		}
+		if err := action(r.Context(), id); err != nil {
+			writeServiceError(w, err)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+type moveListItemRequest struct {
+	ListID int64 `json:"list_id"`
+}
+
+func (h *Handler) handleMoveListItem(w http.ResponseWriter, r *http.Request) {
+	id, ok := pathID(w, r)
+	if !ok {
+		return
+	}
+
+	var req moveListItemRequest
+	if !decodeBody(w, r, &req) {
+		return
+	}
+
+	if err := h.services.List.MoveItem(r.Context(), id, req.ListID); err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) writeList(w http.ResponseWriter, r *http.Request, id int64, status int) {
+	summary, err := h.services.List.GetListSummary(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	items, err := h.services.List.GetListItems(r.Context(), id)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, status, cli.SchemaList, cli.NewListJSON(summary, items))
+}
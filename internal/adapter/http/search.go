@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/typingincolor/bujo/internal/adapter/cli"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func (h *Handler) searchRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("GET /api/search", h.handleSearch)
+	mux.HandleFunc("GET /api/stats", h.handleStats)
+}
+
+// handleSearch accepts the same query syntax as `bujo search`.
+func (h *Handler) handleSearch(w http.ResponseWriter, r *http.Request) {
+	query := r.URL.Query().Get("q")
+	if query == "" {
+		writeError(w, http.StatusBadRequest, "q is required")
+		return
+	}
+
+	opts := domain.NewSearchOptions(query).WithLimit(50)
+	if value := r.URL.Query().Get("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit < 1 {
+			writeError(w, http.StatusBadRequest, "invalid limit: "+value)
+			return
+		}
+		opts = opts.WithLimit(limit)
+	}
+
+	results, err := h.services.Search.Search(r.Context(), opts)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaSearchResult, cli.NewSearchResultsJSON(results))
+}
+
+// handleStats reports on ?from=&to=, defaulting to the last 30 days.
+func (h *Handler) handleStats(w http.ResponseWriter, r *http.Request) {
+	now := today()
+	from, ok := queryDate(w, r, "from", now.AddDate(0, 0, -29))
+	if !ok {
+		return
+	}
+	to, ok := queryDate(w, r, "to", now)
+	if !ok {
+		return
+	}
+	if to.Before(from) {
+		writeError(w, http.StatusBadRequest, "to must not be before from")
+		return
+	}
+
+	stats, err := h.services.Stats.GetStats(r.Context(), from, to)
+	if err != nil {
+		writeServiceError(w, err)
+		return
+	}
+	writeData(w, http.StatusOK, cli.SchemaStats, cli.NewStatsJSON(stats))
+}
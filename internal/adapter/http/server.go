@@ -9,7 +9,7 @@ import (
 	"os"
 	"time"
 
-	"github.com/typingincolor/bujo/internal/service"
+	"github.com/typingincolor/bujo/internal/app"
 )
 
 const (
@@ -23,8 +23,8 @@ type Server struct {
 	port       int
 }
 
-func NewServer(bujo *service.BujoService, port int) *Server {
-	handler := NewHandler(bujo)
+func NewServer(services *app.Services, port int) *Server {
+	handler := NewHandler(services)
 
 	return &Server{
 		httpServer: &http.Server{
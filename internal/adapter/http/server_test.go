@@ -7,24 +7,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"github.com/typingincolor/bujo/internal/domain"
-	"github.com/typingincolor/bujo/internal/repository/sqlite"
-	"github.com/typingincolor/bujo/internal/service"
 )
 
 func TestServerStartStop(t *testing.T) {
-	db, err := sqlite.OpenAndMigrate(":memory:")
-	require.NoError(t, err)
-	t.Cleanup(func() { _ = db.Close() })
-
-	entryRepo := sqlite.NewEntryRepository(db)
-	dayCtxRepo := sqlite.NewDayContextRepository(db)
-	tagRepo := sqlite.NewTagRepository(db)
-	mentionRepo := sqlite.NewMentionRepository(db)
-	parser := domain.NewTreeParser()
-	bujoService := service.NewBujoServiceWithLists(entryRepo, dayCtxRepo, parser, nil, nil, nil, tagRepo, mentionRepo)
-
-	srv := NewServer(bujoService, EphemeralPort)
+	srv := NewServer(setupTestServices(t), EphemeralPort)
 	addr, err := srv.Start()
 	require.NoError(t, err)
 	require.NotEmpty(t, addr)
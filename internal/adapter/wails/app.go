@@ -23,6 +23,7 @@ import (
 type ListWithItems struct {
 	ID    int64
 	Name  string
+	Query string
 	Items []domain.ListItem
 }
 
@@ -55,7 +56,7 @@ func (a *App) Startup(ctx context.Context) {
 		go a.pollForChanges()
 	}
 
-	a.httpServer = bujohttp.NewServer(a.services.Bujo, 0)
+	a.httpServer = bujohttp.NewServer(a.services, 0)
 	if _, err := a.httpServer.Start(); err != nil {
 		fmt.Fprintf(os.Stderr, "warning: failed to start HTTP API: %v\n", err)
 	}
@@ -112,6 +113,11 @@ func (a *App) GetOverdue() ([]domain.Entry, error) {
 	return a.services.Bujo.GetOverdue(a.ctx)
 }
 
+func (a *App) GetOverdueListItems() ([]service.OverdueListItem, error) {
+	now := time.Now()
+	return a.services.List.GetOverdueItems(a.ctx, time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()))
+}
+
 func (a *App) GetHabits(days int) (*service.TrackerStatus, error) {
 	return a.services.Habit.GetTrackerStatus(a.ctx, time.Now(), days)
 }
@@ -131,6 +137,7 @@ func (a *App) GetLists() ([]ListWithItems, error) {
 		result = append(result, ListWithItems{
 			ID:    list.ID,
 			Name:  list.Name,
+			Query: list.Query,
 			Items: items,
 		})
 	}
@@ -209,9 +216,9 @@ func (a *App) UncancelGoal(goalID int64) error {
 	return a.services.Goal.UncancelGoal(a.ctx, goalID)
 }
 
-func (a *App) Search(query string) ([]domain.Entry, error) {
+func (a *App) Search(query string) ([]domain.SearchResult, error) {
 	opts := domain.NewSearchOptions(query)
-	return a.services.Bujo.SearchEntries(a.ctx, opts)
+	return a.services.Search.Search(a.ctx, opts)
 }
 
 func (a *App) SearchByTags(tags []string) ([]domain.Entry, error) {
@@ -268,6 +275,10 @@ func (a *App) LogHabitForDate(habitID int64, count int, date time.Time) error {
 	return a.services.Habit.LogHabitByIDForDate(a.ctx, habitID, count, date)
 }
 
+func (a *App) LogHabitValueForDate(habitID int64, value float64, unit string, date time.Time) error {
+	return a.services.Habit.LogHabitValueByIDForDate(a.ctx, habitID, value, unit, date)
+}
+
 func (a *App) UndoHabitLog(habitID int64) error {
 	return a.services.Habit.UndoLastLogByID(a.ctx, habitID)
 }
@@ -288,6 +299,30 @@ func (a *App) SetHabitMonthlyGoal(habitID int64, monthlyGoal int) error {
 	return a.services.Habit.SetHabitMonthlyGoalByID(a.ctx, habitID, monthlyGoal)
 }
 
+func (a *App) SetHabitSchedule(habitID int64, schedule string) error {
+	return a.services.Habit.SetHabitScheduleByID(a.ctx, habitID, schedule)
+}
+
+func (a *App) SetHabitTarget(habitID int64, target string, atMost bool) error {
+	return a.services.Habit.SetHabitTargetByID(a.ctx, habitID, target, atMost)
+}
+
+func (a *App) SetHabitLimit(habitID int64, limit int, period string) error {
+	return a.services.Habit.SetHabitLimitByID(a.ctx, habitID, limit, domain.StreakPeriod(period))
+}
+
+func (a *App) ClearHabitLimit(habitID int64) error {
+	return a.services.Habit.ClearHabitLimitByID(a.ctx, habitID)
+}
+
+func (a *App) ArchiveHabit(habitID int64) error {
+	return a.services.Habit.ArchiveHabitByID(a.ctx, habitID)
+}
+
+func (a *App) UnarchiveHabit(habitID int64) error {
+	return a.services.Habit.UnarchiveHabitByID(a.ctx, habitID)
+}
+
 func (a *App) AnswerQuestion(questionID int64, answerText string) error {
 	return a.services.Bujo.MarkAnswered(a.ctx, questionID, answerText)
 }
@@ -305,6 +340,18 @@ func (a *App) CreateList(name string) (int64, error) {
 	return list.ID, nil
 }
 
+func (a *App) CreateSmartList(name string, query string) (int64, error) {
+	list, err := a.services.List.CreateSmartList(a.ctx, name, query)
+	if err != nil {
+		return 0, err
+	}
+	return list.ID, nil
+}
+
+func (a *App) SetSmartListQuery(listID int64, query string) error {
+	return a.services.List.SetSmartListQuery(a.ctx, listID, query)
+}
+
 func (a *App) DeleteList(listID int64, force bool) error {
 	return a.services.List.DeleteList(a.ctx, listID, force)
 }
@@ -329,6 +376,10 @@ func (a *App) MoveListItem(itemID int64, targetListID int64) error {
 	return a.services.List.MoveItem(a.ctx, itemID, targetListID)
 }
 
+func (a *App) ScheduleListItem(itemID int64, date time.Time) (int64, error) {
+	return a.services.List.ScheduleItem(a.ctx, itemID, date)
+}
+
 func (a *App) SetMood(date time.Time, mood string) error {
 	return a.services.Bujo.SetMood(a.ctx, date, mood)
 }
@@ -399,6 +450,18 @@ func (a *App) GetLocationHistory() ([]string, error) {
 	return locations, nil
 }
 
+func (a *App) Undo() (*domain.Operation, error) {
+	return a.services.Journal.Undo(a.ctx)
+}
+
+func (a *App) Redo() (*domain.Operation, error) {
+	return a.services.Journal.Redo(a.ctx)
+}
+
+func (a *App) GetOperationHistory(limit int) ([]domain.Operation, error) {
+	return a.services.Journal.History(a.ctx, limit)
+}
+
 func (a *App) GetVersion() string {
 	return cmd.Version()
 }
@@ -451,11 +514,43 @@ type ValidationError struct {
 type ValidationResult struct {
 	IsValid bool              `json:"isValid"`
 	Errors  []ValidationError `json:"errors"`
+	Preview *ApplyResult      `json:"preview,omitempty"`
+}
+
+type DocumentChange struct {
+	Kind       string `json:"kind"`
+	EntryID    int64  `json:"entryId"`
+	LineNumber int    `json:"lineNumber"`
+	Before     string `json:"before"`
+	After      string `json:"after"`
 }
 
 type ApplyResult struct {
-	Inserted int `json:"inserted"`
-	Deleted  int `json:"deleted"`
+	Inserted   int              `json:"inserted"`
+	Updated    int              `json:"updated"`
+	Reparented int              `json:"reparented"`
+	Deleted    int              `json:"deleted"`
+	Changes    []DocumentChange `json:"changes"`
+}
+
+func newApplyResult(result *service.ApplyChangesResult) *ApplyResult {
+	changes := make([]DocumentChange, len(result.Changes))
+	for i, change := range result.Changes {
+		changes[i] = DocumentChange{
+			Kind:       string(change.Kind),
+			EntryID:    change.EntryID,
+			LineNumber: change.LineNumber,
+			Before:     change.Before,
+			After:      change.After,
+		}
+	}
+	return &ApplyResult{
+		Inserted:   result.Inserted,
+		Updated:    result.Updated,
+		Reparented: result.Reparented,
+		Deleted:    result.Deleted,
+		Changes:    changes,
+	}
 }
 
 type ResolvedDate struct {
@@ -467,7 +562,9 @@ func (a *App) GetEditableDocument(date time.Time) (string, error) {
 	return a.services.EditableView.GetEditableDocument(a.ctx, date)
 }
 
-func (a *App) ValidateEditableDocument(doc string) ValidationResult {
+// ValidateEditableDocument checks doc and, when it is valid, previews the
+// changes saving it over date would make.
+func (a *App) ValidateEditableDocument(doc string, date time.Time) ValidationResult {
 	result := a.services.EditableView.ValidateDocument(doc)
 	errors := make([]ValidationError, len(result.Errors))
 	for i, err := range result.Errors {
@@ -476,10 +573,17 @@ func (a *App) ValidateEditableDocument(doc string) ValidationResult {
 			Message:    err.Message,
 		}
 	}
-	return ValidationResult{
+
+	validation := ValidationResult{
 		IsValid: result.IsValid,
 		Errors:  errors,
 	}
+	if result.IsValid {
+		if preview, err := a.services.EditableView.PreviewChanges(a.ctx, doc, date); err == nil {
+			validation.Preview = newApplyResult(preview)
+		}
+	}
+	return validation
 }
 
 func (a *App) ApplyEditableDocument(doc string, date time.Time) (*ApplyResult, error) {
@@ -488,10 +592,7 @@ func (a *App) ApplyEditableDocument(doc string, date time.Time) (*ApplyResult, e
 		return nil, err
 	}
 
-	return &ApplyResult{
-		Inserted: result.Inserted,
-		Deleted:  result.Deleted,
-	}, nil
+	return newApplyResult(result), nil
 }
 
 func (a *App) ApplyEditableDocumentWithActions(doc string, date time.Time, migrateDate *time.Time, listID *int64) (*ApplyResult, error) {
@@ -504,10 +605,7 @@ func (a *App) ApplyEditableDocumentWithActions(doc string, date time.Time, migra
 		return nil, err
 	}
 
-	return &ApplyResult{
-		Inserted: result.Inserted,
-		Deleted:  result.Deleted,
-	}, nil
+	return newApplyResult(result), nil
 }
 
 func (a *App) IsInsightsAvailable() bool {
@@ -63,7 +63,7 @@ func TestApp_ValidateEditableDocument_ValidDocument(t *testing.T) {
 	wailsApp := NewApp(services)
 	wailsApp.Startup(ctx)
 
-	result := wailsApp.ValidateEditableDocument(". Valid task\n- Valid note")
+	result := wailsApp.ValidateEditableDocument(". Valid task\n- Valid note", time.Now())
 
 	assert.True(t, result.IsValid)
 	assert.Empty(t, result.Errors)
@@ -80,7 +80,7 @@ func TestApp_ValidateEditableDocument_InvalidDocument(t *testing.T) {
 	wailsApp := NewApp(services)
 	wailsApp.Startup(ctx)
 
-	result := wailsApp.ValidateEditableDocument("Invalid line without symbol")
+	result := wailsApp.ValidateEditableDocument("Invalid line without symbol", time.Now())
 
 	assert.False(t, result.IsValid)
 	assert.NotEmpty(t, result.Errors)
@@ -97,13 +97,41 @@ func TestApp_ValidateEditableDocument_OrphanChild(t *testing.T) {
 	wailsApp := NewApp(services)
 	wailsApp.Startup(ctx)
 
-	result := wailsApp.ValidateEditableDocument("  . Orphan child without parent")
+	result := wailsApp.ValidateEditableDocument("  . Orphan child without parent", time.Now())
 
 	assert.False(t, result.IsValid)
 	assert.NotEmpty(t, result.Errors)
 	assert.Contains(t, result.Errors[0].Message, "Orphan")
 }
 
+func TestApp_ValidateEditableDocument_PreviewsChangesWithoutSaving(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	today := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	_, err = services.Bujo.LogEntries(ctx, ". Write report\n. Call dentist", service.LogEntriesOptions{Date: today})
+	require.NoError(t, err)
+
+	result := wailsApp.ValidateEditableDocument(". Write the report\n- Pick up parcel", today)
+
+	require.True(t, result.IsValid)
+	require.NotNil(t, result.Preview)
+	assert.Equal(t, 1, result.Preview.Updated)
+	assert.Equal(t, 1, result.Preview.Inserted)
+	assert.Equal(t, 1, result.Preview.Deleted)
+
+	doc, err := wailsApp.GetEditableDocument(today)
+	require.NoError(t, err)
+	assert.Equal(t, ". Write report\n. Call dentist", doc)
+}
+
 func TestApp_ApplyEditableDocument_InsertsNewEntries(t *testing.T) {
 	ctx := context.Background()
 
@@ -146,8 +174,8 @@ func TestApp_ApplyEditableDocument_DeletesEntries(t *testing.T) {
 	result, err := wailsApp.ApplyEditableDocument(". Task to keep", today)
 
 	require.NoError(t, err)
-	assert.Equal(t, 1, result.Inserted)
-	assert.Equal(t, 2, result.Deleted)
+	assert.Equal(t, 0, result.Inserted)
+	assert.Equal(t, 1, result.Deleted)
 
 	doc, err := wailsApp.GetEditableDocument(today)
 	require.NoError(t, err)
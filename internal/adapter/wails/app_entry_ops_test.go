@@ -372,3 +372,39 @@ func TestApp_GetLocationHistory_ReturnsUniqueLocations(t *testing.T) {
 	assert.Contains(t, locations, "Manchester Office")
 	assert.Contains(t, locations, "Home")
 }
+
+func TestApp_UndoAndRedo_RevertDeleteEntry(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	today := time.Now().Truncate(24 * time.Hour)
+	ids, err := wailsApp.AddEntry(". Parent\n  - Child", today)
+	require.NoError(t, err)
+	require.NoError(t, wailsApp.DeleteEntry(ids[0]))
+
+	op, err := wailsApp.Undo()
+	require.NoError(t, err)
+	assert.Equal(t, "Delete entry 1", op.Description)
+
+	child, err := wailsApp.GetEntry(ids[1])
+	require.NoError(t, err)
+	require.NotNil(t, child)
+	assert.Equal(t, ids[0], *child.ParentID)
+
+	history, err := wailsApp.GetOperationHistory(10)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.True(t, history[0].IsUndone())
+
+	_, err = wailsApp.Redo()
+	require.NoError(t, err)
+	_, err = wailsApp.GetEntry(ids[0])
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/typingincolor/bujo/internal/app"
+	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/service"
 )
 
@@ -235,4 +236,123 @@ func TestApp_Search_ReturnsMatchingEntries(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, results, 1)
 	assert.Equal(t, "Buy groceries", results[0].Content)
+	assert.Equal(t, "Buy "+domain.SnippetMarkStart+"groceries"+domain.SnippetMarkEnd, results[0].Snippet)
+}
+
+func TestApp_ScheduleListItem_MovesItemOntoDay(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	list, err := services.List.CreateList(ctx, "Backlog")
+	require.NoError(t, err)
+
+	itemID, err := wailsApp.AddListItem(list.ID, "Write release notes")
+	require.NoError(t, err)
+
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	entryID, err := wailsApp.ScheduleListItem(itemID, date)
+	require.NoError(t, err)
+	assert.Greater(t, entryID, int64(0))
+
+	lists, err := wailsApp.GetLists()
+	require.NoError(t, err)
+	require.Len(t, lists[0].Items, 1)
+	assert.Equal(t, "scheduled", string(lists[0].Items[0].Type))
+
+	days, err := wailsApp.GetDayEntries(date, date)
+	require.NoError(t, err)
+	require.Len(t, days, 1)
+	require.Len(t, days[0].Entries, 1)
+	assert.Equal(t, "Write release notes", days[0].Entries[0].Content)
+}
+
+func TestApp_GetLists_IncludesSmartLists(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	date := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	ids, err := wailsApp.AddEntry(". Chase invoice #waiting\n. Buy milk", date)
+	require.NoError(t, err)
+
+	_, err = wailsApp.CreateSmartList("Waiting", "#waiting status:open")
+	require.NoError(t, err)
+
+	lists, err := wailsApp.GetLists()
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, "#waiting status:open", lists[0].Query)
+	require.Len(t, lists[0].Items, 1)
+	assert.Equal(t, ids[0], lists[0].Items[0].EntryID)
+	assert.Zero(t, lists[0].Items[0].RowID)
+
+	require.NoError(t, wailsApp.MarkEntryDone(ids[0]))
+
+	lists, err = wailsApp.GetLists()
+	require.NoError(t, err)
+	assert.Empty(t, lists[0].Items)
+}
+
+func TestApp_GetLists_IncludesDueDates(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	list, err := services.List.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	due := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+	_, err = services.List.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", service.ListItemOptions{DueDate: &due})
+	require.NoError(t, err)
+
+	lists, err := wailsApp.GetLists()
+	require.NoError(t, err)
+	require.Len(t, lists[0].Items, 1)
+	require.NotNil(t, lists[0].Items[0].DueDate)
+	assert.Equal(t, "2026-10-23", lists[0].Items[0].DueDate.Format("2006-01-02"))
+}
+
+func TestApp_GetOverdueListItems(t *testing.T) {
+	ctx := context.Background()
+
+	factory := app.NewServiceFactory()
+	services, cleanup, err := factory.Create(ctx, ":memory:")
+	require.NoError(t, err)
+	defer cleanup()
+
+	wailsApp := NewApp(services)
+	wailsApp.Startup(ctx)
+
+	list, err := services.List.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	nextWeek := time.Now().AddDate(0, 0, 7)
+	_, err = services.List.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", service.ListItemOptions{DueDate: &lastWeek})
+	require.NoError(t, err)
+	_, err = services.List.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Book dentist", service.ListItemOptions{DueDate: &nextWeek})
+	require.NoError(t, err)
+
+	items, err := wailsApp.GetOverdueListItems()
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, "Renew passport", items[0].Content)
+	assert.Equal(t, "Errands", items[0].ListName)
 }
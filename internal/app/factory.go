@@ -18,9 +18,14 @@ type Services struct {
 	Habit           *service.HabitService
 	List            *service.ListService
 	Goal            *service.GoalService
+	Recurrence      *service.RecurrenceService
+	Search          *service.SearchService
 	Stats           *service.StatsService
+	APIAuth         *service.APIAuthService
 	ChangeDetection *service.ChangeDetectionService
 	EditableView    *service.EditableViewService
+	Journal         *service.OperationJournal
+	Transactor      domain.Transactor
 	Backup          *service.BackupService
 	InsightsRepo    *sqlite.InsightsRepository
 }
@@ -87,9 +92,11 @@ func (f *ServiceFactory) createServices(db *sql.DB, insightsDB *sql.DB) *Service
 	dayCtxRepo := sqlite.NewDayContextRepository(db)
 	habitRepo := sqlite.NewHabitRepository(db)
 	habitLogRepo := sqlite.NewHabitLogRepository(db)
+	habitPauseRepo := sqlite.NewHabitPauseRepository(db)
 	listRepo := sqlite.NewListRepository(db)
 	listItemRepo := sqlite.NewListItemRepository(db)
 	goalRepo := sqlite.NewGoalRepository(db)
+	recurrenceRepo := sqlite.NewRecurrenceRepository(db)
 	entryToListMover := sqlite.NewEntryToListMover(db)
 	parser := domain.NewTreeParser()
 
@@ -98,6 +105,7 @@ func (f *ServiceFactory) createServices(db *sql.DB, insightsDB *sql.DB) *Service
 		dayCtxRepo,
 		habitRepo,
 		habitLogRepo,
+		habitPauseRepo,
 		listRepo,
 		listItemRepo,
 		goalRepo,
@@ -106,18 +114,31 @@ func (f *ServiceFactory) createServices(db *sql.DB, insightsDB *sql.DB) *Service
 	tagRepo := sqlite.NewTagRepository(db)
 	mentionRepo := sqlite.NewMentionRepository(db)
 	backupRepo := sqlite.NewBackupRepository(db)
+	goalLinkRepo := sqlite.NewGoalLinkRepository(db)
+	keyResultRepo := sqlite.NewKeyResultRepository(db)
 
-	bujoService := service.NewBujoServiceWithLists(entryRepo, dayCtxRepo, parser, listRepo, listItemRepo, entryToListMover, tagRepo, mentionRepo)
+	journal := service.NewOperationJournal(sqlite.NewOperationLogRepository(db))
+	transactor := sqlite.NewTransactor(db)
+
+	bujoService := service.NewBujoServiceWithLists(entryRepo, dayCtxRepo, parser, listRepo, listItemRepo, entryToListMover, tagRepo, mentionRepo).
+		WithRecurrences(recurrenceRepo).
+		WithGoalLinks(goalLinkRepo).
+		WithOperationJournal(journal)
 
 	return &Services{
 		DB:              db,
 		Bujo:            bujoService,
-		Habit:           service.NewHabitService(habitRepo, habitLogRepo),
-		List:            service.NewListService(listRepo, listItemRepo),
-		Goal:            service.NewGoalService(goalRepo),
-		Stats:           service.NewStatsService(entryRepo, habitRepo, habitLogRepo),
+		Habit:           service.NewHabitService(habitRepo, habitLogRepo).WithPauseRepository(habitPauseRepo).WithOperationJournal(journal),
+		List:            service.NewListService(listRepo, listItemRepo).WithTemplates(sqlite.NewListTemplateRepository(db)).WithEntryMover(sqlite.NewListItemToEntryMover(db)).WithSmartLists(entryRepo).WithOperationJournal(journal),
+		Goal:            service.NewGoalService(goalRepo).WithEntryLinks(goalLinkRepo, entryRepo).WithKeyResults(keyResultRepo).WithOperationJournal(journal),
+		Recurrence:      service.NewRecurrenceService(recurrenceRepo, entryRepo),
+		Search:          service.NewSearchService(sqlite.NewSearchRepository(db), tagRepo, mentionRepo),
+		Stats:           service.NewStatsService(entryRepo, habitRepo, habitLogRepo).WithPauseRepository(habitPauseRepo).WithGoals(goalRepo, keyResultRepo),
+		APIAuth:         service.NewAPIAuthService(sqlite.NewAPITokenRepository(db), sqlite.NewAPIOriginRepository(db)),
 		ChangeDetection: service.NewChangeDetectionService(changeDetectors),
-		EditableView:    service.NewEditableViewService(entryRepo, entryToListMover, listRepo, tagRepo, mentionRepo),
+		EditableView:    service.NewEditableViewService(entryRepo, entryToListMover, listRepo, tagRepo, mentionRepo).WithTransactor(transactor).WithOperationJournal(journal),
+		Journal:         journal,
+		Transactor:      transactor,
 		Backup:          service.NewBackupService(backupRepo),
 		InsightsRepo:    sqlite.NewInsightsRepository(insightsDB),
 	}
@@ -44,3 +44,27 @@ func ParseFuture(s string) (time.Time, error) {
 
 	return parsed, nil
 }
+
+// ParseMonth parses a month as 2026-03, "March 2026" or "march". A month
+// name without a year means its next occurrence, counting the current month.
+func ParseMonth(s string) (time.Time, error) {
+	now := time.Now()
+
+	for _, layout := range []string{"2006-01", "January 2006", "Jan 2006"} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			return parsed, nil
+		}
+	}
+
+	for _, layout := range []string{"January", "Jan"} {
+		if parsed, err := time.Parse(layout, s); err == nil {
+			year := now.Year()
+			if parsed.Month() < now.Month() {
+				year++
+			}
+			return time.Date(year, parsed.Month(), 1, 0, 0, 0, 0, time.UTC), nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("invalid month: %s", s)
+}
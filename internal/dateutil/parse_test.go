@@ -212,3 +212,60 @@ func TestParseFuture_Today(t *testing.T) {
 		t.Errorf("ParseFuture() = %v, want today %v", got, now)
 	}
 }
+
+func TestParseMonth(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		wantYear int
+		wantMon  time.Month
+	}{
+		{name: "ISO month", input: "2026-03", wantYear: 2026, wantMon: time.March},
+		{name: "month name with year", input: "March 2026", wantYear: 2026, wantMon: time.March},
+		{name: "short month name with year", input: "mar 2027", wantYear: 2027, wantMon: time.March},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMonth(tt.input)
+			if err != nil {
+				t.Fatalf("ParseMonth() error = %v", err)
+			}
+			if got.Year() != tt.wantYear || got.Month() != tt.wantMon || got.Day() != 1 {
+				t.Errorf("ParseMonth() = %v, want %d-%02d-01", got, tt.wantYear, tt.wantMon)
+			}
+		})
+	}
+}
+
+func TestParseMonth_NameWithoutYearIsNextOccurrence(t *testing.T) {
+	now := time.Now()
+
+	got, err := ParseMonth(now.Format("January"))
+	if err != nil {
+		t.Fatalf("ParseMonth() error = %v", err)
+	}
+	if got.Year() != now.Year() || got.Month() != now.Month() {
+		t.Errorf("ParseMonth() = %v, want the current month", got)
+	}
+
+	previous := now.AddDate(0, -1, 0)
+	if previous.Month() == time.December {
+		return
+	}
+	got, err = ParseMonth(previous.Format("January"))
+	if err != nil {
+		t.Fatalf("ParseMonth() error = %v", err)
+	}
+	if got.Year() != now.Year()+1 || got.Month() != previous.Month() {
+		t.Errorf("ParseMonth() = %v, want %s next year", got, previous.Month())
+	}
+}
+
+func TestParseMonth_Invalid(t *testing.T) {
+	for _, input := range []string{"", "someday", "2026-13", "next friday"} {
+		if _, err := ParseMonth(input); err == nil {
+			t.Errorf("ParseMonth() expected error for input %q", input)
+		}
+	}
+}
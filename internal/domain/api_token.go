@@ -0,0 +1,90 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+type APITokenScope string
+
+const (
+	APITokenScopeRead    APITokenScope = "read"
+	APITokenScopeWrite   APITokenScope = "write"
+	APITokenScopeCapture APITokenScope = "capture"
+)
+
+func ParseAPITokenScope(s string) (APITokenScope, error) {
+	switch scope := APITokenScope(strings.ToLower(strings.TrimSpace(s))); scope {
+	case APITokenScopeRead, APITokenScopeWrite, APITokenScopeCapture:
+		return scope, nil
+	}
+	return "", Invalidf("invalid scope: %s (valid scopes: read, write, capture)", s)
+}
+
+// ParseAPITokenScopes parses a comma-separated scope list such as
+// "read,write".
+func ParseAPITokenScopes(s string) ([]APITokenScope, error) {
+	var scopes []APITokenScope
+	for _, part := range strings.Split(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		scope, err := ParseAPITokenScope(part)
+		if err != nil {
+			return nil, err
+		}
+		scopes = append(scopes, scope)
+	}
+	if len(scopes) == 0 {
+		return nil, Invalidf("at least one scope is required")
+	}
+	return scopes, nil
+}
+
+// APIToken describes a credential for the local HTTP API. The token itself
+// is only known when it is created; afterwards only its hash is stored.
+type APIToken struct {
+	ID         int64
+	Name       string
+	Prefix     string
+	Scopes     []APITokenScope
+	CreatedAt  time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+func (t APIToken) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return Invalidf("token name is required")
+	}
+	if len(t.Scopes) == 0 {
+		return Invalidf("at least one scope is required")
+	}
+	return nil
+}
+
+func (t APIToken) IsRevoked() bool {
+	return t.RevokedAt != nil
+}
+
+// Allows reports whether the token grants the required scope. The write
+// scope covers capture, since capturing is a narrower form of writing.
+func (t APIToken) Allows(required APITokenScope) bool {
+	for _, scope := range t.Scopes {
+		if scope == required {
+			return true
+		}
+		if scope == APITokenScopeWrite && required == APITokenScopeCapture {
+			return true
+		}
+	}
+	return false
+}
+
+func (t APIToken) ScopeString() string {
+	parts := make([]string, len(t.Scopes))
+	for i, scope := range t.Scopes {
+		parts[i] = string(scope)
+	}
+	return strings.Join(parts, ",")
+}
@@ -0,0 +1,51 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAPITokenScopes(t *testing.T) {
+	scopes, err := ParseAPITokenScopes("read, Write")
+	require.NoError(t, err)
+	assert.Equal(t, []APITokenScope{APITokenScopeRead, APITokenScopeWrite}, scopes)
+
+	_, err = ParseAPITokenScopes("admin")
+	assert.Error(t, err)
+
+	_, err = ParseAPITokenScopes(" , ")
+	assert.Error(t, err)
+}
+
+func TestAPIToken_Allows(t *testing.T) {
+	tests := []struct {
+		name     string
+		scopes   []APITokenScope
+		required APITokenScope
+		want     bool
+	}{
+		{"read allows read", []APITokenScope{APITokenScopeRead}, APITokenScopeRead, true},
+		{"read denies write", []APITokenScope{APITokenScopeRead}, APITokenScopeWrite, false},
+		{"read denies capture", []APITokenScope{APITokenScopeRead}, APITokenScopeCapture, false},
+		{"write allows capture", []APITokenScope{APITokenScopeWrite}, APITokenScopeCapture, true},
+		{"write denies read", []APITokenScope{APITokenScopeWrite}, APITokenScopeRead, false},
+		{"capture denies read", []APITokenScope{APITokenScopeCapture}, APITokenScopeRead, false},
+		{"capture denies write", []APITokenScope{APITokenScopeCapture}, APITokenScopeWrite, false},
+		{"combined scopes", []APITokenScope{APITokenScopeRead, APITokenScopeWrite}, APITokenScopeRead, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := APIToken{Name: "t", Scopes: tt.scopes}
+			assert.Equal(t, tt.want, token.Allows(tt.required))
+		})
+	}
+}
+
+func TestAPIToken_Validate(t *testing.T) {
+	assert.Error(t, APIToken{Scopes: []APITokenScope{APITokenScopeRead}}.Validate())
+	assert.Error(t, APIToken{Name: "cli"}.Validate())
+	assert.NoError(t, APIToken{Name: "cli", Scopes: []APITokenScope{APITokenScopeRead}}.Validate())
+}
@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"errors"
 	"time"
 )
 
@@ -15,10 +14,10 @@ type DayContext struct {
 
 func (c DayContext) Validate() error {
 	if c.Date.IsZero() {
-		return errors.New("date is required")
+		return Invalidf("date is required")
 	}
 	if c.Location != nil && *c.Location == "" {
-		return errors.New("location cannot be empty string")
+		return Invalidf("location cannot be empty string")
 	}
 	return nil
 }
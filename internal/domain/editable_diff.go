@@ -0,0 +1,293 @@
+package domain
+
+import "strings"
+
+type DocumentChangeKind string
+
+const (
+	DocumentChangeInsert   DocumentChangeKind = "insert"
+	DocumentChangeUpdate   DocumentChangeKind = "update"
+	DocumentChangeDelete   DocumentChangeKind = "delete"
+	DocumentChangeReparent DocumentChangeKind = "reparent"
+)
+
+// editSimilarityThreshold is how alike two lines in the same changed region
+// must be before the new one is treated as an edit of the old one rather
+// than a delete and an insert.
+const editSimilarityThreshold = 0.5
+
+// DocumentChange describes one operation needed to turn the stored entries
+// into the edited document. Before and After are the lines as they appear
+// in the document, without indentation.
+type DocumentChange struct {
+	Kind       DocumentChangeKind
+	EntryID    int64
+	LineNumber int
+	Before     string
+	After      string
+}
+
+// DiffedLine is an edited document line mapped back to the entry it came
+// from. EntryID is zero for new lines and ParentIndex is -1 for top-level
+// lines.
+type DiffedLine struct {
+	Line        ParsedLine
+	Type        EntryType
+	EntryID     int64
+	ParentIndex int
+}
+
+type DocumentDiff struct {
+	Lines   []DiffedLine
+	Deleted []Entry
+	Changes []DocumentChange
+}
+
+func (d *DocumentDiff) HasChanges() bool {
+	return len(d.Changes) > 0
+}
+
+// DiffDocument compares the edited document with the serialized form of
+// existing. Unchanged lines are matched first, in order; lines that only
+// moved are then matched by their text, and finally lines left in the same
+// changed region are paired up when they are similar enough to be edits.
+func DiffDocument(existing []Entry, edited *EditableDocument) *DocumentDiff {
+	before := serializedOrder(existing)
+	after := make([]ParsedLine, 0, len(edited.Lines))
+	for _, line := range edited.Lines {
+		if line.IsValid && !line.IsHeader {
+			after = append(after, line)
+		}
+	}
+
+	beforeText := make([]string, len(before))
+	for i, line := range before {
+		beforeText[i] = editableLineText(line.entry.Type, line.entry.Priority, line.entry.Content)
+	}
+	afterText := make([]string, len(after))
+	for j, line := range after {
+		afterText[j] = editableLineText(line.Symbol, line.Priority, line.Content)
+	}
+
+	match := make([]int, len(after))
+	for j := range match {
+		match[j] = -1
+	}
+	matched := make([]bool, len(before))
+	moved := make([]bool, len(after))
+
+	anchors := longestCommonSubsequence(beforeText, afterText)
+	for _, a := range anchors {
+		match[a[1]] = a[0]
+		matched[a[0]] = true
+	}
+
+	for j := range after {
+		if match[j] >= 0 {
+			continue
+		}
+		for i := range before {
+			if !matched[i] && beforeText[i] == afterText[j] {
+				match[j] = i
+				matched[i] = true
+				moved[j] = true
+				break
+			}
+		}
+	}
+
+	prevI, prevJ := -1, -1
+	for k := 0; k <= len(anchors); k++ {
+		endI, endJ := len(before), len(after)
+		if k < len(anchors) {
+			endI, endJ = anchors[k][0], anchors[k][1]
+		}
+		next := prevI + 1
+		for j := prevJ + 1; j < endJ; j++ {
+			if match[j] >= 0 {
+				continue
+			}
+			for i := next; i < endI; i++ {
+				if !matched[i] && contentSimilarity(before[i].entry.Content, after[j].Content) >= editSimilarityThreshold {
+					match[j] = i
+					matched[i] = true
+					next = i + 1
+					break
+				}
+			}
+		}
+		prevI, prevJ = endI, endJ
+	}
+
+	diff := &DocumentDiff{Lines: make([]DiffedLine, len(after))}
+
+	var depthStack []int
+	hasChildren := make(map[int]bool)
+	for j, line := range after {
+		diff.Lines[j] = DiffedLine{Line: line, Type: line.Symbol, ParentIndex: -1}
+		if match[j] >= 0 {
+			diff.Lines[j].EntryID = before[match[j]].entry.ID
+		}
+
+		if line.Depth > 0 && len(depthStack) > line.Depth-1 {
+			diff.Lines[j].ParentIndex = depthStack[line.Depth-1]
+			hasChildren[depthStack[line.Depth-1]] = true
+		}
+
+		if line.Depth >= len(depthStack) {
+			depthStack = append(depthStack, j)
+		} else {
+			depthStack[line.Depth] = j
+			depthStack = depthStack[:line.Depth+1]
+		}
+	}
+
+	for j := range diff.Lines {
+		dl := &diff.Lines[j]
+		switch {
+		case dl.Line.Symbol == EntryTypeQuestion && hasChildren[j]:
+			dl.Type = EntryTypeAnswered
+		case dl.ParentIndex >= 0 && after[dl.ParentIndex].Symbol == EntryTypeQuestion:
+			dl.Type = EntryTypeAnswer
+		case match[j] >= 0 && dl.Type == EntryTypeNote && before[match[j]].entry.Type == EntryTypeAnswer:
+			// Answers are written as notes, so keep the stored type.
+			dl.Type = EntryTypeAnswer
+		}
+	}
+
+	for j, dl := range diff.Lines {
+		if match[j] < 0 {
+			diff.Changes = append(diff.Changes, DocumentChange{
+				Kind:       DocumentChangeInsert,
+				LineNumber: dl.Line.LineNumber,
+				After:      afterText[j],
+			})
+			continue
+		}
+
+		old := before[match[j]]
+		if old.entry.Type != dl.Type || !samePriority(old.entry.Priority, dl.Line.Priority) || old.entry.Content != dl.Line.Content {
+			diff.Changes = append(diff.Changes, DocumentChange{
+				Kind:       DocumentChangeUpdate,
+				EntryID:    old.entry.ID,
+				LineNumber: dl.Line.LineNumber,
+				Before:     beforeText[match[j]],
+				After:      afterText[j],
+			})
+		}
+
+		if moved[j] || old.depth != dl.Line.Depth || !sameParent(old.entry.ParentID, diff.Lines, dl.ParentIndex) {
+			diff.Changes = append(diff.Changes, DocumentChange{
+				Kind:       DocumentChangeReparent,
+				EntryID:    old.entry.ID,
+				LineNumber: dl.Line.LineNumber,
+				Before:     beforeText[match[j]],
+				After:      afterText[j],
+			})
+		}
+	}
+
+	for i, line := range before {
+		if matched[i] {
+			continue
+		}
+		diff.Deleted = append(diff.Deleted, line.entry)
+		diff.Changes = append(diff.Changes, DocumentChange{
+			Kind:    DocumentChangeDelete,
+			EntryID: line.entry.ID,
+			Before:  beforeText[i],
+		})
+	}
+
+	return diff
+}
+
+func sameParent(oldParentID *int64, lines []DiffedLine, parentIndex int) bool {
+	if parentIndex < 0 {
+		return oldParentID == nil
+	}
+	parentEntryID := lines[parentIndex].EntryID
+	return oldParentID != nil && parentEntryID != 0 && *oldParentID == parentEntryID
+}
+
+func samePriority(a, b Priority) bool {
+	if a == "" {
+		a = PriorityNone
+	}
+	if b == "" {
+		b = PriorityNone
+	}
+	return a == b
+}
+
+func editableLineText(entryType EntryType, priority Priority, content string) string {
+	var line strings.Builder
+	serializeEntryLine(&line, Entry{Type: entryType, Priority: priority, Content: content}, 0)
+	return line.String()
+}
+
+// longestCommonSubsequence returns the index pairs of the longest run of
+// lines shared by a and b, in order.
+func longestCommonSubsequence(a, b []string) [][2]int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+
+	var pairs [][2]int
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			pairs = append(pairs, [2]int{i, j})
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	return pairs
+}
+
+// contentSimilarity is the Dice coefficient of the character bigrams of a
+// and b, ignoring case: 1 for identical text, 0 for nothing in common.
+func contentSimilarity(a, b string) float64 {
+	a, b = strings.ToLower(a), strings.ToLower(b)
+	if a == b {
+		return 1
+	}
+
+	bigrams := func(s string) map[string]int {
+		runes := []rune(s)
+		counts := make(map[string]int)
+		for i := 0; i+1 < len(runes); i++ {
+			counts[string(runes[i:i+2])]++
+		}
+		return counts
+	}
+
+	aBigrams, bBigrams := bigrams(a), bigrams(b)
+	total, shared := 0, 0
+	for _, n := range aBigrams {
+		total += n
+	}
+	for bigram, n := range bBigrams {
+		total += n
+		shared += min(n, aBigrams[bigram])
+	}
+	if total == 0 {
+		return 0
+	}
+	return float64(2*shared) / float64(total)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"testing"
+)
+
+func diffFor(t *testing.T, existing []Entry, doc string) *DocumentDiff {
+	t.Helper()
+	parsed, err := NewEditableDocumentParser().Parse(doc)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	return DiffDocument(existing, parsed)
+}
+
+func TestDiffDocument_UnchangedDocumentHasNoChanges(t *testing.T) {
+	parentID := int64(1)
+	existing := []Entry{
+		{ID: 1, Type: EntryTypeTask, Content: "Project"},
+		{ID: 2, Type: EntryTypeNote, Content: "Detail", Depth: 1, ParentID: &parentID},
+	}
+
+	diff := diffFor(t, existing, Serialize(existing))
+
+	if diff.HasChanges() {
+		t.Errorf("expected no changes, got %+v", diff.Changes)
+	}
+	if diff.Lines[0].EntryID != 1 || diff.Lines[1].EntryID != 2 {
+		t.Errorf("lines not mapped to entries: %+v", diff.Lines)
+	}
+	if diff.Lines[1].ParentIndex != 0 {
+		t.Errorf("ParentIndex = %d, want 0", diff.Lines[1].ParentIndex)
+	}
+}
+
+func TestDiffDocument_SimilarLineIsAnEdit(t *testing.T) {
+	existing := []Entry{
+		{ID: 1, Type: EntryTypeTask, Content: "Write report"},
+		{ID: 2, Type: EntryTypeTask, Content: "Call dentist"},
+	}
+
+	diff := diffFor(t, existing, ". Write the report\n. Buy stamps")
+
+	want := []DocumentChange{
+		{Kind: DocumentChangeUpdate, EntryID: 1, LineNumber: 1, Before: ". Write report", After: ". Write the report"},
+		{Kind: DocumentChangeInsert, LineNumber: 2, After: ". Buy stamps"},
+		{Kind: DocumentChangeDelete, EntryID: 2, Before: ". Call dentist"},
+	}
+	if len(diff.Changes) != len(want) {
+		t.Fatalf("got %d changes, want %d: %+v", len(diff.Changes), len(want), diff.Changes)
+	}
+	for i := range want {
+		if diff.Changes[i] != want[i] {
+			t.Errorf("change %d = %+v, want %+v", i, diff.Changes[i], want[i])
+		}
+	}
+}
+
+func TestDiffDocument_MovedLineIsReparented(t *testing.T) {
+	existing := []Entry{
+		{ID: 1, Type: EntryTypeTask, Content: "First"},
+		{ID: 2, Type: EntryTypeTask, Content: "Second"},
+		{ID: 3, Type: EntryTypeTask, Content: "Third"},
+	}
+
+	diff := diffFor(t, existing, ". Third\n. First\n. Second")
+
+	if len(diff.Changes) != 1 {
+		t.Fatalf("got %d changes, want 1: %+v", len(diff.Changes), diff.Changes)
+	}
+	if diff.Changes[0].Kind != DocumentChangeReparent || diff.Changes[0].EntryID != 3 {
+		t.Errorf("got %+v, want reparent of entry 3", diff.Changes[0])
+	}
+}
+
+func TestDiffDocument_AnswerKeepsItsType(t *testing.T) {
+	parentID := int64(1)
+	existing := []Entry{
+		{ID: 1, Type: EntryTypeAnswered, Content: "How does auth work"},
+		{ID: 2, Type: EntryTypeAnswer, Content: "It uses JWT", Depth: 1, ParentID: &parentID},
+	}
+
+	diff := diffFor(t, existing, "* How does auth work\n  - It uses JWT")
+
+	if diff.HasChanges() {
+		t.Errorf("expected no changes, got %+v", diff.Changes)
+	}
+	if diff.Lines[1].Type != EntryTypeAnswer {
+		t.Errorf("Type = %s, want %s", diff.Lines[1].Type, EntryTypeAnswer)
+	}
+}
+
+func TestDiffDocument_QuestionWithChildIsAnswered(t *testing.T) {
+	existing := []Entry{
+		{ID: 1, Type: EntryTypeQuestion, Content: "Which venue"},
+	}
+
+	diff := diffFor(t, existing, "? Which venue\n  - The town hall")
+
+	if diff.Lines[0].Type != EntryTypeAnswered {
+		t.Errorf("question Type = %s, want %s", diff.Lines[0].Type, EntryTypeAnswered)
+	}
+	if diff.Lines[1].Type != EntryTypeAnswer {
+		t.Errorf("child Type = %s, want %s", diff.Lines[1].Type, EntryTypeAnswer)
+	}
+	if diff.Changes[0].Kind != DocumentChangeUpdate || diff.Changes[0].EntryID != 1 {
+		t.Errorf("got %+v, want update of entry 1", diff.Changes[0])
+	}
+}
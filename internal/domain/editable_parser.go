@@ -65,6 +65,26 @@ func Serialize(entries []Entry) string {
 		return ""
 	}
 
+	var result strings.Builder
+	for i, line := range serializedOrder(entries) {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		serializeEntryLine(&result, line.entry, line.depth)
+	}
+
+	return result.String()
+}
+
+type serializedEntry struct {
+	entry Entry
+	depth int
+}
+
+// serializedOrder lists entries in the order and at the depth Serialize
+// writes them: each root followed by its children. Entries whose parent is
+// not in the slice are left out.
+func serializedOrder(entries []Entry) []serializedEntry {
 	hasParentIDs := false
 	for _, e := range entries {
 		if e.ParentID != nil || e.ID != 0 {
@@ -73,8 +93,12 @@ func Serialize(entries []Entry) string {
 		}
 	}
 
+	ordered := make([]serializedEntry, 0, len(entries))
 	if !hasParentIDs {
-		return serializeFlat(entries)
+		for _, entry := range entries {
+			ordered = append(ordered, serializedEntry{entry: entry, depth: entry.Depth})
+		}
+		return ordered
 	}
 
 	childrenOf := make(map[int64][]int)
@@ -84,42 +108,22 @@ func Serialize(entries []Entry) string {
 		}
 	}
 
-	var result strings.Builder
-	written := 0
-
-	var writeEntry func(idx, depth int)
-	writeEntry = func(idx, depth int) {
+	var visit func(idx, depth int)
+	visit = func(idx, depth int) {
 		entry := entries[idx]
-		if written > 0 {
-			result.WriteString("\n")
-		}
-		serializeEntryLine(&result, entry, depth)
-		written++
-
+		ordered = append(ordered, serializedEntry{entry: entry, depth: depth})
 		for _, childIdx := range childrenOf[entry.ID] {
-			writeEntry(childIdx, depth+1)
+			visit(childIdx, depth+1)
 		}
 	}
 
 	for i, entry := range entries {
 		if entry.ParentID == nil {
-			writeEntry(i, 0)
-		}
-	}
-
-	return result.String()
-}
-
-func serializeFlat(entries []Entry) string {
-	var result strings.Builder
-	for i, entry := range entries {
-		if i > 0 {
-			result.WriteString("\n")
+			visit(i, 0)
 		}
-		serializeEntryLine(&result, entry, entry.Depth)
 	}
 
-	return result.String()
+	return ordered
 }
 
 func serializeEntryLine(result *strings.Builder, entry Entry, depth int) {
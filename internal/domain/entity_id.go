@@ -1,8 +1,6 @@
 package domain
 
 import (
-	"errors"
-
 	"github.com/google/uuid"
 )
 
@@ -18,11 +16,11 @@ func NewEntityID() EntityID {
 
 func ParseEntityID(s string) (EntityID, error) {
 	if s == "" {
-		return "", errors.New("entity ID cannot be empty")
+		return "", Invalidf("entity ID cannot be empty")
 	}
 	parsed, err := uuid.Parse(s)
 	if err != nil {
-		return "", errors.New("invalid entity ID format")
+		return "", Invalidf("invalid entity ID format")
 	}
 	return EntityID(parsed.String()), nil
 }
@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"errors"
 	"time"
 )
 
@@ -64,7 +63,7 @@ func ParsePriority(s string) (Priority, error) {
 	}
 	p := Priority(s)
 	if !p.IsValid() {
-		return PriorityNone, errors.New("invalid priority: must be none, low, medium, or high")
+		return PriorityNone, Invalidf("invalid priority: must be none, low, medium, or high")
 	}
 	return p, nil
 }
@@ -82,6 +81,16 @@ var validEntryTypes = map[EntryType]string{
 	EntryTypeMovedToList: "^",
 }
 
+// ScheduleGranularity is how precisely an entry is scheduled. Month and
+// someday entries make up the future log and stay out of day views.
+type ScheduleGranularity string
+
+const (
+	ScheduleDay     ScheduleGranularity = "day"
+	ScheduleMonth   ScheduleGranularity = "month"
+	ScheduleSomeday ScheduleGranularity = "someday"
+)
+
 func (et EntryType) IsValid() bool {
 	_, ok := validEntryTypes[et]
 	return ok
@@ -102,6 +111,7 @@ type Entry struct {
 	Depth             int
 	Location          *string
 	ScheduledDate     *time.Time
+	Granularity       ScheduleGranularity
 	CreatedAt         time.Time
 	SortOrder         int
 	MigrationCount    int
@@ -109,6 +119,9 @@ type Entry struct {
 	OriginalCreatedAt *time.Time
 	Tags              []string
 	Mentions          []string
+	// Projected marks a later occurrence of a recurrence, shown ahead of its
+	// day but not stored. It has no ID, so nothing can be done to it yet.
+	Projected bool
 }
 
 func NewEntry(entryType EntryType, content string, scheduledDate *time.Time) Entry {
@@ -129,6 +142,12 @@ func (e Entry) HasParent() bool {
 	return e.ParentEntityID != nil && !e.ParentEntityID.IsEmpty()
 }
 
+// IsFutureLog reports whether the entry is scheduled for a month or someday
+// rather than a specific day.
+func (e Entry) IsFutureLog() bool {
+	return e.Granularity == ScheduleMonth || e.Granularity == ScheduleSomeday
+}
+
 func (e Entry) DurationDays() (float64, bool) {
 	if e.CompletedAt == nil {
 		return 0, false
@@ -147,9 +166,12 @@ func (e Entry) IsOverdue(today time.Time) bool {
 	if e.Type == EntryTypeNote || e.Type == EntryTypeEvent {
 		return false
 	}
-	if e.ScheduledDate == nil {
+	if e.ScheduledDate == nil || e.Granularity == ScheduleSomeday {
 		return false
 	}
+	if e.Granularity == ScheduleMonth {
+		return e.ScheduledDate.Year()*12+int(e.ScheduledDate.Month()) < today.Year()*12+int(today.Month())
+	}
 	scheduledDate := e.ScheduledDate.Year()*10000 + int(e.ScheduledDate.Month())*100 + e.ScheduledDate.Day()
 	todayDate := today.Year()*10000 + int(today.Month())*100 + today.Day()
 	return scheduledDate < todayDate
@@ -157,13 +179,13 @@ func (e Entry) IsOverdue(today time.Time) bool {
 
 func (e Entry) Validate() error {
 	if !e.Type.IsValid() {
-		return errors.New("invalid entry type")
+		return Invalidf("invalid entry type")
 	}
 	if e.Content == "" {
-		return errors.New("content cannot be empty")
+		return Invalidf("content cannot be empty")
 	}
 	if e.Depth < 0 {
-		return errors.New("depth cannot be negative")
+		return Invalidf("depth cannot be negative")
 	}
 	return nil
 }
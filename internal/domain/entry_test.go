@@ -121,6 +121,8 @@ func TestEntry_IsOverdue(t *testing.T) {
 	today := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
 	yesterday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
 	tomorrow := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+	thisMonth := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	lastMonth := time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC)
 
 	tests := []struct {
 		name     string
@@ -167,6 +169,21 @@ func TestEntry_IsOverdue(t *testing.T) {
 			entry:    Entry{Type: EntryTypeCancelled, ScheduledDate: &yesterday},
 			expected: false,
 		},
+		{
+			name:     "task scheduled for this month is not overdue",
+			entry:    Entry{Type: EntryTypeTask, ScheduledDate: &thisMonth, Granularity: ScheduleMonth},
+			expected: false,
+		},
+		{
+			name:     "task scheduled for last month is overdue",
+			entry:    Entry{Type: EntryTypeTask, ScheduledDate: &lastMonth, Granularity: ScheduleMonth},
+			expected: true,
+		},
+		{
+			name:     "someday task is never overdue",
+			entry:    Entry{Type: EntryTypeTask, ScheduledDate: &yesterday, Granularity: ScheduleSomeday},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
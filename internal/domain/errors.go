@@ -0,0 +1,28 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNotFound is wrapped by the errors returned for records that do not
+// exist, so adapters can tell them apart from requests that were rejected.
+var ErrNotFound = errors.New("not found")
+
+// ErrInvalid matches the errors returned for requests that were rejected,
+// such as malformed input or an operation the record's state does not allow.
+var ErrInvalid = errors.New("invalid")
+
+type invalidError struct {
+	err error
+}
+
+func (e invalidError) Error() string        { return e.err.Error() }
+func (e invalidError) Unwrap() error        { return e.err }
+func (e invalidError) Is(target error) bool { return target == ErrInvalid }
+
+// Invalidf formats an error that matches ErrInvalid. The message is left
+// as formatted, and %w wraps the cause as it does in fmt.Errorf.
+func Invalidf(format string, args ...any) error {
+	return invalidError{err: fmt.Errorf(format, args...)}
+}
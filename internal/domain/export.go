@@ -5,15 +5,18 @@ import "time"
 const ExportVersion = "1.0"
 
 type ExportData struct {
-	Version     string       `json:"version"`
-	ExportedAt  time.Time    `json:"exported_at"`
-	Entries     []Entry      `json:"entries"`
-	Habits      []Habit      `json:"habits"`
-	HabitLogs   []HabitLog   `json:"habit_logs"`
-	DayContexts []DayContext `json:"day_contexts"`
-	Lists       []List       `json:"lists"`
-	ListItems   []ListItem   `json:"list_items"`
-	Goals       []Goal       `json:"goals"`
+	Version           string             `json:"version"`
+	ExportedAt        time.Time          `json:"exported_at"`
+	Entries           []Entry            `json:"entries"`
+	Habits            []Habit            `json:"habits"`
+	HabitLogs         []HabitLog         `json:"habit_logs"`
+	DayContexts       []DayContext       `json:"day_contexts"`
+	Lists             []List             `json:"lists"`
+	ListItems         []ListItem         `json:"list_items"`
+	Goals             []Goal             `json:"goals"`
+	KeyResults        []KeyResult        `json:"key_results"`
+	KeyResultCheckIns []KeyResultCheckIn `json:"key_result_check_ins"`
+	ListTemplates     []ListTemplate     `json:"list_templates"`
 }
 
 type ExportOptions struct {
@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"errors"
 	"time"
 )
 
@@ -14,22 +13,30 @@ const (
 	GoalStatusCancelled GoalStatus = "cancelled"
 )
 
+// Goal is an objective for a month, quarter or year. Month holds the first
+// month of the goal's period, and ParentEntityID the longer-horizon goal it
+// cascades from.
 type Goal struct {
-	ID         int64
-	EntityID   EntityID
-	Content    string
-	Month      time.Time
-	Status     GoalStatus
-	MigratedTo *time.Time
-	CreatedAt  time.Time
+	ID             int64
+	EntityID       EntityID
+	Content        string
+	Month          time.Time
+	Horizon        GoalHorizon
+	ParentEntityID *EntityID
+	Status         GoalStatus
+	MigratedTo     *time.Time
+	CreatedAt      time.Time
 }
 
 func (g Goal) Validate() error {
 	if g.Content == "" {
-		return errors.New("goal content cannot be empty")
+		return Invalidf("goal content cannot be empty")
 	}
 	if g.Month.IsZero() {
-		return errors.New("goal month is required")
+		return Invalidf("goal month is required")
+	}
+	if g.Horizon != "" && !g.Horizon.IsValid() {
+		return Invalidf("invalid goal horizon: %s", g.Horizon)
 	}
 	return nil
 }
@@ -75,3 +82,26 @@ func (g Goal) MarkCancelled() Goal {
 	g.Status = GoalStatusCancelled
 	return g
 }
+
+// GoalProgress counts how much of a goal is done: the journal tasks linked
+// to it, or the goals cascading from it.
+type GoalProgress struct {
+	Done  int
+	Total int
+}
+
+// CalculateGoalProgress counts a goal's linked tasks. Cancelled and migrated
+// tasks no longer count towards it.
+func CalculateGoalProgress(entries []Entry) GoalProgress {
+	var progress GoalProgress
+	for _, entry := range entries {
+		switch entry.Type {
+		case EntryTypeDone:
+			progress.Done++
+			progress.Total++
+		case EntryTypeTask:
+			progress.Total++
+		}
+	}
+	return progress
+}
@@ -0,0 +1,152 @@
+package domain
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// GoalHorizon is the span of time a goal covers. Yearly and quarterly goals
+// cascade into the goals of the periods inside them, down to monthly goals.
+type GoalHorizon string
+
+const (
+	GoalHorizonYear    GoalHorizon = "year"
+	GoalHorizonQuarter GoalHorizon = "quarter"
+	GoalHorizonMonth   GoalHorizon = "month"
+)
+
+func (h GoalHorizon) IsValid() bool {
+	switch h {
+	case GoalHorizonYear, GoalHorizonQuarter, GoalHorizonMonth:
+		return true
+	}
+	return false
+}
+
+// Rank orders horizons from the longest: a goal can only be the parent of a
+// goal with a higher rank.
+func (h GoalHorizon) Rank() int {
+	switch h {
+	case GoalHorizonYear:
+		return 0
+	case GoalHorizonQuarter:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// GoalPeriodStart returns the first day of the horizon's period containing t.
+func GoalPeriodStart(horizon GoalHorizon, t time.Time) time.Time {
+	switch horizon {
+	case GoalHorizonYear:
+		return time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, t.Location())
+	case GoalHorizonQuarter:
+		month := time.Month((int(t.Month())-1)/3*3 + 1)
+		return time.Date(t.Year(), month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	}
+}
+
+// GoalPeriodEnd returns the first day after the horizon's period starting
+// at start.
+func GoalPeriodEnd(horizon GoalHorizon, start time.Time) time.Time {
+	switch horizon {
+	case GoalHorizonYear:
+		return start.AddDate(1, 0, 0)
+	case GoalHorizonQuarter:
+		return start.AddDate(0, 3, 0)
+	default:
+		return start.AddDate(0, 1, 0)
+	}
+}
+
+// FormatGoalPeriod renders a period for display: "2026", "Q3 2026" or
+// "July 2026".
+func FormatGoalPeriod(horizon GoalHorizon, start time.Time) string {
+	switch horizon {
+	case GoalHorizonYear:
+		return strconv.Itoa(start.Year())
+	case GoalHorizonQuarter:
+		return fmt.Sprintf("Q%d %d", (int(start.Month())-1)/3+1, start.Year())
+	default:
+		return start.Format("January 2006")
+	}
+}
+
+var goalQuarterPattern = regexp.MustCompile(`^(\d{4})-?[qQ]([1-4])$`)
+
+// ParseGoalQuarter parses a quarter such as "2026Q3" or "2026-Q3" into the
+// first day of the quarter.
+func ParseGoalQuarter(s string) (time.Time, error) {
+	m := goalQuarterPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return time.Time{}, Invalidf("invalid quarter format: %s (use YYYYQN)", s)
+	}
+	year, _ := strconv.Atoi(m[1])
+	quarter, _ := strconv.Atoi(m[2])
+	return time.Date(year, time.Month((quarter-1)*3+1), 1, 0, 0, 0, 0, time.UTC), nil
+}
+
+// GetHorizon returns the goal's horizon; goals stored before horizons
+// existed are monthly.
+func (g Goal) GetHorizon() GoalHorizon {
+	if g.Horizon == "" {
+		return GoalHorizonMonth
+	}
+	return g.Horizon
+}
+
+// PeriodEnd returns the first day after the goal's period, which is also
+// the start of the period unfinished goals are migrated to.
+func (g Goal) PeriodEnd() time.Time {
+	return GoalPeriodEnd(g.GetHorizon(), g.Month)
+}
+
+// PeriodLabel renders the goal's period, e.g. "Q3 2026".
+func (g Goal) PeriodLabel() string {
+	return FormatGoalPeriod(g.GetHorizon(), g.Month)
+}
+
+// Overlaps reports whether the goal's period shares any time with
+// [start, end).
+func (g Goal) Overlaps(start, end time.Time) bool {
+	return g.Month.Before(end) && g.PeriodEnd().After(start)
+}
+
+// Contains reports whether a period starting at start lies within the goal's.
+func (g Goal) Contains(start time.Time) bool {
+	return !start.Before(g.Month) && start.Before(g.PeriodEnd())
+}
+
+// CanParent checks that child can cascade from the goal: the goal must span
+// a longer horizon and its period must contain the child's.
+func (g Goal) CanParent(child Goal) error {
+	if g.GetHorizon().Rank() >= child.GetHorizon().Rank() {
+		return Invalidf("a %s goal cannot be the parent of a %s goal", g.GetHorizon(), child.GetHorizon())
+	}
+	if !g.Contains(child.Month) {
+		return Invalidf("%s is not within %s", child.PeriodLabel(), g.PeriodLabel())
+	}
+	return nil
+}
+
+// RollUpGoals counts how many of a goal's children are done. Cancelled and
+// migrated children no longer count towards it.
+func RollUpGoals(children []Goal) GoalProgress {
+	var progress GoalProgress
+	for _, child := range children {
+		switch child.Status {
+		case GoalStatusDone:
+			progress.Done++
+			progress.Total++
+		case GoalStatusActive, "":
+			progress.Total++
+		}
+	}
+	return progress
+}
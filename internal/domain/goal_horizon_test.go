@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseGoalQuarter(t *testing.T) {
+	for _, input := range []string{"2026Q3", "2026-Q3", "2026q3"} {
+		quarter, err := ParseGoalQuarter(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), quarter, input)
+	}
+
+	for _, input := range []string{"2026Q5", "Q3", "2026-07"} {
+		_, err := ParseGoalQuarter(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestGoalPeriodStart(t *testing.T) {
+	day := time.Date(2026, 8, 17, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), GoalPeriodStart(GoalHorizonYear, day))
+	assert.Equal(t, time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), GoalPeriodStart(GoalHorizonQuarter, day))
+	assert.Equal(t, time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), GoalPeriodStart(GoalHorizonMonth, day))
+}
+
+func TestGoal_PeriodLabel(t *testing.T) {
+	q3 := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "2026", Goal{Month: q3, Horizon: GoalHorizonYear}.PeriodLabel())
+	assert.Equal(t, "Q3 2026", Goal{Month: q3, Horizon: GoalHorizonQuarter}.PeriodLabel())
+	assert.Equal(t, "July 2026", Goal{Month: q3}.PeriodLabel())
+}
+
+func TestGoal_CanParent(t *testing.T) {
+	year := Goal{Month: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Horizon: GoalHorizonYear}
+	q3 := Goal{Month: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Horizon: GoalHorizonQuarter}
+	august := Goal{Month: time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC), Horizon: GoalHorizonMonth}
+	october := Goal{Month: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Horizon: GoalHorizonMonth}
+
+	assert.NoError(t, year.CanParent(q3))
+	assert.NoError(t, year.CanParent(august))
+	assert.NoError(t, q3.CanParent(august))
+	assert.ErrorContains(t, q3.CanParent(october), "not within Q3 2026")
+	assert.ErrorContains(t, august.CanParent(q3), "cannot be the parent")
+	assert.Error(t, q3.CanParent(q3))
+}
+
+func TestRollUpGoals(t *testing.T) {
+	children := []Goal{
+		{Status: GoalStatusDone},
+		{Status: GoalStatusActive},
+		{Status: GoalStatusActive},
+		{Status: GoalStatusCancelled},
+		{Status: GoalStatusMigrated},
+	}
+
+	assert.Equal(t, GoalProgress{Done: 1, Total: 3}, RollUpGoals(children))
+}
@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// KeyResult is a measurable outcome of a goal, such as "reach 1000
+// subscribers". It moves from Start towards Target, which is below Start
+// for results that should go down, and Current is its latest check-in.
+type KeyResult struct {
+	ID           int64
+	EntityID     EntityID
+	GoalEntityID EntityID
+	Name         string
+	Start        float64
+	Target       float64
+	Current      float64
+	Unit         string
+	CreatedAt    time.Time
+}
+
+// KeyResultCheckIn records the value of a key result at a point in time.
+type KeyResultCheckIn struct {
+	ID                int64
+	KeyResultEntityID EntityID
+	Value             float64
+	CheckedAt         time.Time
+}
+
+func (k KeyResult) Validate() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return Invalidf("key result name cannot be empty")
+	}
+	if k.Target == k.Start {
+		return Invalidf("key result target must differ from its start value (%s)", FormatHabitValue(k.Start, k.Unit))
+	}
+	return nil
+}
+
+// Progress is how far the key result has moved from its start to its
+// target, from 0 to 1.
+func (k KeyResult) Progress() float64 {
+	if k.Target == k.Start {
+		return 0
+	}
+	progress := (k.Current - k.Start) / (k.Target - k.Start)
+	return math.Max(0, math.Min(progress, 1))
+}
+
+// Describe renders the key result's current value against its target,
+// e.g. "600/1000 subscribers".
+func (k KeyResult) Describe() string {
+	current := FormatHabitValue(k.Current, "")
+	return fmt.Sprintf("%s/%s", current, FormatHabitValue(k.Target, k.Unit))
+}
+
+// KeyResultsProgress is a goal's completion derived from its key results:
+// the average of their progress, from 0 to 1.
+func KeyResultsProgress(keyResults []KeyResult) float64 {
+	if len(keyResults) == 0 {
+		return 0
+	}
+	total := 0.0
+	for _, kr := range keyResults {
+		total += kr.Progress()
+	}
+	return total / float64(len(keyResults))
+}
+
+// FormatPercent renders a 0 to 1 fraction as a whole percentage, e.g. "60%".
+func FormatPercent(fraction float64) string {
+	return fmt.Sprintf("%d%%", int(math.Round(fraction*100)))
+}
+
+// ProgressBar draws a 0 to 1 fraction as a bar of width cells, e.g.
+// "██████░░░░".
+func ProgressBar(fraction float64, width int) string {
+	filled := int(math.Round(math.Max(0, math.Min(fraction, 1)) * float64(width)))
+	return strings.Repeat("█", filled) + strings.Repeat("░", width-filled)
+}
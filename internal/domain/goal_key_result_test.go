@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKeyResult_Validate(t *testing.T) {
+	assert.NoError(t, KeyResult{Name: "Subscribers", Start: 0, Target: 1000}.Validate())
+	assert.Error(t, KeyResult{Name: " ", Target: 10}.Validate())
+	assert.Error(t, KeyResult{Name: "Flat", Start: 5, Target: 5}.Validate())
+}
+
+func TestKeyResult_Progress(t *testing.T) {
+	tests := []struct {
+		name     string
+		kr       KeyResult
+		expected float64
+	}{
+		{"halfway", KeyResult{Start: 0, Target: 1000, Current: 500}, 0.5},
+		{"from non-zero start", KeyResult{Start: 200, Target: 1000, Current: 600}, 0.5},
+		{"decreasing target", KeyResult{Start: 90, Target: 80, Current: 85}, 0.5},
+		{"beyond target", KeyResult{Start: 0, Target: 10, Current: 12}, 1},
+		{"below start", KeyResult{Start: 10, Target: 20, Current: 5}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.expected, tt.kr.Progress(), 0.0001)
+		})
+	}
+}
+
+func TestKeyResult_Describe(t *testing.T) {
+	kr := KeyResult{Start: 0, Target: 1000, Current: 600, Unit: "subscribers"}
+	assert.Equal(t, "600/1000 subscribers", kr.Describe())
+}
+
+func TestKeyResultsProgress(t *testing.T) {
+	assert.Equal(t, 0.0, KeyResultsProgress(nil))
+
+	krs := []KeyResult{
+		{Start: 0, Target: 10, Current: 10},
+		{Start: 0, Target: 10, Current: 0},
+	}
+	assert.InDelta(t, 0.5, KeyResultsProgress(krs), 0.0001)
+}
+
+func TestProgressBar(t *testing.T) {
+	assert.Equal(t, "██████░░░░", ProgressBar(0.6, 10))
+	assert.Equal(t, "░░░░░", ProgressBar(-1, 5))
+	assert.Equal(t, "█████", ProgressBar(2, 5))
+	assert.Equal(t, "60%", FormatPercent(0.6))
+}
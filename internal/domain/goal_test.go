@@ -200,3 +200,17 @@ func TestGoal_MarkCancelled(t *testing.T) {
 	assert.Equal(t, original.Content, updated.Content, "other fields should be copied")
 	assert.Equal(t, original.Month, updated.Month, "other fields should be copied")
 }
+
+func TestCalculateGoalProgress(t *testing.T) {
+	entries := []Entry{
+		{Type: EntryTypeDone},
+		{Type: EntryTypeDone},
+		{Type: EntryTypeTask},
+		{Type: EntryTypeCancelled},
+		{Type: EntryTypeMigrated},
+	}
+
+	progress := CalculateGoalProgress(entries)
+
+	assert.Equal(t, GoalProgress{Done: 2, Total: 3}, progress)
+}
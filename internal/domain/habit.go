@@ -1,7 +1,7 @@
 package domain
 
 import (
-	"errors"
+	"fmt"
 	"time"
 )
 
@@ -12,46 +12,126 @@ type Habit struct {
 	GoalPerDay   int
 	GoalPerWeek  int
 	GoalPerMonth int
+	Kind         HabitKind
+	Unit         string
+	Target       float64
+	TargetType   HabitTargetType
+	Schedule     HabitSchedule
 	CreatedAt    time.Time
+	// ArchivedAt is set once a habit is retired. Archived habits keep
+	// their logs but are left out of the tracker.
+	ArchivedAt *time.Time
+	// Pauses are not stored with the habit; they are loaded alongside it
+	// where streaks and progress are worked out.
+	Pauses []HabitPause `json:"-"`
 }
 
 func (h Habit) Validate() error {
 	if h.Name == "" {
-		return errors.New("habit name cannot be empty")
+		return Invalidf("habit name cannot be empty")
 	}
 	if h.GoalPerDay < 0 {
-		return errors.New("goal per day cannot be negative")
+		return Invalidf("goal per day cannot be negative")
 	}
 	if h.GoalPerWeek < 0 {
-		return errors.New("goal per week cannot be negative")
+		return Invalidf("goal per week cannot be negative")
 	}
 	if h.GoalPerMonth < 0 {
-		return errors.New("goal per month cannot be negative")
+		return Invalidf("goal per month cannot be negative")
+	}
+	if h.IsMeasured() {
+		if h.Target < 0 {
+			return Invalidf("target cannot be negative")
+		}
+		return nil
+	}
+	if h.IsLimit() {
+		return nil
 	}
 	if h.GoalPerDay == 0 && h.GoalPerWeek == 0 && h.GoalPerMonth == 0 {
-		return errors.New("at least one goal must be set")
+		return Invalidf("at least one goal must be set")
 	}
 	return nil
 }
 
+// StreakPeriod is the period a habit's streak and completion are measured
+// in. A habit with a weekly or monthly goal is kept per week or month, so
+// rest days do not break its streak.
+type StreakPeriod string
+
+const (
+	StreakPeriodDay   StreakPeriod = "day"
+	StreakPeriodWeek  StreakPeriod = "week"
+	StreakPeriodMonth StreakPeriod = "month"
+)
+
+// Format renders a streak length in the period, e.g. "1 day" or "3 weeks".
+func (p StreakPeriod) Format(n int) string {
+	if p == "" {
+		p = StreakPeriodDay
+	}
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, p)
+	}
+	return fmt.Sprintf("%d %ss", n, p)
+}
+
+func (h Habit) IsArchived() bool {
+	return h.ArchivedAt != nil
+}
+
+// StreakPeriod prefers the weekly goal, then the monthly goal, falling back
+// to the daily goal every habit has. Measured habits have a daily target.
+func (h Habit) StreakPeriod() StreakPeriod {
+	switch {
+	case h.IsMeasured():
+		return StreakPeriodDay
+	case h.GoalPerWeek > 0:
+		return StreakPeriodWeek
+	case h.GoalPerMonth > 0:
+		return StreakPeriodMonth
+	default:
+		return StreakPeriodDay
+	}
+}
+
+func (h Habit) streakGoal() int {
+	goal := h.GoalPerDay
+	switch h.StreakPeriod() {
+	case StreakPeriodWeek:
+		goal = h.GoalPerWeek
+	case StreakPeriodMonth:
+		goal = h.GoalPerMonth
+	}
+	if goal < 1 {
+		return 1
+	}
+	return goal
+}
+
 type HabitLog struct {
 	ID            int64
 	EntityID      EntityID
 	HabitID       int64
 	HabitEntityID EntityID
 	Count         int
-	LoggedAt      time.Time
+	// Value is the measured amount of a log of a measured habit.
+	Value    float64
+	LoggedAt time.Time
 }
 
 func (l HabitLog) Validate() error {
 	if l.HabitID <= 0 {
-		return errors.New("habit ID must be positive")
+		return Invalidf("habit ID must be positive")
 	}
 	if l.Count <= 0 {
-		return errors.New("count must be positive")
+		return Invalidf("count must be positive")
+	}
+	if l.Value < 0 {
+		return Invalidf("value cannot be negative")
 	}
 	if l.LoggedAt.IsZero() {
-		return errors.New("logged at time is required")
+		return Invalidf("logged at time is required")
 	}
 	return nil
 }
@@ -93,6 +173,171 @@ func CalculateStreak(logs []HabitLog, today time.Time) int {
 	return streak
 }
 
+// CalculateGoalStreak counts the consecutive days, weeks or months, per the
+// habit's StreakPeriod, whose logged count meets the habit's goal. Weeks
+// start on Monday. The current period only adds to the streak once its goal
+// is met, and never breaks it. Rest and paused days of a daily habit, and
+// weeks or months with paused days whose goal was not met, are skipped over.
+// For a limit habit the streak counts clean periods since the habit was
+// created, and going over the ceiling in the current period ends it.
+func CalculateGoalStreak(habit Habit, logs []HabitLog, today time.Time) int {
+	return CalculateGoalStreakSince(habit, logs, time.Time{}, today)
+}
+
+// CalculateGoalStreakSince is CalculateGoalStreak for logs loaded from since
+// onwards. Periods that start before since are not counted, as their logs
+// may be missing; a zero since counts back to the habit's tracking start.
+func CalculateGoalStreakSince(habit Habit, logs []HabitLog, since, today time.Time) int {
+	period := habit.StreakPeriod()
+	sums := habit.sumByPeriod(logs, period)
+	earliest := habit.trackingStart(logs, today)
+	if !since.IsZero() {
+		first := periodStart(period, since)
+		if first.Before(since) {
+			first = nextPeriod(period, first)
+		}
+		if first.After(earliest) {
+			earliest = first
+		}
+	}
+
+	start := periodStart(period, today)
+	if reached := habit.periodReached(sums, start); !reached {
+		if habit.IsLimit() && !habit.excused(period, start, reached) {
+			return 0
+		}
+		start = previousPeriod(period, start)
+	}
+
+	streak := 0
+	for !start.Before(earliest) {
+		reached := habit.periodReached(sums, start)
+		if habit.excused(period, start, reached) {
+			start = previousPeriod(period, start)
+			continue
+		}
+		if !reached {
+			return streak
+		}
+		streak++
+		start = previousPeriod(period, start)
+	}
+	return streak
+}
+
+// CalculateGoalCompletion is the percentage of periods overlapping the last
+// days days whose goal was met. A period that started before the window is
+// judged on all its logs, not just those inside the window. For weekly and
+// monthly habits the current period only counts once its goal is met, so an
+// unfinished week is not a miss; periods skipped by CalculateGoalStreak do
+// not count at all, and neither do the periods before a limit habit was
+// created.
+func CalculateGoalCompletion(habit Habit, logs []HabitLog, days int, today time.Time) float64 {
+	if days <= 0 {
+		return 0.0
+	}
+
+	period := habit.StreakPeriod()
+	todayStart := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	first := periodStart(period, todayStart.AddDate(0, 0, -(days-1)))
+	todayEnd := todayStart.AddDate(0, 0, 1)
+
+	var windowLogs []HabitLog
+	for _, log := range logs {
+		if !log.LoggedAt.Before(first) && log.LoggedAt.Before(todayEnd) {
+			windowLogs = append(windowLogs, log)
+		}
+	}
+	sums := habit.sumByPeriod(windowLogs, period)
+
+	current := periodStart(period, todayStart)
+	since := habit.trackingStart(logs, todayStart)
+	met, total := 0, 0
+	for start := first; !start.After(current); start = nextPeriod(period, start) {
+		if habit.IsLimit() && start.Before(since) {
+			continue
+		}
+		reached := habit.periodReached(sums, start)
+		if habit.excused(period, start, reached) {
+			continue
+		}
+		if start.Equal(current) && period != StreakPeriodDay && !reached {
+			continue
+		}
+		total++
+		if reached {
+			met++
+		}
+	}
+
+	if total == 0 {
+		return 0.0
+	}
+	return (float64(met) / float64(total)) * 100.0
+}
+
+// excused reports whether the period starting at start is left out of
+// streaks and completion: a rest or paused day of a daily habit, or a week
+// or month with paused days whose goal was not met.
+func (h Habit) excused(period StreakPeriod, start time.Time, reached bool) bool {
+	if period == StreakPeriodDay {
+		return h.IsRestDay(start)
+	}
+	return !reached && h.pausedDays(start, nextPeriod(period, start)) > 0
+}
+
+func (h Habit) periodReached(sums map[string]float64, start time.Time) bool {
+	total, logged := sums[periodKey(start)]
+	return h.meetsGoal(total, logged)
+}
+
+func (h Habit) sumByPeriod(logs []HabitLog, period StreakPeriod) map[string]float64 {
+	sums := make(map[string]float64)
+	for _, log := range logs {
+		sums[periodKey(periodStart(period, log.LoggedAt))] += h.amount(log)
+	}
+	return sums
+}
+
+func periodKey(start time.Time) string {
+	return start.Format("2006-01-02")
+}
+
+func periodStart(period StreakPeriod, t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	switch period {
+	case StreakPeriodWeek:
+		daysFromMonday := (int(day.Weekday()) - int(time.Monday) + 7) % 7
+		return day.AddDate(0, 0, -daysFromMonday)
+	case StreakPeriodMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	default:
+		return day
+	}
+}
+
+func nextPeriod(period StreakPeriod, start time.Time) time.Time {
+	switch period {
+	case StreakPeriodWeek:
+		return start.AddDate(0, 0, 7)
+	case StreakPeriodMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 1)
+	}
+}
+
+func previousPeriod(period StreakPeriod, start time.Time) time.Time {
+	switch period {
+	case StreakPeriodWeek:
+		return start.AddDate(0, 0, -7)
+	case StreakPeriodMonth:
+		return start.AddDate(0, -1, 0)
+	default:
+		return start.AddDate(0, 0, -1)
+	}
+}
+
 func CalculateCompletion(logs []HabitLog, days int, today time.Time) float64 {
 	if days <= 0 {
 		return 0.0
@@ -197,3 +442,33 @@ func CalculateMonthlyProgress(logs []HabitLog, goalPerMonth int, date time.Time)
 	count := SumCountForMonth(logs, date)
 	return (float64(count) / float64(goalPerMonth)) * 100.0
 }
+
+// CalculateGoalWeeklyProgress is CalculateWeeklyProgress with the weekly
+// goal reduced in proportion to the days of the week the habit was paused.
+// For a limit habit it is the share of the weekly ceiling used.
+func CalculateGoalWeeklyProgress(habit Habit, logs []HabitLog, weekEnd time.Time) float64 {
+	end := time.Date(weekEnd.Year(), weekEnd.Month(), weekEnd.Day(), 0, 0, 0, 0, weekEnd.Location()).AddDate(0, 0, 1)
+	start := end.AddDate(0, 0, -7)
+	goal := habit.pausedGoal(habit.GoalPerWeek, 7, habit.pausedDays(start, end))
+	return CalculateWeeklyProgress(logs, goal, weekEnd)
+}
+
+// CalculateGoalMonthlyProgress is CalculateMonthlyProgress with the monthly
+// goal reduced in proportion to the days of the month the habit was paused.
+// For a limit habit it is the share of the monthly ceiling used.
+func CalculateGoalMonthlyProgress(habit Habit, logs []HabitLog, date time.Time) float64 {
+	start := time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	end := start.AddDate(0, 1, 0)
+	days := int(end.Sub(start).Hours()/24 + 0.5)
+	goal := habit.pausedGoal(habit.GoalPerMonth, days, habit.pausedDays(start, end))
+	return CalculateMonthlyProgress(logs, goal, date)
+}
+
+// pausedGoal scales a goal down to the unpaused share of its period. A
+// ceiling is left as it is: a pause is no reason to allow less.
+func (h Habit) pausedGoal(goal, days, paused int) int {
+	if paused == 0 || goal <= 0 || h.IsLimit() {
+		return goal
+	}
+	return (goal*(days-paused) + days - 1) / days
+}
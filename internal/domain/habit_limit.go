@@ -0,0 +1,64 @@
+package domain
+
+import "time"
+
+// IsLimit reports whether the habit is about doing less: its goal is a
+// ceiling, and a period is clean when its total stays at or under it, even
+// with nothing logged. Count habits keep their ceilings in the daily, weekly
+// or monthly goal; measured habits in their target.
+func (h Habit) IsLimit() bool {
+	return h.TargetType == HabitTargetAtMost
+}
+
+// Ceiling is the most a limit habit allows per streak period. Unlike a goal
+// it can be zero, as in "no alcohol".
+func (h Habit) Ceiling() float64 {
+	if h.IsMeasured() {
+		return h.Target
+	}
+	switch h.StreakPeriod() {
+	case StreakPeriodWeek:
+		return float64(h.GoalPerWeek)
+	case StreakPeriodMonth:
+		return float64(h.GoalPerMonth)
+	default:
+		return float64(h.GoalPerDay)
+	}
+}
+
+// PeriodTotal sums the logs of the streak period containing day, up to the
+// end of that day.
+func (h Habit) PeriodTotal(logs []HabitLog, day time.Time) float64 {
+	start := periodStart(h.StreakPeriod(), day)
+	end := truncateToDay(day).AddDate(0, 0, 1)
+	total := 0.0
+	for _, log := range logs {
+		if !log.LoggedAt.Before(start) && log.LoggedAt.Before(end) {
+			total += h.amount(log)
+		}
+	}
+	return total
+}
+
+// OverLimit reports whether a limit habit had gone over its ceiling by the
+// end of day.
+func (h Habit) OverLimit(logs []HabitLog, day time.Time) bool {
+	return h.IsLimit() && h.PeriodTotal(logs, day) > h.Ceiling()
+}
+
+// trackingStart is the start of the period in which the habit was created
+// or first logged, whichever is earlier, and at most today's. A limit habit
+// is clean on every day without logs, so its streak and completion must not
+// reach back before it.
+func (h Habit) trackingStart(logs []HabitLog, today time.Time) time.Time {
+	earliest := today
+	if !h.CreatedAt.IsZero() && h.CreatedAt.Before(earliest) {
+		earliest = h.CreatedAt
+	}
+	for _, log := range logs {
+		if log.LoggedAt.Before(earliest) {
+			earliest = log.LoggedAt
+		}
+	}
+	return periodStart(h.StreakPeriod(), earliest)
+}
@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHabit_Validate_LimitAllowsZeroCeiling(t *testing.T) {
+	habit := Habit{Name: "Alcohol", TargetType: HabitTargetAtMost}
+	assert.NoError(t, habit.Validate())
+	assert.True(t, habit.IsLimit())
+	assert.Equal(t, 0.0, habit.Ceiling())
+}
+
+func TestCalculateGoalStreak_LimitCountsCleanDays(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{
+		GoalPerDay: 2,
+		TargetType: HabitTargetAtMost,
+		CreatedAt:  today.AddDate(0, 0, -6),
+	}
+	logs := []HabitLog{
+		{Count: 2, LoggedAt: today.AddDate(0, 0, -1)},
+		{Count: 3, LoggedAt: today.AddDate(0, 0, -3)},
+	}
+
+	assert.Equal(t, 3, CalculateGoalStreak(habit, logs, today))
+}
+
+func TestCalculateGoalStreak_LimitStopsAtCreation(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{TargetType: HabitTargetAtMost, CreatedAt: today.AddDate(0, 0, -4)}
+
+	assert.Equal(t, 5, CalculateGoalStreak(habit, nil, today))
+}
+
+func TestCalculateGoalStreakSince_LimitStopsAtLogWindow(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{TargetType: HabitTargetAtMost, CreatedAt: today.AddDate(-2, 0, 0)}
+	since := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	// Logs from before since were not loaded, so those days are not counted.
+	assert.Equal(t, 4, CalculateGoalStreakSince(habit, nil, since, today))
+}
+
+func TestCalculateGoalStreakSince_WeeklyLimitSkipsPartialWeek(t *testing.T) {
+	// Saturday; the log window starts mid-week on Wednesday 2025-12-31.
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerWeek: 2, TargetType: HabitTargetAtMost, CreatedAt: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	since := time.Date(2025, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 1, CalculateGoalStreakSince(habit, nil, since, today))
+}
+
+func TestCalculateGoalStreak_LimitOverToday(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{TargetType: HabitTargetAtMost, CreatedAt: today.AddDate(0, 0, -4)}
+	logs := []HabitLog{{Count: 1, LoggedAt: today}}
+
+	assert.Equal(t, 0, CalculateGoalStreak(habit, logs, today))
+}
+
+func TestCalculateGoalStreak_WeeklyLimit(t *testing.T) {
+	// Saturday; the week started on Monday 2026-01-05.
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerWeek: 2, TargetType: HabitTargetAtMost, CreatedAt: time.Date(2025, 12, 15, 0, 0, 0, 0, time.UTC)}
+	logs := []HabitLog{
+		{Count: 2, LoggedAt: today.AddDate(0, 0, -1)},
+		{Count: 1, LoggedAt: today.AddDate(0, 0, -7)},
+		{Count: 2, LoggedAt: today.AddDate(0, 0, -8)},
+	}
+
+	assert.Equal(t, StreakPeriodWeek, habit.StreakPeriod())
+	assert.Equal(t, 1, CalculateGoalStreak(habit, logs, today))
+}
+
+func TestCalculateGoalCompletion_LimitSkipsDaysBeforeCreation(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerDay: 1, TargetType: HabitTargetAtMost, CreatedAt: today.AddDate(0, 0, -3)}
+	logs := []HabitLog{{Count: 2, LoggedAt: today.AddDate(0, 0, -1)}}
+
+	assert.Equal(t, 75.0, CalculateGoalCompletion(habit, logs, 7, today))
+}
+
+func TestHabit_OverLimit(t *testing.T) {
+	monday := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerWeek: 2, TargetType: HabitTargetAtMost}
+	logs := []HabitLog{
+		{Count: 2, LoggedAt: monday},
+		{Count: 1, LoggedAt: monday.AddDate(0, 0, 2)},
+	}
+
+	assert.False(t, habit.OverLimit(logs, monday))
+	assert.False(t, habit.OverLimit(logs, monday.AddDate(0, 0, 1)))
+	assert.True(t, habit.OverLimit(logs, monday.AddDate(0, 0, 2)))
+	assert.True(t, habit.OverLimit(logs, monday.AddDate(0, 0, 4)))
+	assert.False(t, habit.OverLimit(logs, monday.AddDate(0, 0, 7)))
+	assert.Equal(t, 3.0, habit.PeriodTotal(logs, monday.AddDate(0, 0, 4)))
+}
+
+func TestHabit_OverLimit_NotALimit(t *testing.T) {
+	day := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerDay: 1}
+	logs := []HabitLog{{Count: 5, LoggedAt: day}}
+
+	assert.False(t, habit.OverLimit(logs, day))
+}
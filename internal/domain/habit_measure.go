@@ -0,0 +1,161 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HabitKind says how a habit is logged. Count habits log whole completions;
+// measured habits log a decimal value in a unit, such as "5.2 km".
+type HabitKind string
+
+const (
+	HabitKindCount    HabitKind = "count"
+	HabitKindMeasured HabitKind = "measured"
+)
+
+// HabitTargetType says whether a measured habit's daily target is a minimum
+// ("run at least 5 km") or a maximum ("at most 2 h of screen time").
+type HabitTargetType string
+
+const (
+	HabitTargetAtLeast HabitTargetType = "at_least"
+	HabitTargetAtMost  HabitTargetType = "at_most"
+)
+
+func (h Habit) IsMeasured() bool {
+	return h.Kind == HabitKindMeasured
+}
+
+// DescribeTarget renders a measured habit's target, e.g. "≥ 5 km/day".
+func (h Habit) DescribeTarget() string {
+	sign := "≥"
+	if h.TargetType == HabitTargetAtMost {
+		sign = "≤"
+	}
+	return fmt.Sprintf("%s %s/day", sign, FormatHabitValue(h.Target, h.Unit))
+}
+
+// amount is what a log adds towards the habit's goal: its value for a
+// measured habit and its count otherwise.
+func (h Habit) amount(log HabitLog) float64 {
+	if h.IsMeasured() {
+		return log.Value
+	}
+	return float64(log.Count)
+}
+
+// meetsGoal reports whether a period's total reaches the habit's goal, or
+// for a limit habit stays within its ceiling. A measured habit with a
+// minimum only meets it on periods with a logged value.
+func (h Habit) meetsGoal(total float64, logged bool) bool {
+	if h.IsLimit() {
+		return total <= h.Ceiling()
+	}
+	if !h.IsMeasured() {
+		return total >= float64(h.streakGoal())
+	}
+	return logged && total >= h.Target
+}
+
+var habitAmountPattern = regexp.MustCompile(`^(\d+(?:\.\d+)?|\.\d+)\s*([^\d\s.].*)?$`)
+
+// ParseHabitAmount splits an amount such as "5.2km", "35 min" or "2.5" into
+// its value and unit. The unit is empty when none is given.
+func ParseHabitAmount(s string) (float64, string, error) {
+	m := habitAmountPattern.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return 0, "", Invalidf("invalid amount: %s", s)
+	}
+	value, err := strconv.ParseFloat(m[1], 64)
+	if err != nil {
+		return 0, "", Invalidf("invalid amount: %s", s)
+	}
+	return value, strings.TrimSpace(m[2]), nil
+}
+
+// FormatHabitValue renders a value with its unit, dropping decimals from
+// whole numbers: "5 km", "2.5 L", "35".
+func FormatHabitValue(value float64, unit string) string {
+	text := strconv.FormatFloat(math.Round(value*100)/100, 'f', -1, 64)
+	if unit == "" {
+		return text
+	}
+	return text + " " + unit
+}
+
+// HabitValueSummary aggregates the values of a measured habit over the days
+// it was logged.
+type HabitValueSummary struct {
+	Total   float64
+	Average float64
+	Max     float64
+	Days    int
+}
+
+// Format renders the summary in a unit, e.g.
+// "42.5 km total, 6.1 km avg, 10 km best".
+func (s HabitValueSummary) Format(unit string) string {
+	return fmt.Sprintf("%s total, %s avg, %s best",
+		FormatHabitValue(s.Total, unit), FormatHabitValue(s.Average, unit), FormatHabitValue(s.Max, unit))
+}
+
+// SummarizeHabitValues totals the habit's logs per day and returns the sum
+// over all days, the average of a logged day and the best day.
+func SummarizeHabitValues(habit Habit, logs []HabitLog) HabitValueSummary {
+	days := make(map[string]float64)
+	for _, log := range logs {
+		days[log.LoggedAt.Format("2006-01-02")] += habit.amount(log)
+	}
+
+	var summary HabitValueSummary
+	for _, total := range days {
+		summary.Total += total
+		summary.Max = math.Max(summary.Max, total)
+	}
+	summary.Days = len(days)
+	if summary.Days > 0 {
+		summary.Average = summary.Total / float64(summary.Days)
+	}
+	return summary
+}
+
+// DayProgress is the share of the habit's daily goal met on day, from 0 to
+// 1. A limit habit's day counts as met while it stays within its ceiling.
+func (h Habit) DayProgress(logs []HabitLog, day time.Time) float64 {
+	if h.IsLimit() {
+		if h.OverLimit(logs, day) {
+			return 0
+		}
+		return 1
+	}
+
+	total := 0.0
+	for _, log := range GetLogsForDay(logs, day) {
+		total += h.amount(log)
+	}
+	goal := float64(h.GoalPerDay)
+	if h.IsMeasured() {
+		goal = h.Target
+	}
+	if goal <= 0 {
+		if total > 0 {
+			return 1
+		}
+		return 0
+	}
+	return math.Min(total/goal, 1)
+}
+
+// SumValueForDay is SumCountForDay for a measured habit's values.
+func SumValueForDay(habit Habit, logs []HabitLog, day time.Time) float64 {
+	sum := 0.0
+	for _, log := range GetLogsForDay(logs, day) {
+		sum += habit.amount(log)
+	}
+	return sum
+}
@@ -0,0 +1,111 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHabitAmount(t *testing.T) {
+	tests := []struct {
+		input string
+		value float64
+		unit  string
+	}{
+		{"5", 5, ""},
+		{"5.2km", 5.2, "km"},
+		{"35 min", 35, "min"},
+		{"2.5 L", 2.5, "L"},
+		{".5h", 0.5, "h"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			value, unit, err := ParseHabitAmount(tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+			assert.Equal(t, tt.unit, unit)
+		})
+	}
+}
+
+func TestParseHabitAmount_Invalid(t *testing.T) {
+	for _, input := range []string{"", "km", "-5km", "5.2.1", "abc"} {
+		t.Run(input, func(t *testing.T) {
+			_, _, err := ParseHabitAmount(input)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestFormatHabitValue(t *testing.T) {
+	assert.Equal(t, "5 km", FormatHabitValue(5, "km"))
+	assert.Equal(t, "2.5 L", FormatHabitValue(2.5, "L"))
+	assert.Equal(t, "0.33", FormatHabitValue(1.0/3, ""))
+}
+
+func TestSummarizeHabitValues(t *testing.T) {
+	habit := Habit{Kind: HabitKindMeasured, Unit: "km"}
+	day := func(d int) time.Time { return time.Date(2026, 1, d, 8, 0, 0, 0, time.UTC) }
+	logs := []HabitLog{
+		{Count: 1, Value: 3, LoggedAt: day(5)},
+		{Count: 1, Value: 2.5, LoggedAt: day(5).Add(time.Hour)},
+		{Count: 1, Value: 4, LoggedAt: day(6)},
+	}
+
+	summary := SummarizeHabitValues(habit, logs)
+
+	assert.Equal(t, 9.5, summary.Total)
+	assert.Equal(t, 4.75, summary.Average)
+	assert.Equal(t, 5.5, summary.Max)
+	assert.Equal(t, 2, summary.Days)
+	assert.Equal(t, "9.5 km total, 4.75 km avg, 5.5 km best", summary.Format("km"))
+}
+
+func TestCalculateGoalStreak_MeasuredAtLeast(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{Kind: HabitKindMeasured, Unit: "km", Target: 5, TargetType: HabitTargetAtLeast}
+	logs := []HabitLog{
+		{Count: 1, Value: 5.2, LoggedAt: today},
+		{Count: 1, Value: 3, LoggedAt: today.AddDate(0, 0, -1)},
+		{Count: 1, Value: 2, LoggedAt: today.AddDate(0, 0, -1)},
+		{Count: 1, Value: 4.9, LoggedAt: today.AddDate(0, 0, -2)},
+	}
+
+	assert.Equal(t, 2, CalculateGoalStreak(habit, logs, today))
+}
+
+func TestCalculateGoalStreak_MeasuredAtMost(t *testing.T) {
+	today := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	habit := Habit{Kind: HabitKindMeasured, Unit: "h", Target: 2, TargetType: HabitTargetAtMost}
+	logs := []HabitLog{
+		{Count: 1, Value: 1.5, LoggedAt: today},
+		{Count: 1, Value: 2, LoggedAt: today.AddDate(0, 0, -1)},
+		{Count: 1, Value: 3, LoggedAt: today.AddDate(0, 0, -2)},
+	}
+
+	assert.Equal(t, 2, CalculateGoalStreak(habit, logs, today))
+}
+
+func TestHabitLog_Validate_NegativeValue(t *testing.T) {
+	log := HabitLog{HabitID: 1, Count: 1, Value: -1, LoggedAt: time.Now()}
+	assert.Error(t, log.Validate())
+}
+
+func TestHabit_DayProgress(t *testing.T) {
+	day := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+
+	count := Habit{GoalPerDay: 4}
+	assert.Equal(t, 0.5, count.DayProgress([]HabitLog{{Count: 2, LoggedAt: day}}, day))
+	assert.Equal(t, 1.0, count.DayProgress([]HabitLog{{Count: 6, LoggedAt: day}}, day))
+	assert.Equal(t, 0.0, count.DayProgress(nil, day))
+
+	measured := Habit{Kind: HabitKindMeasured, Unit: "km", Target: 5, TargetType: HabitTargetAtLeast}
+	assert.Equal(t, 0.5, measured.DayProgress([]HabitLog{{Count: 1, Value: 2.5, LoggedAt: day}}, day))
+
+	limit := Habit{GoalPerDay: 2, TargetType: HabitTargetAtMost}
+	assert.Equal(t, 1.0, limit.DayProgress([]HabitLog{{Count: 2, LoggedAt: day}}, day))
+	assert.Equal(t, 0.0, limit.DayProgress([]HabitLog{{Count: 3, LoggedAt: day}}, day))
+}
@@ -0,0 +1,72 @@
+package domain
+
+import (
+	"time"
+)
+
+// HabitPause excuses a habit for a range of days, e.g. while ill or
+// travelling. Paused days are neither missed nor counted, so they do not
+// break a streak. A pause without a habit applies to every habit (vacation
+// mode); a pause of a single day is a skip.
+type HabitPause struct {
+	ID            int64
+	HabitEntityID EntityID
+	From          time.Time
+	To            time.Time
+	CreatedAt     time.Time
+}
+
+func (p HabitPause) Validate() error {
+	if p.From.IsZero() || p.To.IsZero() {
+		return Invalidf("pause must have a start and end date")
+	}
+	if truncateToDay(p.To).Before(truncateToDay(p.From)) {
+		return Invalidf("pause cannot end before it starts")
+	}
+	return nil
+}
+
+func (p HabitPause) IsGlobal() bool {
+	return p.HabitEntityID.IsEmpty()
+}
+
+func (p HabitPause) IsSkip() bool {
+	return truncateToDay(p.From).Equal(truncateToDay(p.To))
+}
+
+func (p HabitPause) AppliesTo(habit Habit) bool {
+	return p.IsGlobal() || p.HabitEntityID == habit.EntityID
+}
+
+func (p HabitPause) Covers(day time.Time) bool {
+	d := truncateToDay(day)
+	return !d.Before(truncateToDay(p.From)) && !d.After(truncateToDay(p.To))
+}
+
+// IsPaused reports whether a pause of the habit covers the day.
+func (h Habit) IsPaused(day time.Time) bool {
+	for _, p := range h.Pauses {
+		if p.AppliesTo(h) && p.Covers(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsRestDay reports whether the habit is not due on the day, either because
+// the day falls outside its schedule or because the habit is paused.
+func (h Habit) IsRestDay(day time.Time) bool {
+	return !h.Schedule.IsScheduled(day) || h.IsPaused(day)
+}
+
+// pausedDays counts the paused days from start up to, but not including,
+// end.
+func (h Habit) pausedDays(start, end time.Time) int {
+	n := 0
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		if h.IsPaused(day) {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHabitPause_Validate(t *testing.T) {
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, HabitPause{From: day, To: day}.Validate())
+	assert.NoError(t, HabitPause{From: day, To: day.AddDate(0, 0, 3)}.Validate())
+	assert.Error(t, HabitPause{From: day, To: day.AddDate(0, 0, -1)}.Validate())
+	assert.Error(t, HabitPause{From: day}.Validate())
+}
+
+func TestHabitPause_Covers(t *testing.T) {
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	pause := HabitPause{From: from, To: from.AddDate(0, 0, 2)}
+
+	assert.False(t, pause.Covers(from.AddDate(0, 0, -1).Add(23*time.Hour)))
+	assert.True(t, pause.Covers(from))
+	assert.True(t, pause.Covers(from.AddDate(0, 0, 2).Add(20*time.Hour)))
+	assert.False(t, pause.Covers(from.AddDate(0, 0, 3)))
+}
+
+func TestHabit_IsPaused(t *testing.T) {
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	gym := Habit{EntityID: NewEntityID()}
+	other := NewEntityID()
+
+	gym.Pauses = []HabitPause{{HabitEntityID: other, From: day, To: day}}
+	assert.False(t, gym.IsPaused(day))
+
+	gym.Pauses = []HabitPause{{HabitEntityID: gym.EntityID, From: day, To: day}}
+	assert.True(t, gym.IsPaused(day))
+
+	gym.Pauses = []HabitPause{{From: day, To: day}}
+	assert.True(t, gym.IsPaused(day))
+	assert.True(t, gym.IsRestDay(day))
+	assert.False(t, gym.IsRestDay(day.AddDate(0, 0, 1)))
+}
+
+func TestCalculateGoalStreak_Pauses(t *testing.T) {
+	// Tuesday
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	t.Run("daily streak carries on across a pause", func(t *testing.T) {
+		habit := Habit{GoalPerDay: 1, Pauses: []HabitPause{{From: today.AddDate(0, 0, -4), To: today.AddDate(0, 0, -2)}}}
+		logs := []HabitLog{
+			{Count: 1, LoggedAt: today},
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -5)},
+		}
+		assert.Equal(t, 3, CalculateGoalStreak(habit, logs, today))
+		assert.InDelta(t, 75.0, CalculateGoalCompletion(habit, logs, 7, today), 0.01)
+	})
+
+	t.Run("a paused week that missed its goal does not break a weekly streak", func(t *testing.T) {
+		habit := Habit{GoalPerDay: 1, GoalPerWeek: 3, Pauses: []HabitPause{{From: today.AddDate(0, 0, -8), To: today.AddDate(0, 0, -6)}}}
+		logs := []HabitLog{
+			// week of Dec 29, paused Dec 29 - Dec 31
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -4)},
+			// week of Dec 22
+			{Count: 3, LoggedAt: today.AddDate(0, 0, -14)},
+		}
+		assert.Equal(t, 1, CalculateGoalStreak(habit, logs, today))
+	})
+}
+
+func TestCalculateGoalWeeklyProgress_Pauses(t *testing.T) {
+	today := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerWeek: 7}
+	logs := []HabitLog{
+		{Count: 3, LoggedAt: today},
+		{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+	}
+
+	assert.InDelta(t, 57.14, CalculateGoalWeeklyProgress(habit, logs, today), 0.01)
+
+	habit.Pauses = []HabitPause{{From: today.AddDate(0, 0, -6), To: today.AddDate(0, 0, -4)}}
+	assert.InDelta(t, 100.0, CalculateGoalWeeklyProgress(habit, logs, today), 0.01)
+}
+
+func TestCalculateGoalMonthlyProgress_Pauses(t *testing.T) {
+	// January has 31 days
+	today := time.Date(2026, 1, 20, 12, 0, 0, 0, time.UTC)
+	habit := Habit{GoalPerMonth: 10}
+	logs := []HabitLog{{Count: 5, LoggedAt: today}}
+
+	assert.InDelta(t, 50.0, CalculateGoalMonthlyProgress(habit, logs, today), 0.01)
+
+	habit.Pauses = []HabitPause{{From: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), To: time.Date(2026, 1, 16, 0, 0, 0, 0, time.UTC)}}
+	// 15 of 31 days left: the goal shrinks to 5
+	assert.InDelta(t, 100.0, CalculateGoalMonthlyProgress(habit, logs, today), 0.01)
+}
@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HabitSchedule limits the days a habit is due, either to a set of weekdays
+// or to every N days counted from Start. Days outside the schedule are rest
+// days: they are neither missed nor counted. The zero value is every day.
+type HabitSchedule struct {
+	Weekdays  []time.Weekday
+	EveryDays int
+	Start     time.Time
+}
+
+func (s HabitSchedule) IsDaily() bool {
+	return len(s.Weekdays) == 0 && s.EveryDays <= 1
+}
+
+func (s HabitSchedule) IsScheduled(day time.Time) bool {
+	if len(s.Weekdays) > 0 {
+		return containsWeekday(s.Weekdays, day.Weekday())
+	}
+	if s.EveryDays > 1 {
+		days := int(truncateToDay(day).Sub(truncateToDay(s.Start)).Hours() / 24)
+		return ((days%s.EveryDays)+s.EveryDays)%s.EveryDays == 0
+	}
+	return true
+}
+
+// String is the stored form of the schedule, which ParseHabitSchedule reads
+// back: "" for every day, "mon,wed,fri", or "every 3 days from 2026-01-05".
+func (s HabitSchedule) String() string {
+	if len(s.Weekdays) > 0 {
+		return s.weekdayList()
+	}
+	if s.EveryDays > 1 {
+		return fmt.Sprintf("every %d days from %s", s.EveryDays, s.Start.Format("2006-01-02"))
+	}
+	return ""
+}
+
+// Describe renders the schedule for humans, e.g. "mon,wed,fri" or
+// "every 3 days".
+func (s HabitSchedule) Describe() string {
+	if len(s.Weekdays) > 0 {
+		return s.weekdayList()
+	}
+	if s.EveryDays > 1 {
+		return fmt.Sprintf("every %d days", s.EveryDays)
+	}
+	return "daily"
+}
+
+func (s HabitSchedule) weekdayList() string {
+	weekdays := append([]time.Weekday(nil), s.Weekdays...)
+	sort.Slice(weekdays, func(i, j int) bool {
+		return (weekdays[i]+6)%7 < (weekdays[j]+6)%7
+	})
+	names := make([]string, len(weekdays))
+	for i, wd := range weekdays {
+		names[i] = strings.ToLower(wd.String()[:3])
+	}
+	return strings.Join(names, ",")
+}
+
+// ParseHabitSchedule accepts "daily", "weekdays", "weekends", a list of
+// weekdays such as "mon,wed,fri", or "every N days" with an optional
+// "from YYYY-MM-DD". Every-N-day schedules start today unless a start is
+// given.
+func ParseHabitSchedule(s string, today time.Time) (HabitSchedule, error) {
+	input := strings.ToLower(strings.TrimSpace(s))
+
+	switch input {
+	case "", "daily", "every day", "none":
+		return HabitSchedule{}, nil
+	case "weekdays":
+		return HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday}}, nil
+	case "weekends":
+		return HabitSchedule{Weekdays: []time.Weekday{time.Saturday, time.Sunday}}, nil
+	case "every other day":
+		return HabitSchedule{EveryDays: 2, Start: truncateToDay(today)}, nil
+	}
+
+	if strings.HasPrefix(input, "every ") {
+		return parseEveryDaysSchedule(input, today)
+	}
+
+	var weekdays []time.Weekday
+	for _, name := range strings.Split(input, ",") {
+		wd, ok := weekdayNames[strings.TrimSpace(name)]
+		if !ok {
+			return HabitSchedule{}, Invalidf("invalid schedule: %s", s)
+		}
+		if !containsWeekday(weekdays, wd) {
+			weekdays = append(weekdays, wd)
+		}
+	}
+	return HabitSchedule{Weekdays: weekdays}, nil
+}
+
+func parseEveryDaysSchedule(input string, today time.Time) (HabitSchedule, error) {
+	rule, from, hasFrom := strings.Cut(strings.TrimPrefix(input, "every "), " from ")
+	fields := strings.Fields(rule)
+	if len(fields) != 2 || strings.TrimSuffix(fields[1], "s") != "day" {
+		return HabitSchedule{}, Invalidf("invalid schedule: %s", input)
+	}
+	n, err := strconv.Atoi(fields[0])
+	if err != nil || n < 1 {
+		return HabitSchedule{}, Invalidf("invalid schedule interval: %s", fields[0])
+	}
+	if n == 1 {
+		return HabitSchedule{}, nil
+	}
+
+	start := truncateToDay(today)
+	if hasFrom {
+		start, err = time.Parse("2006-01-02", strings.TrimSpace(from))
+		if err != nil {
+			return HabitSchedule{}, Invalidf("invalid schedule start: %s", from)
+		}
+	}
+	return HabitSchedule{EveryDays: n, Start: start}, nil
+}
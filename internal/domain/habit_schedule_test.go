@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseHabitSchedule(t *testing.T) {
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input    string
+		expected HabitSchedule
+	}{
+		{"", HabitSchedule{}},
+		{"daily", HabitSchedule{}},
+		{"every 1 day", HabitSchedule{}},
+		{"mon,wed,fri", HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}},
+		{"Mon, Wed, Mon", HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday}}},
+		{"weekends", HabitSchedule{Weekdays: []time.Weekday{time.Saturday, time.Sunday}}},
+		{"every other day", HabitSchedule{EveryDays: 2, Start: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)}},
+		{"every 3 days", HabitSchedule{EveryDays: 3, Start: time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)}},
+		{"every 3 days from 2026-01-01", HabitSchedule{EveryDays: 3, Start: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			schedule, err := ParseHabitSchedule(tt.input, today)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, schedule)
+		})
+	}
+}
+
+func TestParseHabitSchedule_Invalid(t *testing.T) {
+	today := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	for _, input := range []string{"mon,funday", "every 0 days", "every 3 weeks", "every 3 days from tomorrow"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := ParseHabitSchedule(input, today)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHabitSchedule_IsScheduled(t *testing.T) {
+	// Tuesday
+	tuesday := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	weekdays := HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}
+	assert.False(t, weekdays.IsScheduled(tuesday))
+	assert.True(t, weekdays.IsScheduled(tuesday.AddDate(0, 0, 1)))
+
+	everyThree := HabitSchedule{EveryDays: 3, Start: tuesday}
+	assert.True(t, everyThree.IsScheduled(tuesday))
+	assert.False(t, everyThree.IsScheduled(tuesday.AddDate(0, 0, 1)))
+	assert.True(t, everyThree.IsScheduled(tuesday.AddDate(0, 0, 3)))
+	assert.True(t, everyThree.IsScheduled(tuesday.AddDate(0, 0, -3)))
+	assert.False(t, everyThree.IsScheduled(tuesday.AddDate(0, 0, -1)))
+
+	assert.True(t, HabitSchedule{}.IsScheduled(tuesday))
+}
+
+func TestHabitSchedule_StringRoundTrips(t *testing.T) {
+	today := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+
+	for _, input := range []string{"", "fri,mon", "every 4 days from 2025-12-30"} {
+		schedule, err := ParseHabitSchedule(input, today)
+		require.NoError(t, err)
+
+		parsed, err := ParseHabitSchedule(schedule.String(), time.Now())
+		require.NoError(t, err)
+		assert.Equal(t, schedule.Describe(), parsed.Describe())
+		assert.Equal(t, schedule.String(), parsed.String())
+	}
+
+	schedule, _ := ParseHabitSchedule("fri,mon", today)
+	assert.Equal(t, "mon,fri", schedule.Describe())
+	assert.Equal(t, "daily", HabitSchedule{}.Describe())
+	assert.Equal(t, "every 2 days", HabitSchedule{EveryDays: 2, Start: today}.Describe())
+}
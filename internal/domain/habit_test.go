@@ -205,6 +205,176 @@ func TestCalculateStreak(t *testing.T) {
 	}
 }
 
+func TestHabit_StreakPeriod(t *testing.T) {
+	assert.Equal(t, StreakPeriodDay, Habit{GoalPerDay: 1}.StreakPeriod())
+	assert.Equal(t, StreakPeriodWeek, Habit{GoalPerDay: 1, GoalPerWeek: 3}.StreakPeriod())
+	assert.Equal(t, StreakPeriodMonth, Habit{GoalPerDay: 1, GoalPerMonth: 10}.StreakPeriod())
+	assert.Equal(t, StreakPeriodWeek, Habit{GoalPerWeek: 3, GoalPerMonth: 10}.StreakPeriod())
+}
+
+func TestStreakPeriod_Format(t *testing.T) {
+	assert.Equal(t, "1 day", StreakPeriodDay.Format(1))
+	assert.Equal(t, "0 days", StreakPeriodDay.Format(0))
+	assert.Equal(t, "3 weeks", StreakPeriodWeek.Format(3))
+	assert.Equal(t, "2 months", StreakPeriodMonth.Format(2))
+	assert.Equal(t, "5 days", StreakPeriod("").Format(5))
+}
+
+func TestCalculateGoalStreak(t *testing.T) {
+	// Tuesday
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		habit    Habit
+		logs     []HabitLog
+		expected int
+	}{
+		{
+			name:  "daily streak only counts days meeting the daily goal",
+			habit: Habit{GoalPerDay: 2},
+			logs: []HabitLog{
+				{Count: 2, LoggedAt: today},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -1).Add(time.Hour)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -2)},
+			},
+			expected: 2,
+		},
+		{
+			name:  "unfinished today does not break the daily streak",
+			habit: Habit{GoalPerDay: 2},
+			logs: []HabitLog{
+				{Count: 1, LoggedAt: today},
+				{Count: 2, LoggedAt: today.AddDate(0, 0, -1)},
+				{Count: 3, LoggedAt: today.AddDate(0, 0, -2)},
+			},
+			expected: 2,
+		},
+		{
+			name:  "weekly habit keeps its streak across rest days",
+			habit: Habit{GoalPerDay: 1, GoalPerWeek: 3},
+			logs: []HabitLog{
+				// week of Dec 29
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -8)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -6)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -4)},
+				// week of Dec 22
+				{Count: 3, LoggedAt: today.AddDate(0, 0, -14)},
+			},
+			expected: 2,
+		},
+		{
+			name:  "current week counts once its goal is met",
+			habit: Habit{GoalPerDay: 1, GoalPerWeek: 2},
+			logs: []HabitLog{
+				{Count: 1, LoggedAt: today},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+				{Count: 2, LoggedAt: today.AddDate(0, 0, -7)},
+			},
+			expected: 2,
+		},
+		{
+			name:  "a missed week breaks the streak",
+			habit: Habit{GoalPerDay: 1, GoalPerWeek: 2},
+			logs: []HabitLog{
+				{Count: 2, LoggedAt: today.AddDate(0, 0, -14)},
+			},
+			expected: 0,
+		},
+		{
+			name:  "scheduled habit keeps its streak across rest days",
+			habit: Habit{GoalPerDay: 1, Schedule: HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}},
+			logs: []HabitLog{
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -4)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -6)},
+			},
+			expected: 3,
+		},
+		{
+			name:  "a missed scheduled day breaks the streak",
+			habit: Habit{GoalPerDay: 1, Schedule: HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}},
+			logs: []HabitLog{
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+				{Count: 1, LoggedAt: today.AddDate(0, 0, -6)},
+			},
+			expected: 1,
+		},
+		{
+			name:  "monthly habit counts months meeting the goal",
+			habit: Habit{GoalPerDay: 1, GoalPerMonth: 4},
+			logs: []HabitLog{
+				{Count: 1, LoggedAt: today},
+				{Count: 4, LoggedAt: time.Date(2025, 12, 10, 0, 0, 0, 0, time.UTC)},
+				{Count: 5, LoggedAt: time.Date(2025, 11, 2, 0, 0, 0, 0, time.UTC)},
+				{Count: 1, LoggedAt: time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC)},
+			},
+			expected: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CalculateGoalStreak(tt.habit, tt.logs, today))
+		})
+	}
+}
+
+func TestCalculateGoalCompletion(t *testing.T) {
+	// Tuesday
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	t.Run("daily habit counts days meeting the goal", func(t *testing.T) {
+		habit := Habit{GoalPerDay: 2}
+		logs := []HabitLog{
+			{Count: 2, LoggedAt: today},
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+			{Count: 3, LoggedAt: today.AddDate(0, 0, -2)},
+			{Count: 2, LoggedAt: today.AddDate(0, 0, -10)},
+		}
+		assert.InDelta(t, 50.0, CalculateGoalCompletion(habit, logs, 4, today), 0.01)
+	})
+
+	t.Run("weekly habit skips the unfinished current week", func(t *testing.T) {
+		habit := Habit{GoalPerDay: 1, GoalPerWeek: 3}
+		logs := []HabitLog{
+			{Count: 1, LoggedAt: today},
+			{Count: 3, LoggedAt: today.AddDate(0, 0, -7)},
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -14)},
+		}
+		// weeks of Dec 22 (missed) and Dec 29 (met); the current week is unfinished
+		assert.InDelta(t, 50.0, CalculateGoalCompletion(habit, logs, 15, today), 0.01)
+	})
+
+	t.Run("weekly habit sums the whole week the window starts in", func(t *testing.T) {
+		wednesday := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+		habit := Habit{GoalPerDay: 1, GoalPerWeek: 3}
+		logs := []HabitLog{
+			{Count: 1, LoggedAt: time.Date(2025, 12, 29, 9, 0, 0, 0, time.UTC)},
+			{Count: 1, LoggedAt: time.Date(2025, 12, 30, 9, 0, 0, 0, time.UTC)},
+			{Count: 1, LoggedAt: time.Date(2025, 12, 31, 9, 0, 0, 0, time.UTC)},
+		}
+		// the window starts on Thursday Jan 1, but the week of Dec 29 was met
+		assert.Equal(t, 1, CalculateGoalStreak(habit, logs, wednesday))
+		assert.InDelta(t, 100.0, CalculateGoalCompletion(habit, logs, 7, wednesday), 0.01)
+	})
+
+	t.Run("scheduled habit only counts scheduled days", func(t *testing.T) {
+		habit := Habit{GoalPerDay: 1, Schedule: HabitSchedule{Weekdays: []time.Weekday{time.Monday, time.Wednesday, time.Friday}}}
+		logs := []HabitLog{
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+			{Count: 1, LoggedAt: today.AddDate(0, 0, -6)},
+		}
+		// Wed Dec 31 (met), Fri Jan 2 (missed) and Mon Jan 5 (met)
+		assert.InDelta(t, 66.67, CalculateGoalCompletion(habit, logs, 7, today), 0.01)
+	})
+
+	t.Run("zero days returns 0%", func(t *testing.T) {
+		assert.Equal(t, 0.0, CalculateGoalCompletion(Habit{GoalPerDay: 1}, nil, 0, today))
+	})
+}
+
 func TestCalculateCompletion(t *testing.T) {
 	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
 
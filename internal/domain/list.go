@@ -1,15 +1,18 @@
 package domain
 
 import (
-	"errors"
 	"strings"
 	"time"
 )
 
+// List is a named collection of items kept apart from the journal. A smart
+// list has a Query instead: its items are the journal entries matching the
+// query, worked out each time the list is read.
 type List struct {
 	ID        int64
 	EntityID  EntityID
 	Name      string
+	Query     string
 	CreatedAt time.Time
 }
 
@@ -21,9 +24,37 @@ func NewList(name string) List {
 	}
 }
 
+// NewSmartList returns a list whose items are the entries matching query,
+// written in the search query language.
+func NewSmartList(name, query string) List {
+	list := NewList(name)
+	list.Query = strings.TrimSpace(query)
+	return list
+}
+
+func (l List) IsSmart() bool {
+	return l.Query != ""
+}
+
 func (l List) Validate() error {
 	if strings.TrimSpace(l.Name) == "" {
-		return errors.New("list name cannot be empty")
+		return Invalidf("list name cannot be empty")
+	}
+	if l.IsSmart() {
+		return ValidateSmartListQuery(l.Query)
+	}
+	return nil
+}
+
+// ValidateSmartListQuery checks that query parses and filters on something,
+// so a smart list never matches the whole journal.
+func ValidateSmartListQuery(query string) error {
+	q, err := ParseSearchQuery(query)
+	if err != nil {
+		return Invalidf("invalid smart list query: %w", err)
+	}
+	if q.IsEmpty() {
+		return Invalidf("smart list query cannot be empty")
 	}
 	return nil
 }
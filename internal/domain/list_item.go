@@ -1,7 +1,7 @@
 package domain
 
 import (
-	"errors"
+	"sort"
 	"time"
 )
 
@@ -11,12 +11,14 @@ const (
 	ListItemTypeTask      ListItemType = "task"
 	ListItemTypeDone      ListItemType = "done"
 	ListItemTypeCancelled ListItemType = "cancelled"
+	ListItemTypeScheduled ListItemType = "scheduled"
 )
 
 var validListItemTypes = map[ListItemType]string{
 	ListItemTypeTask:      ".",
 	ListItemTypeDone:      "x",
 	ListItemTypeCancelled: "X",
+	ListItemTypeScheduled: "<",
 }
 
 func (t ListItemType) IsValid() bool {
@@ -28,12 +30,25 @@ func (t ListItemType) Symbol() string {
 	return validListItemTypes[t]
 }
 
+// ListItem is an item of a list. Items can be nested under another item of
+// the same list; SortOrder orders an item among its siblings. DueDate is
+// the day the item is due, if it has a deadline. Tags and Mentions are
+// extracted from the content, and Depth is set by OrderListItems. EntryID
+// is only set on the items of smart lists, which are journal entries.
 type ListItem struct {
 	VersionInfo
-	ListEntityID EntityID
-	Type         ListItemType
-	Content      string
-	CreatedAt    time.Time
+	ListEntityID   EntityID
+	ParentEntityID *EntityID
+	SortOrder      int
+	Priority       Priority
+	Type           ListItemType
+	Content        string
+	CreatedAt      time.Time
+	DueDate        *time.Time
+	Tags           []string
+	Mentions       []string
+	Depth          int
+	EntryID        int64
 }
 
 func NewListItem(listEntityID EntityID, itemType ListItemType, content string) ListItem {
@@ -45,25 +60,96 @@ func NewListItem(listEntityID EntityID, itemType ListItemType, content string) L
 			OpType:    OpTypeInsert,
 		},
 		ListEntityID: listEntityID,
+		Priority:     PriorityNone,
 		Type:         itemType,
 		Content:      content,
 		CreatedAt:    time.Now(),
+		Tags:         ExtractTags(content),
+		Mentions:     ExtractMentions(content),
 	}
 }
 
 func (li ListItem) Validate() error {
 	if li.ListEntityID.IsEmpty() {
-		return errors.New("list entity ID is required")
+		return Invalidf("list entity ID is required")
 	}
 	if !li.Type.IsValid() {
-		return errors.New("invalid list item type")
+		return Invalidf("invalid list item type")
 	}
 	if li.Content == "" {
-		return errors.New("content cannot be empty")
+		return Invalidf("content cannot be empty")
+	}
+	if li.Priority != "" && !li.Priority.IsValid() {
+		return Invalidf("invalid priority: must be none, low, medium, or high")
 	}
 	return nil
 }
 
+// SetContent changes the item's content along with the tags and mentions
+// extracted from it.
+func (li *ListItem) SetContent(content string) {
+	li.Content = content
+	li.Tags = ExtractTags(content)
+	li.Mentions = ExtractMentions(content)
+}
+
+func (li ListItem) HasParent() bool {
+	return li.ParentEntityID != nil && !li.ParentEntityID.IsEmpty()
+}
+
+// OrderListItems arranges a list's items as a tree: every item is followed
+// by its children, and siblings are ordered by SortOrder. Depth is set on
+// each item. Items whose parent is not among items are treated as top-level.
+func OrderListItems(items []ListItem) []ListItem {
+	present := make(map[EntityID]bool, len(items))
+	for _, item := range items {
+		present[item.EntityID] = true
+	}
+
+	children := make(map[EntityID][]ListItem)
+	var roots []ListItem
+	for _, item := range items {
+		if item.HasParent() && present[*item.ParentEntityID] && *item.ParentEntityID != item.EntityID {
+			children[*item.ParentEntityID] = append(children[*item.ParentEntityID], item)
+			continue
+		}
+		roots = append(roots, item)
+	}
+
+	ordered := make([]ListItem, 0, len(items))
+	visited := make(map[EntityID]bool, len(items))
+	var walk func(siblings []ListItem, depth int)
+	walk = func(siblings []ListItem, depth int) {
+		sortSiblings(siblings)
+		for _, item := range siblings {
+			if visited[item.EntityID] {
+				continue
+			}
+			visited[item.EntityID] = true
+			item.Depth = depth
+			ordered = append(ordered, item)
+			walk(children[item.EntityID], depth+1)
+		}
+	}
+	walk(roots, 0)
+
+	for _, item := range items {
+		if !visited[item.EntityID] {
+			walk([]ListItem{item}, 0)
+		}
+	}
+	return ordered
+}
+
+func sortSiblings(items []ListItem) {
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].SortOrder != items[j].SortOrder {
+			return items[i].SortOrder < items[j].SortOrder
+		}
+		return items[i].RowID < items[j].RowID
+	})
+}
+
 func (li ListItem) IsComplete() bool {
 	return li.Type == ListItemTypeDone
 }
@@ -71,3 +157,63 @@ func (li ListItem) IsComplete() bool {
 func (li ListItem) IsCancelled() bool {
 	return li.Type == ListItemTypeCancelled
 }
+
+// IsScheduled reports whether the item has been scheduled onto a day. The
+// item stays in its list as a marker; the journal task it became shares its
+// EntityID.
+func (li ListItem) IsScheduled() bool {
+	return li.Type == ListItemTypeScheduled
+}
+
+// CanSchedule reports whether the item can be scheduled onto a day. Only
+// open tasks can be.
+func (li ListItem) CanSchedule() bool {
+	return li.Type == ListItemTypeTask
+}
+
+// IsOverdue reports whether the item is an open task that was due before
+// today.
+func (li ListItem) IsOverdue(today time.Time) bool {
+	if li.Type != ListItemTypeTask || li.DueDate == nil {
+		return false
+	}
+	return truncateToDay(*li.DueDate).Before(truncateToDay(today))
+}
+
+// IsDue reports whether the item is an open task due today.
+func (li ListItem) IsDue(today time.Time) bool {
+	if li.Type != ListItemTypeTask || li.DueDate == nil {
+		return false
+	}
+	return truncateToDay(*li.DueDate).Equal(truncateToDay(today))
+}
+
+// ListItemFromEntry shows a journal entry as an item of a smart list. The
+// item is not stored in the list, so it has no RowID; EntryID is the ID of
+// the entry it shows. Entries that have been done, cancelled or moved on
+// show as such, and everything else as a task.
+func ListItemFromEntry(listEntityID EntityID, entry Entry) ListItem {
+	itemType := ListItemTypeTask
+	switch entry.Type {
+	case EntryTypeDone, EntryTypeAnswered:
+		itemType = ListItemTypeDone
+	case EntryTypeCancelled:
+		itemType = ListItemTypeCancelled
+	case EntryTypeMigrated, EntryTypeMovedToList:
+		itemType = ListItemTypeScheduled
+	}
+
+	return ListItem{
+		VersionInfo: VersionInfo{
+			EntityID: entry.EntityID,
+		},
+		EntryID:      entry.ID,
+		ListEntityID: listEntityID,
+		Priority:     entry.Priority,
+		Type:         itemType,
+		Content:      entry.Content,
+		CreatedAt:    entry.CreatedAt,
+		Tags:         ExtractTags(entry.Content),
+		Mentions:     ExtractMentions(entry.Content),
+	}
+}
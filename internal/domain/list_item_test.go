@@ -2,8 +2,10 @@ package domain
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestListItemType_Task_IsValid(t *testing.T) {
@@ -91,6 +93,31 @@ func TestListItem_IsComplete_WhenTask_ReturnsFalse(t *testing.T) {
 	assert.False(t, item.IsComplete())
 }
 
+func TestListItemType_Scheduled_IsValid(t *testing.T) {
+	assert.True(t, ListItemTypeScheduled.IsValid())
+	assert.Equal(t, "<", ListItemTypeScheduled.Symbol())
+}
+
+func TestListItem_CanSchedule(t *testing.T) {
+	tests := []struct {
+		itemType ListItemType
+		expected bool
+	}{
+		{ListItemTypeTask, true},
+		{ListItemTypeDone, false},
+		{ListItemTypeCancelled, false},
+		{ListItemTypeScheduled, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.itemType), func(t *testing.T) {
+			item := ListItem{Type: tt.itemType}
+			assert.Equal(t, tt.expected, item.CanSchedule())
+			assert.Equal(t, tt.itemType == ListItemTypeScheduled, item.IsScheduled())
+		})
+	}
+}
+
 func TestNewListItem_SetsEntityIDAndCreatedAt(t *testing.T) {
 	listID := NewEntityID()
 
@@ -130,3 +157,111 @@ func TestListItem_IsCancelled_WhenDone_ReturnsFalse(t *testing.T) {
 
 	assert.False(t, item.IsCancelled())
 }
+
+func TestNewListItem_ExtractsTagsAndMentions(t *testing.T) {
+	item := NewListItem(NewEntityID(), ListItemTypeTask, "Call @alice about #travel")
+
+	assert.Equal(t, []string{"travel"}, item.Tags)
+	assert.Equal(t, []string{"alice"}, item.Mentions)
+	assert.Equal(t, PriorityNone, item.Priority)
+}
+
+func TestListItem_SetContent_UpdatesTagsAndMentions(t *testing.T) {
+	item := NewListItem(NewEntityID(), ListItemTypeTask, "Buy #milk")
+
+	item.SetContent("Ask @bob")
+
+	assert.Equal(t, "Ask @bob", item.Content)
+	assert.Empty(t, item.Tags)
+	assert.Equal(t, []string{"bob"}, item.Mentions)
+}
+
+func TestListItem_Validate_RejectsInvalidPriority(t *testing.T) {
+	item := NewListItem(NewEntityID(), ListItemTypeTask, "Buy milk")
+	item.Priority = "urgent"
+
+	assert.Error(t, item.Validate())
+}
+
+func TestOrderListItems_NestsChildrenUnderParentsBySortOrder(t *testing.T) {
+	listID := NewEntityID()
+	parent := ListItem{VersionInfo: VersionInfo{RowID: 1, EntityID: "p"}, ListEntityID: listID, SortOrder: 2, Content: "Parent"}
+	other := ListItem{VersionInfo: VersionInfo{RowID: 2, EntityID: "o"}, ListEntityID: listID, SortOrder: 1, Content: "Other"}
+	parentID := parent.EntityID
+	second := ListItem{VersionInfo: VersionInfo{RowID: 3, EntityID: "c2"}, ListEntityID: listID, ParentEntityID: &parentID, SortOrder: 2, Content: "Second"}
+	first := ListItem{VersionInfo: VersionInfo{RowID: 4, EntityID: "c1"}, ListEntityID: listID, ParentEntityID: &parentID, SortOrder: 1, Content: "First"}
+
+	ordered := OrderListItems([]ListItem{parent, other, second, first})
+
+	require.Len(t, ordered, 4)
+	assert.Equal(t, []string{"Other", "Parent", "First", "Second"}, []string{ordered[0].Content, ordered[1].Content, ordered[2].Content, ordered[3].Content})
+	assert.Equal(t, []int{0, 0, 1, 1}, []int{ordered[0].Depth, ordered[1].Depth, ordered[2].Depth, ordered[3].Depth})
+}
+
+func TestOrderListItems_TreatsItemsWithMissingParentAsTopLevel(t *testing.T) {
+	missing := EntityID("gone")
+	orphan := ListItem{VersionInfo: VersionInfo{RowID: 1, EntityID: "a"}, ParentEntityID: &missing, SortOrder: 1, Content: "Orphan"}
+
+	ordered := OrderListItems([]ListItem{orphan})
+
+	require.Len(t, ordered, 1)
+	assert.Equal(t, 0, ordered[0].Depth)
+}
+
+func TestOrderListItems_KeepsItemsInACycle(t *testing.T) {
+	a, b := EntityID("a"), EntityID("b")
+	itemA := ListItem{VersionInfo: VersionInfo{RowID: 1, EntityID: a}, ParentEntityID: &b, Content: "A"}
+	itemB := ListItem{VersionInfo: VersionInfo{RowID: 2, EntityID: b}, ParentEntityID: &a, Content: "B"}
+
+	ordered := OrderListItems([]ListItem{itemA, itemB})
+
+	assert.Len(t, ordered, 2)
+}
+
+func TestListItemFromEntry(t *testing.T) {
+	listEntityID := NewEntityID()
+	entry := Entry{ID: 7, EntityID: NewEntityID(), Type: EntryTypeQuestion, Content: "Ask @alex about #budget", Priority: PriorityHigh}
+
+	item := ListItemFromEntry(listEntityID, entry)
+
+	assert.Equal(t, int64(7), item.EntryID)
+	assert.Zero(t, item.RowID)
+	assert.Equal(t, entry.EntityID, item.EntityID)
+	assert.Equal(t, listEntityID, item.ListEntityID)
+	assert.Equal(t, ListItemTypeTask, item.Type)
+	assert.Equal(t, PriorityHigh, item.Priority)
+	assert.Equal(t, []string{"budget"}, item.Tags)
+	assert.Equal(t, []string{"alex"}, item.Mentions)
+
+	for entryType, want := range map[EntryType]ListItemType{
+		EntryTypeAnswered:    ListItemTypeDone,
+		EntryTypeDone:        ListItemTypeDone,
+		EntryTypeCancelled:   ListItemTypeCancelled,
+		EntryTypeMigrated:    ListItemTypeScheduled,
+		EntryTypeMovedToList: ListItemTypeScheduled,
+		EntryTypeNote:        ListItemTypeTask,
+	} {
+		entry.Type = entryType
+		assert.Equal(t, want, ListItemFromEntry(listEntityID, entry).Type, entryType)
+	}
+}
+
+func TestListItem_IsOverdue(t *testing.T) {
+	today := time.Date(2026, 10, 17, 9, 30, 0, 0, time.Local)
+	yesterday := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	dueToday := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	item := NewListItem(NewEntityID(), ListItemTypeTask, "Renew passport")
+	assert.False(t, item.IsOverdue(today))
+
+	item.DueDate = &dueToday
+	assert.False(t, item.IsOverdue(today))
+	assert.True(t, item.IsDue(today))
+
+	item.DueDate = &yesterday
+	assert.True(t, item.IsOverdue(today))
+	assert.False(t, item.IsDue(today))
+
+	item.Type = ListItemTypeDone
+	assert.False(t, item.IsOverdue(today))
+}
@@ -0,0 +1,98 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ListTemplate is a reusable checklist, such as a release checklist or a
+// packing list. Content holds its items in the journal's entry syntax, one
+// per line and indented two spaces per level, so a template can be turned
+// into a list or logged straight into the journal. Content may contain
+// {{variables}} that are filled in when the template is used.
+type ListTemplate struct {
+	ID        int64
+	Name      string
+	Content   string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (t ListTemplate) Validate() error {
+	if strings.TrimSpace(t.Name) == "" {
+		return Invalidf("template name cannot be empty")
+	}
+	if strings.TrimSpace(t.Content) == "" {
+		return Invalidf("template has no items")
+	}
+	return nil
+}
+
+// ItemCount is the number of items in the template.
+func (t ListTemplate) ItemCount() int {
+	count := 0
+	for _, line := range strings.Split(t.Content, "\n") {
+		if strings.TrimSpace(line) != "" {
+			count++
+		}
+	}
+	return count
+}
+
+// ListTemplateContent writes a list's ordered items as template content.
+// Every item is written as an open task, so a checklist captured part-way
+// through starts unchecked.
+func ListTemplateContent(items []ListItem) string {
+	var sb strings.Builder
+	for _, item := range items {
+		sb.WriteString(strings.Repeat("  ", item.Depth))
+		sb.WriteString(". ")
+		if priority := item.Priority.Symbol(); priority != "" {
+			sb.WriteString(priority)
+			sb.WriteString(" ")
+		}
+		sb.WriteString(item.Content)
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+var templateVariablePattern = regexp.MustCompile(`\{\{\s*([A-Za-z][A-Za-z0-9_]*)\s*\}\}`)
+
+// TemplateVariables returns the built-in variables for using a template on
+// date: date, weekday, month and year.
+func TemplateVariables(date time.Time) map[string]string {
+	return map[string]string{
+		"date":    date.Format("2006-01-02"),
+		"weekday": date.Format("Monday"),
+		"month":   date.Format("January"),
+		"year":    date.Format("2006"),
+	}
+}
+
+// ExpandTemplate replaces each {{name}} in content with its value in vars.
+// It is an error for content to use a variable that has no value.
+func ExpandTemplate(content string, vars map[string]string) (string, error) {
+	missing := make(map[string]bool)
+	expanded := templateVariablePattern.ReplaceAllStringFunc(content, func(match string) string {
+		name := templateVariablePattern.FindStringSubmatch(match)[1]
+		value, ok := vars[name]
+		if !ok {
+			missing[name] = true
+			return match
+		}
+		return value
+	})
+
+	if len(missing) > 0 {
+		names := make([]string, 0, len(missing))
+		for name := range missing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return "", Invalidf("no value for template variable: %s", strings.Join(names, ", "))
+	}
+	return expanded, nil
+}
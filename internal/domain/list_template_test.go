@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandTemplate_ReplacesVariables(t *testing.T) {
+	vars := TemplateVariables(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	vars["version"] = "1.4.0"
+
+	expanded, err := ExpandTemplate(". Release {{version}} on {{ date }} ({{weekday}}, {{month}} {{year}})", vars)
+
+	require.NoError(t, err)
+	assert.Equal(t, ". Release 1.4.0 on 2026-10-17 (Saturday, October 2026)", expanded)
+}
+
+func TestExpandTemplate_MissingVariableErrors(t *testing.T) {
+	_, err := ExpandTemplate(". Tag {{version}} for {{customer}}", map[string]string{})
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "customer, version")
+}
+
+func TestExpandTemplate_LeavesOtherBracesAlone(t *testing.T) {
+	expanded, err := ExpandTemplate(". Check {json} and {{ }}", map[string]string{})
+
+	require.NoError(t, err)
+	assert.Equal(t, ". Check {json} and {{ }}", expanded)
+}
+
+func TestListTemplateContent_WritesOpenTasksWithIndentAndPriority(t *testing.T) {
+	items := []ListItem{
+		{Type: ListItemTypeDone, Content: "Pack bag", Priority: PriorityNone},
+		{Type: ListItemTypeCancelled, Content: "Passport", Priority: PriorityHigh, Depth: 1},
+	}
+
+	content := ListTemplateContent(items)
+
+	assert.Equal(t, ". Pack bag\n  . !!! Passport\n", content)
+}
+
+func TestListTemplate_ItemCount(t *testing.T) {
+	template := ListTemplate{Name: "Packing", Content: ". Bag\n  . Socks\n\n. Passport\n"}
+
+	assert.Equal(t, 3, template.ItemCount())
+}
+
+func TestListTemplate_Validate(t *testing.T) {
+	assert.Error(t, ListTemplate{Name: " ", Content: ". Bag"}.Validate())
+	assert.Error(t, ListTemplate{Name: "Packing", Content: "\n"}.Validate())
+	assert.NoError(t, ListTemplate{Name: "Packing", Content: ". Bag"}.Validate())
+}
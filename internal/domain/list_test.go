@@ -50,6 +50,24 @@ func TestList_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "smart list",
+			list: List{
+				ID:    1,
+				Name:  "Waiting on others",
+				Query: "#waiting status:open",
+			},
+			wantErr: false,
+		},
+		{
+			name: "smart list with invalid query",
+			list: List{
+				ID:    1,
+				Name:  "Urgent",
+				Query: "priority:urgent",
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -82,3 +100,11 @@ func TestNewList_GeneratesEntityID(t *testing.T) {
 
 	assert.False(t, list.EntityID.IsEmpty())
 }
+
+func TestNewSmartList(t *testing.T) {
+	list := NewSmartList("Waiting", "  #waiting status:open ")
+
+	assert.Equal(t, "#waiting status:open", list.Query)
+	assert.True(t, list.IsSmart())
+	assert.False(t, NewList("Shopping").IsSmart())
+}
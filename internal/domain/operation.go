@@ -0,0 +1,17 @@
+package domain
+
+import "time"
+
+// Operation is one user-level change, such as marking an entry done or
+// deleting a habit, recorded so it can be undone and redone as a unit.
+type Operation struct {
+	ID          int64
+	Description string
+	CreatedAt   time.Time
+	UndoneAt    *time.Time
+	Changes     int
+}
+
+func (o Operation) IsUndone() bool {
+	return o.UndoneAt != nil
+}
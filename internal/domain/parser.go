@@ -1,7 +1,6 @@
 package domain
 
 import (
-	"fmt"
 	"strings"
 )
 
@@ -53,7 +52,7 @@ func ParseEntryTypeFromString(s string) (EntryType, error) {
 	case "answer", "A":
 		return EntryTypeAnswer, nil
 	default:
-		return "", fmt.Errorf("invalid entry type: %s", s)
+		return "", Invalidf("invalid entry type: %s", s)
 	}
 }
 
@@ -0,0 +1,492 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RecurrenceFrequency string
+
+const (
+	RecurrenceDaily   RecurrenceFrequency = "DAILY"
+	RecurrenceWeekly  RecurrenceFrequency = "WEEKLY"
+	RecurrenceMonthly RecurrenceFrequency = "MONTHLY"
+)
+
+// LastWeekdayOfMonth is the ordinal used for rules such as "last friday".
+const LastWeekdayOfMonth = -1
+
+var weekdayCodes = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+var weekdayNames = map[string]time.Weekday{
+	"su": time.Sunday, "sun": time.Sunday, "sunday": time.Sunday,
+	"mo": time.Monday, "mon": time.Monday, "monday": time.Monday,
+	"tu": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday, "tuesday": time.Tuesday,
+	"we": time.Wednesday, "wed": time.Wednesday, "wednesday": time.Wednesday,
+	"th": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday, "thursday": time.Thursday,
+	"fr": time.Friday, "fri": time.Friday, "friday": time.Friday,
+	"sa": time.Saturday, "sat": time.Saturday, "saturday": time.Saturday,
+}
+
+var ordinalWords = map[string]int{
+	"1st": 1, "first": 1,
+	"2nd": 2, "second": 2,
+	"3rd": 3, "third": 3,
+	"4th": 4, "fourth": 4,
+	"5th": 5, "fifth": 5,
+	"last": LastWeekdayOfMonth,
+}
+
+// RecurrenceRule is a subset of the iCalendar RRULE: a frequency with an
+// interval, optionally restricted to weekdays, a day of the month, or the
+// Nth weekday of the month.
+type RecurrenceRule struct {
+	Frequency      RecurrenceFrequency
+	Interval       int
+	Weekdays       []time.Weekday
+	MonthDay       int
+	WeekdayOrdinal int
+}
+
+func (r RecurrenceRule) Validate() error {
+	switch r.Frequency {
+	case RecurrenceDaily, RecurrenceWeekly, RecurrenceMonthly:
+	default:
+		return Invalidf("invalid recurrence frequency")
+	}
+	if r.Interval < 1 {
+		return Invalidf("recurrence interval must be at least 1")
+	}
+	if r.MonthDay < 0 || r.MonthDay > 31 {
+		return Invalidf("recurrence month day must be between 0 (the start date's day) and 31")
+	}
+	if r.WeekdayOrdinal != 0 {
+		if r.Frequency != RecurrenceMonthly {
+			return Invalidf("nth weekday rules must be monthly")
+		}
+		if len(r.Weekdays) != 1 {
+			return Invalidf("nth weekday rules need exactly one weekday")
+		}
+		if r.WeekdayOrdinal < LastWeekdayOfMonth || r.WeekdayOrdinal > 5 {
+			return Invalidf("weekday ordinal must be 1-5 or last")
+		}
+	}
+	return nil
+}
+
+// OccursOn reports whether the rule produces an occurrence on date for a
+// series anchored at start.
+func (r RecurrenceRule) OccursOn(date, start time.Time) bool {
+	date = truncateToDay(date)
+	start = truncateToDay(start)
+	if date.Before(start) {
+		return false
+	}
+
+	interval := r.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	switch r.Frequency {
+	case RecurrenceDaily:
+		return daysBetween(start, date)%interval == 0
+	case RecurrenceWeekly:
+		weekdays := r.Weekdays
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{start.Weekday()}
+		}
+		if !containsWeekday(weekdays, date.Weekday()) {
+			return false
+		}
+		weeks := daysBetween(startOfWeek(start), startOfWeek(date)) / 7
+		return weeks%interval == 0
+	case RecurrenceMonthly:
+		months := (date.Year()-start.Year())*12 + int(date.Month()) - int(start.Month())
+		if months%interval != 0 {
+			return false
+		}
+		if r.WeekdayOrdinal != 0 {
+			return matchesNthWeekday(date, r.Weekdays[0], r.WeekdayOrdinal)
+		}
+		monthDay := r.MonthDay
+		if monthDay == 0 {
+			monthDay = start.Day()
+		}
+		if last := daysInMonth(date); monthDay > last {
+			monthDay = last
+		}
+		return date.Day() == monthDay
+	}
+	return false
+}
+
+// String renders the rule in RRULE form, e.g. "FREQ=WEEKLY;BYDAY=MO,WE".
+func (r RecurrenceRule) String() string {
+	parts := []string{"FREQ=" + string(r.Frequency)}
+	if r.Interval > 1 {
+		parts = append(parts, "INTERVAL="+strconv.Itoa(r.Interval))
+	}
+	if len(r.Weekdays) > 0 {
+		codes := make([]string, len(r.Weekdays))
+		for i, wd := range r.Weekdays {
+			codes[i] = weekdayCodes[wd]
+		}
+		if r.WeekdayOrdinal != 0 {
+			codes[0] = strconv.Itoa(r.WeekdayOrdinal) + codes[0]
+		}
+		parts = append(parts, "BYDAY="+strings.Join(codes, ","))
+	}
+	if r.MonthDay > 0 {
+		parts = append(parts, "BYMONTHDAY="+strconv.Itoa(r.MonthDay))
+	}
+	return strings.Join(parts, ";")
+}
+
+// Describe renders the rule for humans, e.g. "every mon,wed".
+func (r RecurrenceRule) Describe() string {
+	var sb strings.Builder
+	sb.WriteString("every ")
+	if r.Interval > 1 {
+		sb.WriteString(strconv.Itoa(r.Interval) + " ")
+	}
+	switch r.Frequency {
+	case RecurrenceDaily:
+		sb.WriteString(pluralize("day", r.Interval))
+	case RecurrenceWeekly:
+		if len(r.Weekdays) == 0 {
+			sb.WriteString(pluralize("week", r.Interval))
+			break
+		}
+		if r.Interval > 1 {
+			sb.WriteString("weeks on ")
+		}
+		names := make([]string, len(r.Weekdays))
+		for i, wd := range r.Weekdays {
+			names[i] = strings.ToLower(wd.String()[:3])
+		}
+		sb.WriteString(strings.Join(names, ","))
+	case RecurrenceMonthly:
+		sb.WriteString(pluralize("month", r.Interval))
+		switch {
+		case r.WeekdayOrdinal == LastWeekdayOfMonth:
+			sb.WriteString(" on the last " + strings.ToLower(r.Weekdays[0].String()[:3]))
+		case r.WeekdayOrdinal > 0:
+			sb.WriteString(" on the " + ordinalSuffix(r.WeekdayOrdinal) + " " + strings.ToLower(r.Weekdays[0].String()[:3]))
+		case r.MonthDay > 0:
+			sb.WriteString(" on the " + ordinalSuffix(r.MonthDay))
+		}
+	}
+	return sb.String()
+}
+
+// ParseRecurrenceRule parses the RRULE form produced by RecurrenceRule.String.
+func ParseRecurrenceRule(s string) (RecurrenceRule, error) {
+	rule := RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(strings.TrimPrefix(s, "RRULE:"), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return RecurrenceRule{}, Invalidf("invalid recurrence rule part: %s", part)
+		}
+		switch key {
+		case "FREQ":
+			rule.Frequency = RecurrenceFrequency(value)
+		case "INTERVAL":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return RecurrenceRule{}, Invalidf("invalid recurrence interval: %s", value)
+			}
+			rule.Interval = n
+		case "BYMONTHDAY":
+			n, err := strconv.Atoi(value)
+			if err != nil {
+				return RecurrenceRule{}, Invalidf("invalid recurrence month day: %s", value)
+			}
+			rule.MonthDay = n
+		case "BYDAY":
+			for _, code := range strings.Split(value, ",") {
+				ordinal, wd, err := parseWeekdayCode(code)
+				if err != nil {
+					return RecurrenceRule{}, err
+				}
+				if ordinal != 0 {
+					rule.WeekdayOrdinal = ordinal
+				}
+				rule.Weekdays = append(rule.Weekdays, wd)
+			}
+		default:
+			return RecurrenceRule{}, Invalidf("unsupported recurrence rule part: %s", key)
+		}
+	}
+	if err := rule.Validate(); err != nil {
+		return RecurrenceRule{}, err
+	}
+	return rule, nil
+}
+
+// ParseEvery parses the human form accepted by `bujo add --every`:
+//
+//	day, daily, weekday(s), week, weekly, month, monthly
+//	mon,wed,fri
+//	2 days, 2 weeks, 3 months
+//	15th (day of the month)
+//	2nd tue, last fri
+func ParseEvery(s string) (RecurrenceRule, error) {
+	input := strings.ToLower(strings.TrimSpace(s))
+	input = strings.TrimPrefix(input, "every ")
+	if input == "" {
+		return RecurrenceRule{}, Invalidf("recurrence cannot be empty")
+	}
+
+	switch input {
+	case "day", "daily":
+		return RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1}, nil
+	case "weekday", "weekdays":
+		return RecurrenceRule{
+			Frequency: RecurrenceWeekly,
+			Interval:  1,
+			Weekdays:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+		}, nil
+	case "week", "weekly":
+		return RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1}, nil
+	case "month", "monthly":
+		return RecurrenceRule{Frequency: RecurrenceMonthly, Interval: 1}, nil
+	}
+
+	fields := strings.Fields(input)
+	if len(fields) == 2 {
+		if n, err := strconv.Atoi(fields[0]); err == nil {
+			rule := RecurrenceRule{Interval: n}
+			switch strings.TrimSuffix(fields[1], "s") {
+			case "day":
+				rule.Frequency = RecurrenceDaily
+			case "week":
+				rule.Frequency = RecurrenceWeekly
+			case "month":
+				rule.Frequency = RecurrenceMonthly
+			default:
+				return RecurrenceRule{}, Invalidf("invalid recurrence: %s", s)
+			}
+			return rule, rule.Validate()
+		}
+		if ordinal, ok := ordinalWords[fields[0]]; ok {
+			wd, ok := weekdayNames[fields[1]]
+			if !ok {
+				return RecurrenceRule{}, Invalidf("invalid weekday: %s", fields[1])
+			}
+			return RecurrenceRule{
+				Frequency:      RecurrenceMonthly,
+				Interval:       1,
+				Weekdays:       []time.Weekday{wd},
+				WeekdayOrdinal: ordinal,
+			}, nil
+		}
+	}
+
+	if day, ok := parseMonthDay(input); ok {
+		rule := RecurrenceRule{Frequency: RecurrenceMonthly, Interval: 1, MonthDay: day}
+		return rule, rule.Validate()
+	}
+
+	var weekdays []time.Weekday
+	for _, name := range strings.Split(input, ",") {
+		wd, ok := weekdayNames[strings.TrimSpace(name)]
+		if !ok {
+			return RecurrenceRule{}, Invalidf("invalid recurrence: %s", s)
+		}
+		if !containsWeekday(weekdays, wd) {
+			weekdays = append(weekdays, wd)
+		}
+	}
+	return RecurrenceRule{Frequency: RecurrenceWeekly, Interval: 1, Weekdays: weekdays}, nil
+}
+
+// Recurrence is a template entry that is materialized into the daily log on
+// every date its rule matches.
+type Recurrence struct {
+	ID         int64
+	EntityID   EntityID
+	EntryType  EntryType
+	Content    string
+	Priority   Priority
+	Rule       RecurrenceRule
+	StartDate  time.Time
+	EndDate    *time.Time
+	Exceptions []time.Time
+	CreatedAt  time.Time
+}
+
+func NewRecurrence(entryType EntryType, content string, rule RecurrenceRule, start time.Time) Recurrence {
+	return Recurrence{
+		EntityID:  NewEntityID(),
+		EntryType: entryType,
+		Content:   content,
+		Priority:  PriorityNone,
+		Rule:      rule,
+		StartDate: truncateToDay(start),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (r Recurrence) Validate() error {
+	if r.EntryType != EntryTypeTask && r.EntryType != EntryTypeNote && r.EntryType != EntryTypeEvent {
+		return Invalidf("only tasks, notes and events can recur")
+	}
+	if r.Content == "" {
+		return Invalidf("content cannot be empty")
+	}
+	if r.StartDate.IsZero() {
+		return Invalidf("recurrence start date is required")
+	}
+	if r.EndDate != nil && r.EndDate.Before(r.StartDate) {
+		return Invalidf("recurrence end date must not be before start date")
+	}
+	return r.Rule.Validate()
+}
+
+func (r Recurrence) IsException(date time.Time) bool {
+	key := date.Format("2006-01-02")
+	for _, ex := range r.Exceptions {
+		if ex.Format("2006-01-02") == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (r Recurrence) OccursOn(date time.Time) bool {
+	if r.EndDate != nil && truncateToDay(date).After(truncateToDay(*r.EndDate)) {
+		return false
+	}
+	if r.IsException(date) {
+		return false
+	}
+	return r.Rule.OccursOn(date, r.StartDate)
+}
+
+// Occurrences lists the dates in [from, to] on which the recurrence occurs.
+func (r Recurrence) Occurrences(from, to time.Time) []time.Time {
+	var dates []time.Time
+	for d := truncateToDay(from); !d.After(truncateToDay(to)); d = d.AddDate(0, 0, 1) {
+		if r.OccursOn(d) {
+			dates = append(dates, d)
+		}
+	}
+	return dates
+}
+
+// RecurrenceOccurrence is a single date on which a recurrence falls due.
+type RecurrenceOccurrence struct {
+	Recurrence Recurrence
+	Date       time.Time
+}
+
+func (r Recurrence) ToEntry(date time.Time) Entry {
+	entry := NewEntry(r.EntryType, r.Content, &date)
+	entry.Priority = r.Priority
+	entry.Tags = ExtractTags(r.Content)
+	entry.Mentions = ExtractMentions(r.Content)
+	return entry
+}
+
+func parseWeekdayCode(code string) (int, time.Weekday, error) {
+	if len(code) < 2 {
+		return 0, 0, Invalidf("invalid weekday: %s", code)
+	}
+	prefix, suffix := code[:len(code)-2], code[len(code)-2:]
+	wd, ok := weekdayNames[strings.ToLower(suffix)]
+	if !ok {
+		return 0, 0, Invalidf("invalid weekday: %s", code)
+	}
+	if prefix == "" {
+		return 0, wd, nil
+	}
+	ordinal, err := strconv.Atoi(prefix)
+	if err != nil {
+		return 0, 0, Invalidf("invalid weekday: %s", code)
+	}
+	return ordinal, wd, nil
+}
+
+func parseMonthDay(s string) (int, bool) {
+	for _, suffix := range []string{"st", "nd", "rd", "th"} {
+		if strings.HasSuffix(s, suffix) {
+			n, err := strconv.Atoi(strings.TrimSuffix(s, suffix))
+			if err == nil {
+				return n, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func matchesNthWeekday(date time.Time, wd time.Weekday, ordinal int) bool {
+	if date.Weekday() != wd {
+		return false
+	}
+	if ordinal == LastWeekdayOfMonth {
+		return date.Day()+7 > daysInMonth(date)
+	}
+	return (date.Day()-1)/7+1 == ordinal
+}
+
+func containsWeekday(weekdays []time.Weekday, wd time.Weekday) bool {
+	for _, w := range weekdays {
+		if w == wd {
+			return true
+		}
+	}
+	return false
+}
+
+// truncateToDay keeps only the calendar date, so dates stored as UTC compare
+// cleanly against local times.
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func startOfWeek(t time.Time) time.Time {
+	offset := (int(t.Weekday()) - int(time.Monday) + 7) % 7
+	return t.AddDate(0, 0, -offset)
+}
+
+func daysBetween(from, to time.Time) int {
+	a := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	b := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(b.Sub(a).Hours() / 24)
+}
+
+func daysInMonth(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+func pluralize(word string, n int) string {
+	if n > 1 {
+		return word + "s"
+	}
+	return word
+}
+
+func ordinalSuffix(n int) string {
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+		return strconv.Itoa(n) + "th"
+	case n%10 == 1:
+		return strconv.Itoa(n) + "st"
+	case n%10 == 2:
+		return strconv.Itoa(n) + "nd"
+	case n%10 == 3:
+		return strconv.Itoa(n) + "rd"
+	default:
+		return strconv.Itoa(n) + "th"
+	}
+}
@@ -0,0 +1,186 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestParseEvery(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		want    string
+		wantErr bool
+	}{
+		{"daily", "daily", "FREQ=DAILY", false},
+		{"every day", "every day", "FREQ=DAILY", false},
+		{"weekdays", "weekdays", "FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", false},
+		{"weekday list", "mon,wed", "FREQ=WEEKLY;BYDAY=MO,WE", false},
+		{"weekday list with spaces", "Mon, Fri", "FREQ=WEEKLY;BYDAY=MO,FR", false},
+		{"weekly", "weekly", "FREQ=WEEKLY", false},
+		{"fortnightly", "2 weeks", "FREQ=WEEKLY;INTERVAL=2", false},
+		{"every 3 days", "3 days", "FREQ=DAILY;INTERVAL=3", false},
+		{"monthly", "monthly", "FREQ=MONTHLY", false},
+		{"day of month", "15th", "FREQ=MONTHLY;BYMONTHDAY=15", false},
+		{"nth weekday", "2nd tue", "FREQ=MONTHLY;BYDAY=2TU", false},
+		{"last weekday", "last fri", "FREQ=MONTHLY;BYDAY=-1FR", false},
+		{"empty", "", "", true},
+		{"nonsense", "sometimes", "", true},
+		{"bad month day", "40th", "", true},
+		{"bad unit", "2 years", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseEvery(tt.input)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.String())
+		})
+	}
+}
+
+func TestParseRecurrenceRule_RoundTrip(t *testing.T) {
+	rules := []string{
+		"FREQ=DAILY",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE",
+		"FREQ=MONTHLY;BYMONTHDAY=31",
+		"FREQ=MONTHLY;BYDAY=-1FR",
+	}
+
+	for _, s := range rules {
+		t.Run(s, func(t *testing.T) {
+			rule, err := ParseRecurrenceRule(s)
+			require.NoError(t, err)
+			assert.Equal(t, s, rule.String())
+		})
+	}
+}
+
+func TestParseRecurrenceRule_Invalid(t *testing.T) {
+	_, err := ParseRecurrenceRule("FREQ=YEARLY")
+	assert.Error(t, err)
+
+	_, err = ParseRecurrenceRule("FREQ=WEEKLY;BYDAY=XX")
+	assert.Error(t, err)
+}
+
+func TestRecurrenceRule_OccursOn(t *testing.T) {
+	start := date(2026, time.January, 5) // Monday
+
+	tests := []struct {
+		name  string
+		every string
+		date  time.Time
+		want  bool
+	}{
+		{"daily on start", "daily", start, true},
+		{"daily before start", "daily", date(2026, time.January, 4), false},
+		{"every 3 days hit", "3 days", date(2026, time.January, 11), true},
+		{"every 3 days miss", "3 days", date(2026, time.January, 10), false},
+		{"weekly defaults to start weekday", "weekly", date(2026, time.January, 12), true},
+		{"weekly other weekday", "weekly", date(2026, time.January, 13), false},
+		{"mon,wed on wednesday", "mon,wed", date(2026, time.January, 7), true},
+		{"mon,wed on thursday", "mon,wed", date(2026, time.January, 8), false},
+		{"fortnightly off week", "2 weeks", date(2026, time.January, 12), false},
+		{"fortnightly on week", "2 weeks", date(2026, time.January, 19), true},
+		{"15th", "15th", date(2026, time.February, 15), true},
+		{"31st clamps to end of february", "31st", date(2026, time.February, 28), true},
+		{"2nd tuesday", "2nd tue", date(2026, time.February, 10), true},
+		{"1st tuesday is not 2nd", "2nd tue", date(2026, time.February, 3), false},
+		{"last friday", "last fri", date(2026, time.January, 30), true},
+		{"penultimate friday is not last", "last fri", date(2026, time.January, 23), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := ParseEvery(tt.every)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.OccursOn(tt.date, start))
+		})
+	}
+}
+
+func TestRecurrence_OccursOn_RespectsExceptionsAndEndDate(t *testing.T) {
+	rule, err := ParseEvery("daily")
+	require.NoError(t, err)
+
+	r := NewRecurrence(EntryTypeTask, "Water plants", rule, date(2026, time.January, 1))
+	end := date(2026, time.January, 10)
+	r.EndDate = &end
+	r.Exceptions = []time.Time{date(2026, time.January, 3)}
+
+	assert.True(t, r.OccursOn(date(2026, time.January, 2)))
+	assert.False(t, r.OccursOn(date(2026, time.January, 3)))
+	assert.True(t, r.OccursOn(date(2026, time.January, 10)))
+	assert.False(t, r.OccursOn(date(2026, time.January, 11)))
+}
+
+func TestRecurrence_Occurrences(t *testing.T) {
+	rule, err := ParseEvery("mon,wed")
+	require.NoError(t, err)
+
+	r := NewRecurrence(EntryTypeEvent, "Standup", rule, date(2026, time.January, 1))
+	dates := r.Occurrences(date(2026, time.January, 5), date(2026, time.January, 11))
+
+	require.Len(t, dates, 2)
+	assert.Equal(t, date(2026, time.January, 5), dates[0])
+	assert.Equal(t, date(2026, time.January, 7), dates[1])
+}
+
+func TestRecurrence_Validate(t *testing.T) {
+	rule := RecurrenceRule{Frequency: RecurrenceDaily, Interval: 1}
+
+	tests := []struct {
+		name    string
+		r       Recurrence
+		wantErr bool
+	}{
+		{"valid task", NewRecurrence(EntryTypeTask, "Water plants", rule, date(2026, 1, 1)), false},
+		{"done entries cannot recur", NewRecurrence(EntryTypeDone, "Water plants", rule, date(2026, 1, 1)), true},
+		{"empty content", NewRecurrence(EntryTypeTask, "", rule, date(2026, 1, 1)), true},
+		{"zero interval", NewRecurrence(EntryTypeTask, "x", RecurrenceRule{Frequency: RecurrenceDaily}, date(2026, 1, 1)), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.r.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRecurrenceRule_Describe(t *testing.T) {
+	tests := []struct {
+		every string
+		want  string
+	}{
+		{"daily", "every day"},
+		{"mon,wed", "every mon,wed"},
+		{"2 weeks", "every 2 weeks"},
+		{"15th", "every month on the 15th"},
+		{"last fri", "every month on the last fri"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.every, func(t *testing.T) {
+			rule, err := ParseEvery(tt.every)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, rule.Describe())
+		})
+	}
+}
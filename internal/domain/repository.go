@@ -10,6 +10,8 @@ type EntryRepository interface {
 	GetByID(ctx context.Context, id int64) (*Entry, error)
 	GetByDate(ctx context.Context, date time.Time) ([]Entry, error)
 	GetByDateRange(ctx context.Context, from, to time.Time) ([]Entry, error)
+	GetByMonth(ctx context.Context, month time.Time) ([]Entry, error)
+	GetFutureLog(ctx context.Context) ([]Entry, error)
 	GetAll(ctx context.Context) ([]Entry, error)
 	GetOverdue(ctx context.Context) ([]Entry, error)
 	GetWithChildren(ctx context.Context, id int64) ([]Entry, error)
@@ -22,6 +24,14 @@ type EntryRepository interface {
 	Search(ctx context.Context, opts SearchOptions) ([]Entry, error)
 }
 
+type EntryHistoryRepository interface {
+	GetHistory(ctx context.Context, entityID EntityID) ([]EntryVersion, error)
+	GetAtVersion(ctx context.Context, entityID EntityID, version int) (*EntryVersion, error)
+	GetDeletedTree(ctx context.Context, entityID EntityID) ([]EntryVersion, error)
+	FindEntityID(ctx context.Context, entryID int64) (EntityID, error)
+	Restore(ctx context.Context, entries []Entry) error
+}
+
 type HabitRepository interface {
 	Insert(ctx context.Context, habit Habit) (int64, error)
 	GetByID(ctx context.Context, id int64) (*Habit, error)
@@ -47,6 +57,14 @@ type HabitLogRepository interface {
 	DeleteAll(ctx context.Context) error
 }
 
+type HabitPauseRepository interface {
+	Insert(ctx context.Context, pause HabitPause) (int64, error)
+	GetByID(ctx context.Context, id int64) (*HabitPause, error)
+	GetRange(ctx context.Context, start, end time.Time) ([]HabitPause, error)
+	GetAll(ctx context.Context) ([]HabitPause, error)
+	Delete(ctx context.Context, id int64) error
+}
+
 type DayContextRepository interface {
 	Upsert(ctx context.Context, dayCtx DayContext) error
 	GetByDate(ctx context.Context, date time.Time) (*DayContext, error)
@@ -64,6 +82,7 @@ type ListRepository interface {
 	GetByEntityID(ctx context.Context, entityID EntityID) (*List, error)
 	GetAll(ctx context.Context) ([]List, error)
 	Rename(ctx context.Context, id int64, newName string) error
+	UpdateQuery(ctx context.Context, id int64, query string) error
 	Delete(ctx context.Context, id int64) error
 	DeleteAll(ctx context.Context) error
 	GetItemCount(ctx context.Context, listID int64) (int, error)
@@ -77,6 +96,7 @@ type ListItemRepository interface {
 	GetByListEntityID(ctx context.Context, listEntityID EntityID) ([]ListItem, error)
 	GetByListID(ctx context.Context, listID int64) ([]ListItem, error)
 	GetAll(ctx context.Context) ([]ListItem, error)
+	GetOverdue(ctx context.Context, today time.Time) ([]ListItem, error)
 	Update(ctx context.Context, item ListItem) error
 	Delete(ctx context.Context, id int64) error
 	DeleteAll(ctx context.Context) error
@@ -100,6 +120,37 @@ type MentionRepository interface {
 	DeleteByEntryID(ctx context.Context, entryID int64) error
 }
 
+type GoalLinkRepository interface {
+	LinkEntry(ctx context.Context, goalEntityID EntityID, entryID int64) error
+	UnlinkEntry(ctx context.Context, goalEntityID EntityID, entryID int64) error
+	GetEntryIDs(ctx context.Context, goalEntityIDs []EntityID) (map[EntityID][]int64, error)
+	CopyEntryLinks(ctx context.Context, fromEntryID, toEntryID int64) error
+	CopyGoalLinks(ctx context.Context, from, to EntityID) error
+}
+
+type KeyResultRepository interface {
+	Insert(ctx context.Context, kr KeyResult) (int64, error)
+	GetByID(ctx context.Context, id int64) (*KeyResult, error)
+	GetByEntityID(ctx context.Context, entityID EntityID) (*KeyResult, error)
+	GetByGoals(ctx context.Context, goalEntityIDs []EntityID) (map[EntityID][]KeyResult, error)
+	GetAll(ctx context.Context) ([]KeyResult, error)
+	Update(ctx context.Context, kr KeyResult) error
+	Delete(ctx context.Context, id int64) error
+	DeleteAll(ctx context.Context) error
+	InsertCheckIn(ctx context.Context, checkIn KeyResultCheckIn) (int64, error)
+	GetCheckIns(ctx context.Context, keyResultEntityID EntityID) ([]KeyResultCheckIn, error)
+	GetAllCheckIns(ctx context.Context) ([]KeyResultCheckIn, error)
+}
+
+type ListTemplateRepository interface {
+	Insert(ctx context.Context, template ListTemplate) (int64, error)
+	GetByName(ctx context.Context, name string) (*ListTemplate, error)
+	GetAll(ctx context.Context) ([]ListTemplate, error)
+	Update(ctx context.Context, template ListTemplate) error
+	Delete(ctx context.Context, id int64) error
+	DeleteAll(ctx context.Context) error
+}
+
 type ChangeDetector interface {
 	GetLastModified(ctx context.Context) (time.Time, error)
 }
@@ -107,3 +158,58 @@ type ChangeDetector interface {
 type EntryToListMover interface {
 	MoveEntryToList(ctx context.Context, entry Entry, listEntityID EntityID) error
 }
+
+type ListItemToEntryMover interface {
+	MoveListItemToEntry(ctx context.Context, item ListItem, date time.Time) (int64, error)
+}
+
+type RecurrenceRepository interface {
+	Insert(ctx context.Context, recurrence Recurrence) (int64, error)
+	GetByID(ctx context.Context, id int64) (*Recurrence, error)
+	GetAll(ctx context.Context) ([]Recurrence, error)
+	GetActive(ctx context.Context, from, to time.Time) ([]Recurrence, error)
+	Delete(ctx context.Context, id int64) error
+	AddException(ctx context.Context, id int64, date time.Time) error
+	GetMaterializedDates(ctx context.Context, id int64, from, to time.Time) ([]time.Time, error)
+	MarkMaterialized(ctx context.Context, id int64, date time.Time, entryID int64) error
+	Materialize(ctx context.Context, occurrences []RecurrenceOccurrence) error
+}
+
+type SearchRepository interface {
+	Search(ctx context.Context, opts SearchOptions) ([]SearchResult, error)
+}
+
+type APITokenRepository interface {
+	Insert(ctx context.Context, token APIToken, hash string) (int64, error)
+	GetByID(ctx context.Context, id int64) (*APIToken, error)
+	GetByHash(ctx context.Context, hash string) (*APIToken, error)
+	GetAll(ctx context.Context) ([]APIToken, error)
+	Revoke(ctx context.Context, id int64, at time.Time) error
+	TouchLastUsed(ctx context.Context, id int64, at time.Time) error
+}
+
+type APIOriginRepository interface {
+	GetAll(ctx context.Context) ([]string, error)
+	Add(ctx context.Context, origin string) error
+	Remove(ctx context.Context, origin string) error
+	Contains(ctx context.Context, origin string) (bool, error)
+}
+
+// OperationLogRepository records the rows changed while an operation is
+// open and can revert or replay them. Only changes made with the context
+// Begin returns are recorded, and End must be given that context. Undo and
+// Redo return nil when there is nothing to undo or redo.
+type OperationLogRepository interface {
+	Begin(ctx context.Context, description string) (context.Context, int64, error)
+	End(ctx context.Context, id int64) (bool, error)
+	Undo(ctx context.Context) (*Operation, error)
+	Redo(ctx context.Context) (*Operation, error)
+	GetRecent(ctx context.Context, limit int) ([]Operation, error)
+}
+
+// Transactor runs fn in one transaction, which the repository calls made
+// with the context fn is given take part in. It is rolled back if fn fails.
+// Nested calls roll back only their own changes when they fail.
+type Transactor interface {
+	InTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
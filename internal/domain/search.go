@@ -9,6 +9,8 @@ type SearchOptions struct {
 	DateTo   *time.Time
 	Tags     []string
 	Mentions []string
+	Priority *Priority
+	Status   SearchStatus
 	Limit    int
 }
 
@@ -40,7 +42,46 @@ func (o SearchOptions) WithMentions(mentions []string) SearchOptions {
 	return o
 }
 
+func (o SearchOptions) WithPriority(priority Priority) SearchOptions {
+	o.Priority = &priority
+	return o
+}
+
+func (o SearchOptions) WithStatus(status SearchStatus) SearchOptions {
+	o.Status = status
+	return o
+}
+
 func (o SearchOptions) WithLimit(limit int) SearchOptions {
 	o.Limit = limit
 	return o
 }
+
+// ParseQuery parses Query using the search query language and merges in the
+// explicit filters set on the options.
+func (o SearchOptions) ParseQuery() (SearchQuery, error) {
+	q, err := ParseSearchQuery(o.Query)
+	if err != nil {
+		return SearchQuery{}, err
+	}
+
+	if o.Type != nil {
+		q.Type = o.Type
+	}
+	q.Tags = append(q.Tags, o.Tags...)
+	q.Mentions = append(q.Mentions, o.Mentions...)
+	if o.DateFrom != nil {
+		q.From = o.DateFrom
+	}
+	if o.DateTo != nil {
+		q.To = o.DateTo
+	}
+	if o.Priority != nil {
+		q.Priority = o.Priority
+	}
+	if o.Status != "" {
+		q.Status = o.Status
+	}
+
+	return q, nil
+}
@@ -0,0 +1,303 @@
+package domain
+
+import (
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Snippet highlight markers. They are control characters so they can never
+// collide with journal content; renderers replace them with their own style.
+const (
+	SnippetMarkStart = "\x02"
+	SnippetMarkEnd   = "\x03"
+)
+
+type SearchSource string
+
+const (
+	SearchSourceEntry    SearchSource = "entry"
+	SearchSourceListItem SearchSource = "list_item"
+	SearchSourceGoal     SearchSource = "goal"
+)
+
+type SearchResult struct {
+	Source  SearchSource
+	ID      int64
+	Content string
+	Snippet string
+	Rank    float64
+	Date    *time.Time
+	Context string
+	Entry   *Entry
+}
+
+// HighlightSnippet applies highlight to every marked span in snippet.
+func HighlightSnippet(snippet string, highlight func(string) string) string {
+	var sb strings.Builder
+	for {
+		start := strings.Index(snippet, SnippetMarkStart)
+		if start == -1 {
+			break
+		}
+		end := strings.Index(snippet[start:], SnippetMarkEnd)
+		if end == -1 {
+			break
+		}
+		end += start
+		sb.WriteString(snippet[:start])
+		sb.WriteString(highlight(snippet[start+len(SnippetMarkStart) : end]))
+		snippet = snippet[end+len(SnippetMarkEnd):]
+	}
+	sb.WriteString(snippet)
+	return sb.String()
+}
+
+// SearchStatus filters by whether an entry is still to be done, rather than
+// by its exact type.
+type SearchStatus string
+
+const (
+	SearchStatusOpen      SearchStatus = "open"
+	SearchStatusDone      SearchStatus = "done"
+	SearchStatusCancelled SearchStatus = "cancelled"
+)
+
+var searchStatusEntryTypes = map[SearchStatus][]EntryType{
+	SearchStatusOpen:      {EntryTypeTask, EntryTypeQuestion},
+	SearchStatusDone:      {EntryTypeDone, EntryTypeAnswered},
+	SearchStatusCancelled: {EntryTypeCancelled},
+}
+
+var searchStatusListItemTypes = map[SearchStatus][]ListItemType{
+	SearchStatusOpen:      {ListItemTypeTask},
+	SearchStatusDone:      {ListItemTypeDone},
+	SearchStatusCancelled: {ListItemTypeCancelled},
+}
+
+func (s SearchStatus) IsValid() bool {
+	_, ok := searchStatusEntryTypes[s]
+	return ok
+}
+
+// EntryTypes are the entry types with the status. Open entries are the
+// tasks still to be done and the questions still waiting on an answer.
+func (s SearchStatus) EntryTypes() []EntryType {
+	return searchStatusEntryTypes[s]
+}
+
+// ListItemTypes are the list item types with the status.
+func (s SearchStatus) ListItemTypes() []ListItemType {
+	return searchStatusListItemTypes[s]
+}
+
+type SearchTerm struct {
+	Text   string
+	Phrase bool
+	Prefix bool
+	Or     bool // joined to the previous term with OR instead of AND
+}
+
+// SearchQuery is the parsed form of the search query language:
+//
+//	word            entries containing a word starting with word
+//	"exact phrase"  phrase match
+//	a OR b          either term
+//	-word           exclude entries containing word
+//	tag:name #name  filter by tag
+//	@name           filter by mention
+//	type:task       filter by entry type
+//	status:open     filter by status (open, done, cancelled)
+//	priority:high   filter by priority (none, low, medium, high)
+//	after:DATE      scheduled after DATE (YYYY-MM-DD)
+//	before:DATE     scheduled before DATE (YYYY-MM-DD)
+type SearchQuery struct {
+	Terms    []SearchTerm
+	Exclude  []SearchTerm
+	Tags     []string
+	Mentions []string
+	Type     *EntryType
+	Status   SearchStatus
+	Priority *Priority
+	From     *time.Time // inclusive
+	To       *time.Time // inclusive
+}
+
+func ParseSearchQuery(input string) (SearchQuery, error) {
+	var q SearchQuery
+	orNext := false
+	for _, tok := range tokenizeSearchQuery(input) {
+		if tok.quoted {
+			q.Terms = append(q.Terms, SearchTerm{Text: tok.text, Phrase: true, Or: orNext && len(q.Terms) > 0})
+			orNext = false
+			continue
+		}
+
+		text := tok.text
+		switch {
+		case text == "OR":
+			orNext = true
+			continue
+		case strings.HasPrefix(text, "-") && len(text) > 1:
+			term := strings.TrimPrefix(text, "-")
+			if tok.negatedQuote {
+				q.Exclude = append(q.Exclude, SearchTerm{Text: term, Phrase: true})
+			} else {
+				q.Exclude = append(q.Exclude, newSearchTerm(term))
+			}
+		case strings.HasPrefix(text, "tag:") && len(text) > 4:
+			q.Tags = append(q.Tags, strings.ToLower(text[4:]))
+		case strings.HasPrefix(text, "#") && len(text) > 1:
+			q.Tags = append(q.Tags, strings.ToLower(text[1:]))
+		case strings.HasPrefix(text, "@") && len(text) > 1:
+			q.Mentions = append(q.Mentions, strings.ToLower(text[1:]))
+		case strings.HasPrefix(text, "type:"):
+			entryType := EntryType(strings.ToLower(text[5:]))
+			if !entryType.IsValid() {
+				return SearchQuery{}, Invalidf("invalid entry type: %s", text[5:])
+			}
+			q.Type = &entryType
+		case strings.HasPrefix(text, "status:"):
+			status := SearchStatus(strings.ToLower(text[7:]))
+			if !status.IsValid() {
+				return SearchQuery{}, Invalidf("invalid status: %s (use open, done or cancelled)", text[7:])
+			}
+			q.Status = status
+		case strings.HasPrefix(text, "priority:"):
+			priority := Priority(strings.ToLower(text[9:]))
+			if !priority.IsValid() {
+				return SearchQuery{}, Invalidf("invalid priority: %s (use none, low, medium or high)", text[9:])
+			}
+			q.Priority = &priority
+		case strings.HasPrefix(text, "after:"):
+			d, err := time.Parse("2006-01-02", text[6:])
+			if err != nil {
+				return SearchQuery{}, Invalidf("invalid date in %s (use YYYY-MM-DD)", text)
+			}
+			d = d.AddDate(0, 0, 1)
+			q.From = &d
+		case strings.HasPrefix(text, "before:"):
+			d, err := time.Parse("2006-01-02", text[7:])
+			if err != nil {
+				return SearchQuery{}, Invalidf("invalid date in %s (use YYYY-MM-DD)", text)
+			}
+			d = d.AddDate(0, 0, -1)
+			q.To = &d
+		default:
+			term := newSearchTerm(text)
+			if term.Text == "" {
+				continue
+			}
+			term.Or = orNext && len(q.Terms) > 0
+			q.Terms = append(q.Terms, term)
+		}
+		orNext = false
+	}
+
+	return q, nil
+}
+
+// MatchExpression renders the positive terms as an FTS5 MATCH expression.
+func (q SearchQuery) MatchExpression() string {
+	return buildMatchExpression(q.Terms)
+}
+
+// ExcludeExpression renders the excluded terms as a single FTS5 expression
+// matching any of them.
+func (q SearchQuery) ExcludeExpression() string {
+	terms := make([]SearchTerm, len(q.Exclude))
+	for i, t := range q.Exclude {
+		t.Or = i > 0
+		terms[i] = t
+	}
+	return buildMatchExpression(terms)
+}
+
+func (q SearchQuery) HasText() bool {
+	return len(q.Terms) > 0
+}
+
+func (q SearchQuery) IsEmpty() bool {
+	return len(q.Terms) == 0 && len(q.Exclude) == 0 && len(q.Tags) == 0 &&
+		len(q.Mentions) == 0 && q.Type == nil && q.Status == "" && q.Priority == nil &&
+		q.From == nil && q.To == nil
+}
+
+func buildMatchExpression(terms []SearchTerm) string {
+	var sb strings.Builder
+	for i, t := range terms {
+		if i > 0 {
+			if t.Or {
+				sb.WriteString(" OR ")
+			} else {
+				sb.WriteString(" AND ")
+			}
+		}
+		sb.WriteString(`"`)
+		sb.WriteString(strings.ReplaceAll(t.Text, `"`, `""`))
+		sb.WriteString(`"`)
+		if t.Prefix {
+			sb.WriteString("*")
+		}
+	}
+	return sb.String()
+}
+
+// newSearchTerm builds a bare word term. Bare words match as prefixes so that
+// partial words typed in search-as-you-type views still find results.
+func newSearchTerm(text string) SearchTerm {
+	return SearchTerm{
+		Text: strings.TrimFunc(text, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		}),
+		Prefix: true,
+	}
+}
+
+type searchToken struct {
+	text         string
+	quoted       bool
+	negatedQuote bool
+}
+
+func tokenizeSearchQuery(input string) []searchToken {
+	var tokens []searchToken
+	runes := []rune(input)
+	for i := 0; i < len(runes); {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		negated := runes[i] == '-' && i+1 < len(runes) && runes[i+1] == '"'
+		if runes[i] == '"' || negated {
+			start := i + 1
+			if negated {
+				start++
+			}
+			end := start
+			for end < len(runes) && runes[end] != '"' {
+				end++
+			}
+			// An unterminated quote runs to the end of the input so that
+			// search-as-you-type never errors mid-phrase.
+			phrase := strings.TrimSpace(string(runes[start:end]))
+			if phrase != "" {
+				if negated {
+					tokens = append(tokens, searchToken{text: "-" + phrase, negatedQuote: true})
+				} else {
+					tokens = append(tokens, searchToken{text: phrase, quoted: true})
+				}
+			}
+			i = end + 1
+			continue
+		}
+
+		start := i
+		for i < len(runes) && !unicode.IsSpace(runes[i]) {
+			i++
+		}
+		tokens = append(tokens, searchToken{text: string(runes[start:i])})
+	}
+	return tokens
+}
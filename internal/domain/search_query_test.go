@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseSearchQuery_MatchExpression(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"groceries", `"groceries"*`},
+		{"buy milk", `"buy"* AND "milk"*`},
+		{`"exact phrase"`, `"exact phrase"`},
+		{"milk OR bread", `"milk"* OR "bread"*`},
+		{`call "the dentist"`, `"call"* AND "the dentist"`},
+		{`"unterminated phrase`, `"unterminated phrase"`},
+		{"milk,", `"milk"*`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			q, err := ParseSearchQuery(tt.input)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := q.MatchExpression(); got != tt.want {
+				t.Errorf("MatchExpression() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSearchQuery_Exclude(t *testing.T) {
+	q, err := ParseSearchQuery(`meeting -standup -"weekly sync"`)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := q.MatchExpression(); got != `"meeting"*` {
+		t.Errorf("MatchExpression() = %q", got)
+	}
+	if got := q.ExcludeExpression(); got != `"standup"* OR "weekly sync"` {
+		t.Errorf("ExcludeExpression() = %q", got)
+	}
+}
+
+func TestParseSearchQuery_Filters(t *testing.T) {
+	q, err := ParseSearchQuery("report tag:Work #urgent @alice type:task after:2026-01-01 before:2026-02-01")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(q.Terms) != 1 || q.Terms[0].Text != "report" {
+		t.Errorf("expected single term 'report', got %v", q.Terms)
+	}
+	if strings.Join(q.Tags, ",") != "work,urgent" {
+		t.Errorf("expected tags work,urgent, got %v", q.Tags)
+	}
+	if strings.Join(q.Mentions, ",") != "alice" {
+		t.Errorf("expected mention alice, got %v", q.Mentions)
+	}
+	if q.Type == nil || *q.Type != EntryTypeTask {
+		t.Errorf("expected type task, got %v", q.Type)
+	}
+	if q.From == nil || !q.From.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected after: to be exclusive, got %v", q.From)
+	}
+	if q.To == nil || !q.To.Equal(time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected before: to be exclusive, got %v", q.To)
+	}
+}
+
+func TestParseSearchQuery_StatusAndPriority(t *testing.T) {
+	q, err := ParseSearchQuery("#waiting status:Open priority:high")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if q.Status != SearchStatusOpen {
+		t.Errorf("expected status open, got %q", q.Status)
+	}
+	if q.Priority == nil || *q.Priority != PriorityHigh {
+		t.Errorf("expected priority high, got %v", q.Priority)
+	}
+	if q.IsEmpty() {
+		t.Error("expected query with status and priority to be non-empty")
+	}
+}
+
+func TestSearchStatus_EntryTypes(t *testing.T) {
+	open := SearchStatusOpen.EntryTypes()
+	for _, entryType := range []EntryType{EntryTypeDone, EntryTypeCancelled, EntryTypeMigrated, EntryTypeAnswered} {
+		for _, openType := range open {
+			if openType == entryType {
+				t.Errorf("expected %s not to be open", entryType)
+			}
+		}
+	}
+	if got := SearchStatusDone.EntryTypes(); len(got) != 2 {
+		t.Errorf("expected done and answered to be done, got %v", got)
+	}
+}
+
+func TestParseSearchQuery_Errors(t *testing.T) {
+	for _, input := range []string{"type:chore", "after:yesterday", "before:2026-13-01", "status:later", "priority:urgent"} {
+		if _, err := ParseSearchQuery(input); err == nil {
+			t.Errorf("expected error for %q", input)
+		}
+	}
+}
+
+func TestParseSearchQuery_FiltersOnlyHasNoText(t *testing.T) {
+	q, err := ParseSearchQuery("#work")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.HasText() {
+		t.Error("expected no text terms")
+	}
+	if q.IsEmpty() {
+		t.Error("expected query with tag filter to be non-empty")
+	}
+}
+
+func TestSearchOptions_ParseQuery_MergesOptions(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC)
+	opts := NewSearchOptions("milk").WithType(EntryTypeTask).WithDateRange(from, to).
+		WithPriority(PriorityLow).WithStatus(SearchStatusOpen)
+
+	q, err := opts.ParseQuery()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if q.Type == nil || *q.Type != EntryTypeTask {
+		t.Errorf("expected type task, got %v", q.Type)
+	}
+	if q.From == nil || !q.From.Equal(from) || q.To == nil || !q.To.Equal(to) {
+		t.Errorf("expected date range to be merged, got %v - %v", q.From, q.To)
+	}
+	if q.Priority == nil || *q.Priority != PriorityLow || q.Status != SearchStatusOpen {
+		t.Errorf("expected priority and status to be merged, got %v %q", q.Priority, q.Status)
+	}
+}
+
+func TestHighlightSnippet(t *testing.T) {
+	snippet := "buy " + SnippetMarkStart + "milk" + SnippetMarkEnd + " and " + SnippetMarkStart + "bread" + SnippetMarkEnd
+	got := HighlightSnippet(snippet, func(s string) string { return "[" + s + "]" })
+
+	if got != "buy [milk] and [bread]" {
+		t.Errorf("HighlightSnippet() = %q", got)
+	}
+}
@@ -9,6 +9,7 @@ type Stats struct {
 	TaskCompletion TaskCompletion
 	Productivity   Productivity
 	HabitStats     HabitStats
+	Goals          []GoalStat
 }
 
 type StatsPeriod struct {
@@ -49,14 +50,37 @@ type HabitStats struct {
 	BestStreak HabitStreak
 	MostLogged HabitLogCount
 	TotalLogs  int
+	Measured   []HabitMeasure
 }
 
+// HabitStreak is a habit's current streak; Days is its length in Period,
+// which is days, weeks or months depending on the habit's goal.
 type HabitStreak struct {
 	HabitName string
 	Days      int
+	Period    StreakPeriod
 }
 
 type HabitLogCount struct {
 	HabitName string
 	Count     int
 }
+
+// HabitMeasure sums up a measured habit's logged values over the period.
+type HabitMeasure struct {
+	HabitName string
+	Unit      string
+	Values    HabitValueSummary
+}
+
+// GoalStat is the completion of a goal in the period, measured by its key
+// results.
+type GoalStat struct {
+	Content    string
+	Period     string
+	KeyResults []KeyResult
+}
+
+func (g GoalStat) Progress() float64 {
+	return KeyResultsProgress(g.KeyResults)
+}
@@ -40,3 +40,21 @@ func (v VersionInfo) IsCurrent() bool {
 func (v VersionInfo) IsDeleted() bool {
 	return v.OpType == OpTypeDelete
 }
+
+// EntryVersion is a snapshot of an entry as it was at one version. Entry.ID
+// is the entry's row ID, which survives edits, and Entry.ParentEntityID
+// identifies its parent across deletes and restores.
+type EntryVersion struct {
+	VersionInfo
+	Entry Entry
+}
+
+type GoalVersion struct {
+	VersionInfo
+	Goal Goal
+}
+
+type HabitVersion struct {
+	VersionInfo
+	Habit Habit
+}
@@ -0,0 +1,169 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type APITokenRepository struct {
+	db *sql.DB
+}
+
+func NewAPITokenRepository(db *sql.DB) *APITokenRepository {
+	return &APITokenRepository{db: db}
+}
+
+func (r *APITokenRepository) Insert(ctx context.Context, token domain.APIToken, hash string) (int64, error) {
+	createdAt := token.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO api_tokens (name, token_hash, prefix, scopes, created_at)
+		VALUES (?, ?, ?, ?, ?)
+	`, token.Name, hash, token.Prefix, token.ScopeString(), createdAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (r *APITokenRepository) GetByID(ctx context.Context, id int64) (*domain.APIToken, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, prefix, scopes, created_at, last_used_at, revoked_at
+		FROM api_tokens WHERE id = ?
+	`, id)
+
+	token, err := scanAPIToken(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return token, err
+}
+
+func (r *APITokenRepository) GetByHash(ctx context.Context, hash string) (*domain.APIToken, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, prefix, scopes, created_at, last_used_at, revoked_at
+		FROM api_tokens WHERE token_hash = ?
+	`, hash)
+
+	token, err := scanAPIToken(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return token, err
+}
+
+func (r *APITokenRepository) GetAll(ctx context.Context) ([]domain.APIToken, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, name, prefix, scopes, created_at, last_used_at, revoked_at
+		FROM api_tokens ORDER BY id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var tokens []domain.APIToken
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, *token)
+	}
+	return tokens, rows.Err()
+}
+
+func (r *APITokenRepository) Revoke(ctx context.Context, id int64, at time.Time) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		UPDATE api_tokens SET revoked_at = ? WHERE id = ? AND revoked_at IS NULL
+	`, at.Format(time.RFC3339), id)
+	return err
+}
+
+func (r *APITokenRepository) TouchLastUsed(ctx context.Context, id int64, at time.Time) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		UPDATE api_tokens SET last_used_at = ? WHERE id = ?
+	`, at.Format(time.RFC3339), id)
+	return err
+}
+
+func scanAPIToken(row rowScanner) (*domain.APIToken, error) {
+	var token domain.APIToken
+	var scopes, createdAt string
+	var lastUsedAt, revokedAt sql.NullString
+
+	err := row.Scan(&token.ID, &token.Name, &token.Prefix, &scopes, &createdAt, &lastUsedAt, &revokedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, scope := range strings.Split(scopes, ",") {
+		if scope != "" {
+			token.Scopes = append(token.Scopes, domain.APITokenScope(scope))
+		}
+	}
+	token.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	if lastUsedAt.Valid {
+		t, _ := time.Parse(time.RFC3339, lastUsedAt.String)
+		token.LastUsedAt = &t
+	}
+	if revokedAt.Valid {
+		t, _ := time.Parse(time.RFC3339, revokedAt.String)
+		token.RevokedAt = &t
+	}
+
+	return &token, nil
+}
+
+type APIOriginRepository struct {
+	db *sql.DB
+}
+
+func NewAPIOriginRepository(db *sql.DB) *APIOriginRepository {
+	return &APIOriginRepository{db: db}
+}
+
+func (r *APIOriginRepository) GetAll(ctx context.Context) ([]string, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `SELECT origin FROM api_allowed_origins ORDER BY origin`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var origins []string
+	for rows.Next() {
+		var origin string
+		if err := rows.Scan(&origin); err != nil {
+			return nil, err
+		}
+		origins = append(origins, origin)
+	}
+	return origins, rows.Err()
+}
+
+func (r *APIOriginRepository) Add(ctx context.Context, origin string) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT OR IGNORE INTO api_allowed_origins (origin, created_at) VALUES (?, ?)
+	`, origin, time.Now().Format(time.RFC3339))
+	return err
+}
+
+func (r *APIOriginRepository) Remove(ctx context.Context, origin string) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `DELETE FROM api_allowed_origins WHERE origin = ?`, origin)
+	return err
+}
+
+func (r *APIOriginRepository) Contains(ctx context.Context, origin string) (bool, error) {
+	var count int
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM api_allowed_origins WHERE origin = ?
+	`, origin).Scan(&count)
+	return count > 0, err
+}
@@ -31,7 +31,7 @@ func (r *DayContextRepository) Upsert(ctx context.Context, dayCtx domain.DayCont
 			entityID = domain.NewEntityID()
 		}
 
-		_, err = r.db.ExecContext(ctx, `
+		_, err = dbFor(ctx, r.db).ExecContext(ctx, `
 			INSERT INTO day_context (date, location, mood, weather, entity_id, version, valid_from, op_type)
 			VALUES (?, ?, ?, ?, ?, ?, ?, ?)
 		`, dateStr, dayCtx.Location, dayCtx.Mood, dayCtx.Weather,
@@ -39,7 +39,7 @@ func (r *DayContextRepository) Upsert(ctx context.Context, dayCtx domain.DayCont
 		return err
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -75,7 +75,7 @@ func (r *DayContextRepository) Upsert(ctx context.Context, dayCtx domain.DayCont
 func (r *DayContextRepository) GetByDate(ctx context.Context, date time.Time) (*domain.DayContext, error) {
 	dateStr := date.Format("2006-01-02")
 
-	row := r.db.QueryRowContext(ctx, `
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT date, location, mood, weather, entity_id
 		FROM day_context WHERE date = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, dateStr)
@@ -95,7 +95,7 @@ func (r *DayContextRepository) Delete(ctx context.Context, date time.Time) error
 	now := time.Now().Format(time.RFC3339)
 	dateStr := date.Format("2006-01-02")
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -132,7 +132,7 @@ func (r *DayContextRepository) GetRange(ctx context.Context, start, end time.Tim
 	startStr := start.Format("2006-01-02")
 	endStr := end.Format("2006-01-02")
 
-	rows, err := r.db.QueryContext(ctx, `
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
 		SELECT date, location, mood, weather, entity_id
 		FROM day_context
 		WHERE date >= ? AND date <= ?
@@ -176,7 +176,7 @@ func (r *DayContextRepository) GetRange(ctx context.Context, start, end time.Tim
 }
 
 func (r *DayContextRepository) GetAll(ctx context.Context) ([]domain.DayContext, error) {
-	rows, err := r.db.QueryContext(ctx, `
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
 		SELECT date, location, mood, weather, entity_id
 		FROM day_context
 		WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
@@ -219,7 +219,7 @@ func (r *DayContextRepository) GetAll(ctx context.Context) ([]domain.DayContext,
 }
 
 func (r *DayContextRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM day_context")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM day_context")
 	return err
 }
 
@@ -254,7 +254,7 @@ func (r *DayContextRepository) scanDayContext(row *sql.Row) (*domain.DayContext,
 }
 
 func (r *DayContextRepository) GetDeleted(ctx context.Context) ([]domain.DayContext, error) {
-	rows, err := r.db.QueryContext(ctx, `
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
 		SELECT date, location, mood, weather, entity_id
 		FROM day_context
 		WHERE op_type = 'DELETE'
@@ -299,7 +299,7 @@ func (r *DayContextRepository) GetDeleted(ctx context.Context) ([]domain.DayCont
 
 func (r *DayContextRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM day_context`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM day_context`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -321,7 +321,7 @@ func (r *DayContextRepository) Restore(ctx context.Context, entityID domain.Enti
 		OpType   string
 	}
 
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT date, location, mood, weather, version, op_type
 		FROM day_context WHERE entity_id = ?
 		ORDER BY version DESC LIMIT 1
@@ -336,7 +336,7 @@ func (r *DayContextRepository) Restore(ctx context.Context, entityID domain.Enti
 		return nil // Not deleted, nothing to restore
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package sqlite
 
 import (
+	"context"
 	"database/sql"
 	"embed"
 	"fmt"
@@ -53,6 +54,10 @@ func RunMigrations(db *sql.DB) error {
 		return fmt.Errorf("failed to run migrations: %w", err)
 	}
 
+	if err := dropPersistentOperationTriggers(db); err != nil {
+		return fmt.Errorf("failed to drop operation log triggers: %w", err)
+	}
+
 	return nil
 }
 
@@ -69,3 +74,68 @@ func OpenAndMigrate(dsn string) (*sql.DB, error) {
 
 	return db, nil
 }
+
+// queryer is satisfied by *sql.DB, *sql.Conn and *sql.Tx.
+type queryer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+type connContextKey struct{}
+
+type txContextKey struct{}
+
+// dbFor returns what a repository should run its statements on: the
+// transaction ctx carries, if any, then the connection ctx was pinned to by
+// an open operation, so the operation's reads and writes happen where its
+// changes are recorded, and otherwise db.
+func dbFor(ctx context.Context, db *sql.DB) queryer {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	if conn, ok := ctx.Value(connContextKey{}).(*sql.Conn); ok {
+		return conn
+	}
+	return db
+}
+
+// txn is the transaction a repository method runs in. One begun with a
+// context that already carries a transaction joins it, and leaves
+// committing and rolling back to whoever began it.
+type txn struct {
+	*sql.Tx
+	joined bool
+}
+
+func (t *txn) Commit() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Commit()
+}
+
+func (t *txn) Rollback() error {
+	if t.joined {
+		return nil
+	}
+	return t.Tx.Rollback()
+}
+
+func beginTx(ctx context.Context, db *sql.DB) (*txn, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return &txn{Tx: tx, joined: true}, nil
+	}
+
+	var tx *sql.Tx
+	var err error
+	if conn, ok := ctx.Value(connContextKey{}).(*sql.Conn); ok {
+		tx, err = conn.BeginTx(ctx, nil)
+	} else {
+		tx, err = db.BeginTx(ctx, nil)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &txn{Tx: tx}, nil
+}
@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// entryVersionColumns lines entry_versions up with entryColumns so rows can
+// be read with scanEntryRow, followed by the version columns.
+const entryVersionColumns = `entry_id, type, content, priority, NULL, depth, location, scheduled_date, created_at, entity_id,
+	sort_order, migration_count, completed_at, original_created_at, schedule_granularity,
+	row_id, version, valid_from, valid_to, op_type, parent_entity_id`
+
+type EntryHistoryRepository struct {
+	db *sql.DB
+}
+
+func NewEntryHistoryRepository(db *sql.DB) *EntryHistoryRepository {
+	return &EntryHistoryRepository{db: db}
+}
+
+func (r *EntryHistoryRepository) GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.EntryVersion, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+entryVersionColumns+`
+		FROM entry_versions
+		WHERE entity_id = ?
+		ORDER BY version
+	`, entityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanEntryVersions(rows)
+}
+
+func (r *EntryHistoryRepository) GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.EntryVersion, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT `+entryVersionColumns+`
+		FROM entry_versions
+		WHERE entity_id = ? AND version = ?
+	`, entityID.String(), version)
+
+	v, err := scanEntryVersion(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// GetDeletedTree returns the entry as it was when deleted, followed by the
+// children that were deleted along with it, parents before children.
+func (r *EntryHistoryRepository) GetDeletedTree(ctx context.Context, entityID domain.EntityID) ([]domain.EntryVersion, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		WITH RECURSIVE
+			root AS (
+				SELECT entity_id, valid_from FROM entry_versions
+				WHERE entity_id = ? AND valid_to IS NULL AND op_type = 'DELETE'
+			),
+			tree(entity_id, level) AS (
+				SELECT entity_id, 0 FROM root
+				UNION ALL
+				SELECT v.entity_id, t.level + 1
+				FROM entry_versions v
+				JOIN tree t ON v.parent_entity_id = t.entity_id
+				WHERE v.valid_to IS NULL AND v.op_type = 'DELETE'
+					AND v.valid_from = (SELECT valid_from FROM root)
+			)
+		SELECT `+entryVersionColumns+`
+		FROM entry_versions
+		JOIN tree USING (entity_id)
+		WHERE valid_to IS NULL AND op_type = 'DELETE'
+		ORDER BY tree.level, sort_order, entry_id
+	`, entityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return scanEntryVersions(rows)
+}
+
+// FindEntityID returns the entity an entry ID belonged to, including
+// entries that have since been deleted. It returns "" when unknown.
+func (r *EntryHistoryRepository) FindEntityID(ctx context.Context, entryID int64) (domain.EntityID, error) {
+	var entityID string
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT entity_id FROM entry_versions WHERE entry_id = ? ORDER BY row_id DESC LIMIT 1
+	`, entryID).Scan(&entityID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+	return domain.EntityID(entityID), nil
+}
+
+// Restore inserts previously deleted entries under their original IDs so
+// references to them keep working. Parents must come before children.
+func (r *EntryHistoryRepository) Restore(ctx context.Context, entries []domain.Entry) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	now := time.Now().Format(time.RFC3339)
+	for _, entry := range entries {
+		var completedAt, originalCreatedAt *string
+		if entry.CompletedAt != nil {
+			s := entry.CompletedAt.Format(time.RFC3339)
+			completedAt = &s
+		}
+		if entry.OriginalCreatedAt != nil {
+			s := entry.OriginalCreatedAt.Format(time.RFC3339)
+			originalCreatedAt = &s
+		}
+
+		_, err := tx.ExecContext(ctx, `
+			INSERT INTO entries (id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, version, valid_from, op_type, sort_order, migration_count, completed_at, original_created_at, schedule_granularity)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1, ?, 'INSERT', ?, ?, ?, ?, ?)
+		`, entry.ID, entry.Type, entry.Content, entry.Priority, entry.ParentID, entry.Depth, entry.Location,
+			entry.ScheduledDate.Format("2006-01-02"), entry.CreatedAt.Format(time.RFC3339), entry.EntityID.String(),
+			now, entry.SortOrder, entry.MigrationCount, completedAt, originalCreatedAt, scheduleGranularity(entry))
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func scanEntryVersion(row rowScanner) (domain.EntryVersion, error) {
+	var v domain.EntryVersion
+	var opType, validFrom string
+	var validTo, parentEntityID sql.NullString
+
+	entry, err := scanEntryRow(row, &v.RowID, &v.Version, &validFrom, &validTo, &opType, &parentEntityID)
+	if err != nil {
+		return domain.EntryVersion{}, err
+	}
+
+	v.Entry = entry
+	v.EntityID = entry.EntityID
+	v.OpType = domain.OpType(opType)
+	v.ValidFrom, _ = time.Parse(time.RFC3339, validFrom)
+	if validTo.Valid {
+		t, _ := time.Parse(time.RFC3339, validTo.String)
+		v.ValidTo = &t
+	}
+	if parentEntityID.Valid {
+		parent := domain.EntityID(parentEntityID.String)
+		v.Entry.ParentEntityID = &parent
+	}
+	return v, nil
+}
+
+func scanEntryVersions(rows *sql.Rows) ([]domain.EntryVersion, error) {
+	var versions []domain.EntryVersion
+	for rows.Next() {
+		v, err := scanEntryVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
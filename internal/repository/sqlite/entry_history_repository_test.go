@@ -0,0 +1,127 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func insertHistoryTestEntry(t *testing.T, repo *EntryRepository, content string, parentID *int64, depth int) domain.Entry {
+	t.Helper()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	entry := domain.Entry{
+		Type:          domain.EntryTypeTask,
+		Content:       content,
+		ParentID:      parentID,
+		Depth:         depth,
+		ScheduledDate: &date,
+		CreatedAt:     time.Now(),
+		EntityID:      domain.NewEntityID(),
+	}
+	id, err := repo.Insert(context.Background(), entry)
+	require.NoError(t, err)
+	entry.ID = id
+	return entry
+}
+
+func TestEntryHistoryRepository_RecordsEditsAndDeletes(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewEntryHistoryRepository(db)
+	ctx := context.Background()
+
+	entry := insertHistoryTestEntry(t, entryRepo, "Buy milk", nil, 0)
+	entry.Content = "Buy oat milk"
+	require.NoError(t, entryRepo.Update(ctx, entry))
+	require.NoError(t, entryRepo.Delete(ctx, entry.ID))
+
+	history, err := repo.GetHistory(ctx, entry.EntityID)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	assert.Equal(t, domain.OpTypeInsert, history[0].OpType)
+	assert.Equal(t, "Buy milk", history[0].Entry.Content)
+	assert.NotNil(t, history[0].ValidTo)
+	assert.Equal(t, domain.OpTypeUpdate, history[1].OpType)
+	assert.Equal(t, "Buy oat milk", history[1].Entry.Content)
+	assert.True(t, history[2].IsDeleted())
+	assert.True(t, history[2].IsCurrent())
+	assert.Equal(t, entry.ID, history[2].Entry.ID)
+}
+
+func TestEntryHistoryRepository_IgnoresReordering(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewEntryHistoryRepository(db)
+	ctx := context.Background()
+
+	entry := insertHistoryTestEntry(t, entryRepo, "Write report", nil, 0)
+	entry.SortOrder = 7
+	require.NoError(t, entryRepo.Update(ctx, entry))
+
+	history, err := repo.GetHistory(ctx, entry.EntityID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
+
+func TestEntryHistoryRepository_GetDeletedTreeAndRestore(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewEntryHistoryRepository(db)
+	ctx := context.Background()
+
+	parent := insertHistoryTestEntry(t, entryRepo, "Project", nil, 0)
+	child := insertHistoryTestEntry(t, entryRepo, "Detail", &parent.ID, 1)
+	grandchild := insertHistoryTestEntry(t, entryRepo, "Subtask", &child.ID, 2)
+	require.NoError(t, entryRepo.DeleteWithChildren(ctx, parent.ID))
+
+	tree, err := repo.GetDeletedTree(ctx, parent.EntityID)
+	require.NoError(t, err)
+	require.Len(t, tree, 3)
+	assert.Equal(t, parent.ID, tree[0].Entry.ID)
+	assert.Equal(t, child.ID, tree[1].Entry.ID)
+	assert.Equal(t, parent.EntityID, *tree[1].Entry.ParentEntityID)
+	assert.Equal(t, grandchild.ID, tree[2].Entry.ID)
+
+	entries := make([]domain.Entry, len(tree))
+	for i, v := range tree {
+		entries[i] = v.Entry
+	}
+	entries[1].ParentID = &parent.ID
+	entries[2].ParentID = &child.ID
+	require.NoError(t, repo.Restore(ctx, entries))
+
+	restored, err := entryRepo.GetByID(ctx, grandchild.ID)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, "Subtask", restored.Content)
+	assert.Equal(t, child.ID, *restored.ParentID)
+
+	entityID, err := repo.FindEntityID(ctx, child.ID)
+	require.NoError(t, err)
+	assert.Equal(t, child.EntityID, entityID)
+}
+
+func TestEntryHistoryRepository_KeepsMostRecentVersions(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewEntryHistoryRepository(db)
+	ctx := context.Background()
+
+	entry := insertHistoryTestEntry(t, entryRepo, "Draft 0", nil, 0)
+	for i := 1; i <= maxEntityVersions+5; i++ {
+		entry.Content = fmt.Sprintf("Draft %d", i)
+		require.NoError(t, entryRepo.Update(ctx, entry))
+	}
+
+	history, err := repo.GetHistory(ctx, entry.EntityID)
+	require.NoError(t, err)
+	require.Len(t, history, maxEntityVersions)
+	assert.Equal(t, 7, history[0].Version)
+	assert.Equal(t, fmt.Sprintf("Draft %d", maxEntityVersions+5), history[len(history)-1].Entry.Content)
+}
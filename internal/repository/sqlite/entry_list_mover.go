@@ -19,18 +19,14 @@ func NewEntryToListMover(db *sql.DB) *EntryToListMover {
 func (m *EntryToListMover) MoveEntryToList(ctx context.Context, entry domain.Entry, listEntityID domain.EntityID) error {
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := m.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, m.db)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = tx.Rollback() }()
 
 	listItem := domain.NewListItem(listEntityID, domain.ListItemTypeTask, entry.Content)
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO list_items (entity_id, version, valid_from, op_type, list_entity_id, type, content, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, listItem.EntityID.String(), listItem.Version, now, domain.OpTypeInsert.String(),
-		listItem.ListEntityID.String(), string(listItem.Type), listItem.Content, listItem.CreatedAt.Format(time.RFC3339))
+	_, err = insertListItemVersion(ctx, tx, listItem, listItem.Version, domain.OpTypeInsert, now)
 	if err != nil {
 		return err
 	}
@@ -19,6 +19,16 @@ func NewEntryRepository(db *sql.DB) *EntryRepository {
 }
 
 func (r *EntryRepository) Insert(ctx context.Context, entry domain.Entry) (int64, error) {
+	return insertEntry(ctx, dbFor(ctx, r.db), entry)
+}
+
+// execer is satisfied by both *sql.DB and *sql.Tx, so inserts can be shared
+// between repositories and the transactions of other ones.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+}
+
+func insertEntry(ctx context.Context, db execer, entry domain.Entry) (int64, error) {
 	var scheduledDateStr string
 	if entry.ScheduledDate != nil {
 		scheduledDateStr = entry.ScheduledDate.Format("2006-01-02")
@@ -37,6 +47,8 @@ func (r *EntryRepository) Insert(ctx context.Context, entry domain.Entry) (int64
 		priority = domain.PriorityNone
 	}
 
+	granularity := scheduleGranularity(entry)
+
 	var completedAtStr *string
 	if entry.CompletedAt != nil {
 		s := entry.CompletedAt.Format(time.RFC3339)
@@ -48,11 +60,15 @@ func (r *EntryRepository) Insert(ctx context.Context, entry domain.Entry) (int64
 		originalCreatedAtStr = &s
 	}
 
-	result, err := r.db.ExecContext(ctx, `
-		INSERT INTO entries (type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, version, valid_from, op_type, sort_order, migration_count, completed_at, original_created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1, ?, 'INSERT', ?, ?, ?, ?)
+	// Without an explicit sort order an entry goes after the highest one on
+	// its day, so it lands after entries reordered in the editable view. A
+	// day that was never reordered keeps every entry at 0, in creation order.
+	result, err := db.ExecContext(ctx, `
+		INSERT INTO entries (type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, version, valid_from, op_type, sort_order, migration_count, completed_at, original_created_at, schedule_granularity)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1, ?, 'INSERT',
+			COALESCE(NULLIF(?, 0), (SELECT NULLIF(MAX(sort_order), 0) + 1 FROM entries WHERE scheduled_date = ? AND schedule_granularity = ?), 0), ?, ?, ?, ?)
 	`, entry.Type, entry.Content, priority, entry.ParentID, entry.Depth, entry.Location, scheduledDateStr, entry.CreatedAt.Format(time.RFC3339),
-		entityID.String(), now, entry.SortOrder, entry.MigrationCount, completedAtStr, originalCreatedAtStr)
+		entityID.String(), now, entry.SortOrder, scheduledDateStr, granularity, entry.MigrationCount, completedAtStr, originalCreatedAtStr, granularity)
 
 	if err != nil {
 		return 0, err
@@ -62,8 +78,8 @@ func (r *EntryRepository) Insert(ctx context.Context, entry domain.Entry) (int64
 }
 
 func (r *EntryRepository) GetByID(ctx context.Context, id int64) (*domain.Entry, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 		FROM entries WHERE id = ?
 	`, id)
 
@@ -73,10 +89,10 @@ func (r *EntryRepository) GetByID(ctx context.Context, id int64) (*domain.Entry,
 func (r *EntryRepository) GetByDate(ctx context.Context, date time.Time) ([]domain.Entry, error) {
 	dateStr := date.Format("2006-01-02")
 
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
-		FROM entries WHERE scheduled_date = ?
-		ORDER BY created_at, id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
+		FROM entries WHERE scheduled_date = ? AND schedule_granularity = 'day'
+		ORDER BY sort_order, created_at, id
 	`, dateStr)
 	if err != nil {
 		return nil, err
@@ -90,10 +106,10 @@ func (r *EntryRepository) GetByDateRange(ctx context.Context, from, to time.Time
 	fromStr := from.Format("2006-01-02")
 	toStr := to.Format("2006-01-02")
 
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
-		FROM entries WHERE scheduled_date >= ? AND scheduled_date <= ?
-		ORDER BY scheduled_date, created_at, id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
+		FROM entries WHERE scheduled_date >= ? AND scheduled_date <= ? AND schedule_granularity = 'day'
+		ORDER BY scheduled_date, sort_order, created_at, id
 	`, fromStr, toStr)
 	if err != nil {
 		return nil, err
@@ -103,25 +119,60 @@ func (r *EntryRepository) GetByDateRange(ctx context.Context, from, to time.Time
 	return r.scanEntries(rows)
 }
 
+// GetByMonth returns the entries scheduled for the month as a whole, as
+// opposed to one of its days.
+func (r *EntryRepository) GetByMonth(ctx context.Context, month time.Time) ([]domain.Entry, error) {
+	monthStr := time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+entryColumns("e")+`
+		FROM entries e WHERE e.scheduled_date = ? AND e.schedule_granularity = 'month'
+		ORDER BY e.sort_order, e.created_at, e.id
+	`, monthStr)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return r.scanEntries(rows)
+}
+
+// GetFutureLog returns every entry scheduled for a month or for someday,
+// months in order followed by someday entries.
+func (r *EntryRepository) GetFutureLog(ctx context.Context) ([]domain.Entry, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+entryColumns("e")+`
+		FROM entries e WHERE e.schedule_granularity IN ('month', 'someday')
+		ORDER BY e.schedule_granularity = 'someday', CASE WHEN e.schedule_granularity = 'month' THEN e.scheduled_date END,
+			e.sort_order, e.created_at, e.id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return r.scanEntries(rows)
+}
+
 func (r *EntryRepository) GetOverdue(ctx context.Context) ([]domain.Entry, error) {
 	dateStr := time.Now().Format("2006-01-02")
 
-	rows, err := r.db.QueryContext(ctx, `
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
 		WITH RECURSIVE
 		overdue_tasks AS (
-			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 			FROM entries
-			WHERE scheduled_date < ? AND type = 'task'
+			WHERE scheduled_date < ? AND type = 'task' AND schedule_granularity = 'day'
 		),
 		parent_chain AS (
-			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 			FROM overdue_tasks
 			UNION
-			SELECT e.id, e.type, e.content, e.priority, e.parent_id, e.depth, e.location, e.scheduled_date, e.created_at, e.entity_id, e.sort_order, e.migration_count, e.completed_at, e.original_created_at
+			SELECT e.id, e.type, e.content, e.priority, e.parent_id, e.depth, e.location, e.scheduled_date, e.created_at, e.entity_id, e.sort_order, e.migration_count, e.completed_at, e.original_created_at, e.schedule_granularity
 			FROM entries e
 			INNER JOIN parent_chain pc ON e.id = pc.parent_id
 		)
-		SELECT DISTINCT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+		SELECT DISTINCT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 		FROM parent_chain
 		ORDER BY scheduled_date, depth, created_at, id
 	`, dateStr)
@@ -134,16 +185,16 @@ func (r *EntryRepository) GetOverdue(ctx context.Context) ([]domain.Entry, error
 }
 
 func (r *EntryRepository) GetWithChildren(ctx context.Context, id int64) ([]domain.Entry, error) {
-	rows, err := r.db.QueryContext(ctx, `
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
 		WITH RECURSIVE tree AS (
-			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+			SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 			FROM entries WHERE id = ?
 			UNION ALL
-			SELECT e.id, e.type, e.content, e.priority, e.parent_id, e.depth, e.location, e.scheduled_date, e.created_at, e.entity_id, e.sort_order, e.migration_count, e.completed_at, e.original_created_at
+			SELECT e.id, e.type, e.content, e.priority, e.parent_id, e.depth, e.location, e.scheduled_date, e.created_at, e.entity_id, e.sort_order, e.migration_count, e.completed_at, e.original_created_at, e.schedule_granularity
 			FROM entries e
 			JOIN tree t ON e.parent_id = t.id
 		)
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at FROM tree ORDER BY depth, id
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity FROM tree ORDER BY depth, id
 	`, id)
 	if err != nil {
 		return nil, err
@@ -166,6 +217,8 @@ func (r *EntryRepository) Update(ctx context.Context, entry domain.Entry) error
 		priority = domain.PriorityNone
 	}
 
+	granularity := scheduleGranularity(entry)
+
 	var completedAtStr *string
 	if entry.CompletedAt != nil {
 		s := entry.CompletedAt.Format(time.RFC3339)
@@ -178,10 +231,10 @@ func (r *EntryRepository) Update(ctx context.Context, entry domain.Entry) error
 		originalCreatedAtStr = &s
 	}
 
-	result, err := r.db.ExecContext(ctx, `
-		UPDATE entries SET type = ?, content = ?, priority = ?, parent_id = ?, depth = ?, location = ?, scheduled_date = ?, sort_order = ?, migration_count = ?, completed_at = ?, original_created_at = ?
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		UPDATE entries SET type = ?, content = ?, priority = ?, parent_id = ?, depth = ?, location = ?, scheduled_date = ?, sort_order = ?, migration_count = ?, completed_at = ?, original_created_at = ?, schedule_granularity = ?
 		WHERE id = ?
-	`, entry.Type, entry.Content, priority, entry.ParentID, entry.Depth, entry.Location, scheduledDateStr, entry.SortOrder, entry.MigrationCount, completedAtStr, originalCreatedAtStr, entry.ID)
+	`, entry.Type, entry.Content, priority, entry.ParentID, entry.Depth, entry.Location, scheduledDateStr, entry.SortOrder, entry.MigrationCount, completedAtStr, originalCreatedAtStr, granularity, entry.ID)
 	if err != nil {
 		return err
 	}
@@ -191,31 +244,31 @@ func (r *EntryRepository) Update(ctx context.Context, entry domain.Entry) error
 		return err
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("entry not found: %d", entry.ID)
+		return fmt.Errorf("entry %w: %d", domain.ErrNotFound, entry.ID)
 	}
 
 	return nil
 }
 
 func (r *EntryRepository) Delete(ctx context.Context, id int64) error {
-	_, err := r.db.ExecContext(ctx, `DELETE FROM entries WHERE id = ?`, id)
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `DELETE FROM entries WHERE id = ?`, id)
 	return err
 }
 
 func (r *EntryRepository) DeleteByDate(ctx context.Context, date time.Time) error {
 	dateStr := date.Format("2006-01-02")
-	_, err := r.db.ExecContext(ctx, "DELETE FROM entries WHERE scheduled_date = ?", dateStr)
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM entries WHERE scheduled_date = ? AND schedule_granularity = 'day'", dateStr)
 	return err
 }
 
 func (r *EntryRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM entries")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM entries")
 	return err
 }
 
 func (r *EntryRepository) GetChildren(ctx context.Context, parentID int64) ([]domain.Entry, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 		FROM entries WHERE parent_id = ?
 		ORDER BY id
 	`, parentID)
@@ -228,7 +281,7 @@ func (r *EntryRepository) GetChildren(ctx context.Context, parentID int64) ([]do
 }
 
 func (r *EntryRepository) DeleteWithChildren(ctx context.Context, id int64) error {
-	_, err := r.db.ExecContext(ctx, `
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
 		WITH RECURSIVE tree AS (
 			SELECT id FROM entries WHERE id = ?
 			UNION ALL
@@ -241,11 +294,11 @@ func (r *EntryRepository) DeleteWithChildren(ctx context.Context, id int64) erro
 
 func (r *EntryRepository) scanEntry(row *sql.Row) (*domain.Entry, error) {
 	var entry domain.Entry
-	var typeStr, priorityStr string
+	var typeStr, priorityStr, granularity string
 	var scheduledDate, location, createdAt, entityID, completedAt, originalCreatedAt sql.NullString
 	var parentID sql.NullInt64
 
-	err := row.Scan(&entry.ID, &typeStr, &entry.Content, &priorityStr, &parentID, &entry.Depth, &location, &scheduledDate, &createdAt, &entityID, &entry.SortOrder, &entry.MigrationCount, &completedAt, &originalCreatedAt)
+	err := row.Scan(&entry.ID, &typeStr, &entry.Content, &priorityStr, &parentID, &entry.Depth, &location, &scheduledDate, &createdAt, &entityID, &entry.SortOrder, &entry.MigrationCount, &completedAt, &originalCreatedAt, &granularity)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -255,6 +308,7 @@ func (r *EntryRepository) scanEntry(row *sql.Row) (*domain.Entry, error) {
 
 	entry.Type = domain.EntryType(typeStr)
 	entry.Priority = domain.Priority(priorityStr)
+	entry.Granularity = domain.ScheduleGranularity(granularity)
 
 	if parentID.Valid {
 		entry.ParentID = &parentID.Int64
@@ -301,66 +355,85 @@ func (r *EntryRepository) scanEntries(rows *sql.Rows) ([]domain.Entry, error) {
 	var entries []domain.Entry
 
 	for rows.Next() {
-		var entry domain.Entry
-		var typeStr, priorityStr string
-		var scheduledDate, location, createdAt, entityID, completedAt, originalCreatedAt sql.NullString
-		var parentID sql.NullInt64
-
-		err := rows.Scan(&entry.ID, &typeStr, &entry.Content, &priorityStr, &parentID, &entry.Depth, &location, &scheduledDate, &createdAt, &entityID, &entry.SortOrder, &entry.MigrationCount, &completedAt, &originalCreatedAt)
+		entry, err := scanEntryRow(rows)
 		if err != nil {
 			return nil, err
 		}
+		entries = append(entries, entry)
+	}
+
+	return entries, rows.Err()
+}
 
-		entry.Type = domain.EntryType(typeStr)
-		entry.Priority = domain.Priority(priorityStr)
+func entryColumns(alias string) string {
+	columns := []string{"id", "type", "content", "priority", "parent_id", "depth", "location", "scheduled_date", "created_at", "entity_id", "sort_order", "migration_count", "completed_at", "original_created_at", "schedule_granularity"}
+	for i, c := range columns {
+		columns[i] = alias + "." + c
+	}
+	return strings.Join(columns, ", ")
+}
 
-		if parentID.Valid {
-			entry.ParentID = &parentID.Int64
-		}
-		if location.Valid {
-			entry.Location = &location.String
-		}
-		if scheduledDate.Valid {
-			t, err := time.Parse("2006-01-02", scheduledDate.String)
-			if err != nil {
-				return nil, fmt.Errorf("parse scheduled_date %q: %w", scheduledDate.String, err)
-			}
-			entry.ScheduledDate = &t
-		}
-		if createdAt.Valid {
-			t, err := time.Parse(time.RFC3339, createdAt.String)
-			if err != nil {
-				return nil, fmt.Errorf("parse created_at %q: %w", createdAt.String, err)
-			}
-			entry.CreatedAt = t
+// scanEntryRow scans the columns listed by entryColumns, followed by any
+// extra destinations selected after them.
+func scanEntryRow(row rowScanner, extra ...any) (domain.Entry, error) {
+	var entry domain.Entry
+	var typeStr, priorityStr, granularity string
+	var scheduledDate, location, createdAt, entityID, completedAt, originalCreatedAt sql.NullString
+	var parentID sql.NullInt64
+
+	dest := []any{&entry.ID, &typeStr, &entry.Content, &priorityStr, &parentID, &entry.Depth, &location, &scheduledDate, &createdAt, &entityID, &entry.SortOrder, &entry.MigrationCount, &completedAt, &originalCreatedAt, &granularity}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return domain.Entry{}, err
+	}
+
+	entry.Type = domain.EntryType(typeStr)
+	entry.Priority = domain.Priority(priorityStr)
+	entry.Granularity = domain.ScheduleGranularity(granularity)
+
+	if parentID.Valid {
+		entry.ParentID = &parentID.Int64
+	}
+	if location.Valid {
+		entry.Location = &location.String
+	}
+	if scheduledDate.Valid {
+		t, err := time.Parse("2006-01-02", scheduledDate.String)
+		if err != nil {
+			return domain.Entry{}, fmt.Errorf("parse scheduled_date %q: %w", scheduledDate.String, err)
 		}
-		if entityID.Valid {
-			entry.EntityID = domain.EntityID(entityID.String)
+		entry.ScheduledDate = &t
+	}
+	if createdAt.Valid {
+		t, err := time.Parse(time.RFC3339, createdAt.String)
+		if err != nil {
+			return domain.Entry{}, fmt.Errorf("parse created_at %q: %w", createdAt.String, err)
 		}
-		if completedAt.Valid {
-			t, err := time.Parse(time.RFC3339, completedAt.String)
-			if err != nil {
-				return nil, fmt.Errorf("parse completed_at %q: %w", completedAt.String, err)
-			}
-			entry.CompletedAt = &t
+		entry.CreatedAt = t
+	}
+	if entityID.Valid {
+		entry.EntityID = domain.EntityID(entityID.String)
+	}
+	if completedAt.Valid {
+		t, err := time.Parse(time.RFC3339, completedAt.String)
+		if err != nil {
+			return domain.Entry{}, fmt.Errorf("parse completed_at %q: %w", completedAt.String, err)
 		}
-		if originalCreatedAt.Valid {
-			t, err := time.Parse(time.RFC3339, originalCreatedAt.String)
-			if err != nil {
-				return nil, fmt.Errorf("parse original_created_at %q: %w", originalCreatedAt.String, err)
-			}
-			entry.OriginalCreatedAt = &t
+		entry.CompletedAt = &t
+	}
+	if originalCreatedAt.Valid {
+		t, err := time.Parse(time.RFC3339, originalCreatedAt.String)
+		if err != nil {
+			return domain.Entry{}, fmt.Errorf("parse original_created_at %q: %w", originalCreatedAt.String, err)
 		}
-
-		entries = append(entries, entry)
+		entry.OriginalCreatedAt = &t
 	}
 
-	return entries, rows.Err()
+	return entry, nil
 }
 
 func (r *EntryRepository) GetAll(ctx context.Context) ([]domain.Entry, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, type, content, priority, parent_id, depth, location, scheduled_date, created_at, entity_id, sort_order, migration_count, completed_at, original_created_at, schedule_granularity
 		FROM entries
 		ORDER BY scheduled_date, created_at, id
 	`)
@@ -374,7 +447,7 @@ func (r *EntryRepository) GetAll(ctx context.Context) ([]domain.Entry, error) {
 
 func (r *EntryRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT MAX(valid_from) FROM entries
 	`).Scan(&validFrom)
 	if err != nil {
@@ -387,52 +460,16 @@ func (r *EntryRepository) GetLastModified(ctx context.Context) (time.Time, error
 }
 
 func (r *EntryRepository) Search(ctx context.Context, opts domain.SearchOptions) ([]domain.Entry, error) {
-	if opts.Query == "" && opts.Type == nil && len(opts.Tags) == 0 && len(opts.Mentions) == 0 {
-		return []domain.Entry{}, nil
-	}
-
-	query := `
-		SELECT DISTINCT e.id, e.type, e.content, e.priority, e.parent_id, e.depth, e.location, e.scheduled_date, e.created_at, e.entity_id, e.sort_order, e.migration_count, e.completed_at, e.original_created_at
-		FROM entries e
-	`
-	var args []any
-
-	if len(opts.Tags) > 0 {
-		placeholders := make([]string, len(opts.Tags))
-		for i, tag := range opts.Tags {
-			placeholders[i] = "?"
-			args = append(args, tag)
-		}
-		query += fmt.Sprintf(` JOIN entry_tags et ON e.id = et.entry_id AND et.tag IN (%s)`, strings.Join(placeholders, ","))
-	}
-
-	if len(opts.Mentions) > 0 {
-		placeholders := make([]string, len(opts.Mentions))
-		for i, mention := range opts.Mentions {
-			placeholders[i] = "?"
-			args = append(args, mention)
-		}
-		query += fmt.Sprintf(` JOIN entry_mentions em ON e.id = em.entry_id AND em.mention IN (%s)`, strings.Join(placeholders, ","))
-	}
-
-	query += ` WHERE 1=1`
-
-	if opts.Query != "" {
-		query += ` AND e.content LIKE '%' || ? || '%' COLLATE NOCASE`
-		args = append(args, opts.Query)
-	}
-
-	if opts.Type != nil {
-		query += ` AND e.type = ?`
-		args = append(args, string(*opts.Type))
+	q, err := opts.ParseQuery()
+	if err != nil {
+		return nil, err
 	}
-
-	if opts.DateFrom != nil && opts.DateTo != nil {
-		query += ` AND e.scheduled_date >= ? AND e.scheduled_date <= ?`
-		args = append(args, opts.DateFrom.Format("2006-01-02"), opts.DateTo.Format("2006-01-02"))
+	if q.IsEmpty() {
+		return []domain.Entry{}, nil
 	}
 
-	query += ` ORDER BY e.scheduled_date DESC, e.created_at DESC, e.id DESC`
+	from, where, args := entrySearchClauses(q)
+	query := `SELECT ` + entryColumns("e") + ` FROM entries e` + from + where + entrySearchOrder(q)
 
 	limit := opts.Limit
 	if limit <= 0 {
@@ -441,7 +478,7 @@ func (r *EntryRepository) Search(ctx context.Context, opts domain.SearchOptions)
 	query += ` LIMIT ?`
 	args = append(args, limit)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -449,3 +486,12 @@ func (r *EntryRepository) Search(ctx context.Context, opts domain.SearchOptions)
 
 	return r.scanEntries(rows)
 }
+
+// scheduleGranularity defaults entries that don't say otherwise to being
+// scheduled for a day.
+func scheduleGranularity(entry domain.Entry) domain.ScheduleGranularity {
+	if entry.Granularity == "" {
+		return domain.ScheduleDay
+	}
+	return entry.Granularity
+}
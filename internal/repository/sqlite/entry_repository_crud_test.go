@@ -286,6 +286,30 @@ func TestEntryRepository_GetOverdue_IncludesParentChainForOverdueTasks(t *testin
 	assert.Contains(t, contents, "Follow up action", "Should include overdue task")
 }
 
+func TestEntryRepository_Insert_GoesAfterReorderedEntries(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	for i, content := range []string{"Second", "First"} {
+		_, err := repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: content, ScheduledDate: &date, SortOrder: i + 1, CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+	for _, content := range []string{"Third", "Fourth"} {
+		_, err := repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: content, ScheduledDate: &date, CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+
+	entries, err := repo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, entries, 4)
+	for i, content := range []string{"Second", "First", "Third", "Fourth"} {
+		assert.Equal(t, content, entries[i].Content)
+		assert.Equal(t, i+1, entries[i].SortOrder)
+	}
+}
+
 func TestEntryRepository_GetByDate_OrdersByCreatedAtThenID(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewEntryRepository(db)
@@ -574,3 +598,80 @@ func TestEntryRepository_NilCompletedAt_RoundTrips(t *testing.T) {
 	assert.Nil(t, result.CompletedAt)
 	assert.Nil(t, result.OriginalCreatedAt)
 }
+
+func TestEntryRepository_Granularity_DefaultsToDay(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Task", CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	result, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, domain.ScheduleDay, result.Granularity)
+}
+
+func TestEntryRepository_FutureLogEntries_StayOutOfDayQueries(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	lastMonth := time.Now().AddDate(0, -1, 0)
+	lastMonth = time.Date(lastMonth.Year(), lastMonth.Month(), 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Day task", ScheduledDate: &lastMonth, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Month task", ScheduledDate: &lastMonth, Granularity: domain.ScheduleMonth, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Someday task", ScheduledDate: &lastMonth, Granularity: domain.ScheduleSomeday, CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	byDate, err := repo.GetByDate(ctx, lastMonth)
+	require.NoError(t, err)
+	require.Len(t, byDate, 1)
+	assert.Equal(t, "Day task", byDate[0].Content)
+
+	byRange, err := repo.GetByDateRange(ctx, lastMonth, lastMonth)
+	require.NoError(t, err)
+	require.Len(t, byRange, 1)
+
+	overdue, err := repo.GetOverdue(ctx)
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, "Day task", overdue[0].Content)
+
+	byMonth, err := repo.GetByMonth(ctx, lastMonth)
+	require.NoError(t, err)
+	require.Len(t, byMonth, 1)
+	assert.Equal(t, "Month task", byMonth[0].Content)
+	assert.Equal(t, domain.ScheduleMonth, byMonth[0].Granularity)
+}
+
+func TestEntryRepository_GetFutureLog_MonthsInOrderThenSomeday(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	february := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	today := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	for _, e := range []domain.Entry{
+		{Type: domain.EntryTypeTask, Content: "Someday", ScheduledDate: &today, Granularity: domain.ScheduleSomeday},
+		{Type: domain.EntryTypeTask, Content: "March", ScheduledDate: &march, Granularity: domain.ScheduleMonth},
+		{Type: domain.EntryTypeTask, Content: "February", ScheduledDate: &february, Granularity: domain.ScheduleMonth},
+		{Type: domain.EntryTypeTask, Content: "Day", ScheduledDate: &today},
+	} {
+		e.CreatedAt = time.Now()
+		_, err := repo.Insert(ctx, e)
+		require.NoError(t, err)
+	}
+
+	log, err := repo.GetFutureLog(ctx)
+	require.NoError(t, err)
+	require.Len(t, log, 3)
+	assert.Equal(t, "February", log[0].Content)
+	assert.Equal(t, "March", log[1].Content)
+	assert.Equal(t, "Someday", log[2].Content)
+}
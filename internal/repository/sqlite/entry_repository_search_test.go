@@ -82,6 +82,33 @@ func TestEntryRepository_Search_FilterByType(t *testing.T) {
 	assert.Equal(t, domain.EntryTypeNote, results[0].Type)
 }
 
+func TestEntryRepository_Search_FilterByPriorityAndStatus(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	entries := []domain.Entry{
+		{Type: domain.EntryTypeTask, Content: "Urgent open keyword", Priority: domain.PriorityHigh, CreatedAt: time.Now()},
+		{Type: domain.EntryTypeDone, Content: "Urgent done keyword", Priority: domain.PriorityHigh, CreatedAt: time.Now()},
+		{Type: domain.EntryTypeTask, Content: "Someday keyword", Priority: domain.PriorityLow, CreatedAt: time.Now()},
+		{Type: domain.EntryTypeNote, Content: "Note keyword", Priority: domain.PriorityHigh, CreatedAt: time.Now()},
+	}
+	for _, e := range entries {
+		_, err := repo.Insert(ctx, e)
+		require.NoError(t, err)
+	}
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions("priority:high status:open"))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Urgent open keyword", results[0].Content)
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("keyword").WithStatus(domain.SearchStatusDone))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.Equal(t, "Urgent done keyword", results[0].Content)
+}
+
 func TestEntryRepository_Search_FilterByDateRange(t *testing.T) {
 	db := setupTestDB(t)
 	repo := NewEntryRepository(db)
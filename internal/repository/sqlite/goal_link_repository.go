@@ -0,0 +1,93 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// GoalLinkRepository stores the journal entries linked to each goal. Links
+// are kept by goal entity so they survive edits to the goal.
+type GoalLinkRepository struct {
+	db *sql.DB
+}
+
+func NewGoalLinkRepository(db *sql.DB) *GoalLinkRepository {
+	return &GoalLinkRepository{db: db}
+}
+
+func (r *GoalLinkRepository) LinkEntry(ctx context.Context, goalEntityID domain.EntityID, entryID int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx,
+		"INSERT OR IGNORE INTO goal_entries (goal_entity_id, entry_id) VALUES (?, ?)",
+		goalEntityID.String(), entryID)
+	return err
+}
+
+func (r *GoalLinkRepository) UnlinkEntry(ctx context.Context, goalEntityID domain.EntityID, entryID int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx,
+		"DELETE FROM goal_entries WHERE goal_entity_id = ? AND entry_id = ?",
+		goalEntityID.String(), entryID)
+	return err
+}
+
+// GetEntryIDs returns the linked entry IDs of each goal, in the order they
+// were linked.
+func (r *GoalLinkRepository) GetEntryIDs(ctx context.Context, goalEntityIDs []domain.EntityID) (map[domain.EntityID][]int64, error) {
+	result := make(map[domain.EntityID][]int64)
+	if len(goalEntityIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, len(goalEntityIDs))
+	args := make([]interface{}, len(goalEntityIDs))
+	for i, id := range goalEntityIDs {
+		placeholders[i] = "?"
+		args[i] = id.String()
+	}
+
+	query := fmt.Sprintf(
+		"SELECT goal_entity_id, entry_id FROM goal_entries WHERE goal_entity_id IN (%s) ORDER BY rowid",
+		strings.Join(placeholders, ","),
+	)
+
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var goalEntityID string
+		var entryID int64
+		if err := rows.Scan(&goalEntityID, &entryID); err != nil {
+			return nil, err
+		}
+		id := domain.EntityID(goalEntityID)
+		result[id] = append(result[id], entryID)
+	}
+
+	return result, rows.Err()
+}
+
+// CopyEntryLinks links toEntryID to every goal fromEntryID is linked to, so
+// a migrated task keeps counting towards its goals.
+func (r *GoalLinkRepository) CopyEntryLinks(ctx context.Context, fromEntryID, toEntryID int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT OR IGNORE INTO goal_entries (goal_entity_id, entry_id)
+		SELECT goal_entity_id, ? FROM goal_entries WHERE entry_id = ?
+	`, toEntryID, fromEntryID)
+	return err
+}
+
+// CopyGoalLinks links every entry of one goal to another, for a goal
+// migrated to a new month.
+func (r *GoalLinkRepository) CopyGoalLinks(ctx context.Context, from, to domain.EntityID) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT OR IGNORE INTO goal_entries (goal_entity_id, entry_id)
+		SELECT ?, entry_id FROM goal_entries WHERE goal_entity_id = ?
+	`, to.String(), from.String())
+	return err
+}
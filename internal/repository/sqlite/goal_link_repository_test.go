@@ -0,0 +1,84 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestGoalLinkRepository_LinkAndGet(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	linkRepo := NewGoalLinkRepository(db)
+	ctx := context.Background()
+
+	goalID := domain.NewEntityID()
+	first := insertTestEntry(t, entryRepo, "Write chapter one")
+	second := insertTestEntry(t, entryRepo, "Write chapter two")
+
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, first))
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, second))
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, first))
+
+	links, err := linkRepo.GetEntryIDs(ctx, []domain.EntityID{goalID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{first, second}, links[goalID])
+}
+
+func TestGoalLinkRepository_Unlink(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	linkRepo := NewGoalLinkRepository(db)
+	ctx := context.Background()
+
+	goalID := domain.NewEntityID()
+	entryID := insertTestEntry(t, entryRepo, "Write chapter one")
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, entryID))
+
+	require.NoError(t, linkRepo.UnlinkEntry(ctx, goalID, entryID))
+
+	links, err := linkRepo.GetEntryIDs(ctx, []domain.EntityID{goalID})
+	require.NoError(t, err)
+	assert.Empty(t, links[goalID])
+}
+
+func TestGoalLinkRepository_DeletedEntryIsUnlinked(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	linkRepo := NewGoalLinkRepository(db)
+	ctx := context.Background()
+
+	goalID := domain.NewEntityID()
+	entryID := insertTestEntry(t, entryRepo, "Write chapter one")
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, entryID))
+
+	require.NoError(t, entryRepo.Delete(ctx, entryID))
+
+	links, err := linkRepo.GetEntryIDs(ctx, []domain.EntityID{goalID})
+	require.NoError(t, err)
+	assert.Empty(t, links[goalID])
+}
+
+func TestGoalLinkRepository_CopyLinks(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	linkRepo := NewGoalLinkRepository(db)
+	ctx := context.Background()
+
+	goalID := domain.NewEntityID()
+	migratedGoalID := domain.NewEntityID()
+	oldEntry := insertTestEntry(t, entryRepo, "Write chapter one")
+	newEntry := insertTestEntry(t, entryRepo, "Write chapter one")
+	require.NoError(t, linkRepo.LinkEntry(ctx, goalID, oldEntry))
+
+	require.NoError(t, linkRepo.CopyEntryLinks(ctx, oldEntry, newEntry))
+	require.NoError(t, linkRepo.CopyGoalLinks(ctx, goalID, migratedGoalID))
+
+	links, err := linkRepo.GetEntryIDs(ctx, []domain.EntityID{goalID, migratedGoalID})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{oldEntry, newEntry}, links[goalID])
+	assert.Equal(t, []int64{oldEntry, newEntry}, links[migratedGoalID])
+}
@@ -35,10 +35,10 @@ func (r *GoalRepository) Insert(ctx context.Context, goal domain.Goal) (int64, e
 		migratedTo = &mt
 	}
 
-	result, err := r.db.ExecContext(ctx, `
-		INSERT INTO goals (entity_id, content, month, status, migrated_to, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, entityID.String(), goal.Content, monthKey, string(status), migratedTo, goal.CreatedAt.Format(time.RFC3339),
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO goals (entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, entityID.String(), goal.Content, monthKey, string(goal.GetHorizon()), goalParentEntityID(goal), string(status), migratedTo, goal.CreatedAt.Format(time.RFC3339),
 		1, now, domain.OpTypeInsert.String())
 
 	if err != nil {
@@ -50,7 +50,7 @@ func (r *GoalRepository) Insert(ctx context.Context, goal domain.Goal) (int64, e
 
 func (r *GoalRepository) GetByID(ctx context.Context, id int64) (*domain.Goal, error) {
 	var entityID string
-	err := r.db.QueryRowContext(ctx, `SELECT entity_id FROM goals WHERE id = ?`, id).Scan(&entityID)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT entity_id FROM goals WHERE id = ?`, id).Scan(&entityID)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -58,8 +58,8 @@ func (r *GoalRepository) GetByID(ctx context.Context, id int64) (*domain.Goal, e
 		return nil, err
 	}
 
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, entity_id, content, month, status, migrated_to, created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at
 		FROM goals WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, entityID)
 
@@ -69,83 +69,29 @@ func (r *GoalRepository) GetByID(ctx context.Context, id int64) (*domain.Goal, e
 func (r *GoalRepository) GetByMonth(ctx context.Context, month time.Time) ([]domain.Goal, error) {
 	monthKey := month.Format("2006-01")
 
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, entity_id, content, month, status, migrated_to, created_at
-		FROM goals WHERE month = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
+	return r.queryGoals(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at
+		FROM goals WHERE month = ? AND horizon = 'month' AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 		ORDER BY created_at
 	`, monthKey)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = rows.Close() }()
-
-	var goals []domain.Goal
-	for rows.Next() {
-		var goal domain.Goal
-		var entityID sql.NullString
-		var migratedTo sql.NullString
-		var monthStr, statusStr, createdAt string
-
-		err := rows.Scan(&goal.ID, &entityID, &goal.Content, &monthStr, &statusStr, &migratedTo, &createdAt)
-		if err != nil {
-			return nil, err
-		}
-
-		if entityID.Valid {
-			goal.EntityID = domain.EntityID(entityID.String)
-		}
-		goal.Month, _ = time.Parse("2006-01", monthStr)
-		goal.Status = domain.GoalStatus(statusStr)
-		if migratedTo.Valid {
-			mt, _ := time.Parse("2006-01", migratedTo.String)
-			goal.MigratedTo = &mt
-		}
-		goal.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-
-		goals = append(goals, goal)
-	}
+}
 
-	return goals, rows.Err()
+// GetInRange returns the goals of every horizon whose period starts in
+// [from, to).
+func (r *GoalRepository) GetInRange(ctx context.Context, from, to time.Time) ([]domain.Goal, error) {
+	return r.queryGoals(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at
+		FROM goals WHERE month >= ? AND month < ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
+		ORDER BY month, created_at
+	`, from.Format("2006-01"), to.Format("2006-01"))
 }
 
 func (r *GoalRepository) GetAll(ctx context.Context) ([]domain.Goal, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, entity_id, content, month, status, migrated_to, created_at
+	return r.queryGoals(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at
 		FROM goals WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 		ORDER BY month DESC, created_at
 	`)
-	if err != nil {
-		return nil, err
-	}
-	defer func() { _ = rows.Close() }()
-
-	var goals []domain.Goal
-	for rows.Next() {
-		var goal domain.Goal
-		var entityID sql.NullString
-		var migratedTo sql.NullString
-		var monthStr, statusStr, createdAt string
-
-		err := rows.Scan(&goal.ID, &entityID, &goal.Content, &monthStr, &statusStr, &migratedTo, &createdAt)
-		if err != nil {
-			return nil, err
-		}
-
-		if entityID.Valid {
-			goal.EntityID = domain.EntityID(entityID.String)
-		}
-		goal.Month, _ = time.Parse("2006-01", monthStr)
-		goal.Status = domain.GoalStatus(statusStr)
-		if migratedTo.Valid {
-			mt, _ := time.Parse("2006-01", migratedTo.String)
-			goal.MigratedTo = &mt
-		}
-		goal.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-
-		goals = append(goals, goal)
-	}
-
-	return goals, rows.Err()
 }
 
 func (r *GoalRepository) Update(ctx context.Context, goal domain.Goal) error {
@@ -166,7 +112,7 @@ func (r *GoalRepository) Update(ctx context.Context, goal domain.Goal) error {
 		migratedTo = &mt
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -188,14 +134,18 @@ func (r *GoalRepository) Update(ctx context.Context, goal domain.Goal) error {
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO goals (entity_id, content, month, status, migrated_to, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, current.EntityID.String(), goal.Content, monthKey, string(goal.Status), migratedTo,
+		INSERT INTO goals (entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, current.EntityID.String(), goal.Content, monthKey, string(goal.GetHorizon()), goalParentEntityID(goal), string(goal.Status), migratedTo,
 		current.CreatedAt.Format(time.RFC3339), maxVersion+1, now, domain.OpTypeUpdate.String())
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "goals", "id", current.EntityID, ""); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -216,7 +166,7 @@ func (r *GoalRepository) Delete(ctx context.Context, id int64) error {
 		migratedTo = &mt
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -239,25 +189,29 @@ func (r *GoalRepository) Delete(ctx context.Context, id int64) error {
 
 	monthKey := goal.Month.Format("2006-01")
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO goals (entity_id, content, month, status, migrated_to, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, goal.EntityID.String(), goal.Content, monthKey, string(goal.Status), migratedTo,
+		INSERT INTO goals (entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, goal.EntityID.String(), goal.Content, monthKey, string(goal.GetHorizon()), goalParentEntityID(*goal), string(goal.Status), migratedTo,
 		goal.CreatedAt.Format(time.RFC3339), maxVersion+1, now, domain.OpTypeDelete.String())
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "goals", "id", goal.EntityID, ""); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 func (r *GoalRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM goals")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM goals")
 	return err
 }
 
 func (r *GoalRepository) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.Goal, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, entity_id, content, month, status, migrated_to, created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at
 		FROM goals WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, entityID.String())
 
@@ -279,7 +233,7 @@ func (r *GoalRepository) MoveToMonth(ctx context.Context, id int64, newMonth tim
 
 func (r *GoalRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM goals`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM goals`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -290,23 +244,54 @@ func (r *GoalRepository) GetLastModified(ctx context.Context) (time.Time, error)
 }
 
 func (r *GoalRepository) scanGoal(row *sql.Row) (*domain.Goal, error) {
-	var goal domain.Goal
-	var entityID sql.NullString
-	var migratedTo sql.NullString
-	var monthStr, statusStr, createdAt string
-
-	err := row.Scan(&goal.ID, &entityID, &goal.Content, &monthStr, &statusStr, &migratedTo, &createdAt)
+	goal, err := scanGoalRow(row)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, err
 	}
+	return &goal, nil
+}
+
+func (r *GoalRepository) queryGoals(ctx context.Context, query string, args ...any) ([]domain.Goal, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var goals []domain.Goal
+	for rows.Next() {
+		goal, err := scanGoalRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		goals = append(goals, goal)
+	}
+
+	return goals, rows.Err()
+}
+
+func scanGoalRow(row rowScanner, extra ...any) (domain.Goal, error) {
+	var goal domain.Goal
+	var entityID, parentEntityID, migratedTo sql.NullString
+	var monthStr, horizon, statusStr, createdAt string
+
+	dest := append([]any{&goal.ID, &entityID, &goal.Content, &monthStr, &horizon, &parentEntityID, &statusStr, &migratedTo, &createdAt}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return domain.Goal{}, err
+	}
 
 	if entityID.Valid {
 		goal.EntityID = domain.EntityID(entityID.String)
 	}
 	goal.Month, _ = time.Parse("2006-01", monthStr)
+	goal.Horizon = domain.GoalHorizon(horizon)
+	if parentEntityID.Valid {
+		parent := domain.EntityID(parentEntityID.String)
+		goal.ParentEntityID = &parent
+	}
 	goal.Status = domain.GoalStatus(statusStr)
 	if migratedTo.Valid {
 		mt, _ := time.Parse("2006-01", migratedTo.String)
@@ -314,5 +299,107 @@ func (r *GoalRepository) scanGoal(row *sql.Row) (*domain.Goal, error) {
 	}
 	goal.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
 
-	return &goal, nil
+	return goal, nil
+}
+
+func goalParentEntityID(goal domain.Goal) any {
+	if goal.ParentEntityID == nil {
+		return nil
+	}
+	return goal.ParentEntityID.String()
+}
+
+func (r *GoalRepository) GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.GoalVersion, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, valid_to, op_type
+		FROM goals WHERE entity_id = ?
+		ORDER BY version
+	`, entityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var versions []domain.GoalVersion
+	for rows.Next() {
+		v, err := scanGoalVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+func (r *GoalRepository) GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.GoalVersion, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, valid_to, op_type
+		FROM goals WHERE entity_id = ? AND version = ?
+	`, entityID.String(), version)
+
+	v, err := scanGoalVersion(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RestoreVersion makes a copy of an earlier version the current one. It
+// also brings back a deleted goal.
+func (r *GoalRepository) RestoreVersion(ctx context.Context, entityID domain.EntityID, version int) error {
+	now := time.Now().Format(time.RFC3339)
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE goals SET valid_to = ? WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '')
+	`, now, entityID.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO goals (entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at, version, valid_from, op_type)
+		SELECT entity_id, content, month, horizon, parent_entity_id, status, migrated_to, created_at,
+			(SELECT MAX(version) FROM goals WHERE entity_id = ?) + 1, ?, ?
+		FROM goals WHERE entity_id = ? AND version = ?
+	`, entityID.String(), now, domain.OpTypeUpdate.String(), entityID.String(), version)
+	if err != nil {
+		return err
+	}
+
+	if err := pruneVersions(ctx, tx, "goals", "id", entityID, ""); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func scanGoalVersion(row rowScanner) (domain.GoalVersion, error) {
+	var v domain.GoalVersion
+	var validTo sql.NullString
+	var validFrom, opType string
+
+	goal, err := scanGoalRow(row, &v.Version, &validFrom, &validTo, &opType)
+	if err != nil {
+		return domain.GoalVersion{}, err
+	}
+
+	v.Goal = goal
+	v.EntityID = goal.EntityID
+	v.RowID = goal.ID
+	v.ValidFrom, _ = time.Parse(time.RFC3339, validFrom)
+	if validTo.Valid && validTo.String != "" {
+		t, _ := time.Parse(time.RFC3339, validTo.String)
+		v.ValidTo = &t
+	}
+	v.OpType = domain.OpType(opType)
+	return v, nil
 }
@@ -190,3 +190,78 @@ func TestGoalRepository_Update_CreatesNewVersion(t *testing.T) {
 	assert.Equal(t, domain.GoalStatusDone, updated.Status)
 	assert.Equal(t, entityID, updated.EntityID, "Entity ID should remain the same")
 }
+
+func TestGoalRepository_RestoreVersion(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewGoalRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.Goal{
+		Content:   "Run 5k",
+		Month:     time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	goal, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	goal.Content = "Run 10k"
+	require.NoError(t, repo.Update(ctx, *goal))
+	require.NoError(t, repo.Delete(ctx, goal.ID))
+
+	require.NoError(t, repo.RestoreVersion(ctx, goal.EntityID, 1))
+
+	restored, err := repo.GetByEntityID(ctx, goal.EntityID)
+	require.NoError(t, err)
+	require.NotNil(t, restored)
+	assert.Equal(t, "Run 5k", restored.Content)
+
+	history, err := repo.GetHistory(ctx, goal.EntityID)
+	require.NoError(t, err)
+	require.Len(t, history, 4)
+	assert.True(t, history[2].IsDeleted())
+	assert.True(t, history[3].IsCurrent())
+	assert.Equal(t, 4, history[3].Version)
+}
+
+func TestGoalRepository_HorizonAndParent(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewGoalRepository(db)
+	ctx := context.Background()
+
+	q3 := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	parentID, err := repo.Insert(ctx, domain.Goal{
+		Content:   "Launch v2",
+		Month:     q3,
+		Horizon:   domain.GoalHorizonQuarter,
+		Status:    domain.GoalStatusActive,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+	parent, err := repo.GetByID(ctx, parentID)
+	require.NoError(t, err)
+
+	childID, err := repo.Insert(ctx, domain.Goal{
+		Content:        "Ship the beta",
+		Month:          q3,
+		ParentEntityID: &parent.EntityID,
+		Status:         domain.GoalStatusActive,
+		CreatedAt:      time.Now(),
+	})
+	require.NoError(t, err)
+
+	child, err := repo.GetByID(ctx, childID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GoalHorizonMonth, child.Horizon)
+	require.NotNil(t, child.ParentEntityID)
+	assert.Equal(t, parent.EntityID, *child.ParentEntityID)
+	assert.Equal(t, domain.GoalHorizonQuarter, parent.Horizon)
+
+	monthGoals, err := repo.GetByMonth(ctx, q3)
+	require.NoError(t, err)
+	require.Len(t, monthGoals, 1)
+	assert.Equal(t, "Ship the beta", monthGoals[0].Content)
+
+	yearGoals, err := repo.GetInRange(ctx, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Len(t, yearGoals, 2)
+}
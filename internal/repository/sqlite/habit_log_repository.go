@@ -23,10 +23,10 @@ func (r *HabitLogRepository) Insert(ctx context.Context, log domain.HabitLog) (i
 	}
 	now := time.Now().Format(time.RFC3339)
 
-	result, err := r.db.ExecContext(ctx, `
-		INSERT INTO habit_logs (habit_id, count, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, log.HabitID, log.Count, log.LoggedAt.Format(time.RFC3339),
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO habit_logs (habit_id, count, value, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, log.HabitID, log.Count, log.Value, log.LoggedAt.Format(time.RFC3339),
 		entityID.String(), log.HabitEntityID.String(), 1, now, domain.OpTypeInsert.String())
 
 	if err != nil {
@@ -38,7 +38,7 @@ func (r *HabitLogRepository) Insert(ctx context.Context, log domain.HabitLog) (i
 
 func (r *HabitLogRepository) GetByID(ctx context.Context, id int64) (*domain.HabitLog, error) {
 	var entityID string
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT entity_id FROM habit_logs WHERE id = ?
 	`, id).Scan(&entityID)
 	if err == sql.ErrNoRows {
@@ -52,8 +52,8 @@ func (r *HabitLogRepository) GetByID(ctx context.Context, id int64) (*domain.Hab
 }
 
 func (r *HabitLogRepository) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.HabitLog, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, entityID.String())
 
@@ -61,7 +61,7 @@ func (r *HabitLogRepository) GetByEntityID(ctx context.Context, entityID domain.
 	var loggedAt string
 	var scannedEntityID, habitEntityID sql.NullString
 
-	err := row.Scan(&log.ID, &log.HabitID, &log.Count, &loggedAt, &scannedEntityID, &habitEntityID)
+	err := row.Scan(&log.ID, &log.HabitID, &log.Count, &log.Value, &loggedAt, &scannedEntityID, &habitEntityID)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -80,8 +80,8 @@ func (r *HabitLogRepository) GetByEntityID(ctx context.Context, entityID domain.
 }
 
 func (r *HabitLogRepository) GetByHabitID(ctx context.Context, habitID int64) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs WHERE habit_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 		ORDER BY logged_at
 	`, habitID)
@@ -94,8 +94,8 @@ func (r *HabitLogRepository) GetByHabitID(ctx context.Context, habitID int64) ([
 }
 
 func (r *HabitLogRepository) GetRange(ctx context.Context, habitID int64, start, end time.Time) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE habit_id = ? AND logged_at >= ? AND logged_at <= ?
 		AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
@@ -110,8 +110,8 @@ func (r *HabitLogRepository) GetRange(ctx context.Context, habitID int64, start,
 }
 
 func (r *HabitLogRepository) GetRangeByEntityID(ctx context.Context, habitEntityID domain.EntityID, start, end time.Time) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE habit_entity_id = ? AND logged_at >= ? AND logged_at <= ?
 		AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
@@ -126,8 +126,8 @@ func (r *HabitLogRepository) GetRangeByEntityID(ctx context.Context, habitEntity
 }
 
 func (r *HabitLogRepository) GetAllRange(ctx context.Context, start, end time.Time) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE logged_at >= ? AND logged_at <= ?
 		AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
@@ -142,8 +142,8 @@ func (r *HabitLogRepository) GetAllRange(ctx context.Context, start, end time.Ti
 }
 
 func (r *HabitLogRepository) GetAll(ctx context.Context) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 		ORDER BY logged_at
@@ -157,7 +157,7 @@ func (r *HabitLogRepository) GetAll(ctx context.Context) ([]domain.HabitLog, err
 }
 
 func (r *HabitLogRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM habit_logs")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM habit_logs")
 	return err
 }
 
@@ -172,7 +172,7 @@ func (r *HabitLogRepository) Delete(ctx context.Context, id int64) error {
 
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -194,9 +194,9 @@ func (r *HabitLogRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO habit_logs (habit_id, count, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, log.HabitID, log.Count, log.LoggedAt.Format(time.RFC3339),
+		INSERT INTO habit_logs (habit_id, count, value, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, log.HabitID, log.Count, log.Value, log.LoggedAt.Format(time.RFC3339),
 		log.EntityID.String(), log.HabitEntityID.String(), maxVersion+1, now, domain.OpTypeDelete.String())
 	if err != nil {
 		return err
@@ -206,8 +206,8 @@ func (r *HabitLogRepository) Delete(ctx context.Context, id int64) error {
 }
 
 func (r *HabitLogRepository) GetLastByHabitID(ctx context.Context, habitID int64) (*domain.HabitLog, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE habit_id = ?
 		AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
@@ -219,7 +219,7 @@ func (r *HabitLogRepository) GetLastByHabitID(ctx context.Context, habitID int64
 	var loggedAt string
 	var entityID, habitEntityID sql.NullString
 
-	err := row.Scan(&log.ID, &log.HabitID, &log.Count, &loggedAt, &entityID, &habitEntityID)
+	err := row.Scan(&log.ID, &log.HabitID, &log.Count, &log.Value, &loggedAt, &entityID, &habitEntityID)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -238,8 +238,8 @@ func (r *HabitLogRepository) GetLastByHabitID(ctx context.Context, habitID int64
 }
 
 func (r *HabitLogRepository) GetDeleted(ctx context.Context) ([]domain.HabitLog, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, habit_id, count, logged_at, entity_id, habit_entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, habit_id, count, value, logged_at, entity_id, habit_entity_id
 		FROM habit_logs
 		WHERE op_type = 'DELETE'
 		AND valid_to IS NULL
@@ -259,18 +259,19 @@ func (r *HabitLogRepository) Restore(ctx context.Context, entityID domain.Entity
 	var lastLog struct {
 		HabitID       int64
 		Count         int
+		Value         float64
 		LoggedAt      string
 		HabitEntityID sql.NullString
 		Version       int
 		OpType        string
 	}
 
-	err := r.db.QueryRowContext(ctx, `
-		SELECT habit_id, count, logged_at, habit_entity_id, version, op_type
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT habit_id, count, value, logged_at, habit_entity_id, version, op_type
 		FROM habit_logs WHERE entity_id = ?
 		ORDER BY version DESC LIMIT 1
 	`, entityID.String()).Scan(
-		&lastLog.HabitID, &lastLog.Count, &lastLog.LoggedAt,
+		&lastLog.HabitID, &lastLog.Count, &lastLog.Value, &lastLog.LoggedAt,
 		&lastLog.HabitEntityID, &lastLog.Version, &lastLog.OpType)
 	if err != nil {
 		return 0, err
@@ -280,7 +281,7 @@ func (r *HabitLogRepository) Restore(ctx context.Context, entityID domain.Entity
 		return 0, nil // Not deleted, nothing to restore
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return 0, err
 	}
@@ -299,9 +300,9 @@ func (r *HabitLogRepository) Restore(ctx context.Context, entityID domain.Entity
 	}
 
 	result, err := tx.ExecContext(ctx, `
-		INSERT INTO habit_logs (habit_id, count, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, lastLog.HabitID, lastLog.Count, lastLog.LoggedAt,
+		INSERT INTO habit_logs (habit_id, count, value, logged_at, entity_id, habit_entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, lastLog.HabitID, lastLog.Count, lastLog.Value, lastLog.LoggedAt,
 		entityID.String(), habitEntityID, lastLog.Version+1, now, domain.OpTypeInsert.String())
 	if err != nil {
 		return 0, err
@@ -316,7 +317,7 @@ func (r *HabitLogRepository) Restore(ctx context.Context, entityID domain.Entity
 
 func (r *HabitLogRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM habit_logs`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM habit_logs`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -334,7 +335,7 @@ func (r *HabitLogRepository) scanLogs(rows *sql.Rows) ([]domain.HabitLog, error)
 		var loggedAt string
 		var entityID, habitEntityID sql.NullString
 
-		err := rows.Scan(&log.ID, &log.HabitID, &log.Count, &loggedAt, &entityID, &habitEntityID)
+		err := rows.Scan(&log.ID, &log.HabitID, &log.Count, &log.Value, &loggedAt, &entityID, &habitEntityID)
 		if err != nil {
 			return nil, err
 		}
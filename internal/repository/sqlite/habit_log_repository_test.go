@@ -355,3 +355,25 @@ func TestHabitLogRepository_GetRangeByEntityID_AfterHabitRename(t *testing.T) {
 	assert.Len(t, results, 1, "Logs should be retrievable via entity_id after habit rename")
 	assert.Equal(t, 5, results[0].Count)
 }
+
+func TestHabitLogRepository_InsertWithValue(t *testing.T) {
+	db := setupTestDB(t)
+	habitRepo := NewHabitRepository(db)
+	repo := NewHabitLogRepository(db)
+	ctx := context.Background()
+
+	habitID := createTestHabit(t, habitRepo, "Run")
+
+	id, err := repo.Insert(ctx, domain.HabitLog{
+		HabitID:  habitID,
+		Count:    1,
+		Value:    5.2,
+		LoggedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	log, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, log)
+	assert.Equal(t, 5.2, log.Value)
+}
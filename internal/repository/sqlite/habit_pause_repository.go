@@ -0,0 +1,125 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type HabitPauseRepository struct {
+	db *sql.DB
+}
+
+func NewHabitPauseRepository(db *sql.DB) *HabitPauseRepository {
+	return &HabitPauseRepository{db: db}
+}
+
+func (r *HabitPauseRepository) Insert(ctx context.Context, pause domain.HabitPause) (int64, error) {
+	var habitEntityID *string
+	if !pause.IsGlobal() {
+		s := pause.HabitEntityID.String()
+		habitEntityID = &s
+	}
+
+	createdAt := pause.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO habit_pauses (habit_entity_id, start_date, end_date, created_at)
+		VALUES (?, ?, ?, ?)
+	`, habitEntityID, pause.From.Format("2006-01-02"), pause.To.Format("2006-01-02"), createdAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (r *HabitPauseRepository) GetByID(ctx context.Context, id int64) (*domain.HabitPause, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, habit_entity_id, start_date, end_date, created_at
+		FROM habit_pauses WHERE id = ?
+	`, id)
+
+	pause, err := scanHabitPause(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pause, nil
+}
+
+// GetRange returns the pauses of every habit that overlap [start, end].
+func (r *HabitPauseRepository) GetRange(ctx context.Context, start, end time.Time) ([]domain.HabitPause, error) {
+	return r.query(ctx, `
+		SELECT id, habit_entity_id, start_date, end_date, created_at
+		FROM habit_pauses
+		WHERE start_date <= ? AND end_date >= ?
+		ORDER BY start_date, id
+	`, end.Format("2006-01-02"), start.Format("2006-01-02"))
+}
+
+func (r *HabitPauseRepository) GetAll(ctx context.Context) ([]domain.HabitPause, error) {
+	return r.query(ctx, `
+		SELECT id, habit_entity_id, start_date, end_date, created_at
+		FROM habit_pauses ORDER BY start_date, id
+	`)
+}
+
+func (r *HabitPauseRepository) Delete(ctx context.Context, id int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM habit_pauses WHERE id = ?", id)
+	return err
+}
+
+func (r *HabitPauseRepository) GetLastModified(ctx context.Context) (time.Time, error) {
+	var createdAt sql.NullString
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(created_at) FROM habit_pauses`).Scan(&createdAt)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if !createdAt.Valid {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, createdAt.String)
+}
+
+func (r *HabitPauseRepository) query(ctx context.Context, query string, args ...any) ([]domain.HabitPause, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var pauses []domain.HabitPause
+	for rows.Next() {
+		pause, err := scanHabitPause(rows)
+		if err != nil {
+			return nil, err
+		}
+		pauses = append(pauses, pause)
+	}
+	return pauses, rows.Err()
+}
+
+func scanHabitPause(row rowScanner) (domain.HabitPause, error) {
+	var pause domain.HabitPause
+	var habitEntityID sql.NullString
+	var startDate, endDate, createdAt string
+
+	if err := row.Scan(&pause.ID, &habitEntityID, &startDate, &endDate, &createdAt); err != nil {
+		return domain.HabitPause{}, err
+	}
+
+	if habitEntityID.Valid {
+		pause.HabitEntityID = domain.EntityID(habitEntityID.String)
+	}
+	pause.From, _ = time.Parse("2006-01-02", startDate)
+	pause.To, _ = time.Parse("2006-01-02", endDate)
+	pause.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	return pause, nil
+}
@@ -0,0 +1,83 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestHabitPauseRepository_InsertAndGetByID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitPauseRepository(db)
+	ctx := context.Background()
+
+	from := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 9, 0, 0, 0, 0, time.UTC)
+	entityID := domain.NewEntityID()
+
+	id, err := repo.Insert(ctx, domain.HabitPause{HabitEntityID: entityID, From: from, To: to})
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+	assert.Equal(t, entityID, got.HabitEntityID)
+	assert.Equal(t, from, got.From)
+	assert.Equal(t, to, got.To)
+	assert.False(t, got.IsGlobal())
+}
+
+func TestHabitPauseRepository_GlobalPause(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitPauseRepository(db)
+	ctx := context.Background()
+
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	id, err := repo.Insert(ctx, domain.HabitPause{From: day, To: day})
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, got.IsGlobal())
+	assert.True(t, got.IsSkip())
+}
+
+func TestHabitPauseRepository_GetRange(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitPauseRepository(db)
+	ctx := context.Background()
+
+	jan := func(day int) time.Time { return time.Date(2026, 1, day, 0, 0, 0, 0, time.UTC) }
+	_, err := repo.Insert(ctx, domain.HabitPause{From: jan(1), To: jan(3)})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, domain.HabitPause{From: jan(8), To: jan(12)})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, domain.HabitPause{From: jan(20), To: jan(20)})
+	require.NoError(t, err)
+
+	pauses, err := repo.GetRange(ctx, jan(3), jan(10))
+	require.NoError(t, err)
+	require.Len(t, pauses, 2)
+	assert.Equal(t, jan(1), pauses[0].From)
+	assert.Equal(t, jan(8), pauses[1].From)
+}
+
+func TestHabitPauseRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitPauseRepository(db)
+	ctx := context.Background()
+
+	day := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	id, err := repo.Insert(ctx, domain.HabitPause{From: day, To: day})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, id))
+
+	pauses, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, pauses)
+}
@@ -9,6 +9,11 @@ import (
 	"github.com/typingincolor/bujo/internal/domain"
 )
 
+// habitRowsInUse selects habit rows that logs still point at. Logs keep
+// the ID of the version they were made against and cascade on delete, so
+// those rows must outlive version pruning.
+const habitRowsInUse = `SELECT habit_id FROM habit_logs`
+
 type HabitRepository struct {
 	db *sql.DB
 }
@@ -24,10 +29,10 @@ func (r *HabitRepository) Insert(ctx context.Context, habit domain.Habit) (int64
 	}
 	now := time.Now().Format(time.RFC3339)
 
-	result, err := r.db.ExecContext(ctx, `
-		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, habit.CreatedAt.Format(time.RFC3339),
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, habitKind(habit), habit.Unit, habit.Target, habitTargetType(habit), habit.Schedule.String(), habitArchivedAt(habit.ArchivedAt), habit.CreatedAt.Format(time.RFC3339),
 		entityID.String(), 1, now, domain.OpTypeInsert.String())
 
 	if err != nil {
@@ -39,7 +44,7 @@ func (r *HabitRepository) Insert(ctx context.Context, habit domain.Habit) (int64
 
 func (r *HabitRepository) GetByID(ctx context.Context, id int64) (*domain.Habit, error) {
 	var entityID string
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT entity_id FROM habits WHERE id = ?
 	`, id).Scan(&entityID)
 	if err == sql.ErrNoRows {
@@ -49,8 +54,8 @@ func (r *HabitRepository) GetByID(ctx context.Context, id int64) (*domain.Habit,
 		return nil, err
 	}
 
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id
 		FROM habits WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, entityID)
 
@@ -58,8 +63,8 @@ func (r *HabitRepository) GetByID(ctx context.Context, id int64) (*domain.Habit,
 }
 
 func (r *HabitRepository) GetByName(ctx context.Context, name string) (*domain.Habit, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id
 		FROM habits WHERE name = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, name)
 
@@ -92,8 +97,8 @@ func (r *HabitRepository) GetOrCreate(ctx context.Context, name string, goalPerD
 }
 
 func (r *HabitRepository) GetAll(ctx context.Context) ([]domain.Habit, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id
 		FROM habits WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 		ORDER BY name
 	`)
@@ -105,15 +110,17 @@ func (r *HabitRepository) GetAll(ctx context.Context) ([]domain.Habit, error) {
 	var habits []domain.Habit
 	for rows.Next() {
 		var habit domain.Habit
-		var createdAt string
-		var entityID sql.NullString
+		var schedule, createdAt string
+		var entityID, archivedAt sql.NullString
 
-		err := rows.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &createdAt, &entityID)
+		err := rows.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &habit.Kind, &habit.Unit, &habit.Target, &habit.TargetType, &schedule, &archivedAt, &createdAt, &entityID)
 		if err != nil {
 			return nil, err
 		}
 
 		habit.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+		habit.Schedule, _ = domain.ParseHabitSchedule(schedule, habit.CreatedAt)
+		habit.ArchivedAt = parseHabitArchivedAt(archivedAt)
 		if entityID.Valid {
 			habit.EntityID = domain.EntityID(entityID.String)
 		}
@@ -129,12 +136,12 @@ func (r *HabitRepository) Update(ctx context.Context, habit domain.Habit) error
 		return err
 	}
 	if current == nil {
-		return fmt.Errorf("habit not found: %d", habit.ID)
+		return fmt.Errorf("habit %w: %d", domain.ErrNotFound, habit.ID)
 	}
 
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -156,14 +163,18 @@ func (r *HabitRepository) Update(ctx context.Context, habit domain.Habit) error
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, current.CreatedAt.Format(time.RFC3339),
+		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, habitKind(habit), habit.Unit, habit.Target, habitTargetType(habit), habit.Schedule.String(), habitArchivedAt(habit.ArchivedAt), current.CreatedAt.Format(time.RFC3339),
 		current.EntityID.String(), maxVersion+1, now, domain.OpTypeUpdate.String())
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "habits", "id", current.EntityID, habitRowsInUse); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -178,7 +189,7 @@ func (r *HabitRepository) Delete(ctx context.Context, id int64) error {
 
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -200,25 +211,29 @@ func (r *HabitRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, habit.CreatedAt.Format(time.RFC3339),
+		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, habit.Name, habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth, habitKind(*habit), habit.Unit, habit.Target, habitTargetType(*habit), habit.Schedule.String(), habitArchivedAt(habit.ArchivedAt), habit.CreatedAt.Format(time.RFC3339),
 		habit.EntityID.String(), maxVersion+1, now, domain.OpTypeDelete.String())
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "habits", "id", habit.EntityID, habitRowsInUse); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 func (r *HabitRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM habits")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM habits")
 	return err
 }
 
 func (r *HabitRepository) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.Habit, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id
 		FROM habits WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'
 	`, entityID.String())
 
@@ -226,8 +241,8 @@ func (r *HabitRepository) GetByEntityID(ctx context.Context, entityID domain.Ent
 }
 
 func (r *HabitRepository) GetDeleted(ctx context.Context) ([]domain.Habit, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id
 		FROM habits
 		WHERE op_type = 'DELETE'
 		AND valid_to IS NULL
@@ -241,15 +256,17 @@ func (r *HabitRepository) GetDeleted(ctx context.Context) ([]domain.Habit, error
 	var habits []domain.Habit
 	for rows.Next() {
 		var habit domain.Habit
-		var createdAt string
-		var entityID sql.NullString
+		var schedule, createdAt string
+		var entityID, archivedAt sql.NullString
 
-		err := rows.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &createdAt, &entityID)
+		err := rows.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &habit.Kind, &habit.Unit, &habit.Target, &habit.TargetType, &schedule, &archivedAt, &createdAt, &entityID)
 		if err != nil {
 			return nil, err
 		}
 
 		habit.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+		habit.Schedule, _ = domain.ParseHabitSchedule(schedule, habit.CreatedAt)
+		habit.ArchivedAt = parseHabitArchivedAt(archivedAt)
 		if entityID.Valid {
 			habit.EntityID = domain.EntityID(entityID.String)
 		}
@@ -267,17 +284,23 @@ func (r *HabitRepository) Restore(ctx context.Context, entityID domain.EntityID)
 		GoalPerDay   int
 		GoalPerWeek  int
 		GoalPerMonth int
+		Kind         string
+		Unit         string
+		Target       float64
+		TargetType   string
+		Schedule     string
+		ArchivedAt   sql.NullString
 		CreatedAt    string
 		Version      int
 		OpType       string
 	}
 
-	err := r.db.QueryRowContext(ctx, `
-		SELECT name, goal_per_day, goal_per_week, goal_per_month, created_at, version, op_type
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, version, op_type
 		FROM habits WHERE entity_id = ?
 		ORDER BY version DESC LIMIT 1
 	`, entityID.String()).Scan(
-		&lastHabit.Name, &lastHabit.GoalPerDay, &lastHabit.GoalPerWeek, &lastHabit.GoalPerMonth, &lastHabit.CreatedAt,
+		&lastHabit.Name, &lastHabit.GoalPerDay, &lastHabit.GoalPerWeek, &lastHabit.GoalPerMonth, &lastHabit.Kind, &lastHabit.Unit, &lastHabit.Target, &lastHabit.TargetType, &lastHabit.Schedule, &lastHabit.ArchivedAt, &lastHabit.CreatedAt,
 		&lastHabit.Version, &lastHabit.OpType)
 	if err != nil {
 		return 0, err
@@ -287,7 +310,7 @@ func (r *HabitRepository) Restore(ctx context.Context, entityID domain.EntityID)
 		return 0, nil // Not deleted, nothing to restore
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return 0, err
 	}
@@ -301,9 +324,9 @@ func (r *HabitRepository) Restore(ctx context.Context, entityID domain.EntityID)
 	}
 
 	result, err := tx.ExecContext(ctx, `
-		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, created_at, entity_id, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)
-	`, lastHabit.Name, lastHabit.GoalPerDay, lastHabit.GoalPerWeek, lastHabit.GoalPerMonth, lastHabit.CreatedAt,
+		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, lastHabit.Name, lastHabit.GoalPerDay, lastHabit.GoalPerWeek, lastHabit.GoalPerMonth, lastHabit.Kind, lastHabit.Unit, lastHabit.Target, lastHabit.TargetType, lastHabit.Schedule, lastHabit.ArchivedAt, lastHabit.CreatedAt,
 		entityID.String(), lastHabit.Version+1, now, domain.OpTypeInsert.String())
 	if err != nil {
 		return 0, err
@@ -318,7 +341,7 @@ func (r *HabitRepository) Restore(ctx context.Context, entityID domain.EntityID)
 
 func (r *HabitRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM habits`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM habits`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -330,10 +353,10 @@ func (r *HabitRepository) GetLastModified(ctx context.Context) (time.Time, error
 
 func (r *HabitRepository) scanHabit(row *sql.Row) (*domain.Habit, error) {
 	var habit domain.Habit
-	var createdAt string
-	var entityID sql.NullString
+	var schedule, createdAt string
+	var entityID, archivedAt sql.NullString
 
-	err := row.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &createdAt, &entityID)
+	err := row.Scan(&habit.ID, &habit.Name, &habit.GoalPerDay, &habit.GoalPerWeek, &habit.GoalPerMonth, &habit.Kind, &habit.Unit, &habit.Target, &habit.TargetType, &schedule, &archivedAt, &createdAt, &entityID)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -342,8 +365,143 @@ func (r *HabitRepository) scanHabit(row *sql.Row) (*domain.Habit, error) {
 	}
 
 	habit.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	habit.Schedule, _ = domain.ParseHabitSchedule(schedule, habit.CreatedAt)
+	habit.ArchivedAt = parseHabitArchivedAt(archivedAt)
 	if entityID.Valid {
 		habit.EntityID = domain.EntityID(entityID.String)
 	}
 	return &habit, nil
 }
+
+func (r *HabitRepository) GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.HabitVersion, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, valid_to, op_type
+		FROM habits WHERE entity_id = ?
+		ORDER BY version
+	`, entityID.String())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var versions []domain.HabitVersion
+	for rows.Next() {
+		v, err := scanHabitVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+		versions = append(versions, v)
+	}
+	return versions, rows.Err()
+}
+
+func (r *HabitRepository) GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.HabitVersion, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, valid_to, op_type
+		FROM habits WHERE entity_id = ? AND version = ?
+	`, entityID.String(), version)
+
+	v, err := scanHabitVersion(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// RestoreVersion makes a copy of an earlier version the current one. It
+// also brings back a deleted habit.
+func (r *HabitRepository) RestoreVersion(ctx context.Context, entityID domain.EntityID, version int) error {
+	now := time.Now().Format(time.RFC3339)
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE habits SET valid_to = ? WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '')
+	`, now, entityID.String())
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO habits (name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id, version, valid_from, op_type)
+		SELECT name, goal_per_day, goal_per_week, goal_per_month, kind, unit, target, target_type, schedule, archived_at, created_at, entity_id,
+			(SELECT MAX(version) FROM habits WHERE entity_id = ?) + 1, ?, ?
+		FROM habits WHERE entity_id = ? AND version = ?
+	`, entityID.String(), now, domain.OpTypeUpdate.String(), entityID.String(), version)
+	if err != nil {
+		return err
+	}
+
+	if err := pruneVersions(ctx, tx, "habits", "id", entityID, habitRowsInUse); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func habitKind(habit domain.Habit) string {
+	if habit.Kind == "" {
+		return string(domain.HabitKindCount)
+	}
+	return string(habit.Kind)
+}
+
+func habitTargetType(habit domain.Habit) string {
+	if habit.TargetType == "" {
+		return string(domain.HabitTargetAtLeast)
+	}
+	return string(habit.TargetType)
+}
+
+func habitArchivedAt(archivedAt *time.Time) any {
+	if archivedAt == nil {
+		return nil
+	}
+	return archivedAt.Format(time.RFC3339)
+}
+
+func parseHabitArchivedAt(archivedAt sql.NullString) *time.Time {
+	if !archivedAt.Valid || archivedAt.String == "" {
+		return nil
+	}
+	t, err := time.Parse(time.RFC3339, archivedAt.String)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func scanHabitVersion(row rowScanner) (domain.HabitVersion, error) {
+	var v domain.HabitVersion
+	var entityID, validTo, archivedAt sql.NullString
+	var schedule, createdAt, validFrom, opType string
+
+	err := row.Scan(&v.Habit.ID, &v.Habit.Name, &v.Habit.GoalPerDay, &v.Habit.GoalPerWeek, &v.Habit.GoalPerMonth,
+		&v.Habit.Kind, &v.Habit.Unit, &v.Habit.Target, &v.Habit.TargetType, &schedule, &archivedAt, &createdAt, &entityID, &v.Version, &validFrom, &validTo, &opType)
+	if err != nil {
+		return domain.HabitVersion{}, err
+	}
+
+	if entityID.Valid {
+		v.Habit.EntityID = domain.EntityID(entityID.String)
+		v.EntityID = v.Habit.EntityID
+	}
+	v.RowID = v.Habit.ID
+	v.Habit.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	v.Habit.Schedule, _ = domain.ParseHabitSchedule(schedule, v.Habit.CreatedAt)
+	v.Habit.ArchivedAt = parseHabitArchivedAt(archivedAt)
+	v.ValidFrom, _ = time.Parse(time.RFC3339, validFrom)
+	if validTo.Valid && validTo.String != "" {
+		t, _ := time.Parse(time.RFC3339, validTo.String)
+		v.ValidTo = &t
+	}
+	v.OpType = domain.OpType(opType)
+	return v, nil
+}
@@ -356,3 +356,124 @@ func TestHabitRepository_GetAll_IncludesWeeklyMonthlyGoals(t *testing.T) {
 	require.Len(t, habits, 1)
 	assert.Equal(t, 4, habits[0].GoalPerMonth)
 }
+
+func TestHabitRepository_UpdateWithSchedule(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.Habit{Name: "Gym", GoalPerDay: 1, CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	habit, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, habit.Schedule.IsDaily())
+
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	habit.Schedule = domain.HabitSchedule{EveryDays: 3, Start: start}
+	require.NoError(t, repo.Update(ctx, *habit))
+
+	habits, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, habits, 1)
+	assert.Equal(t, 3, habits[0].Schedule.EveryDays)
+	assert.Equal(t, start, habits[0].Schedule.Start)
+
+	history, err := repo.GetHistory(ctx, habit.EntityID)
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+	assert.True(t, history[0].Habit.Schedule.IsDaily())
+	require.NoError(t, repo.RestoreVersion(ctx, habit.EntityID, 1))
+
+	restored, err := repo.GetByEntityID(ctx, habit.EntityID)
+	require.NoError(t, err)
+	assert.True(t, restored.Schedule.IsDaily())
+}
+
+func TestHabitRepository_PruningKeepsVersionsWithLogs(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitRepository(db)
+	logRepo := NewHabitLogRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.Habit{Name: "Gym", GoalPerDay: 1, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	habit, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	_, err = logRepo.Insert(ctx, domain.HabitLog{HabitID: id, HabitEntityID: habit.EntityID, Count: 1, LoggedAt: time.Now()})
+	require.NoError(t, err)
+
+	for i := 1; i <= maxEntityVersions+5; i++ {
+		habit.GoalPerDay = i
+		require.NoError(t, repo.Update(ctx, *habit))
+	}
+
+	history, err := repo.GetHistory(ctx, habit.EntityID)
+	require.NoError(t, err)
+	assert.Len(t, history, maxEntityVersions+1)
+	assert.Equal(t, 1, history[0].Version)
+
+	logs, err := logRepo.GetByHabitID(ctx, id)
+	require.NoError(t, err)
+	assert.Len(t, logs, 1)
+}
+
+func TestHabitRepository_MeasuredHabit(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.Habit{
+		Name:       "Screen time",
+		Kind:       domain.HabitKindMeasured,
+		Unit:       "h",
+		Target:     2.5,
+		TargetType: domain.HabitTargetAtMost,
+		CreatedAt:  time.Now(),
+	})
+	require.NoError(t, err)
+
+	habit, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, habit.IsMeasured())
+	assert.Equal(t, "h", habit.Unit)
+	assert.Equal(t, 2.5, habit.Target)
+	assert.Equal(t, domain.HabitTargetAtMost, habit.TargetType)
+
+	countID := createTestHabit(t, repo, "Gym")
+	count, err := repo.GetByID(ctx, countID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.HabitKindCount, count.Kind)
+	assert.Equal(t, domain.HabitTargetAtLeast, count.TargetType)
+}
+
+func TestHabitRepository_ArchivedHabit(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewHabitRepository(db)
+	ctx := context.Background()
+
+	id := createTestHabit(t, repo, "Gym")
+	habit, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.False(t, habit.IsArchived())
+
+	archivedAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	habit.ArchivedAt = &archivedAt
+	require.NoError(t, repo.Update(ctx, *habit))
+
+	archived, err := repo.GetByName(ctx, "Gym")
+	require.NoError(t, err)
+	require.True(t, archived.IsArchived())
+	assert.True(t, archivedAt.Equal(*archived.ArchivedAt))
+
+	all, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.True(t, all[0].IsArchived())
+
+	archived.ArchivedAt = nil
+	require.NoError(t, repo.Update(ctx, *archived))
+	restored, err := repo.GetByName(ctx, "Gym")
+	require.NoError(t, err)
+	assert.False(t, restored.IsArchived())
+}
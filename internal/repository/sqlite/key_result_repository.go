@@ -0,0 +1,204 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// KeyResultRepository stores the key results of goals and their check-ins.
+// Key results belong to a goal entity, so they survive edits to the goal.
+type KeyResultRepository struct {
+	db *sql.DB
+}
+
+func NewKeyResultRepository(db *sql.DB) *KeyResultRepository {
+	return &KeyResultRepository{db: db}
+}
+
+const keyResultColumns = `id, entity_id, goal_entity_id, name, start_value, target_value, current_value, unit, created_at`
+
+func (r *KeyResultRepository) Insert(ctx context.Context, kr domain.KeyResult) (int64, error) {
+	entityID := kr.EntityID
+	if entityID.IsEmpty() {
+		entityID = domain.NewEntityID()
+	}
+	createdAt := kr.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO goal_key_results (entity_id, goal_entity_id, name, start_value, target_value, current_value, unit, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, entityID.String(), kr.GoalEntityID.String(), kr.Name, kr.Start, kr.Target, kr.Current, kr.Unit,
+		createdAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (r *KeyResultRepository) GetByID(ctx context.Context, id int64) (*domain.KeyResult, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT `+keyResultColumns+` FROM goal_key_results WHERE id = ?`, id)
+	return r.scanOne(row)
+}
+
+func (r *KeyResultRepository) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.KeyResult, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT `+keyResultColumns+` FROM goal_key_results WHERE entity_id = ?`, entityID.String())
+	return r.scanOne(row)
+}
+
+// GetByGoals returns the key results of each goal, in the order they were
+// added.
+func (r *KeyResultRepository) GetByGoals(ctx context.Context, goalEntityIDs []domain.EntityID) (map[domain.EntityID][]domain.KeyResult, error) {
+	result := make(map[domain.EntityID][]domain.KeyResult)
+	if len(goalEntityIDs) == 0 {
+		return result, nil
+	}
+
+	placeholders := make([]string, len(goalEntityIDs))
+	args := make([]interface{}, len(goalEntityIDs))
+	for i, id := range goalEntityIDs {
+		placeholders[i] = "?"
+		args[i] = id.String()
+	}
+
+	query := fmt.Sprintf(
+		"SELECT "+keyResultColumns+" FROM goal_key_results WHERE goal_entity_id IN (%s) ORDER BY id",
+		strings.Join(placeholders, ","),
+	)
+
+	krs, err := r.query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	for _, kr := range krs {
+		result[kr.GoalEntityID] = append(result[kr.GoalEntityID], kr)
+	}
+	return result, nil
+}
+
+func (r *KeyResultRepository) GetAll(ctx context.Context) ([]domain.KeyResult, error) {
+	return r.query(ctx, `SELECT `+keyResultColumns+` FROM goal_key_results ORDER BY id`)
+}
+
+func (r *KeyResultRepository) Update(ctx context.Context, kr domain.KeyResult) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		UPDATE goal_key_results
+		SET goal_entity_id = ?, name = ?, start_value = ?, target_value = ?, current_value = ?, unit = ?
+		WHERE id = ?
+	`, kr.GoalEntityID.String(), kr.Name, kr.Start, kr.Target, kr.Current, kr.Unit, kr.ID)
+	return err
+}
+
+func (r *KeyResultRepository) Delete(ctx context.Context, id int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM goal_key_results WHERE id = ?", id)
+	return err
+}
+
+func (r *KeyResultRepository) DeleteAll(ctx context.Context) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM goal_key_results")
+	return err
+}
+
+func (r *KeyResultRepository) InsertCheckIn(ctx context.Context, checkIn domain.KeyResultCheckIn) (int64, error) {
+	checkedAt := checkIn.CheckedAt
+	if checkedAt.IsZero() {
+		checkedAt = time.Now()
+	}
+
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO goal_key_result_checkins (key_result_entity_id, value, checked_at)
+		VALUES (?, ?, ?)
+	`, checkIn.KeyResultEntityID.String(), checkIn.Value, checkedAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+// GetCheckIns returns a key result's check-ins, oldest first.
+func (r *KeyResultRepository) GetCheckIns(ctx context.Context, keyResultEntityID domain.EntityID) ([]domain.KeyResultCheckIn, error) {
+	return r.queryCheckIns(ctx, `
+		SELECT id, key_result_entity_id, value, checked_at
+		FROM goal_key_result_checkins WHERE key_result_entity_id = ?
+		ORDER BY checked_at, id
+	`, keyResultEntityID.String())
+}
+
+func (r *KeyResultRepository) GetAllCheckIns(ctx context.Context) ([]domain.KeyResultCheckIn, error) {
+	return r.queryCheckIns(ctx, `
+		SELECT id, key_result_entity_id, value, checked_at
+		FROM goal_key_result_checkins ORDER BY checked_at, id
+	`)
+}
+
+func (r *KeyResultRepository) scanOne(row *sql.Row) (*domain.KeyResult, error) {
+	kr, err := scanKeyResult(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &kr, nil
+}
+
+func (r *KeyResultRepository) query(ctx context.Context, query string, args ...any) ([]domain.KeyResult, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var krs []domain.KeyResult
+	for rows.Next() {
+		kr, err := scanKeyResult(rows)
+		if err != nil {
+			return nil, err
+		}
+		krs = append(krs, kr)
+	}
+	return krs, rows.Err()
+}
+
+func (r *KeyResultRepository) queryCheckIns(ctx context.Context, query string, args ...any) ([]domain.KeyResultCheckIn, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var checkIns []domain.KeyResultCheckIn
+	for rows.Next() {
+		var checkIn domain.KeyResultCheckIn
+		var keyResultEntityID, checkedAt string
+		if err := rows.Scan(&checkIn.ID, &keyResultEntityID, &checkIn.Value, &checkedAt); err != nil {
+			return nil, err
+		}
+		checkIn.KeyResultEntityID = domain.EntityID(keyResultEntityID)
+		checkIn.CheckedAt, _ = time.Parse(time.RFC3339, checkedAt)
+		checkIns = append(checkIns, checkIn)
+	}
+	return checkIns, rows.Err()
+}
+
+func scanKeyResult(row rowScanner) (domain.KeyResult, error) {
+	var kr domain.KeyResult
+	var entityID, goalEntityID, createdAt string
+
+	err := row.Scan(&kr.ID, &entityID, &goalEntityID, &kr.Name, &kr.Start, &kr.Target, &kr.Current, &kr.Unit, &createdAt)
+	if err != nil {
+		return domain.KeyResult{}, err
+	}
+
+	kr.EntityID = domain.EntityID(entityID)
+	kr.GoalEntityID = domain.EntityID(goalEntityID)
+	kr.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	return kr, nil
+}
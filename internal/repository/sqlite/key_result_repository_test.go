@@ -0,0 +1,92 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestKeyResultRepository_InsertAndGet(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewKeyResultRepository(db)
+	ctx := context.Background()
+
+	goalID := domain.NewEntityID()
+	id, err := repo.Insert(ctx, domain.KeyResult{
+		GoalEntityID: goalID,
+		Name:         "Newsletter subscribers",
+		Start:        200,
+		Target:       1000,
+		Current:      200,
+		Unit:         "subscribers",
+	})
+	require.NoError(t, err)
+
+	kr, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, kr)
+	assert.Equal(t, "Newsletter subscribers", kr.Name)
+	assert.Equal(t, 1000.0, kr.Target)
+	assert.False(t, kr.EntityID.IsEmpty())
+
+	byGoal, err := repo.GetByGoals(ctx, []domain.EntityID{goalID})
+	require.NoError(t, err)
+	require.Len(t, byGoal[goalID], 1)
+	assert.Equal(t, id, byGoal[goalID][0].ID)
+}
+
+func TestKeyResultRepository_UpdateAndCheckIns(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewKeyResultRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.KeyResult{GoalEntityID: domain.NewEntityID(), Name: "Revenue", Target: 100})
+	require.NoError(t, err)
+	kr, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+
+	day := time.Date(2026, 7, 1, 9, 0, 0, 0, time.UTC)
+	for i, value := range []float64{20, 45} {
+		_, err := repo.InsertCheckIn(ctx, domain.KeyResultCheckIn{
+			KeyResultEntityID: kr.EntityID,
+			Value:             value,
+			CheckedAt:         day.AddDate(0, 0, i*7),
+		})
+		require.NoError(t, err)
+	}
+	kr.Current = 45
+	require.NoError(t, repo.Update(ctx, *kr))
+
+	updated, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.Equal(t, 45.0, updated.Current)
+
+	checkIns, err := repo.GetCheckIns(ctx, kr.EntityID)
+	require.NoError(t, err)
+	require.Len(t, checkIns, 2)
+	assert.Equal(t, 20.0, checkIns[0].Value)
+	assert.Equal(t, day, checkIns[0].CheckedAt)
+}
+
+func TestKeyResultRepository_DeleteRemovesCheckIns(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewKeyResultRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.KeyResult{GoalEntityID: domain.NewEntityID(), Name: "Revenue", Target: 100})
+	require.NoError(t, err)
+	kr, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	_, err = repo.InsertCheckIn(ctx, domain.KeyResultCheckIn{KeyResultEntityID: kr.EntityID, Value: 10})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Delete(ctx, id))
+
+	checkIns, err := repo.GetAllCheckIns(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, checkIns)
+}
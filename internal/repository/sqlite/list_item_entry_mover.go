@@ -0,0 +1,90 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type ListItemToEntryMover struct {
+	db *sql.DB
+}
+
+func NewListItemToEntryMover(db *sql.DB) *ListItemToEntryMover {
+	return &ListItemToEntryMover{db: db}
+}
+
+// MoveListItemToEntry adds the item to the journal as a task on date and
+// marks it scheduled in its list. The task takes the item's EntityID, so
+// it carries on the item's history, and keeps when the item was created.
+func (m *ListItemToEntryMover) MoveListItemToEntry(ctx context.Context, item domain.ListItem, date time.Time) (int64, error) {
+	now := time.Now().Format(time.RFC3339)
+	dateStr := date.Format("2006-01-02")
+
+	tx, err := beginTx(ctx, m.db)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	priority := item.Priority
+	if priority == "" {
+		priority = domain.PriorityNone
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO entries (type, content, priority, depth, scheduled_date, created_at, entity_id, version, valid_from, op_type, sort_order, migration_count, original_created_at, schedule_granularity)
+		VALUES (?, ?, ?, 0, ?, ?, ?, 1, ?, 'INSERT',
+			COALESCE((SELECT NULLIF(MAX(sort_order), 0) + 1 FROM entries WHERE scheduled_date = ? AND schedule_granularity = ?), 0), 0, ?, ?)
+	`, domain.EntryTypeTask, item.Content, priority, dateStr, now, item.EntityID.String(), now,
+		dateStr, domain.ScheduleDay, item.CreatedAt.Format(time.RFC3339), domain.ScheduleDay)
+	if err != nil {
+		return 0, err
+	}
+	entryID, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, tag := range domain.ExtractTags(item.Content) {
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO entry_tags (entry_id, tag) VALUES (?, ?)`, entryID, tag); err != nil {
+			return 0, err
+		}
+	}
+	for _, mention := range domain.ExtractMentions(item.Content) {
+		if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO entry_mentions (entry_id, mention) VALUES (?, ?)`, entryID, mention); err != nil {
+			return 0, err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE list_items SET valid_to = ? WHERE entity_id = ? AND valid_to IS NULL
+	`, now, item.EntityID.String())
+	if err != nil {
+		return 0, err
+	}
+
+	var maxVersion int
+	err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(version), 0) FROM list_items WHERE entity_id = ?
+	`, item.EntityID.String()).Scan(&maxVersion)
+	if err != nil {
+		return 0, err
+	}
+
+	item.Type = domain.ListItemTypeScheduled
+	if _, err := insertListItemVersion(ctx, tx, item, maxVersion+1, domain.OpTypeUpdate, now); err != nil {
+		return 0, err
+	}
+
+	if err := pruneVersions(ctx, tx, "list_items", "row_id", item.EntityID, ""); err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return entryID, nil
+}
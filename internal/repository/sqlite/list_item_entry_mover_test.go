@@ -0,0 +1,58 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestListItemToEntryMover_MoveListItemToEntry(t *testing.T) {
+	db, err := OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	entryRepo := NewEntryRepository(db)
+	listRepo := NewListRepository(db)
+	listItemRepo := NewListItemRepository(db)
+	tagRepo := NewTagRepository(db)
+	mover := NewListItemToEntryMover(db)
+	ctx := context.Background()
+
+	list, err := listRepo.Create(ctx, "Backlog")
+	require.NoError(t, err)
+	item := domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Write release notes #work")
+	item.Priority = domain.PriorityHigh
+	itemID, err := listItemRepo.Insert(ctx, item)
+	require.NoError(t, err)
+	saved, err := listItemRepo.GetByID(ctx, itemID)
+	require.NoError(t, err)
+
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+	entryID, err := mover.MoveListItemToEntry(ctx, *saved, date)
+	require.NoError(t, err)
+
+	entry, err := entryRepo.GetByID(ctx, entryID)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, domain.EntryTypeTask, entry.Type)
+	assert.Equal(t, "Write release notes #work", entry.Content)
+	assert.Equal(t, domain.PriorityHigh, entry.Priority)
+	assert.Equal(t, "2026-10-18", entry.ScheduledDate.Format("2006-01-02"))
+	assert.Equal(t, saved.EntityID, entry.EntityID)
+	require.NotNil(t, entry.OriginalCreatedAt)
+
+	tags, err := tagRepo.GetTagsForEntries(ctx, []int64{entryID})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tags[entryID])
+
+	items, err := listItemRepo.GetByListEntityID(ctx, list.EntityID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, domain.ListItemTypeScheduled, items[0].Type)
+	assert.Equal(t, saved.EntityID, items[0].EntityID)
+	assert.Equal(t, 2, items[0].Version)
+}
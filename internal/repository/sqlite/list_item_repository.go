@@ -17,24 +17,68 @@ func NewListItemRepository(db *sql.DB) *ListItemRepository {
 }
 
 func (r *ListItemRepository) Insert(ctx context.Context, item domain.ListItem) (int64, error) {
-	now := time.Now().Format(time.RFC3339)
-
-	result, err := r.db.ExecContext(ctx, `
-		INSERT INTO list_items (entity_id, version, valid_from, op_type, list_entity_id, type, content, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, item.EntityID.String(), item.Version, now, domain.OpTypeInsert.String(),
-		item.ListEntityID.String(), string(item.Type), item.Content, item.CreatedAt.Format(time.RFC3339))
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
 
+	result, err := insertListItemVersion(ctx, tx, item, item.Version, domain.OpTypeInsert, time.Now().Format(time.RFC3339))
 	if err != nil {
 		return 0, err
 	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	return id, tx.Commit()
+}
+
+const listItemColumns = `row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, parent_entity_id, sort_order, priority, type, content, created_at, due_date`
+
+// insertListItemVersion inserts a row for a version of a list item. An item
+// without a sort order is placed after the other items of its list.
+func insertListItemVersion(ctx context.Context, tx queryer, item domain.ListItem, version int, opType domain.OpType, validFrom string) (sql.Result, error) {
+	sortOrder := item.SortOrder
+	if sortOrder == 0 {
+		err := tx.QueryRowContext(ctx, `
+			SELECT COALESCE(MAX(sort_order), 0) + 1 FROM list_items
+			WHERE list_entity_id = ? AND valid_to IS NULL AND op_type != 'DELETE'
+		`, item.ListEntityID.String()).Scan(&sortOrder)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	priority := item.Priority
+	if priority == "" {
+		priority = domain.PriorityNone
+	}
+
+	var parentEntityID *string
+	if item.HasParent() {
+		id := item.ParentEntityID.String()
+		parentEntityID = &id
+	}
+
+	var dueDate *string
+	if item.DueDate != nil {
+		date := item.DueDate.Format("2006-01-02")
+		dueDate = &date
+	}
 
-	return result.LastInsertId()
+	return tx.ExecContext(ctx, `
+		INSERT INTO list_items (entity_id, version, valid_from, op_type, list_entity_id, parent_entity_id, sort_order, priority, type, content, created_at, due_date)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+	`, item.EntityID.String(), version, validFrom, opType.String(),
+		item.ListEntityID.String(), parentEntityID, sortOrder, string(priority),
+		string(item.Type), item.Content, item.CreatedAt.Format(time.RFC3339), dueDate)
 }
 
 func (r *ListItemRepository) GetByID(ctx context.Context, id int64) (*domain.ListItem, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE row_id = ? AND valid_to IS NULL
 	`, id)
@@ -48,7 +92,7 @@ func (r *ListItemRepository) GetByID(ctx context.Context, id int64) (*domain.Lis
 	}
 
 	var entityID string
-	err = r.db.QueryRowContext(ctx, `SELECT entity_id FROM list_items WHERE row_id = ?`, id).Scan(&entityID)
+	err = dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT entity_id FROM list_items WHERE row_id = ?`, id).Scan(&entityID)
 	if err != nil {
 		return nil, nil // Row doesn't exist at all
 	}
@@ -57,8 +101,8 @@ func (r *ListItemRepository) GetByID(ctx context.Context, id int64) (*domain.Lis
 }
 
 func (r *ListItemRepository) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.ListItem, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE entity_id = ? AND valid_to IS NULL AND op_type != 'DELETE'
 	`, entityID.String())
@@ -67,11 +111,11 @@ func (r *ListItemRepository) GetByEntityID(ctx context.Context, entityID domain.
 }
 
 func (r *ListItemRepository) GetByListEntityID(ctx context.Context, listEntityID domain.EntityID) ([]domain.ListItem, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE list_entity_id = ? AND valid_to IS NULL AND op_type != 'DELETE'
-		ORDER BY row_id
+		ORDER BY sort_order, row_id
 	`, listEntityID.String())
 	if err != nil {
 		return nil, err
@@ -82,12 +126,11 @@ func (r *ListItemRepository) GetByListEntityID(ctx context.Context, listEntityID
 }
 
 func (r *ListItemRepository) GetByListID(ctx context.Context, listID int64) ([]domain.ListItem, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT li.row_id, li.entity_id, li.version, li.valid_from, li.valid_to, li.op_type, li.list_entity_id, li.type, li.content, li.created_at
-		FROM list_items li
-		JOIN lists l ON li.list_entity_id = l.entity_id
-		WHERE l.id = ? AND li.valid_to IS NULL AND li.op_type != 'DELETE'
-		ORDER BY li.row_id
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+listItemColumns+`
+		FROM list_items
+		WHERE list_entity_id = (SELECT entity_id FROM lists WHERE id = ?) AND valid_to IS NULL AND op_type != 'DELETE'
+		ORDER BY sort_order, row_id
 	`, listID)
 	if err != nil {
 		return nil, err
@@ -98,8 +141,8 @@ func (r *ListItemRepository) GetByListID(ctx context.Context, listID int64) ([]d
 }
 
 func (r *ListItemRepository) GetAll(ctx context.Context) ([]domain.ListItem, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE valid_to IS NULL AND op_type != 'DELETE'
 		ORDER BY row_id
@@ -112,10 +155,27 @@ func (r *ListItemRepository) GetAll(ctx context.Context) ([]domain.ListItem, err
 	return r.scanItems(rows)
 }
 
+// GetOverdue returns the open tasks of every list that were due before
+// today, the earliest due first.
+func (r *ListItemRepository) GetOverdue(ctx context.Context, today time.Time) ([]domain.ListItem, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+listItemColumns+`
+		FROM list_items
+		WHERE due_date < ? AND type = 'task' AND valid_to IS NULL AND op_type != 'DELETE'
+		ORDER BY due_date, row_id
+	`, today.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	return r.scanItems(rows)
+}
+
 func (r *ListItemRepository) Update(ctx context.Context, item domain.ListItem) error {
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -136,15 +196,15 @@ func (r *ListItemRepository) Update(ctx context.Context, item domain.ListItem) e
 		return err
 	}
 
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO list_items (entity_id, version, valid_from, op_type, list_entity_id, type, content, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, item.EntityID.String(), maxVersion+1, now, domain.OpTypeUpdate.String(),
-		item.ListEntityID.String(), string(item.Type), item.Content, item.CreatedAt.Format(time.RFC3339))
+	_, err = insertListItemVersion(ctx, tx, item, maxVersion+1, domain.OpTypeUpdate, now)
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "list_items", "row_id", item.EntityID, ""); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
@@ -159,7 +219,7 @@ func (r *ListItemRepository) Delete(ctx context.Context, id int64) error {
 		return nil // Already deleted or doesn't exist
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -180,26 +240,26 @@ func (r *ListItemRepository) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO list_items (entity_id, version, valid_from, op_type, list_entity_id, type, content, created_at)
-		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
-	`, item.EntityID.String(), maxVersion+1, now, domain.OpTypeDelete.String(),
-		item.ListEntityID.String(), string(item.Type), item.Content, item.CreatedAt.Format(time.RFC3339))
+	_, err = insertListItemVersion(ctx, tx, *item, maxVersion+1, domain.OpTypeDelete, now)
 	if err != nil {
 		return err
 	}
 
+	if err := pruneVersions(ctx, tx, "list_items", "row_id", item.EntityID, ""); err != nil {
+		return err
+	}
+
 	return tx.Commit()
 }
 
 func (r *ListItemRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM list_items")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM list_items")
 	return err
 }
 
 func (r *ListItemRepository) GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.ListItem, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE entity_id = ?
 		ORDER BY version
@@ -213,25 +273,52 @@ func (r *ListItemRepository) GetHistory(ctx context.Context, entityID domain.Ent
 }
 
 func (r *ListItemRepository) scanItem(row *sql.Row) (*domain.ListItem, error) {
+	item, err := scanListItemRow(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
+
+func (r *ListItemRepository) scanItems(rows *sql.Rows) ([]domain.ListItem, error) {
+	var items []domain.ListItem
+
+	for rows.Next() {
+		item, err := scanListItemRow(rows)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
+}
+
+func scanListItemRow(row rowScanner) (domain.ListItem, error) {
 	var item domain.ListItem
-	var entityID, listEntityID, opType, itemType string
+	var entityID, listEntityID, opType, priority, itemType string
 	var validFrom, createdAt string
-	var validTo sql.NullString
+	var validTo, parentEntityID, dueDate sql.NullString
 
 	err := row.Scan(
 		&item.RowID, &entityID, &item.Version, &validFrom, &validTo, &opType,
-		&listEntityID, &itemType, &item.Content, &createdAt,
+		&listEntityID, &parentEntityID, &item.SortOrder, &priority, &itemType, &item.Content, &createdAt,
+		&dueDate,
 	)
-
-	if err == sql.ErrNoRows {
-		return nil, nil
-	}
 	if err != nil {
-		return nil, err
+		return domain.ListItem{}, err
 	}
 
 	item.EntityID = domain.EntityID(entityID)
 	item.ListEntityID = domain.EntityID(listEntityID)
+	if parentEntityID.Valid && parentEntityID.String != "" {
+		parent := domain.EntityID(parentEntityID.String)
+		item.ParentEntityID = &parent
+	}
+	item.Priority = domain.Priority(priority)
 	item.OpType = domain.OpType(opType)
 	item.Type = domain.ListItemType(itemType)
 	item.ValidFrom, _ = time.Parse(time.RFC3339, validFrom)
@@ -240,47 +327,20 @@ func (r *ListItemRepository) scanItem(row *sql.Row) (*domain.ListItem, error) {
 		item.ValidTo = &t
 	}
 	item.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-
-	return &item, nil
-}
-
-func (r *ListItemRepository) scanItems(rows *sql.Rows) ([]domain.ListItem, error) {
-	var items []domain.ListItem
-
-	for rows.Next() {
-		var item domain.ListItem
-		var entityID, listEntityID, opType, itemType string
-		var validFrom, createdAt string
-		var validTo sql.NullString
-
-		err := rows.Scan(
-			&item.RowID, &entityID, &item.Version, &validFrom, &validTo, &opType,
-			&listEntityID, &itemType, &item.Content, &createdAt,
-		)
-		if err != nil {
-			return nil, err
+	if dueDate.Valid {
+		if t, err := time.Parse("2006-01-02", dueDate.String); err == nil {
+			item.DueDate = &t
 		}
-
-		item.EntityID = domain.EntityID(entityID)
-		item.ListEntityID = domain.EntityID(listEntityID)
-		item.OpType = domain.OpType(opType)
-		item.Type = domain.ListItemType(itemType)
-		item.ValidFrom, _ = time.Parse(time.RFC3339, validFrom)
-		if validTo.Valid {
-			t, _ := time.Parse(time.RFC3339, validTo.String)
-			item.ValidTo = &t
-		}
-		item.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
-
-		items = append(items, item)
 	}
+	item.Tags = domain.ExtractTags(item.Content)
+	item.Mentions = domain.ExtractMentions(item.Content)
 
-	return items, rows.Err()
+	return item, nil
 }
 
 func (r *ListItemRepository) GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.ListItem, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT row_id, entity_id, version, valid_from, valid_to, op_type, list_entity_id, type, content, created_at
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT `+listItemColumns+`
 		FROM list_items
 		WHERE entity_id = ? AND version = ?
 	`, entityID.String(), version)
@@ -290,7 +350,7 @@ func (r *ListItemRepository) GetAtVersion(ctx context.Context, entityID domain.E
 
 func (r *ListItemRepository) CountArchivable(ctx context.Context, olderThan time.Time) (int, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT COUNT(*) FROM list_items
 		WHERE valid_to IS NOT NULL AND valid_to < ?
 	`, olderThan.Format(time.RFC3339)).Scan(&count)
@@ -299,7 +359,7 @@ func (r *ListItemRepository) CountArchivable(ctx context.Context, olderThan time
 
 func (r *ListItemRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM list_items`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM list_items`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -310,7 +370,7 @@ func (r *ListItemRepository) GetLastModified(ctx context.Context) (time.Time, er
 }
 
 func (r *ListItemRepository) DeleteArchivable(ctx context.Context, olderThan time.Time) (int, error) {
-	result, err := r.db.ExecContext(ctx, `
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
 		DELETE FROM list_items
 		WHERE valid_to IS NOT NULL AND valid_to < ?
 	`, olderThan.Format(time.RFC3339))
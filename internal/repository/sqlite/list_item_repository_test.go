@@ -3,6 +3,7 @@ package sqlite
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -167,3 +168,127 @@ func TestListItemRepository_GetHistory_ReturnsAllVersions(t *testing.T) {
 	assert.Equal(t, 1, history[0].Version)
 	assert.Equal(t, 2, history[1].Version)
 }
+
+func TestListItemRepository_Insert_AppendsToEndOfList(t *testing.T) {
+	repo, listRepo := setupListItemRepo(t)
+	ctx := context.Background()
+	list := createTestList(t, listRepo)
+
+	firstID, err := repo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "First"))
+	require.NoError(t, err)
+	secondID, err := repo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Second"))
+	require.NoError(t, err)
+
+	first, err := repo.GetByID(ctx, firstID)
+	require.NoError(t, err)
+	second, err := repo.GetByID(ctx, secondID)
+	require.NoError(t, err)
+	assert.Less(t, first.SortOrder, second.SortOrder)
+}
+
+func TestListItemRepository_Update_PersistsHierarchyAndPriority(t *testing.T) {
+	repo, listRepo := setupListItemRepo(t)
+	ctx := context.Background()
+	list := createTestList(t, listRepo)
+
+	parent := domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Parent")
+	_, err := repo.Insert(ctx, parent)
+	require.NoError(t, err)
+	childID, err := repo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Call @alice #work"))
+	require.NoError(t, err)
+
+	child, err := repo.GetByID(ctx, childID)
+	require.NoError(t, err)
+	child.ParentEntityID = &parent.EntityID
+	child.SortOrder = 7
+	child.Priority = domain.PriorityHigh
+	require.NoError(t, repo.Update(ctx, *child))
+
+	updated, err := repo.GetByEntityID(ctx, child.EntityID)
+	require.NoError(t, err)
+	require.NotNil(t, updated.ParentEntityID)
+	assert.Equal(t, parent.EntityID, *updated.ParentEntityID)
+	assert.Equal(t, 7, updated.SortOrder)
+	assert.Equal(t, domain.PriorityHigh, updated.Priority)
+	assert.Equal(t, []string{"work"}, updated.Tags)
+	assert.Equal(t, []string{"alice"}, updated.Mentions)
+}
+
+func TestListItemRepository_GetByListEntityID_OrdersBySortOrder(t *testing.T) {
+	repo, listRepo := setupListItemRepo(t)
+	ctx := context.Background()
+	list := createTestList(t, listRepo)
+
+	first := domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "First")
+	first.SortOrder = 2
+	_, err := repo.Insert(ctx, first)
+	require.NoError(t, err)
+	second := domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Second")
+	second.SortOrder = 1
+	_, err = repo.Insert(ctx, second)
+	require.NoError(t, err)
+
+	items, err := repo.GetByListEntityID(ctx, list.EntityID)
+
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.Equal(t, "Second", items[0].Content)
+	assert.Equal(t, "First", items[1].Content)
+}
+
+func TestListItemRepository_DueDate_PersistsAcrossVersions(t *testing.T) {
+	repo, listRepo := setupListItemRepo(t)
+	ctx := context.Background()
+	list := createTestList(t, listRepo)
+
+	due := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+	item := domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Renew passport")
+	item.DueDate = &due
+	id, err := repo.Insert(ctx, item)
+	require.NoError(t, err)
+
+	saved, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, saved.DueDate)
+	assert.Equal(t, "2026-10-23", saved.DueDate.Format("2006-01-02"))
+
+	saved.Priority = domain.PriorityHigh
+	require.NoError(t, repo.Update(ctx, *saved))
+	updated, err := repo.GetByEntityID(ctx, item.EntityID)
+	require.NoError(t, err)
+	require.NotNil(t, updated.DueDate)
+	assert.Equal(t, "2026-10-23", updated.DueDate.Format("2006-01-02"))
+
+	updated.DueDate = nil
+	require.NoError(t, repo.Update(ctx, *updated))
+	cleared, err := repo.GetByEntityID(ctx, item.EntityID)
+	require.NoError(t, err)
+	assert.Nil(t, cleared.DueDate)
+}
+
+func TestListItemRepository_GetOverdue(t *testing.T) {
+	repo, listRepo := setupListItemRepo(t)
+	ctx := context.Background()
+	list := createTestList(t, listRepo)
+	today := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	insert := func(content string, itemType domain.ListItemType, due time.Time) {
+		item := domain.NewListItem(list.EntityID, itemType, content)
+		item.DueDate = &due
+		_, err := repo.Insert(ctx, item)
+		require.NoError(t, err)
+	}
+	insert("Due last week", domain.ListItemTypeTask, today.AddDate(0, 0, -7))
+	insert("Due yesterday", domain.ListItemTypeTask, today.AddDate(0, 0, -1))
+	insert("Due today", domain.ListItemTypeTask, today)
+	insert("Done late", domain.ListItemTypeDone, today.AddDate(0, 0, -3))
+	_, err := repo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "No due date"))
+	require.NoError(t, err)
+
+	overdue, err := repo.GetOverdue(ctx, today)
+
+	require.NoError(t, err)
+	require.Len(t, overdue, 2)
+	assert.Equal(t, "Due last week", overdue[0].Content)
+	assert.Equal(t, "Due yesterday", overdue[1].Content)
+}
@@ -25,7 +25,7 @@ func (r *ListRepository) Create(ctx context.Context, name string) (*domain.List,
 
 	now := time.Now().Format(time.RFC3339)
 
-	result, err := r.db.ExecContext(ctx,
+	result, err := dbFor(ctx, r.db).ExecContext(ctx,
 		"INSERT INTO lists (name, entity_id, created_at, version, valid_from, op_type) VALUES (?, ?, ?, ?, ?, ?)",
 		list.Name, list.EntityID.String(), list.CreatedAt, 1, now, domain.OpTypeInsert.String(),
 	)
@@ -45,9 +45,9 @@ func (r *ListRepository) Create(ctx context.Context, name string) (*domain.List,
 func (r *ListRepository) InsertWithEntityID(ctx context.Context, list domain.List) (int64, error) {
 	now := time.Now().Format(time.RFC3339)
 
-	result, err := r.db.ExecContext(ctx,
-		"INSERT INTO lists (name, entity_id, created_at, version, valid_from, op_type) VALUES (?, ?, ?, ?, ?, ?)",
-		list.Name, list.EntityID.String(), list.CreatedAt.Format(time.RFC3339), 1, now, domain.OpTypeInsert.String(),
+	result, err := dbFor(ctx, r.db).ExecContext(ctx,
+		"INSERT INTO lists (name, query, entity_id, created_at, version, valid_from, op_type) VALUES (?, ?, ?, ?, ?, ?, ?)",
+		list.Name, list.Query, list.EntityID.String(), list.CreatedAt.Format(time.RFC3339), 1, now, domain.OpTypeInsert.String(),
 	)
 	if err != nil {
 		return 0, err
@@ -58,7 +58,7 @@ func (r *ListRepository) InsertWithEntityID(ctx context.Context, list domain.Lis
 
 func (r *ListRepository) GetByID(ctx context.Context, id int64) (*domain.List, error) {
 	var entityID string
-	err := r.db.QueryRowContext(ctx,
+	err := dbFor(ctx, r.db).QueryRowContext(ctx,
 		"SELECT entity_id FROM lists WHERE id = ?", id,
 	).Scan(&entityID)
 	if err == sql.ErrNoRows {
@@ -71,10 +71,10 @@ func (r *ListRepository) GetByID(ctx context.Context, id int64) (*domain.List, e
 	var list domain.List
 	var eid sql.NullString
 	var createdAt string
-	err = r.db.QueryRowContext(ctx,
-		"SELECT id, entity_id, name, created_at FROM lists WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
+	err = dbFor(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, entity_id, name, query, created_at FROM lists WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
 		entityID,
-	).Scan(&list.ID, &eid, &list.Name, &createdAt)
+	).Scan(&list.ID, &eid, &list.Name, &list.Query, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -93,10 +93,10 @@ func (r *ListRepository) GetByName(ctx context.Context, name string) (*domain.Li
 	var list domain.List
 	var entityID sql.NullString
 	var createdAt string
-	err := r.db.QueryRowContext(ctx,
-		"SELECT id, entity_id, name, created_at FROM lists WHERE name = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
+	err := dbFor(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, entity_id, name, query, created_at FROM lists WHERE name = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
 		name,
-	).Scan(&list.ID, &entityID, &list.Name, &createdAt)
+	).Scan(&list.ID, &entityID, &list.Name, &list.Query, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -115,10 +115,10 @@ func (r *ListRepository) GetByEntityID(ctx context.Context, entityID domain.Enti
 	var list domain.List
 	var eid sql.NullString
 	var createdAt string
-	err := r.db.QueryRowContext(ctx,
-		"SELECT id, entity_id, name, created_at FROM lists WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
+	err := dbFor(ctx, r.db).QueryRowContext(ctx,
+		"SELECT id, entity_id, name, query, created_at FROM lists WHERE entity_id = ? AND (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE'",
 		entityID.String(),
-	).Scan(&list.ID, &eid, &list.Name, &createdAt)
+	).Scan(&list.ID, &eid, &list.Name, &list.Query, &createdAt)
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -134,8 +134,8 @@ func (r *ListRepository) GetByEntityID(ctx context.Context, entityID domain.Enti
 }
 
 func (r *ListRepository) GetAll(ctx context.Context) ([]domain.List, error) {
-	rows, err := r.db.QueryContext(ctx,
-		"SELECT id, entity_id, name, created_at FROM lists WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE' ORDER BY name",
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx,
+		"SELECT id, entity_id, name, query, created_at FROM lists WHERE (valid_to IS NULL OR valid_to = '') AND op_type != 'DELETE' ORDER BY name",
 	)
 	if err != nil {
 		return nil, err
@@ -147,7 +147,7 @@ func (r *ListRepository) GetAll(ctx context.Context) ([]domain.List, error) {
 		var list domain.List
 		var entityID sql.NullString
 		var createdAt string
-		if err := rows.Scan(&list.ID, &entityID, &list.Name, &createdAt); err != nil {
+		if err := rows.Scan(&list.ID, &entityID, &list.Name, &list.Query, &createdAt); err != nil {
 			return nil, err
 		}
 		list.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
@@ -165,17 +165,34 @@ func (r *ListRepository) GetAll(ctx context.Context) ([]domain.List, error) {
 }
 
 func (r *ListRepository) Rename(ctx context.Context, id int64, newName string) error {
+	return r.update(ctx, id, func(list *domain.List) {
+		list.Name = newName
+	})
+}
+
+// UpdateQuery changes a smart list's saved search query.
+func (r *ListRepository) UpdateQuery(ctx context.Context, id int64, query string) error {
+	return r.update(ctx, id, func(list *domain.List) {
+		list.Query = query
+	})
+}
+
+// update writes a new version of the list with change applied.
+func (r *ListRepository) update(ctx context.Context, id int64, change func(*domain.List)) error {
 	current, err := r.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if current == nil {
-		return fmt.Errorf("list not found: %d", id)
+		return fmt.Errorf("list %w: %d", domain.ErrNotFound, id)
 	}
 
+	updated := *current
+	change(&updated)
+
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -197,9 +214,9 @@ func (r *ListRepository) Rename(ctx context.Context, id int64, newName string) e
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO lists (name, entity_id, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, newName, current.EntityID.String(), current.CreatedAt.Format(time.RFC3339),
+		INSERT INTO lists (name, query, entity_id, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, updated.Name, updated.Query, current.EntityID.String(), current.CreatedAt.Format(time.RFC3339),
 		maxVersion+1, now, domain.OpTypeUpdate.String())
 	if err != nil {
 		return err
@@ -219,7 +236,7 @@ func (r *ListRepository) Delete(ctx context.Context, id int64) error {
 
 	now := time.Now().Format(time.RFC3339)
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return err
 	}
@@ -241,9 +258,9 @@ func (r *ListRepository) Delete(ctx context.Context, id int64) error {
 	}
 
 	_, err = tx.ExecContext(ctx, `
-		INSERT INTO lists (name, entity_id, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, list.Name, list.EntityID.String(), list.CreatedAt.Format(time.RFC3339),
+		INSERT INTO lists (name, query, entity_id, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, list.Name, list.Query, list.EntityID.String(), list.CreatedAt.Format(time.RFC3339),
 		maxVersion+1, now, domain.OpTypeDelete.String())
 	if err != nil {
 		return err
@@ -253,13 +270,13 @@ func (r *ListRepository) Delete(ctx context.Context, id int64) error {
 }
 
 func (r *ListRepository) DeleteAll(ctx context.Context) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM lists")
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM lists")
 	return err
 }
 
 func (r *ListRepository) GetDeleted(ctx context.Context) ([]domain.List, error) {
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, entity_id, name, created_at
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, entity_id, name, query, created_at
 		FROM lists
 		WHERE op_type = 'DELETE'
 		AND valid_to IS NULL
@@ -275,7 +292,7 @@ func (r *ListRepository) GetDeleted(ctx context.Context) ([]domain.List, error)
 		var list domain.List
 		var entityID sql.NullString
 		var createdAt string
-		if err := rows.Scan(&list.ID, &entityID, &list.Name, &createdAt); err != nil {
+		if err := rows.Scan(&list.ID, &entityID, &list.Name, &list.Query, &createdAt); err != nil {
 			return nil, err
 		}
 		list.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
@@ -293,17 +310,18 @@ func (r *ListRepository) Restore(ctx context.Context, entityID domain.EntityID)
 
 	var lastList struct {
 		Name      string
+		Query     string
 		CreatedAt string
 		Version   int
 		OpType    string
 	}
 
-	err := r.db.QueryRowContext(ctx, `
-		SELECT name, created_at, version, op_type
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT name, query, created_at, version, op_type
 		FROM lists WHERE entity_id = ?
 		ORDER BY version DESC LIMIT 1
 	`, entityID.String()).Scan(
-		&lastList.Name, &lastList.CreatedAt, &lastList.Version, &lastList.OpType)
+		&lastList.Name, &lastList.Query, &lastList.CreatedAt, &lastList.Version, &lastList.OpType)
 	if err != nil {
 		return 0, err
 	}
@@ -312,7 +330,7 @@ func (r *ListRepository) Restore(ctx context.Context, entityID domain.EntityID)
 		return 0, nil // Not deleted, nothing to restore
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return 0, err
 	}
@@ -326,9 +344,9 @@ func (r *ListRepository) Restore(ctx context.Context, entityID domain.EntityID)
 	}
 
 	result, err := tx.ExecContext(ctx, `
-		INSERT INTO lists (name, entity_id, created_at, version, valid_from, op_type)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, lastList.Name, entityID.String(), lastList.CreatedAt,
+		INSERT INTO lists (name, query, entity_id, created_at, version, valid_from, op_type)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, lastList.Name, lastList.Query, entityID.String(), lastList.CreatedAt,
 		lastList.Version+1, now, domain.OpTypeInsert.String())
 	if err != nil {
 		return 0, err
@@ -343,7 +361,7 @@ func (r *ListRepository) Restore(ctx context.Context, entityID domain.EntityID)
 
 func (r *ListRepository) GetItemCount(ctx context.Context, listID int64) (int, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT COUNT(*) FROM list_items li
 		JOIN lists l ON li.list_entity_id = l.entity_id
 		WHERE l.id = ? AND li.valid_to IS NULL AND li.op_type != 'DELETE'
@@ -353,7 +371,7 @@ func (r *ListRepository) GetItemCount(ctx context.Context, listID int64) (int, e
 
 func (r *ListRepository) GetLastModified(ctx context.Context) (time.Time, error) {
 	var validFrom sql.NullString
-	err := r.db.QueryRowContext(ctx, `SELECT MAX(valid_from) FROM lists`).Scan(&validFrom)
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT MAX(valid_from) FROM lists`).Scan(&validFrom)
 	if err != nil {
 		return time.Time{}, err
 	}
@@ -365,7 +383,7 @@ func (r *ListRepository) GetLastModified(ctx context.Context) (time.Time, error)
 
 func (r *ListRepository) GetDoneCount(ctx context.Context, listID int64) (int, error) {
 	var count int
-	err := r.db.QueryRowContext(ctx, `
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `
 		SELECT COUNT(*) FROM list_items li
 		JOIN lists l ON li.list_entity_id = l.entity_id
 		WHERE l.id = ? AND li.type = 'done' AND li.valid_to IS NULL AND li.op_type != 'DELETE'
@@ -0,0 +1,102 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// ListTemplateRepository stores reusable list templates. Template names are
+// unique, ignoring case.
+type ListTemplateRepository struct {
+	db *sql.DB
+}
+
+func NewListTemplateRepository(db *sql.DB) *ListTemplateRepository {
+	return &ListTemplateRepository{db: db}
+}
+
+const listTemplateColumns = `id, name, content, created_at, updated_at`
+
+func (r *ListTemplateRepository) Insert(ctx context.Context, template domain.ListTemplate) (int64, error) {
+	createdAt := template.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+	updatedAt := template.UpdatedAt
+	if updatedAt.IsZero() {
+		updatedAt = createdAt
+	}
+
+	result, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT INTO list_templates (name, content, created_at, updated_at)
+		VALUES (?, ?, ?, ?)
+	`, template.Name, template.Content, createdAt.Format(time.RFC3339), updatedAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
+func (r *ListTemplateRepository) GetByName(ctx context.Context, name string) (*domain.ListTemplate, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT `+listTemplateColumns+` FROM list_templates WHERE name = ?`, name)
+	template, err := scanListTemplate(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+func (r *ListTemplateRepository) GetAll(ctx context.Context) ([]domain.ListTemplate, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `SELECT `+listTemplateColumns+` FROM list_templates ORDER BY name COLLATE NOCASE`)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var templates []domain.ListTemplate
+	for rows.Next() {
+		template, err := scanListTemplate(rows)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, template)
+	}
+	return templates, rows.Err()
+}
+
+func (r *ListTemplateRepository) Update(ctx context.Context, template domain.ListTemplate) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		UPDATE list_templates SET name = ?, content = ?, updated_at = ? WHERE id = ?
+	`, template.Name, template.Content, time.Now().Format(time.RFC3339), template.ID)
+	return err
+}
+
+func (r *ListTemplateRepository) Delete(ctx context.Context, id int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM list_templates WHERE id = ?", id)
+	return err
+}
+
+func (r *ListTemplateRepository) DeleteAll(ctx context.Context) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM list_templates")
+	return err
+}
+
+func scanListTemplate(row rowScanner) (domain.ListTemplate, error) {
+	var template domain.ListTemplate
+	var createdAt, updatedAt string
+
+	err := row.Scan(&template.ID, &template.Name, &template.Content, &createdAt, &updatedAt)
+	if err != nil {
+		return domain.ListTemplate{}, err
+	}
+
+	template.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	template.UpdatedAt, _ = time.Parse(time.RFC3339, updatedAt)
+	return template, nil
+}
@@ -0,0 +1,77 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func setupListTemplateRepo(t *testing.T) *ListTemplateRepository {
+	t.Helper()
+	db, err := OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	return NewListTemplateRepository(db)
+}
+
+func TestListTemplateRepository_InsertAndGetByName(t *testing.T) {
+	repo := setupListTemplateRepo(t)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.ListTemplate{Name: "Release", Content: ". Tag {{date}}\n"})
+	require.NoError(t, err)
+
+	found, err := repo.GetByName(ctx, "release")
+
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, id, found.ID)
+	assert.Equal(t, "Release", found.Name)
+	assert.Equal(t, ". Tag {{date}}\n", found.Content)
+	assert.False(t, found.CreatedAt.IsZero())
+}
+
+func TestListTemplateRepository_GetByName_NotFound(t *testing.T) {
+	repo := setupListTemplateRepo(t)
+
+	found, err := repo.GetByName(context.Background(), "missing")
+
+	require.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestListTemplateRepository_Insert_RejectsDuplicateName(t *testing.T) {
+	repo := setupListTemplateRepo(t)
+	ctx := context.Background()
+
+	_, err := repo.Insert(ctx, domain.ListTemplate{Name: "Release", Content: ". Tag"})
+	require.NoError(t, err)
+
+	_, err = repo.Insert(ctx, domain.ListTemplate{Name: "RELEASE", Content: ". Tag"})
+
+	assert.Error(t, err)
+}
+
+func TestListTemplateRepository_UpdateAndDelete(t *testing.T) {
+	repo := setupListTemplateRepo(t)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, domain.ListTemplate{Name: "Packing", Content: ". Socks"})
+	require.NoError(t, err)
+	_, err = repo.Insert(ctx, domain.ListTemplate{Name: "Onboarding", Content: ". Laptop"})
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Update(ctx, domain.ListTemplate{ID: id, Name: "Packing", Content: ". Passport"}))
+	found, err := repo.GetByName(ctx, "Packing")
+	require.NoError(t, err)
+	assert.Equal(t, ". Passport", found.Content)
+
+	require.NoError(t, repo.Delete(ctx, id))
+	all, err := repo.GetAll(ctx)
+	require.NoError(t, err)
+	require.Len(t, all, 1)
+	assert.Equal(t, "Onboarding", all[0].Name)
+}
@@ -20,7 +20,7 @@ func (r *MentionRepository) InsertEntryMentions(ctx context.Context, entryID int
 		return nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
@@ -59,7 +59,7 @@ func (r *MentionRepository) GetMentionsForEntries(ctx context.Context, entryIDs
 		strings.Join(placeholders, ","),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query mentions: %w", err)
 	}
@@ -78,7 +78,7 @@ func (r *MentionRepository) GetMentionsForEntries(ctx context.Context, entryIDs
 }
 
 func (r *MentionRepository) GetAllMentions(ctx context.Context) ([]string, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT DISTINCT mention FROM entry_mentions ORDER BY mention")
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, "SELECT DISTINCT mention FROM entry_mentions ORDER BY mention")
 	if err != nil {
 		return nil, fmt.Errorf("query all mentions: %w", err)
 	}
@@ -97,7 +97,7 @@ func (r *MentionRepository) GetAllMentions(ctx context.Context) ([]string, error
 }
 
 func (r *MentionRepository) DeleteByEntryID(ctx context.Context, entryID int64) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM entry_mentions WHERE entry_id = ?", entryID)
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM entry_mentions WHERE entry_id = ?", entryID)
 	if err != nil {
 		return fmt.Errorf("delete mentions for entry %d: %w", entryID, err)
 	}
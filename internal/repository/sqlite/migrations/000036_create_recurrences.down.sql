@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS recurrence_occurrences;
+DROP TABLE IF EXISTS recurrence_exceptions;
+DROP TABLE IF EXISTS recurrences;
@@ -0,0 +1,27 @@
+CREATE TABLE recurrences (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id TEXT NOT NULL UNIQUE,
+    type TEXT NOT NULL,
+    content TEXT NOT NULL,
+    priority TEXT NOT NULL DEFAULT 'none',
+    rule TEXT NOT NULL,
+    start_date TEXT NOT NULL,
+    end_date TEXT,
+    created_at TEXT NOT NULL
+);
+
+CREATE TABLE recurrence_exceptions (
+    recurrence_id INTEGER NOT NULL,
+    date TEXT NOT NULL,
+    PRIMARY KEY (recurrence_id, date),
+    FOREIGN KEY (recurrence_id) REFERENCES recurrences(id) ON DELETE CASCADE
+);
+
+CREATE TABLE recurrence_occurrences (
+    recurrence_id INTEGER NOT NULL,
+    date TEXT NOT NULL,
+    entry_id INTEGER,
+    PRIMARY KEY (recurrence_id, date),
+    FOREIGN KEY (recurrence_id) REFERENCES recurrences(id) ON DELETE CASCADE,
+    FOREIGN KEY (entry_id) REFERENCES entries(id) ON DELETE SET NULL
+);
@@ -0,0 +1,14 @@
+DROP TRIGGER IF EXISTS goals_fts_update;
+DROP TRIGGER IF EXISTS goals_fts_delete;
+DROP TRIGGER IF EXISTS goals_fts_insert;
+DROP TABLE IF EXISTS goals_fts;
+
+DROP TRIGGER IF EXISTS list_items_fts_update;
+DROP TRIGGER IF EXISTS list_items_fts_delete;
+DROP TRIGGER IF EXISTS list_items_fts_insert;
+DROP TABLE IF EXISTS list_items_fts;
+
+DROP TRIGGER IF EXISTS entries_fts_update;
+DROP TRIGGER IF EXISTS entries_fts_delete;
+DROP TRIGGER IF EXISTS entries_fts_insert;
+DROP TABLE IF EXISTS entries_fts;
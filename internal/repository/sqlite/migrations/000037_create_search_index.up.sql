@@ -0,0 +1,68 @@
+-- Full-text indexes over entries, list items and goals.
+-- External content tables keep the index small; triggers keep it in sync.
+
+CREATE VIRTUAL TABLE entries_fts USING fts5(
+    content,
+    content='entries',
+    content_rowid='id',
+    tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER entries_fts_insert AFTER INSERT ON entries BEGIN
+    INSERT INTO entries_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER entries_fts_delete AFTER DELETE ON entries BEGIN
+    INSERT INTO entries_fts(entries_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER entries_fts_update AFTER UPDATE OF content ON entries BEGIN
+    INSERT INTO entries_fts(entries_fts, rowid, content) VALUES ('delete', old.id, old.content);
+    INSERT INTO entries_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+INSERT INTO entries_fts(entries_fts) VALUES ('rebuild');
+
+CREATE VIRTUAL TABLE list_items_fts USING fts5(
+    content,
+    content='list_items',
+    content_rowid='row_id',
+    tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER list_items_fts_insert AFTER INSERT ON list_items BEGIN
+    INSERT INTO list_items_fts(rowid, content) VALUES (new.row_id, new.content);
+END;
+
+CREATE TRIGGER list_items_fts_delete AFTER DELETE ON list_items BEGIN
+    INSERT INTO list_items_fts(list_items_fts, rowid, content) VALUES ('delete', old.row_id, old.content);
+END;
+
+CREATE TRIGGER list_items_fts_update AFTER UPDATE OF content ON list_items BEGIN
+    INSERT INTO list_items_fts(list_items_fts, rowid, content) VALUES ('delete', old.row_id, old.content);
+    INSERT INTO list_items_fts(rowid, content) VALUES (new.row_id, new.content);
+END;
+
+INSERT INTO list_items_fts(list_items_fts) VALUES ('rebuild');
+
+CREATE VIRTUAL TABLE goals_fts USING fts5(
+    content,
+    content='goals',
+    content_rowid='id',
+    tokenize='unicode61 remove_diacritics 2'
+);
+
+CREATE TRIGGER goals_fts_insert AFTER INSERT ON goals BEGIN
+    INSERT INTO goals_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+CREATE TRIGGER goals_fts_delete AFTER DELETE ON goals BEGIN
+    INSERT INTO goals_fts(goals_fts, rowid, content) VALUES ('delete', old.id, old.content);
+END;
+
+CREATE TRIGGER goals_fts_update AFTER UPDATE OF content ON goals BEGIN
+    INSERT INTO goals_fts(goals_fts, rowid, content) VALUES ('delete', old.id, old.content);
+    INSERT INTO goals_fts(rowid, content) VALUES (new.id, new.content);
+END;
+
+INSERT INTO goals_fts(goals_fts) VALUES ('rebuild');
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS api_allowed_origins;
+DROP TABLE IF EXISTS api_tokens;
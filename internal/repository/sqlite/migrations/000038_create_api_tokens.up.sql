@@ -0,0 +1,17 @@
+CREATE TABLE api_tokens (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL,
+    token_hash TEXT NOT NULL UNIQUE,
+    prefix TEXT NOT NULL,
+    scopes TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    last_used_at TEXT,
+    revoked_at TEXT
+);
+
+CREATE TABLE api_allowed_origins (
+    origin TEXT PRIMARY KEY,
+    created_at TEXT NOT NULL
+);
+
+INSERT INTO api_allowed_origins (origin, created_at) VALUES ('https://mail.google.com', strftime('%Y-%m-%dT%H:%M:%SZ', 'now'));
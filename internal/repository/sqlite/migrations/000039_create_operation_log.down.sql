@@ -0,0 +1,39 @@
+DROP TRIGGER IF EXISTS oplog_entries_insert;
+DROP TRIGGER IF EXISTS oplog_entries_update;
+DROP TRIGGER IF EXISTS oplog_entries_delete;
+DROP TRIGGER IF EXISTS oplog_entry_tags_insert;
+DROP TRIGGER IF EXISTS oplog_entry_tags_update;
+DROP TRIGGER IF EXISTS oplog_entry_tags_delete;
+DROP TRIGGER IF EXISTS oplog_entry_mentions_insert;
+DROP TRIGGER IF EXISTS oplog_entry_mentions_update;
+DROP TRIGGER IF EXISTS oplog_entry_mentions_delete;
+DROP TRIGGER IF EXISTS oplog_day_context_insert;
+DROP TRIGGER IF EXISTS oplog_day_context_update;
+DROP TRIGGER IF EXISTS oplog_day_context_delete;
+DROP TRIGGER IF EXISTS oplog_habits_insert;
+DROP TRIGGER IF EXISTS oplog_habits_update;
+DROP TRIGGER IF EXISTS oplog_habits_delete;
+DROP TRIGGER IF EXISTS oplog_habit_logs_insert;
+DROP TRIGGER IF EXISTS oplog_habit_logs_update;
+DROP TRIGGER IF EXISTS oplog_habit_logs_delete;
+DROP TRIGGER IF EXISTS oplog_lists_insert;
+DROP TRIGGER IF EXISTS oplog_lists_update;
+DROP TRIGGER IF EXISTS oplog_lists_delete;
+DROP TRIGGER IF EXISTS oplog_list_items_insert;
+DROP TRIGGER IF EXISTS oplog_list_items_update;
+DROP TRIGGER IF EXISTS oplog_list_items_delete;
+DROP TRIGGER IF EXISTS oplog_goals_insert;
+DROP TRIGGER IF EXISTS oplog_goals_update;
+DROP TRIGGER IF EXISTS oplog_goals_delete;
+DROP TRIGGER IF EXISTS oplog_recurrences_insert;
+DROP TRIGGER IF EXISTS oplog_recurrences_update;
+DROP TRIGGER IF EXISTS oplog_recurrences_delete;
+DROP TRIGGER IF EXISTS oplog_recurrence_exceptions_insert;
+DROP TRIGGER IF EXISTS oplog_recurrence_exceptions_update;
+DROP TRIGGER IF EXISTS oplog_recurrence_exceptions_delete;
+DROP TRIGGER IF EXISTS oplog_recurrence_occurrences_insert;
+DROP TRIGGER IF EXISTS oplog_recurrence_occurrences_update;
+DROP TRIGGER IF EXISTS oplog_recurrence_occurrences_delete;
+
+DROP TABLE IF EXISTS operation_changes;
+DROP TABLE IF EXISTS operations;
@@ -0,0 +1,20 @@
+CREATE TABLE operations (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    description TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    recording INTEGER NOT NULL DEFAULT 0,
+    undone_at TEXT
+);
+
+CREATE TABLE operation_changes (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    operation_id INTEGER NOT NULL,
+    table_name TEXT NOT NULL,
+    row_id INTEGER NOT NULL,
+    kind TEXT NOT NULL CHECK (kind IN ('insert', 'update', 'delete')),
+    before TEXT,
+    after TEXT,
+    FOREIGN KEY (operation_id) REFERENCES operations(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_operation_changes_operation ON operation_changes(operation_id);
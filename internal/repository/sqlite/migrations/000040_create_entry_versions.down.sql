@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS entry_versions_retention;
+DROP TRIGGER IF EXISTS entry_versions_delete;
+DROP TRIGGER IF EXISTS entry_versions_update;
+DROP TRIGGER IF EXISTS entry_versions_insert;
+DROP TABLE IF EXISTS entry_versions;
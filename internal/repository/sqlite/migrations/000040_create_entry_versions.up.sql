@@ -0,0 +1,101 @@
+-- Version history for entries. Entries are updated in place so their IDs
+-- stay stable; triggers copy each change into entry_versions instead.
+-- Each version row is a full snapshot, and a DELETE row keeps the entry as
+-- it was when deleted so the entry and its children can be restored.
+
+CREATE TABLE entry_versions (
+    row_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    valid_from TEXT NOT NULL,
+    valid_to TEXT,
+    op_type TEXT NOT NULL CHECK (op_type IN ('INSERT', 'UPDATE', 'DELETE')),
+    entry_id INTEGER NOT NULL,
+    parent_entity_id TEXT,
+    type TEXT NOT NULL,
+    content TEXT NOT NULL,
+    priority TEXT NOT NULL,
+    depth INTEGER NOT NULL,
+    location TEXT,
+    scheduled_date TEXT NOT NULL,
+    sort_order INTEGER NOT NULL,
+    migration_count INTEGER NOT NULL,
+    completed_at TEXT,
+    original_created_at TEXT,
+    created_at TEXT NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_entry_versions_entity_version ON entry_versions(entity_id, version);
+CREATE INDEX idx_entry_versions_parent ON entry_versions(parent_entity_id);
+CREATE INDEX idx_entry_versions_valid_to ON entry_versions(valid_to);
+
+CREATE TRIGGER entry_versions_insert AFTER INSERT ON entries
+WHEN new.entity_id IS NOT NULL
+BEGIN
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = new.entity_id AND valid_to IS NULL;
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at)
+    VALUES (new.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = new.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'INSERT', new.id,
+        (SELECT entity_id FROM entries WHERE id = new.parent_id),
+        new.type, new.content, new.priority, new.depth, new.location, new.scheduled_date,
+        new.sort_order, new.migration_count, new.completed_at, new.original_created_at, new.created_at);
+END;
+
+-- Reordering touches every entry on a day, so only changes a user would
+-- recognise as an edit start a new version.
+CREATE TRIGGER entry_versions_update AFTER UPDATE ON entries
+WHEN new.entity_id IS NOT NULL AND (
+    new.type IS NOT old.type OR new.content IS NOT old.content OR new.priority IS NOT old.priority
+    OR new.parent_id IS NOT old.parent_id OR new.scheduled_date IS NOT old.scheduled_date)
+BEGIN
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = new.entity_id AND valid_to IS NULL;
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at)
+    VALUES (new.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = new.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'UPDATE', new.id,
+        (SELECT entity_id FROM entries WHERE id = new.parent_id),
+        new.type, new.content, new.priority, new.depth, new.location, new.scheduled_date,
+        new.sort_order, new.migration_count, new.completed_at, new.original_created_at, new.created_at);
+END;
+
+-- A subtree is deleted in one statement, so the parent row may already be
+-- gone; fall back to the parent recorded in the previous version.
+CREATE TRIGGER entry_versions_delete AFTER DELETE ON entries
+WHEN old.entity_id IS NOT NULL
+BEGIN
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at)
+    VALUES (old.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = old.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'DELETE', old.id,
+        COALESCE((SELECT entity_id FROM entries WHERE id = old.parent_id),
+            (SELECT parent_entity_id FROM entry_versions WHERE entity_id = old.entity_id AND valid_to IS NULL)),
+        old.type, old.content, old.priority, old.depth, old.location, old.scheduled_date,
+        old.sort_order, old.migration_count, old.completed_at, old.original_created_at, old.created_at);
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = old.entity_id AND valid_to IS NULL AND op_type != 'DELETE';
+END;
+
+-- Keep the most recent 50 versions of each entry.
+CREATE TRIGGER entry_versions_retention AFTER INSERT ON entry_versions
+BEGIN
+    DELETE FROM entry_versions WHERE entity_id = new.entity_id AND version <= new.version - 50;
+END;
+
+INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+    type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+    completed_at, original_created_at, created_at)
+SELECT e.entity_id, ROW_NUMBER() OVER (PARTITION BY e.entity_id ORDER BY e.id), COALESCE(e.valid_from, e.created_at), 'INSERT', e.id, p.entity_id,
+    e.type, e.content, e.priority, e.depth, e.location, e.scheduled_date,
+    e.sort_order, e.migration_count, e.completed_at, e.original_created_at, e.created_at
+FROM entries e
+LEFT JOIN entries p ON p.id = e.parent_id
+WHERE e.entity_id IS NOT NULL;
@@ -0,0 +1,67 @@
+-- Entries can be scheduled for a whole month or for someday instead of a
+-- specific day. Month entries keep the first of the month as their
+-- scheduled_date; someday entries keep the date they were deferred on.
+
+ALTER TABLE entries ADD COLUMN schedule_granularity TEXT NOT NULL DEFAULT 'day' CHECK (schedule_granularity IN ('day', 'month', 'someday'));
+ALTER TABLE entry_versions ADD COLUMN schedule_granularity TEXT NOT NULL DEFAULT 'day';
+
+CREATE INDEX idx_entries_schedule_granularity ON entries(schedule_granularity, scheduled_date);
+
+DROP TRIGGER IF EXISTS entry_versions_insert;
+DROP TRIGGER IF EXISTS entry_versions_update;
+DROP TRIGGER IF EXISTS entry_versions_delete;
+
+CREATE TRIGGER entry_versions_insert AFTER INSERT ON entries
+WHEN new.entity_id IS NOT NULL
+BEGIN
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = new.entity_id AND valid_to IS NULL;
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at, schedule_granularity)
+    VALUES (new.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = new.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'INSERT', new.id,
+        (SELECT entity_id FROM entries WHERE id = new.parent_id),
+        new.type, new.content, new.priority, new.depth, new.location, new.scheduled_date,
+        new.sort_order, new.migration_count, new.completed_at, new.original_created_at, new.created_at,
+        new.schedule_granularity);
+END;
+
+CREATE TRIGGER entry_versions_update AFTER UPDATE ON entries
+WHEN new.entity_id IS NOT NULL AND (
+    new.type IS NOT old.type OR new.content IS NOT old.content OR new.priority IS NOT old.priority
+    OR new.parent_id IS NOT old.parent_id OR new.scheduled_date IS NOT old.scheduled_date
+    OR new.schedule_granularity IS NOT old.schedule_granularity)
+BEGIN
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = new.entity_id AND valid_to IS NULL;
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at, schedule_granularity)
+    VALUES (new.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = new.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'UPDATE', new.id,
+        (SELECT entity_id FROM entries WHERE id = new.parent_id),
+        new.type, new.content, new.priority, new.depth, new.location, new.scheduled_date,
+        new.sort_order, new.migration_count, new.completed_at, new.original_created_at, new.created_at,
+        new.schedule_granularity);
+END;
+
+CREATE TRIGGER entry_versions_delete AFTER DELETE ON entries
+WHEN old.entity_id IS NOT NULL
+BEGIN
+    INSERT INTO entry_versions (entity_id, version, valid_from, op_type, entry_id, parent_entity_id,
+        type, content, priority, depth, location, scheduled_date, sort_order, migration_count,
+        completed_at, original_created_at, created_at, schedule_granularity)
+    VALUES (old.entity_id,
+        COALESCE((SELECT MAX(version) FROM entry_versions WHERE entity_id = old.entity_id), 0) + 1,
+        strftime('%Y-%m-%dT%H:%M:%SZ', 'now'), 'DELETE', old.id,
+        COALESCE((SELECT entity_id FROM entries WHERE id = old.parent_id),
+            (SELECT parent_entity_id FROM entry_versions WHERE entity_id = old.entity_id AND valid_to IS NULL)),
+        old.type, old.content, old.priority, old.depth, old.location, old.scheduled_date,
+        old.sort_order, old.migration_count, old.completed_at, old.original_created_at, old.created_at,
+        old.schedule_granularity);
+    UPDATE entry_versions SET valid_to = strftime('%Y-%m-%dT%H:%M:%SZ', 'now')
+    WHERE entity_id = old.entity_id AND valid_to IS NULL AND op_type != 'DELETE';
+END;
@@ -0,0 +1,4 @@
+-- A habit can be due on set weekdays ("mon,wed,fri") or every N days
+-- ("every 3 days from 2026-01-05"). Empty means every day.
+
+ALTER TABLE habits ADD COLUMN schedule TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_habit_pauses_dates;
+DROP TABLE IF EXISTS habit_pauses;
@@ -0,0 +1,13 @@
+-- Pauses excuse a habit for a range of days. A NULL habit_entity_id pauses
+-- every habit; a pause of one day is a skip.
+
+CREATE TABLE habit_pauses (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    habit_entity_id TEXT,
+    start_date TEXT NOT NULL,
+    end_date TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    CHECK (end_date >= start_date)
+);
+
+CREATE INDEX idx_habit_pauses_dates ON habit_pauses(start_date, end_date);
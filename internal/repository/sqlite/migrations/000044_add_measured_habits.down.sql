@@ -0,0 +1,6 @@
+ALTER TABLE habit_logs DROP COLUMN value;
+
+ALTER TABLE habits DROP COLUMN target_type;
+ALTER TABLE habits DROP COLUMN target;
+ALTER TABLE habits DROP COLUMN unit;
+ALTER TABLE habits DROP COLUMN kind;
@@ -0,0 +1,10 @@
+-- Measured habits record a decimal value in a unit ("5.2 km") against a
+-- daily target that is either a minimum or a maximum. Count habits keep
+-- using habit_logs.count; measured logs store their value alongside it.
+
+ALTER TABLE habits ADD COLUMN kind TEXT NOT NULL DEFAULT 'count' CHECK (kind IN ('count', 'measured'));
+ALTER TABLE habits ADD COLUMN unit TEXT NOT NULL DEFAULT '';
+ALTER TABLE habits ADD COLUMN target REAL NOT NULL DEFAULT 0;
+ALTER TABLE habits ADD COLUMN target_type TEXT NOT NULL DEFAULT 'at_least' CHECK (target_type IN ('at_least', 'at_most'));
+
+ALTER TABLE habit_logs ADD COLUMN value REAL NOT NULL DEFAULT 0;
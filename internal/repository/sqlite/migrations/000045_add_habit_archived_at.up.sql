@@ -0,0 +1,4 @@
+-- Archived habits are retired without deleting them: they drop out of the
+-- tracker but keep their logs for stats and exports.
+
+ALTER TABLE habits ADD COLUMN archived_at TEXT;
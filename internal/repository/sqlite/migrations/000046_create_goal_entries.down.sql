@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_goal_entries_entry;
+DROP TABLE IF EXISTS goal_entries;
@@ -0,0 +1,12 @@
+-- Links journal tasks to the monthly goal they serve. Goals are versioned,
+-- so links point at the goal's entity; entries keep their ID when edited.
+
+CREATE TABLE goal_entries (
+    goal_entity_id TEXT NOT NULL,
+    entry_id INTEGER NOT NULL,
+    created_at DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (goal_entity_id, entry_id),
+    FOREIGN KEY (entry_id) REFERENCES entries(id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_goal_entries_entry ON goal_entries(entry_id);
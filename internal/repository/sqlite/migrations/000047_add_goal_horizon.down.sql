@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS idx_goals_parent_entity_id;
+ALTER TABLE goals DROP COLUMN parent_entity_id;
+ALTER TABLE goals DROP COLUMN horizon;
@@ -0,0 +1,8 @@
+-- Goals can span a year or a quarter as well as a month. month holds the
+-- first month of the goal's period; parent_entity_id is the longer-horizon
+-- goal a goal cascades from.
+
+ALTER TABLE goals ADD COLUMN horizon TEXT NOT NULL DEFAULT 'month' CHECK (horizon IN ('year', 'quarter', 'month'));
+ALTER TABLE goals ADD COLUMN parent_entity_id TEXT;
+
+CREATE INDEX idx_goals_parent_entity_id ON goals(parent_entity_id);
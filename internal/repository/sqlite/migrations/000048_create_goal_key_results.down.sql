@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_goal_key_result_checkins_key_result;
+DROP TABLE IF EXISTS goal_key_result_checkins;
+DROP INDEX IF EXISTS idx_goal_key_results_goal;
+DROP TABLE IF EXISTS goal_key_results;
@@ -0,0 +1,26 @@
+-- Key results make a goal measurable: each moves from a start value towards
+-- a target, and every check-in of its current value is kept as history.
+
+CREATE TABLE goal_key_results (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id TEXT NOT NULL UNIQUE,
+    goal_entity_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    start_value REAL NOT NULL DEFAULT 0,
+    target_value REAL NOT NULL,
+    current_value REAL NOT NULL DEFAULT 0,
+    unit TEXT NOT NULL DEFAULT '',
+    created_at TEXT NOT NULL
+);
+
+CREATE INDEX idx_goal_key_results_goal ON goal_key_results(goal_entity_id);
+
+CREATE TABLE goal_key_result_checkins (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    key_result_entity_id TEXT NOT NULL,
+    value REAL NOT NULL,
+    checked_at TEXT NOT NULL,
+    FOREIGN KEY (key_result_entity_id) REFERENCES goal_key_results(entity_id) ON DELETE CASCADE
+);
+
+CREATE INDEX idx_goal_key_result_checkins_key_result ON goal_key_result_checkins(key_result_entity_id);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_list_items_parent_entity_id;
+ALTER TABLE list_items DROP COLUMN priority;
+ALTER TABLE list_items DROP COLUMN sort_order;
+ALTER TABLE list_items DROP COLUMN parent_entity_id;
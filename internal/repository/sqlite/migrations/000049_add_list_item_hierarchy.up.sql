@@ -0,0 +1,11 @@
+-- List items can be nested under another item of the same list, ordered
+-- explicitly among their siblings, and prioritised. Existing items keep the
+-- order they were shown in.
+
+ALTER TABLE list_items ADD COLUMN parent_entity_id TEXT;
+ALTER TABLE list_items ADD COLUMN sort_order INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE list_items ADD COLUMN priority TEXT NOT NULL DEFAULT 'none' CHECK (priority IN ('none', 'low', 'medium', 'high'));
+
+UPDATE list_items SET sort_order = row_id;
+
+CREATE INDEX idx_list_items_parent_entity_id ON list_items(parent_entity_id) WHERE valid_to IS NULL;
@@ -0,0 +1,10 @@
+-- List templates are reusable checklists. Their items are kept as text in
+-- the journal's entry syntax, so they can become a list or journal entries.
+
+CREATE TABLE list_templates (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    name TEXT NOT NULL UNIQUE COLLATE NOCASE,
+    content TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    updated_at TEXT NOT NULL
+);
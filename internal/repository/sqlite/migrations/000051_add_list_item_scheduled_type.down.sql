@@ -0,0 +1,52 @@
+-- Remove 'scheduled' as a valid list item type
+-- First convert any scheduled items back to task
+
+PRAGMA foreign_keys=off;
+
+UPDATE list_items SET type = 'task' WHERE type = 'scheduled';
+
+CREATE TABLE list_items_new (
+    row_id INTEGER PRIMARY KEY AUTOINCREMENT,
+    entity_id TEXT NOT NULL,
+    version INTEGER NOT NULL DEFAULT 1,
+    valid_from TEXT NOT NULL,
+    valid_to TEXT,
+    op_type TEXT NOT NULL DEFAULT 'INSERT' CHECK (op_type IN ('INSERT', 'UPDATE', 'DELETE')),
+    list_entity_id TEXT NOT NULL,
+    list_id INTEGER,
+    type TEXT NOT NULL CHECK (type IN ('task', 'done', 'cancelled')),
+    content TEXT NOT NULL,
+    created_at TEXT NOT NULL,
+    parent_entity_id TEXT,
+    sort_order INTEGER NOT NULL DEFAULT 0,
+    priority TEXT NOT NULL DEFAULT 'none' CHECK (priority IN ('none', 'low', 'medium', 'high'))
+);
+
+INSERT INTO list_items_new SELECT * FROM list_items;
+
+DROP TABLE list_items;
+
+ALTER TABLE list_items_new RENAME TO list_items;
+
+CREATE INDEX idx_list_items_current ON list_items(entity_id) WHERE valid_to IS NULL;
+CREATE INDEX idx_list_items_list ON list_items(list_entity_id) WHERE valid_to IS NULL;
+CREATE INDEX idx_list_items_list_id ON list_items(list_id) WHERE valid_to IS NULL;
+CREATE INDEX idx_list_items_parent_entity_id ON list_items(parent_entity_id) WHERE valid_to IS NULL;
+
+-- Dropping the table dropped the search index triggers too.
+CREATE TRIGGER list_items_fts_insert AFTER INSERT ON list_items BEGIN
+    INSERT INTO list_items_fts(rowid, content) VALUES (new.row_id, new.content);
+END;
+
+CREATE TRIGGER list_items_fts_delete AFTER DELETE ON list_items BEGIN
+    INSERT INTO list_items_fts(list_items_fts, rowid, content) VALUES ('delete', old.row_id, old.content);
+END;
+
+CREATE TRIGGER list_items_fts_update AFTER UPDATE OF content ON list_items BEGIN
+    INSERT INTO list_items_fts(list_items_fts, rowid, content) VALUES ('delete', old.row_id, old.content);
+    INSERT INTO list_items_fts(rowid, content) VALUES (new.row_id, new.content);
+END;
+
+INSERT INTO list_items_fts(list_items_fts) VALUES ('rebuild');
+
+PRAGMA foreign_keys=on;
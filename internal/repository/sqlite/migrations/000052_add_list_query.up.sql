@@ -0,0 +1,4 @@
+-- A smart list has a saved search query instead of items of its own; its
+-- items are the journal entries that match the query when it is read.
+
+ALTER TABLE lists ADD COLUMN query TEXT NOT NULL DEFAULT '';
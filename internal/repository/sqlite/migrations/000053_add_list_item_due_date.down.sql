@@ -0,0 +1 @@
+ALTER TABLE list_items DROP COLUMN due_date;
@@ -0,0 +1,4 @@
+-- List items can have a due date, a day written YYYY-MM-DD, so backlog
+-- items with deadlines show up as overdue.
+
+ALTER TABLE list_items ADD COLUMN due_date TEXT;
@@ -0,0 +1,527 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// operationLogTables are the tables whose changes are recorded while an
+// operation is open.
+var operationLogTables = []string{
+	"entries",
+	"entry_tags",
+	"entry_mentions",
+	"day_context",
+	"habits",
+	"habit_logs",
+	"habit_pauses",
+	"lists",
+	"list_items",
+	"goals",
+	"goal_entries",
+	"goal_key_results",
+	"goal_key_result_checkins",
+	"list_templates",
+	"recurrences",
+	"recurrence_exceptions",
+	"recurrence_occurrences",
+}
+
+// maxOperations is how many operations are kept for undo.
+const maxOperations = 200
+
+// dropPersistentOperationTriggers removes the triggers earlier builds
+// created in the database itself, which recorded writes from every
+// connection rather than only the one an operation runs on.
+func dropPersistentOperationTriggers(db *sql.DB) error {
+	for _, table := range operationLogTables {
+		for _, kind := range []string{"insert", "update", "delete"} {
+			if _, err := db.Exec(fmt.Sprintf(`DROP TRIGGER IF EXISTS main.oplog_%s_%s`, table, kind)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// installOperationTriggers creates, once per connection, the TEMP triggers
+// that copy changed rows into operation_changes and the TEMP table naming
+// the operation they are recorded against. Being TEMP, both are private to
+// the connection, so writes made on other connections or by other
+// processes are never attributed to the operation. The triggers are
+// generated from the live schema, so columns added by later migrations are
+// captured without having to rewrite them by hand.
+func installOperationTriggers(ctx context.Context, conn *sql.Conn) error {
+	var installed int
+	err := conn.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM temp.sqlite_master WHERE type = 'table' AND name = 'operation_recording'
+	`).Scan(&installed)
+	if err != nil || installed > 0 {
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, table := range operationLogTables {
+		columns, err := tableColumns(ctx, tx, table)
+		if err != nil {
+			return err
+		}
+
+		for _, kind := range []string{"insert", "update", "delete"} {
+			name := fmt.Sprintf("oplog_%s_%s", table, kind)
+
+			rowID, before, after := "NEW.rowid", "NULL", "NULL"
+			switch kind {
+			case "insert":
+				after = rowJSON("NEW", columns)
+			case "update":
+				before, after = rowJSON("OLD", columns), rowJSON("NEW", columns)
+			case "delete":
+				rowID, before = "OLD.rowid", rowJSON("OLD", columns)
+			}
+
+			_, err := tx.ExecContext(ctx, fmt.Sprintf(`
+				CREATE TEMP TRIGGER %s AFTER %s ON main.%s
+				BEGIN
+					INSERT INTO main.operation_changes (operation_id, table_name, row_id, kind, before, after)
+					SELECT operation_id, '%s', %s, '%s', %s, %s
+					FROM temp.operation_recording;
+				END
+			`, name, strings.ToUpper(kind), table, table, rowID, kind, before, after))
+			if err != nil {
+				return fmt.Errorf("failed to create trigger %s: %w", name, err)
+			}
+		}
+	}
+
+	// Created last, so its presence means the triggers are in place too.
+	if _, err := tx.ExecContext(ctx, `CREATE TEMP TABLE operation_recording (operation_id INTEGER NOT NULL)`); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func tableColumns(ctx context.Context, tx *sql.Tx, table string) ([]string, error) {
+	rows, err := tx.QueryContext(ctx, `SELECT name FROM pragma_table_info(?)`, table)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var columns []string
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		columns = append(columns, name)
+	}
+	if len(columns) == 0 {
+		return nil, fmt.Errorf("table %s has no columns", table)
+	}
+	return columns, rows.Err()
+}
+
+func rowJSON(ref string, columns []string) string {
+	args := make([]string, len(columns))
+	for i, column := range columns {
+		args[i] = fmt.Sprintf("'%s', %s.%s", column, ref, column)
+	}
+	return "json_object(" + strings.Join(args, ", ") + ")"
+}
+
+type OperationLogRepository struct {
+	db *sql.DB
+}
+
+func NewOperationLogRepository(db *sql.DB) *OperationLogRepository {
+	return &OperationLogRepository{db: db}
+}
+
+// Begin opens a new operation on a connection of its own and returns a
+// context pinned to it. Only writes made with that context are recorded.
+// The operation stays marked as recording until End, so it cannot be undone
+// or listed while it is still open.
+func (r *OperationLogRepository) Begin(ctx context.Context, description string) (context.Context, int64, error) {
+	conn, err := r.db.Conn(ctx)
+	if err != nil {
+		return ctx, 0, err
+	}
+
+	id, err := beginOperation(ctx, conn, description)
+	if err != nil {
+		_ = conn.Close()
+		return ctx, 0, err
+	}
+	return context.WithValue(ctx, connContextKey{}, conn), id, nil
+}
+
+func beginOperation(ctx context.Context, conn *sql.Conn, description string) (int64, error) {
+	if err := installOperationTriggers(ctx, conn); err != nil {
+		return 0, err
+	}
+
+	result, err := conn.ExecContext(ctx, `
+		INSERT INTO operations (description, created_at, recording) VALUES (?, ?, 1)
+	`, description, time.Now().Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	if _, err := conn.ExecContext(ctx, `DELETE FROM temp.operation_recording`); err != nil {
+		return 0, err
+	}
+	_, err = conn.ExecContext(ctx, `INSERT INTO temp.operation_recording (operation_id) VALUES (?)`, id)
+	return id, err
+}
+
+// End closes the operation and releases the connection Begin pinned ctx
+// to. Operations that changed nothing are discarded and report false;
+// otherwise the redo history is dropped, as it no longer applies, and the
+// log is trimmed to maxOperations.
+func (r *OperationLogRepository) End(ctx context.Context, id int64) (bool, error) {
+	if conn, ok := ctx.Value(connContextKey{}).(*sql.Conn); ok {
+		defer func() { _ = conn.Close() }()
+		if _, err := conn.ExecContext(ctx, `DELETE FROM temp.operation_recording`); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := dbFor(ctx, r.db).ExecContext(ctx, `UPDATE operations SET recording = 0 WHERE id = ?`, id); err != nil {
+		return false, err
+	}
+
+	var changes int
+	err := dbFor(ctx, r.db).QueryRowContext(ctx, `SELECT COUNT(*) FROM operation_changes WHERE operation_id = ?`, id).Scan(&changes)
+	if err != nil {
+		return false, err
+	}
+
+	if changes == 0 {
+		_, err := dbFor(ctx, r.db).ExecContext(ctx, `DELETE FROM operations WHERE id = ?`, id)
+		return false, err
+	}
+
+	if _, err := dbFor(ctx, r.db).ExecContext(ctx, `DELETE FROM operations WHERE undone_at IS NOT NULL`); err != nil {
+		return true, err
+	}
+	_, err = dbFor(ctx, r.db).ExecContext(ctx, `
+		DELETE FROM operations
+		WHERE recording = 0 AND id NOT IN (SELECT id FROM operations ORDER BY id DESC LIMIT ?)
+	`, maxOperations)
+	return true, err
+}
+
+// Undo reverts the most recent operation that has not been undone.
+func (r *OperationLogRepository) Undo(ctx context.Context) (*domain.Operation, error) {
+	return r.apply(ctx, `
+		SELECT id, description, created_at, undone_at,
+			(SELECT COUNT(*) FROM operation_changes WHERE operation_id = operations.id)
+		FROM operations WHERE undone_at IS NULL AND recording = 0
+		ORDER BY id DESC LIMIT 1
+	`, true)
+}
+
+// Redo replays the most recently undone operation.
+func (r *OperationLogRepository) Redo(ctx context.Context) (*domain.Operation, error) {
+	return r.apply(ctx, `
+		SELECT id, description, created_at, undone_at,
+			(SELECT COUNT(*) FROM operation_changes WHERE operation_id = operations.id)
+		FROM operations WHERE undone_at IS NOT NULL
+		ORDER BY id ASC LIMIT 1
+	`, false)
+}
+
+func (r *OperationLogRepository) GetRecent(ctx context.Context, limit int) ([]domain.Operation, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT id, description, created_at, undone_at,
+			(SELECT COUNT(*) FROM operation_changes WHERE operation_id = operations.id)
+		FROM operations WHERE recording = 0
+		ORDER BY id DESC LIMIT ?
+	`, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var operations []domain.Operation
+	for rows.Next() {
+		op, err := scanOperation(rows)
+		if err != nil {
+			return nil, err
+		}
+		operations = append(operations, *op)
+	}
+	return operations, rows.Err()
+}
+
+type operationChange struct {
+	table  string
+	rowID  int64
+	kind   string
+	before sql.NullString
+	after  sql.NullString
+}
+
+func (r *OperationLogRepository) apply(ctx context.Context, query string, undo bool) (*domain.Operation, error) {
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	op, err := scanOperation(tx.QueryRowContext(ctx, query))
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	changes, err := loadOperationChanges(ctx, tx, op.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Rows are restored one at a time, so parents and children can be
+	// briefly out of step until the whole operation has been applied.
+	if _, err := tx.ExecContext(ctx, `PRAGMA defer_foreign_keys = ON`); err != nil {
+		return nil, err
+	}
+
+	// Each row must still be as the operation left it (or found it, when
+	// redoing). Anything else means it has been changed since, and restoring
+	// the snapshot would silently throw that change away.
+	if undo {
+		for i := len(changes) - 1; i >= 0; i-- {
+			if err := checkRow(ctx, tx, changes[i].table, changes[i].rowID, changes[i].after); err != nil {
+				return nil, err
+			}
+			if err := revertChange(ctx, tx, changes[i]); err != nil {
+				return nil, err
+			}
+		}
+		now := time.Now()
+		op.UndoneAt = &now
+		_, err = tx.ExecContext(ctx, `UPDATE operations SET undone_at = ? WHERE id = ?`, now.Format(time.RFC3339), op.ID)
+	} else {
+		for _, change := range changes {
+			if err := checkRow(ctx, tx, change.table, change.rowID, change.before); err != nil {
+				return nil, err
+			}
+			if err := replayChange(ctx, tx, change); err != nil {
+				return nil, err
+			}
+		}
+		op.UndoneAt = nil
+		_, err = tx.ExecContext(ctx, `UPDATE operations SET undone_at = NULL WHERE id = ?`, op.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func loadOperationChanges(ctx context.Context, tx *sql.Tx, operationID int64) ([]operationChange, error) {
+	rows, err := tx.QueryContext(ctx, `
+		SELECT table_name, row_id, kind, before, after
+		FROM operation_changes WHERE operation_id = ? ORDER BY id
+	`, operationID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var changes []operationChange
+	for rows.Next() {
+		var c operationChange
+		if err := rows.Scan(&c.table, &c.rowID, &c.kind, &c.before, &c.after); err != nil {
+			return nil, err
+		}
+		if !isOperationLogTable(c.table) {
+			return nil, fmt.Errorf("operation log refers to unknown table %s", c.table)
+		}
+		changes = append(changes, c)
+	}
+	return changes, rows.Err()
+}
+
+// checkRow reports an error unless the row matches snapshot, or is absent
+// when there is no snapshot. Only the snapshot's columns are compared, so
+// columns added since it was taken do not count as changes.
+func checkRow(ctx context.Context, tx *sql.Tx, table string, rowID int64, snapshot sql.NullString) error {
+	var want map[string]any
+	var columns []string
+	if snapshot.Valid {
+		names, values, err := decodeRowSnapshot(snapshot.String)
+		if err != nil {
+			return err
+		}
+		want = make(map[string]any, len(names))
+		for i, name := range names {
+			want[name] = values[i]
+		}
+		columns = names
+	} else {
+		columns = []string{"rowid"}
+	}
+
+	var current string
+	query := fmt.Sprintf(`SELECT %s FROM %s AS r WHERE rowid = ?`, rowJSON("r", columns), table)
+	err := tx.QueryRowContext(ctx, query, rowID).Scan(&current)
+	if err == sql.ErrNoRows {
+		if !snapshot.Valid {
+			return nil
+		}
+		return domain.Invalidf("%s row %d has been deleted since", table, rowID)
+	}
+	if err != nil {
+		return err
+	}
+	if !snapshot.Valid {
+		return domain.Invalidf("%s row %d has been recreated since", table, rowID)
+	}
+
+	names, values, err := decodeRowSnapshot(current)
+	if err != nil {
+		return err
+	}
+	for i, name := range names {
+		if !reflect.DeepEqual(want[name], values[i]) {
+			return domain.Invalidf("%s row %d has been changed since", table, rowID)
+		}
+	}
+	return nil
+}
+
+func revertChange(ctx context.Context, tx *sql.Tx, c operationChange) error {
+	switch c.kind {
+	case "insert":
+		return deleteRow(ctx, tx, c.table, c.rowID)
+	case "update":
+		return updateRow(ctx, tx, c.table, c.rowID, c.before.String)
+	default:
+		return insertRow(ctx, tx, c.table, c.rowID, c.before.String)
+	}
+}
+
+func replayChange(ctx context.Context, tx *sql.Tx, c operationChange) error {
+	switch c.kind {
+	case "insert":
+		return insertRow(ctx, tx, c.table, c.rowID, c.after.String)
+	case "update":
+		return updateRow(ctx, tx, c.table, c.rowID, c.after.String)
+	default:
+		return deleteRow(ctx, tx, c.table, c.rowID)
+	}
+}
+
+func deleteRow(ctx context.Context, tx *sql.Tx, table string, rowID int64) error {
+	_, err := tx.ExecContext(ctx, fmt.Sprintf(`DELETE FROM %s WHERE rowid = ?`, table), rowID)
+	return err
+}
+
+func insertRow(ctx context.Context, tx *sql.Tx, table string, rowID int64, snapshot string) error {
+	columns, values, err := decodeRowSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)+1), ", ")
+	query := fmt.Sprintf(`INSERT INTO %s (rowid, %s) VALUES (%s)`, table, strings.Join(columns, ", "), placeholders)
+	_, err = tx.ExecContext(ctx, query, append([]any{rowID}, values...)...)
+	return err
+}
+
+func updateRow(ctx context.Context, tx *sql.Tx, table string, rowID int64, snapshot string) error {
+	columns, values, err := decodeRowSnapshot(snapshot)
+	if err != nil {
+		return err
+	}
+
+	assignments := make([]string, len(columns))
+	for i, column := range columns {
+		assignments[i] = column + " = ?"
+	}
+	query := fmt.Sprintf(`UPDATE %s SET %s WHERE rowid = ?`, table, strings.Join(assignments, ", "))
+	_, err = tx.ExecContext(ctx, query, append(values, rowID)...)
+	return err
+}
+
+func decodeRowSnapshot(snapshot string) ([]string, []any, error) {
+	decoder := json.NewDecoder(strings.NewReader(snapshot))
+	decoder.UseNumber()
+
+	var row map[string]any
+	if err := decoder.Decode(&row); err != nil {
+		return nil, nil, fmt.Errorf("invalid row snapshot: %w", err)
+	}
+
+	columns := make([]string, 0, len(row))
+	for column := range row {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	values := make([]any, len(columns))
+	for i, column := range columns {
+		value := row[column]
+		if n, ok := value.(json.Number); ok {
+			if integer, err := n.Int64(); err == nil {
+				value = integer
+			} else if f, err := n.Float64(); err == nil {
+				value = f
+			}
+		}
+		values[i] = value
+	}
+	return columns, values, nil
+}
+
+func isOperationLogTable(table string) bool {
+	for _, t := range operationLogTables {
+		if t == table {
+			return true
+		}
+	}
+	return false
+}
+
+func scanOperation(row rowScanner) (*domain.Operation, error) {
+	var op domain.Operation
+	var createdAt string
+	var undoneAt sql.NullString
+
+	if err := row.Scan(&op.ID, &op.Description, &createdAt, &undoneAt, &op.Changes); err != nil {
+		return nil, err
+	}
+
+	op.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+	if undoneAt.Valid {
+		t, _ := time.Parse(time.RFC3339, undoneAt.String)
+		op.UndoneAt = &t
+	}
+	return &op, nil
+}
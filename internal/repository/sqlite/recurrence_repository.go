@@ -0,0 +1,287 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type RecurrenceRepository struct {
+	db *sql.DB
+}
+
+func NewRecurrenceRepository(db *sql.DB) *RecurrenceRepository {
+	return &RecurrenceRepository{db: db}
+}
+
+func (r *RecurrenceRepository) Insert(ctx context.Context, recurrence domain.Recurrence) (int64, error) {
+	entityID := recurrence.EntityID
+	if entityID.IsEmpty() {
+		entityID = domain.NewEntityID()
+	}
+
+	priority := recurrence.Priority
+	if priority == "" {
+		priority = domain.PriorityNone
+	}
+
+	var endDate *string
+	if recurrence.EndDate != nil {
+		s := recurrence.EndDate.Format("2006-01-02")
+		endDate = &s
+	}
+
+	createdAt := recurrence.CreatedAt
+	if createdAt.IsZero() {
+		createdAt = time.Now()
+	}
+
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO recurrences (entity_id, type, content, priority, rule, start_date, end_date, created_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+	`, entityID.String(), string(recurrence.EntryType), recurrence.Content, string(priority), recurrence.Rule.String(),
+		recurrence.StartDate.Format("2006-01-02"), endDate, createdAt.Format(time.RFC3339))
+	if err != nil {
+		return 0, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	for _, ex := range recurrence.Exceptions {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO recurrence_exceptions (recurrence_id, date) VALUES (?, ?)
+		`, id, ex.Format("2006-01-02")); err != nil {
+			return 0, err
+		}
+	}
+
+	return id, tx.Commit()
+}
+
+func (r *RecurrenceRepository) GetByID(ctx context.Context, id int64) (*domain.Recurrence, error) {
+	row := dbFor(ctx, r.db).QueryRowContext(ctx, `
+		SELECT id, entity_id, type, content, priority, rule, start_date, end_date, created_at
+		FROM recurrences WHERE id = ?
+	`, id)
+
+	recurrence, err := scanRecurrence(row)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.loadExceptions(ctx, recurrence); err != nil {
+		return nil, err
+	}
+	return recurrence, nil
+}
+
+func (r *RecurrenceRepository) GetAll(ctx context.Context) ([]domain.Recurrence, error) {
+	return r.query(ctx, `
+		SELECT id, entity_id, type, content, priority, rule, start_date, end_date, created_at
+		FROM recurrences ORDER BY id
+	`)
+}
+
+// GetActive returns recurrences whose date span overlaps [from, to].
+func (r *RecurrenceRepository) GetActive(ctx context.Context, from, to time.Time) ([]domain.Recurrence, error) {
+	return r.query(ctx, `
+		SELECT id, entity_id, type, content, priority, rule, start_date, end_date, created_at
+		FROM recurrences
+		WHERE start_date <= ? AND (end_date IS NULL OR end_date >= ?)
+		ORDER BY id
+	`, to.Format("2006-01-02"), from.Format("2006-01-02"))
+}
+
+func (r *RecurrenceRepository) Delete(ctx context.Context, id int64) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM recurrences WHERE id = ?", id)
+	return err
+}
+
+func (r *RecurrenceRepository) AddException(ctx context.Context, id int64, date time.Time) error {
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT OR IGNORE INTO recurrence_exceptions (recurrence_id, date) VALUES (?, ?)
+	`, id, date.Format("2006-01-02"))
+	return err
+}
+
+func (r *RecurrenceRepository) GetMaterializedDates(ctx context.Context, id int64, from, to time.Time) ([]time.Time, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT date FROM recurrence_occurrences
+		WHERE recurrence_id = ? AND date >= ? AND date <= ?
+		ORDER BY date
+	`, id, from.Format("2006-01-02"), to.Format("2006-01-02"))
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var dates []time.Time
+	for rows.Next() {
+		var dateStr string
+		if err := rows.Scan(&dateStr); err != nil {
+			return nil, err
+		}
+		d, _ := time.Parse("2006-01-02", dateStr)
+		dates = append(dates, d)
+	}
+
+	return dates, rows.Err()
+}
+
+func (r *RecurrenceRepository) MarkMaterialized(ctx context.Context, id int64, date time.Time, entryID int64) error {
+	var entry *int64
+	if entryID > 0 {
+		entry = &entryID
+	}
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, `
+		INSERT OR IGNORE INTO recurrence_occurrences (recurrence_id, date, entry_id) VALUES (?, ?, ?)
+	`, id, date.Format("2006-01-02"), entry)
+	return err
+}
+
+// Materialize adds an entry to the journal for each occurrence that has not
+// been materialized yet, all in one transaction. An occurrence is claimed
+// before its entry is inserted, so concurrent readers cannot both create it.
+func (r *RecurrenceRepository) Materialize(ctx context.Context, occurrences []domain.RecurrenceOccurrence) error {
+	tx, err := beginTx(ctx, r.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, occurrence := range occurrences {
+		dateStr := occurrence.Date.Format("2006-01-02")
+		result, err := tx.ExecContext(ctx, `
+			INSERT OR IGNORE INTO recurrence_occurrences (recurrence_id, date) VALUES (?, ?)
+		`, occurrence.Recurrence.ID, dateStr)
+		if err != nil {
+			return err
+		}
+		if claimed, err := result.RowsAffected(); err != nil {
+			return err
+		} else if claimed == 0 {
+			continue
+		}
+
+		entry := occurrence.Recurrence.ToEntry(occurrence.Date)
+		entryID, err := insertEntry(ctx, tx, entry)
+		if err != nil {
+			return err
+		}
+		for _, tag := range entry.Tags {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO entry_tags (entry_id, tag) VALUES (?, ?)`, entryID, tag); err != nil {
+				return err
+			}
+		}
+		for _, mention := range entry.Mentions {
+			if _, err := tx.ExecContext(ctx, `INSERT OR IGNORE INTO entry_mentions (entry_id, mention) VALUES (?, ?)`, entryID, mention); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE recurrence_occurrences SET entry_id = ? WHERE recurrence_id = ? AND date = ?
+		`, entryID, occurrence.Recurrence.ID, dateStr)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *RecurrenceRepository) query(ctx context.Context, query string, args ...any) ([]domain.Recurrence, error) {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	var recurrences []domain.Recurrence
+	for rows.Next() {
+		recurrence, err := scanRecurrence(rows)
+		if err != nil {
+			_ = rows.Close()
+			return nil, err
+		}
+		recurrences = append(recurrences, *recurrence)
+	}
+	if err := rows.Err(); err != nil {
+		_ = rows.Close()
+		return nil, err
+	}
+	_ = rows.Close()
+
+	for i := range recurrences {
+		if err := r.loadExceptions(ctx, &recurrences[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return recurrences, nil
+}
+
+func (r *RecurrenceRepository) loadExceptions(ctx context.Context, recurrence *domain.Recurrence) error {
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, `
+		SELECT date FROM recurrence_exceptions WHERE recurrence_id = ? ORDER BY date
+	`, recurrence.ID)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = rows.Close() }()
+
+	for rows.Next() {
+		var dateStr string
+		if err := rows.Scan(&dateStr); err != nil {
+			return err
+		}
+		d, _ := time.Parse("2006-01-02", dateStr)
+		recurrence.Exceptions = append(recurrence.Exceptions, d)
+	}
+
+	return rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRecurrence(row rowScanner) (*domain.Recurrence, error) {
+	var recurrence domain.Recurrence
+	var entityID, entryType, priority, rule, startDate, createdAt string
+	var endDate sql.NullString
+
+	err := row.Scan(&recurrence.ID, &entityID, &entryType, &recurrence.Content, &priority, &rule, &startDate, &endDate, &createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	recurrence.EntityID = domain.EntityID(entityID)
+	recurrence.EntryType = domain.EntryType(entryType)
+	recurrence.Priority = domain.Priority(priority)
+	recurrence.Rule, err = domain.ParseRecurrenceRule(rule)
+	if err != nil {
+		return nil, err
+	}
+	recurrence.StartDate, _ = time.Parse("2006-01-02", startDate)
+	if endDate.Valid {
+		d, _ := time.Parse("2006-01-02", endDate.String)
+		recurrence.EndDate = &d
+	}
+	recurrence.CreatedAt, _ = time.Parse(time.RFC3339, createdAt)
+
+	return &recurrence, nil
+}
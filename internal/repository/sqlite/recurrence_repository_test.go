@@ -0,0 +1,176 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func newTestRecurrence(t *testing.T, every string, start time.Time) domain.Recurrence {
+	t.Helper()
+	rule, err := domain.ParseEvery(every)
+	require.NoError(t, err)
+	return domain.NewRecurrence(domain.EntryTypeTask, "Water plants", rule, start)
+}
+
+func TestRecurrenceRepository_InsertAndGetByID(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	start := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	recurrence := newTestRecurrence(t, "mon,wed", start)
+	recurrence.EndDate = &end
+	recurrence.Priority = domain.PriorityHigh
+	recurrence.Exceptions = []time.Time{time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)}
+
+	id, err := repo.Insert(ctx, recurrence)
+	require.NoError(t, err)
+
+	got, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	require.NotNil(t, got)
+
+	assert.Equal(t, recurrence.EntityID, got.EntityID)
+	assert.Equal(t, domain.EntryTypeTask, got.EntryType)
+	assert.Equal(t, "Water plants", got.Content)
+	assert.Equal(t, domain.PriorityHigh, got.Priority)
+	assert.Equal(t, "FREQ=WEEKLY;BYDAY=MO,WE", got.Rule.String())
+	assert.Equal(t, "2026-01-05", got.StartDate.Format("2006-01-02"))
+	require.NotNil(t, got.EndDate)
+	assert.Equal(t, "2026-03-01", got.EndDate.Format("2006-01-02"))
+	require.Len(t, got.Exceptions, 1)
+	assert.Equal(t, "2026-01-07", got.Exceptions[0].Format("2006-01-02"))
+}
+
+func TestRecurrenceRepository_GetByID_NotFound(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+
+	got, err := repo.GetByID(context.Background(), 999)
+
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
+
+func TestRecurrenceRepository_GetActive(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	ended := newTestRecurrence(t, "daily", time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	endDate := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	ended.EndDate = &endDate
+	_, err := repo.Insert(ctx, ended)
+	require.NoError(t, err)
+
+	future := newTestRecurrence(t, "daily", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+	_, err = repo.Insert(ctx, future)
+	require.NoError(t, err)
+
+	current := newTestRecurrence(t, "daily", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	currentID, err := repo.Insert(ctx, current)
+	require.NoError(t, err)
+
+	active, err := repo.GetActive(ctx, time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 2, 7, 0, 0, 0, 0, time.UTC))
+
+	require.NoError(t, err)
+	require.Len(t, active, 1)
+	assert.Equal(t, currentID, active[0].ID)
+}
+
+func TestRecurrenceRepository_AddException(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, newTestRecurrence(t, "daily", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+
+	skip := time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, repo.AddException(ctx, id, skip))
+	require.NoError(t, repo.AddException(ctx, id, skip))
+
+	got, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.Len(t, got.Exceptions, 1)
+	assert.False(t, got.OccursOn(skip))
+}
+
+func TestRecurrenceRepository_MarkMaterialized(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	entryRepo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, newTestRecurrence(t, "daily", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+
+	day := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	entryID, err := entryRepo.Insert(ctx, domain.NewEntry(domain.EntryTypeTask, "Water plants", &day))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.MarkMaterialized(ctx, id, day, entryID))
+
+	dates, err := repo.GetMaterializedDates(ctx, id, time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 31, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Len(t, dates, 1)
+	assert.Equal(t, "2026-01-02", dates[0].Format("2006-01-02"))
+
+	// Deleting the generated entry must not resurrect the occurrence.
+	require.NoError(t, entryRepo.Delete(ctx, entryID))
+	dates, err = repo.GetMaterializedDates(ctx, id, day, day)
+	require.NoError(t, err)
+	assert.Len(t, dates, 1)
+}
+
+func TestRecurrenceRepository_Materialize(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	entryRepo := NewEntryRepository(db)
+	ctx := context.Background()
+
+	recurrence := newTestRecurrence(t, "daily", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	id, err := repo.Insert(ctx, recurrence)
+	require.NoError(t, err)
+	recurrence.ID = id
+
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+	occurrences := []domain.RecurrenceOccurrence{
+		{Recurrence: recurrence, Date: from},
+		{Recurrence: recurrence, Date: to},
+	}
+	require.NoError(t, repo.Materialize(ctx, occurrences))
+	// A reader that saw the same dates as unmaterialized must not add them again.
+	require.NoError(t, repo.Materialize(ctx, occurrences))
+
+	entries, err := entryRepo.GetByDateRange(ctx, from, to)
+	require.NoError(t, err)
+	assert.Len(t, entries, 2)
+
+	dates, err := repo.GetMaterializedDates(ctx, id, from, to)
+	require.NoError(t, err)
+	assert.Len(t, dates, 2)
+}
+
+func TestRecurrenceRepository_Delete(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewRecurrenceRepository(db)
+	ctx := context.Background()
+
+	id, err := repo.Insert(ctx, newTestRecurrence(t, "daily", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)))
+	require.NoError(t, err)
+	require.NoError(t, repo.AddException(ctx, id, time.Date(2026, 1, 3, 0, 0, 0, 0, time.UTC)))
+
+	require.NoError(t, repo.Delete(ctx, id))
+
+	got, err := repo.GetByID(ctx, id)
+	require.NoError(t, err)
+	assert.Nil(t, got)
+}
@@ -0,0 +1,314 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+const snippetTokens = 12
+
+type SearchRepository struct {
+	db *sql.DB
+}
+
+func NewSearchRepository(db *sql.DB) *SearchRepository {
+	return &SearchRepository{db: db}
+}
+
+// Search runs a ranked full-text search across entries, list items and goals.
+// List items and goals have no tags, mentions or schedule, so they are only
+// searched when the query does not filter on those. Goals have no priority
+// or status either.
+func (r *SearchRepository) Search(ctx context.Context, opts domain.SearchOptions) ([]domain.SearchResult, error) {
+	q, err := opts.ParseQuery()
+	if err != nil {
+		return nil, err
+	}
+	if q.IsEmpty() {
+		return []domain.SearchResult{}, nil
+	}
+
+	limit := opts.Limit
+	if limit <= 0 {
+		limit = 50
+	}
+
+	results, err := r.searchEntries(ctx, q, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if q.HasText() && len(q.Tags) == 0 && len(q.Mentions) == 0 {
+		if q.Type == nil || q.Type.IsValid() && isListItemType(*q.Type) {
+			items, err := r.searchListItems(ctx, q, limit)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, items...)
+		}
+
+		if q.Type == nil && q.Priority == nil && q.Status == "" {
+			goals, err := r.searchGoals(ctx, q, limit)
+			if err != nil {
+				return nil, err
+			}
+			results = append(results, goals...)
+		}
+	}
+
+	if q.HasText() {
+		sort.SliceStable(results, func(i, j int) bool {
+			return results[i].Rank < results[j].Rank
+		})
+	}
+	if len(results) > limit {
+		results = results[:limit]
+	}
+
+	return results, nil
+}
+
+func (r *SearchRepository) searchEntries(ctx context.Context, q domain.SearchQuery, limit int) ([]domain.SearchResult, error) {
+	columns := entryColumns("e")
+	if q.HasText() {
+		columns += `, snippet(entries_fts, 0, ?, ?, '…', ` + strconv.Itoa(snippetTokens) + `), bm25(entries_fts)`
+	} else {
+		columns += `, e.content, 0`
+	}
+
+	from, where, args := entrySearchClauses(q)
+	if q.HasText() {
+		args = append([]any{domain.SnippetMarkStart, domain.SnippetMarkEnd}, args...)
+	}
+
+	query := `SELECT ` + columns + ` FROM entries e` + from + where + entrySearchOrder(q) + ` LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var results []domain.SearchResult
+	for rows.Next() {
+		var snippet string
+		var rank float64
+		entry, err := scanEntryRow(rows, &snippet, &rank)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, domain.SearchResult{
+			Source:  domain.SearchSourceEntry,
+			ID:      entry.ID,
+			Content: entry.Content,
+			Snippet: snippet,
+			Rank:    rank,
+			Date:    entry.ScheduledDate,
+			Entry:   &entry,
+		})
+	}
+
+	return results, rows.Err()
+}
+
+func (r *SearchRepository) searchListItems(ctx context.Context, q domain.SearchQuery, limit int) ([]domain.SearchResult, error) {
+	query := `
+		SELECT li.row_id, li.content, li.created_at, COALESCE(l.name, ''),
+			snippet(list_items_fts, 0, ?, ?, '…', ` + strconv.Itoa(snippetTokens) + `), bm25(list_items_fts)
+		FROM list_items li
+		JOIN list_items_fts ON list_items_fts.rowid = li.row_id
+		LEFT JOIN lists l ON l.entity_id = li.list_entity_id AND (l.valid_to IS NULL OR l.valid_to = '') AND l.op_type != 'DELETE'
+		WHERE list_items_fts MATCH ?
+		AND (li.valid_to IS NULL OR li.valid_to = '') AND li.op_type != 'DELETE'`
+	args := []any{domain.SnippetMarkStart, domain.SnippetMarkEnd, q.MatchExpression()}
+
+	if len(q.Exclude) > 0 {
+		query += ` AND li.row_id NOT IN (SELECT rowid FROM list_items_fts WHERE list_items_fts MATCH ?)`
+		args = append(args, q.ExcludeExpression())
+	}
+	if q.Type != nil {
+		query += ` AND li.type = ?`
+		args = append(args, string(*q.Type))
+	}
+	if q.Priority != nil {
+		query += ` AND li.priority = ?`
+		args = append(args, string(*q.Priority))
+	}
+	if q.Status != "" {
+		types := q.Status.ListItemTypes()
+		query += ` AND li.type IN (` + placeholders(len(types)) + `)`
+		for _, t := range types {
+			args = append(args, string(t))
+		}
+	}
+	if q.From != nil {
+		query += ` AND substr(li.created_at, 1, 10) >= ?`
+		args = append(args, q.From.Format("2006-01-02"))
+	}
+	if q.To != nil {
+		query += ` AND substr(li.created_at, 1, 10) <= ?`
+		args = append(args, q.To.Format("2006-01-02"))
+	}
+	query += ` ORDER BY bm25(list_items_fts) LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var results []domain.SearchResult
+	for rows.Next() {
+		var result domain.SearchResult
+		var createdAt string
+		if err := rows.Scan(&result.ID, &result.Content, &createdAt, &result.Context, &result.Snippet, &result.Rank); err != nil {
+			return nil, err
+		}
+		result.Source = domain.SearchSourceListItem
+		if t, err := time.Parse(time.RFC3339, createdAt); err == nil {
+			result.Date = &t
+		}
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+func (r *SearchRepository) searchGoals(ctx context.Context, q domain.SearchQuery, limit int) ([]domain.SearchResult, error) {
+	query := `
+		SELECT g.id, g.content, g.month,
+			snippet(goals_fts, 0, ?, ?, '…', ` + strconv.Itoa(snippetTokens) + `), bm25(goals_fts)
+		FROM goals g
+		JOIN goals_fts ON goals_fts.rowid = g.id
+		WHERE goals_fts MATCH ?
+		AND (g.valid_to IS NULL OR g.valid_to = '') AND g.op_type != 'DELETE'`
+	args := []any{domain.SnippetMarkStart, domain.SnippetMarkEnd, q.MatchExpression()}
+
+	if len(q.Exclude) > 0 {
+		query += ` AND g.id NOT IN (SELECT rowid FROM goals_fts WHERE goals_fts MATCH ?)`
+		args = append(args, q.ExcludeExpression())
+	}
+	if q.From != nil {
+		query += ` AND g.month >= ?`
+		args = append(args, q.From.Format("2006-01"))
+	}
+	if q.To != nil {
+		query += ` AND g.month <= ?`
+		args = append(args, q.To.Format("2006-01"))
+	}
+	query += ` ORDER BY bm25(goals_fts) LIMIT ?`
+	args = append(args, limit)
+
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = rows.Close() }()
+
+	var results []domain.SearchResult
+	for rows.Next() {
+		var result domain.SearchResult
+		var month string
+		if err := rows.Scan(&result.ID, &result.Content, &month, &result.Snippet, &result.Rank); err != nil {
+			return nil, err
+		}
+		result.Source = domain.SearchSourceGoal
+		result.Context = month
+		if t, err := time.Parse("2006-01", month); err == nil {
+			result.Date = &t
+		}
+		results = append(results, result)
+	}
+
+	return results, rows.Err()
+}
+
+// entrySearchClauses builds the FROM joins and WHERE clause shared by entry
+// searches. The FTS table is joined only when the query has positive terms.
+func entrySearchClauses(q domain.SearchQuery) (string, string, []any) {
+	var from string
+	var conditions []string
+	var args []any
+
+	if q.HasText() {
+		from = ` JOIN entries_fts ON entries_fts.rowid = e.id`
+		conditions = append(conditions, `entries_fts MATCH ?`)
+		args = append(args, q.MatchExpression())
+	}
+
+	if len(q.Exclude) > 0 {
+		conditions = append(conditions, `e.id NOT IN (SELECT rowid FROM entries_fts WHERE entries_fts MATCH ?)`)
+		args = append(args, q.ExcludeExpression())
+	}
+
+	if len(q.Tags) > 0 {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM entry_tags et WHERE et.entry_id = e.id AND et.tag IN (`+placeholders(len(q.Tags))+`))`)
+		for _, tag := range q.Tags {
+			args = append(args, tag)
+		}
+	}
+
+	if len(q.Mentions) > 0 {
+		conditions = append(conditions, `EXISTS (SELECT 1 FROM entry_mentions em WHERE em.entry_id = e.id AND em.mention IN (`+placeholders(len(q.Mentions))+`))`)
+		for _, mention := range q.Mentions {
+			args = append(args, mention)
+		}
+	}
+
+	if q.Type != nil {
+		conditions = append(conditions, `e.type = ?`)
+		args = append(args, string(*q.Type))
+	}
+
+	if q.Priority != nil {
+		conditions = append(conditions, `e.priority = ?`)
+		args = append(args, string(*q.Priority))
+	}
+
+	if q.Status != "" {
+		types := q.Status.EntryTypes()
+		conditions = append(conditions, `e.type IN (`+placeholders(len(types))+`)`)
+		for _, t := range types {
+			args = append(args, string(t))
+		}
+	}
+
+	if q.From != nil {
+		conditions = append(conditions, `e.scheduled_date >= ?`)
+		args = append(args, q.From.Format("2006-01-02"))
+	}
+
+	if q.To != nil {
+		conditions = append(conditions, `e.scheduled_date <= ?`)
+		args = append(args, q.To.Format("2006-01-02"))
+	}
+
+	where := ""
+	if len(conditions) > 0 {
+		where = ` WHERE ` + strings.Join(conditions, ` AND `)
+	}
+	return from, where, args
+}
+
+func entrySearchOrder(q domain.SearchQuery) string {
+	if q.HasText() {
+		return ` ORDER BY bm25(entries_fts), e.scheduled_date DESC, e.id DESC`
+	}
+	return ` ORDER BY e.scheduled_date DESC, e.created_at DESC, e.id DESC`
+}
+
+func isListItemType(t domain.EntryType) bool {
+	return t == domain.EntryTypeTask || t == domain.EntryTypeDone || t == domain.EntryTypeCancelled
+}
+
+func placeholders(n int) string {
+	return strings.TrimSuffix(strings.Repeat("?,", n), ",")
+}
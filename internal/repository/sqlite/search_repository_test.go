@@ -0,0 +1,178 @@
+package sqlite
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func searchContents(results []domain.SearchResult) []string {
+	contents := make([]string, len(results))
+	for i, r := range results {
+		contents[i] = r.Content
+	}
+	return contents
+}
+
+func TestSearchRepository_RanksByRelevance(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewSearchRepository(db)
+	ctx := context.Background()
+
+	for _, content := range []string{
+		"Call the plumber about the kitchen sink and the bathroom tap",
+		"Plumber plumber plumber",
+		"Buy milk",
+	} {
+		_, err := entryRepo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: content, CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions("plumber"))
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "Plumber plumber plumber", results[0].Content)
+	assert.LessOrEqual(t, results[0].Rank, results[1].Rank)
+	assert.Equal(t, domain.SearchSourceEntry, results[0].Source)
+	require.NotNil(t, results[0].Entry)
+	assert.Contains(t, results[1].Snippet, domain.SnippetMarkStart+"plumber"+domain.SnippetMarkEnd)
+}
+
+func TestSearchRepository_PhraseAndExclude(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewSearchRepository(db)
+	ctx := context.Background()
+
+	for _, content := range []string{
+		"Weekly team meeting",
+		"Team weekly meeting",
+		"Weekly team meeting standup",
+	} {
+		_, err := entryRepo.Insert(ctx, domain.Entry{Type: domain.EntryTypeEvent, Content: content, CreatedAt: time.Now()})
+		require.NoError(t, err)
+	}
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions(`"weekly team" -standup`))
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Weekly team meeting"}, searchContents(results))
+}
+
+func TestSearchRepository_TagTypeAndDateFilters(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	tagRepo := NewTagRepository(db)
+	repo := NewSearchRepository(db)
+	ctx := context.Background()
+
+	jan5 := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+	jan10 := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	insert := func(entryType domain.EntryType, content string, date time.Time, tags ...string) {
+		id, err := entryRepo.Insert(ctx, domain.Entry{Type: entryType, Content: content, ScheduledDate: &date, CreatedAt: time.Now()})
+		require.NoError(t, err)
+		if len(tags) > 0 {
+			require.NoError(t, tagRepo.InsertEntryTags(ctx, id, tags))
+		}
+	}
+	insert(domain.EntryTypeTask, "Write report #work", jan5, "work")
+	insert(domain.EntryTypeNote, "Report feedback #work", jan10, "work")
+	insert(domain.EntryTypeTask, "Report on holiday", jan10)
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions("report tag:work type:task"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Write report #work"}, searchContents(results))
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("report after:2026-01-05"))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"Report feedback #work", "Report on holiday"}, searchContents(results))
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("#work before:2026-01-10"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Write report #work"}, searchContents(results))
+}
+
+func TestSearchRepository_IncludesListItemsAndGoals(t *testing.T) {
+	db := setupTestDB(t)
+	listRepo := NewListRepository(db)
+	itemRepo := NewListItemRepository(db)
+	goalRepo := NewGoalRepository(db)
+	repo := NewSearchRepository(db)
+	ctx := context.Background()
+
+	list, err := listRepo.Create(ctx, "Shopping")
+	require.NoError(t, err)
+	_, err = itemRepo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Marathon shoes"))
+	require.NoError(t, err)
+
+	_, err = goalRepo.Insert(ctx, domain.Goal{
+		EntityID:  domain.NewEntityID(),
+		Content:   "Run a marathon",
+		Month:     time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC),
+		Status:    domain.GoalStatusActive,
+		CreatedAt: time.Now(),
+	})
+	require.NoError(t, err)
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions("marathon"))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	sources := map[domain.SearchSource]domain.SearchResult{}
+	for _, r := range results {
+		sources[r.Source] = r
+	}
+	assert.Equal(t, "Shopping", sources[domain.SearchSourceListItem].Context)
+	assert.Equal(t, "2026-04", sources[domain.SearchSourceGoal].Context)
+	assert.Nil(t, sources[domain.SearchSourceGoal].Entry)
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("marathon type:note"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchRepository_IndexFollowsUpdatesAndDeletes(t *testing.T) {
+	db := setupTestDB(t)
+	entryRepo := NewEntryRepository(db)
+	repo := NewSearchRepository(db)
+	ctx := context.Background()
+
+	id, err := entryRepo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Call dentist", CreatedAt: time.Now()})
+	require.NoError(t, err)
+
+	entry, err := entryRepo.GetByID(ctx, id)
+	require.NoError(t, err)
+	entry.Content = "Call optician"
+	require.NoError(t, entryRepo.Update(ctx, *entry))
+
+	results, err := repo.Search(ctx, domain.NewSearchOptions("dentist"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("optician"))
+	require.NoError(t, err)
+	assert.Len(t, results, 1)
+
+	require.NoError(t, entryRepo.Delete(ctx, id))
+
+	results, err = repo.Search(ctx, domain.NewSearchOptions("optician"))
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
+
+func TestSearchRepository_EmptyQuery(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewSearchRepository(db)
+
+	results, err := repo.Search(context.Background(), domain.NewSearchOptions("   "))
+
+	require.NoError(t, err)
+	assert.Empty(t, results)
+}
@@ -20,7 +20,7 @@ func (r *TagRepository) InsertEntryTags(ctx context.Context, entryID int64, tags
 		return nil
 	}
 
-	tx, err := r.db.BeginTx(ctx, nil)
+	tx, err := beginTx(ctx, r.db)
 	if err != nil {
 		return fmt.Errorf("begin tx: %w", err)
 	}
@@ -59,7 +59,7 @@ func (r *TagRepository) GetTagsForEntries(ctx context.Context, entryIDs []int64)
 		strings.Join(placeholders, ","),
 	)
 
-	rows, err := r.db.QueryContext(ctx, query, args...)
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("query tags: %w", err)
 	}
@@ -78,7 +78,7 @@ func (r *TagRepository) GetTagsForEntries(ctx context.Context, entryIDs []int64)
 }
 
 func (r *TagRepository) GetAllTags(ctx context.Context) ([]string, error) {
-	rows, err := r.db.QueryContext(ctx, "SELECT DISTINCT tag FROM entry_tags ORDER BY tag")
+	rows, err := dbFor(ctx, r.db).QueryContext(ctx, "SELECT DISTINCT tag FROM entry_tags ORDER BY tag")
 	if err != nil {
 		return nil, fmt.Errorf("query all tags: %w", err)
 	}
@@ -97,7 +97,7 @@ func (r *TagRepository) GetAllTags(ctx context.Context) ([]string, error) {
 }
 
 func (r *TagRepository) DeleteByEntryID(ctx context.Context, entryID int64) error {
-	_, err := r.db.ExecContext(ctx, "DELETE FROM entry_tags WHERE entry_id = ?", entryID)
+	_, err := dbFor(ctx, r.db).ExecContext(ctx, "DELETE FROM entry_tags WHERE entry_id = ?", entryID)
 	if err != nil {
 		return fmt.Errorf("delete tags for entry %d: %w", entryID, err)
 	}
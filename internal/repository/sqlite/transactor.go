@@ -0,0 +1,54 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Transactor struct {
+	db *sql.DB
+}
+
+func NewTransactor(db *sql.DB) *Transactor {
+	return &Transactor{db: db}
+}
+
+// InTransaction runs fn in one transaction, which every repository call
+// made with the context fn is given joins. It is rolled back if fn fails.
+// Called inside another transaction it runs fn in a savepoint instead, so
+// only fn's own changes are rolled back and the outer transaction goes on.
+func (t *Transactor) InTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	if tx, ok := ctx.Value(txContextKey{}).(*sql.Tx); ok {
+		return inSavepoint(ctx, tx, fn)
+	}
+
+	tx, err := beginTx(ctx, t.db)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx.Tx)); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// inSavepoint relies on SQLite resolving a savepoint name to the innermost
+// one, so nested savepoints can all share it.
+func inSavepoint(ctx context.Context, tx *sql.Tx, fn func(ctx context.Context) error) error {
+	if _, err := tx.ExecContext(ctx, `SAVEPOINT nested`); err != nil {
+		return err
+	}
+
+	if err := fn(ctx); err != nil {
+		if _, rbErr := tx.ExecContext(ctx, `ROLLBACK TO nested`); rbErr != nil {
+			return rbErr
+		}
+		_, _ = tx.ExecContext(ctx, `RELEASE nested`)
+		return err
+	}
+
+	_, err := tx.ExecContext(ctx, `RELEASE nested`)
+	return err
+}
@@ -0,0 +1,59 @@
+package sqlite
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestTransactor_InTransaction(t *testing.T) {
+	db := setupTestDB(t)
+	repo := NewEntryRepository(db)
+	transactor := NewTransactor(db)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	insert := func(ctx context.Context, content string) error {
+		_, err := repo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: content, ScheduledDate: &date, CreatedAt: time.Now()})
+		return err
+	}
+	contents := func() []string {
+		entries, err := repo.GetByDate(ctx, date)
+		require.NoError(t, err)
+		var result []string
+		for _, entry := range entries {
+			result = append(result, entry.Content)
+		}
+		return result
+	}
+
+	t.Run("rolls back everything when fn fails", func(t *testing.T) {
+		err := transactor.InTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, "Discarded"))
+			return errors.New("failed")
+		})
+		assert.EqualError(t, err, "failed")
+		assert.Empty(t, contents())
+	})
+
+	t.Run("rolls back only a failed nested call", func(t *testing.T) {
+		err := transactor.InTransaction(ctx, func(ctx context.Context) error {
+			require.NoError(t, insert(ctx, "Kept"))
+			nestedErr := transactor.InTransaction(ctx, func(ctx context.Context) error {
+				require.NoError(t, insert(ctx, "Rolled back"))
+				return errors.New("nested failed")
+			})
+			assert.EqualError(t, nestedErr, "nested failed")
+			return transactor.InTransaction(ctx, func(ctx context.Context) error {
+				return insert(ctx, "Also kept")
+			})
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"Kept", "Also kept"}, contents())
+	})
+}
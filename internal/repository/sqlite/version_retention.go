@@ -0,0 +1,28 @@
+package sqlite
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// maxEntityVersions bounds how many versions of one goal, habit or list
+// item are kept. entry_versions applies the same limit in a trigger.
+const maxEntityVersions = 50
+
+// pruneVersions removes superseded rows of an entity that fall outside the
+// newest maxEntityVersions. Rows matching keep, when given, are never
+// removed, so rows other tables still point at survive.
+func pruneVersions(ctx context.Context, tx queryer, table, idColumn string, entityID domain.EntityID, keep string) error {
+	query := fmt.Sprintf(`
+		DELETE FROM %[1]s
+		WHERE entity_id = ? AND valid_to IS NOT NULL AND valid_to != ''
+			AND version <= (SELECT MAX(version) FROM %[1]s WHERE entity_id = ?) - ?`, table)
+	if keep != "" {
+		query += fmt.Sprintf(" AND %s NOT IN (%s)", idColumn, keep)
+	}
+
+	_, err := tx.ExecContext(ctx, query, entityID.String(), entityID.String(), maxEntityVersions)
+	return err
+}
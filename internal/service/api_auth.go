@@ -0,0 +1,181 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+const (
+	apiTokenPrefix      = "bujo_"
+	apiTokenRandomBytes = 32
+	apiTokenPrefixLen   = 8
+)
+
+// APIAuthService manages the tokens and CORS origins that guard the local
+// HTTP API.
+type APIAuthService struct {
+	tokenRepo  domain.APITokenRepository
+	originRepo domain.APIOriginRepository
+}
+
+func NewAPIAuthService(tokenRepo domain.APITokenRepository, originRepo domain.APIOriginRepository) *APIAuthService {
+	return &APIAuthService{
+		tokenRepo:  tokenRepo,
+		originRepo: originRepo,
+	}
+}
+
+// CreateToken issues a new token and returns its secret. Only a hash of the
+// secret is stored, so it cannot be shown again.
+func (s *APIAuthService) CreateToken(ctx context.Context, name string, scopes []domain.APITokenScope) (string, *domain.APIToken, error) {
+	secret, err := generateAPIToken()
+	if err != nil {
+		return "", nil, err
+	}
+
+	token := domain.APIToken{
+		Name:      strings.TrimSpace(name),
+		Prefix:    secret[:len(apiTokenPrefix)+apiTokenPrefixLen],
+		Scopes:    scopes,
+		CreatedAt: time.Now(),
+	}
+	if err := token.Validate(); err != nil {
+		return "", nil, err
+	}
+
+	id, err := s.tokenRepo.Insert(ctx, token, hashAPIToken(secret))
+	if err != nil {
+		return "", nil, err
+	}
+	token.ID = id
+
+	return secret, &token, nil
+}
+
+// ReplaceToken revokes every active token called name and issues a new one
+// in their place, so a client that asks for its token again does not leave
+// the old ones working.
+func (s *APIAuthService) ReplaceToken(ctx context.Context, name string, scopes []domain.APITokenScope) (string, *domain.APIToken, error) {
+	tokens, err := s.tokenRepo.GetAll(ctx)
+	if err != nil {
+		return "", nil, err
+	}
+	now := time.Now()
+	for _, token := range tokens {
+		if token.Name == strings.TrimSpace(name) && !token.IsRevoked() {
+			if err := s.tokenRepo.Revoke(ctx, token.ID, now); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+	return s.CreateToken(ctx, name, scopes)
+}
+
+func (s *APIAuthService) ListTokens(ctx context.Context) ([]domain.APIToken, error) {
+	return s.tokenRepo.GetAll(ctx)
+}
+
+func (s *APIAuthService) RevokeToken(ctx context.Context, id int64) error {
+	token, err := s.tokenRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if token == nil {
+		return fmt.Errorf("token %w: %d", domain.ErrNotFound, id)
+	}
+	if token.IsRevoked() {
+		return domain.Invalidf("token %d is already revoked", id)
+	}
+	return s.tokenRepo.Revoke(ctx, id, time.Now())
+}
+
+// Authenticate resolves a presented secret to its token. Unknown and revoked
+// tokens are rejected with the same error so callers cannot tell them apart.
+func (s *APIAuthService) Authenticate(ctx context.Context, secret string) (*domain.APIToken, error) {
+	if !strings.HasPrefix(secret, apiTokenPrefix) {
+		return nil, domain.Invalidf("invalid API token")
+	}
+
+	token, err := s.tokenRepo.GetByHash(ctx, hashAPIToken(secret))
+	if err != nil {
+		return nil, err
+	}
+	if token == nil || token.IsRevoked() {
+		return nil, domain.Invalidf("invalid API token")
+	}
+
+	now := time.Now()
+	if err := s.tokenRepo.TouchLastUsed(ctx, token.ID, now); err != nil {
+		return nil, err
+	}
+	token.LastUsedAt = &now
+
+	return token, nil
+}
+
+func (s *APIAuthService) ListOrigins(ctx context.Context) ([]string, error) {
+	return s.originRepo.GetAll(ctx)
+}
+
+func (s *APIAuthService) AllowOrigin(ctx context.Context, origin string) (string, error) {
+	normalized, err := normalizeOrigin(origin)
+	if err != nil {
+		return "", err
+	}
+	return normalized, s.originRepo.Add(ctx, normalized)
+}
+
+func (s *APIAuthService) DisallowOrigin(ctx context.Context, origin string) error {
+	normalized, err := normalizeOrigin(origin)
+	if err != nil {
+		return err
+	}
+	allowed, err := s.originRepo.Contains(ctx, normalized)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return fmt.Errorf("origin %w: %s", domain.ErrNotFound, normalized)
+	}
+	return s.originRepo.Remove(ctx, normalized)
+}
+
+func (s *APIAuthService) IsOriginAllowed(ctx context.Context, origin string) (bool, error) {
+	if origin == "" {
+		return false, nil
+	}
+	return s.originRepo.Contains(ctx, origin)
+}
+
+// normalizeOrigin reduces a URL to the scheme://host[:port] form browsers
+// send in the Origin header.
+func normalizeOrigin(origin string) (string, error) {
+	u, err := url.Parse(strings.TrimSpace(origin))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", domain.Invalidf("invalid origin: %s (use scheme://host, e.g. https://mail.google.com)", origin)
+	}
+	return u.Scheme + "://" + strings.ToLower(u.Host), nil
+}
+
+func generateAPIToken() (string, error) {
+	b := make([]byte, apiTokenRandomBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+	return apiTokenPrefix + hex.EncodeToString(b), nil
+}
+
+// hashAPIToken uses a plain SHA-256 digest: tokens carry 256 bits of
+// randomness, so a slow password hash would add nothing.
+func hashAPIToken(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+func setupAPIAuthService(t *testing.T) *APIAuthService {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return NewAPIAuthService(sqlite.NewAPITokenRepository(db), sqlite.NewAPIOriginRepository(db))
+}
+
+func TestAPIAuthService_CreateAndAuthenticate(t *testing.T) {
+	auth := setupAPIAuthService(t)
+	ctx := context.Background()
+
+	secret, token, err := auth.CreateToken(ctx, "scripts", []domain.APITokenScope{domain.APITokenScopeRead})
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(secret, "bujo_"))
+	assert.True(t, strings.HasPrefix(secret, token.Prefix))
+	assert.Nil(t, token.LastUsedAt)
+
+	authenticated, err := auth.Authenticate(ctx, secret)
+	require.NoError(t, err)
+	assert.Equal(t, token.ID, authenticated.ID)
+	assert.Equal(t, "scripts", authenticated.Name)
+
+	tokens, err := auth.ListTokens(ctx)
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	assert.NotNil(t, tokens[0].LastUsedAt)
+}
+
+func TestAPIAuthService_ReplaceToken_RevokesTokensWithTheSameName(t *testing.T) {
+	auth := setupAPIAuthService(t)
+	ctx := context.Background()
+	capture := []domain.APITokenScope{domain.APITokenScopeCapture}
+
+	first, _, err := auth.ReplaceToken(ctx, "bookmarklet", capture)
+	require.NoError(t, err)
+	other, _, err := auth.CreateToken(ctx, "scripts", []domain.APITokenScope{domain.APITokenScopeRead})
+	require.NoError(t, err)
+	second, _, err := auth.ReplaceToken(ctx, "bookmarklet", capture)
+	require.NoError(t, err)
+
+	_, err = auth.Authenticate(ctx, first)
+	assert.Error(t, err)
+	_, err = auth.Authenticate(ctx, second)
+	assert.NoError(t, err)
+	_, err = auth.Authenticate(ctx, other)
+	assert.NoError(t, err)
+}
+
+func TestAPIAuthService_StoresOnlyHash(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	auth := NewAPIAuthService(sqlite.NewAPITokenRepository(db), sqlite.NewAPIOriginRepository(db))
+
+	secret, _, err := auth.CreateToken(context.Background(), "scripts", []domain.APITokenScope{domain.APITokenScopeRead})
+	require.NoError(t, err)
+
+	var count int
+	require.NoError(t, db.QueryRow(`SELECT COUNT(*) FROM api_tokens WHERE token_hash = ?`, secret).Scan(&count))
+	assert.Zero(t, count)
+}
+
+func TestAPIAuthService_Authenticate_Rejects(t *testing.T) {
+	auth := setupAPIAuthService(t)
+	ctx := context.Background()
+
+	secret, token, err := auth.CreateToken(ctx, "old", []domain.APITokenScope{domain.APITokenScopeWrite})
+	require.NoError(t, err)
+
+	_, err = auth.Authenticate(ctx, "bujo_0000")
+	assert.Error(t, err)
+	_, err = auth.Authenticate(ctx, strings.TrimPrefix(secret, "bujo_"))
+	assert.Error(t, err)
+
+	require.NoError(t, auth.RevokeToken(ctx, token.ID))
+	_, err = auth.Authenticate(ctx, secret)
+	assert.Error(t, err)
+
+	err = auth.RevokeToken(ctx, token.ID)
+	assert.ErrorContains(t, err, "already revoked")
+	err = auth.RevokeToken(ctx, 999)
+	assert.ErrorContains(t, err, "not found")
+}
+
+func TestAPIAuthService_CreateToken_RequiresName(t *testing.T) {
+	auth := setupAPIAuthService(t)
+
+	_, _, err := auth.CreateToken(context.Background(), "  ", []domain.APITokenScope{domain.APITokenScopeRead})
+	assert.Error(t, err)
+}
+
+func TestAPIAuthService_Origins(t *testing.T) {
+	auth := setupAPIAuthService(t)
+	ctx := context.Background()
+
+	allowed, err := auth.IsOriginAllowed(ctx, "https://mail.google.com")
+	require.NoError(t, err)
+	assert.True(t, allowed, "gmail is allowed by default")
+
+	origin, err := auth.AllowOrigin(ctx, "https://Outlook.Office.com/mail/inbox")
+	require.NoError(t, err)
+	assert.Equal(t, "https://outlook.office.com", origin)
+
+	origins, err := auth.ListOrigins(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"https://mail.google.com", "https://outlook.office.com"}, origins)
+
+	require.NoError(t, auth.DisallowOrigin(ctx, "https://mail.google.com"))
+	allowed, err = auth.IsOriginAllowed(ctx, "https://mail.google.com")
+	require.NoError(t, err)
+	assert.False(t, allowed)
+
+	assert.Error(t, auth.DisallowOrigin(ctx, "https://mail.google.com"))
+	_, err = auth.AllowOrigin(ctx, "mail.google.com")
+	assert.Error(t, err)
+}
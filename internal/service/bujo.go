@@ -19,6 +19,9 @@ type BujoService struct {
 	entryToListMover domain.EntryToListMover
 	tagRepo          domain.TagRepository
 	mentionRepo      domain.MentionRepository
+	recurrenceRepo   domain.RecurrenceRepository
+	goalLinkRepo     domain.GoalLinkRepository
+	journal          *OperationJournal
 }
 
 func NewBujoService(entryRepo domain.EntryRepository, dayCtxRepo domain.DayContextRepository, parser *domain.TreeParser) *BujoService {
@@ -42,18 +45,47 @@ func NewBujoServiceWithLists(entryRepo domain.EntryRepository, dayCtxRepo domain
 	}
 }
 
+// WithRecurrences enables materializing recurring entries into the days
+// returned by GetDailyAgenda and GetDayEntries.
+func (s *BujoService) WithRecurrences(recurrenceRepo domain.RecurrenceRepository) *BujoService {
+	s.recurrenceRepo = recurrenceRepo
+	return s
+}
+
+// WithGoalLinks keeps a task linked to its goals when it is migrated.
+func (s *BujoService) WithGoalLinks(goalLinkRepo domain.GoalLinkRepository) *BujoService {
+	s.goalLinkRepo = goalLinkRepo
+	return s
+}
+
+// WithOperationJournal records every change made through the service so it
+// can be undone.
+func (s *BujoService) WithOperationJournal(journal *OperationJournal) *BujoService {
+	s.journal = journal
+	return s
+}
+
 type LogEntriesOptions struct {
-	Date     time.Time
-	Location *string
-	ParentID *int64
+	Date        time.Time
+	Granularity domain.ScheduleGranularity
+	Location    *string
+	ParentID    *int64
 }
 
 func (s *BujoService) LogEntries(ctx context.Context, input string, opts LogEntriesOptions) ([]int64, error) {
+	ctx, end := s.journal.Begin(ctx, "Add entries")
+	defer end()
+
 	entries, err := s.parser.Parse(input)
 	if err != nil {
 		return nil, err
 	}
 
+	date, granularity := opts.Date, opts.Granularity
+	if granularity == domain.ScheduleMonth {
+		date = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, date.Location())
+	}
+
 	var parentDepth int
 	if opts.ParentID != nil {
 		parent, err := s.entryRepo.GetByID(ctx, *opts.ParentID)
@@ -61,19 +93,24 @@ func (s *BujoService) LogEntries(ctx context.Context, input string, opts LogEntr
 			return nil, err
 		}
 		if parent == nil {
-			return nil, fmt.Errorf("parent entry %d not found", *opts.ParentID)
+			return nil, fmt.Errorf("parent entry %d %w", *opts.ParentID, domain.ErrNotFound)
 		}
 		if parent.Type == domain.EntryTypeQuestion {
-			return nil, fmt.Errorf("cannot add children to questions, use answer instead")
+			return nil, domain.Invalidf("cannot add children to questions, use answer instead")
 		}
 		parentDepth = parent.Depth + 1
+		// Children of future log entries stay in the future log with them.
+		if parent.IsFutureLog() {
+			date, granularity = *parent.ScheduledDate, parent.Granularity
+		}
 	}
 
 	ids := make([]int64, 0, len(entries))
 	idMap := make(map[int]int64) // maps original index to database ID
 
 	for i, entry := range entries {
-		entry.ScheduledDate = &opts.Date
+		entry.ScheduledDate = &date
+		entry.Granularity = granularity
 		entry.Location = opts.Location
 		entry.CreatedAt = time.Now()
 
@@ -117,11 +154,12 @@ func (s *BujoService) LogEntries(ctx context.Context, input string, opts LogEntr
 }
 
 type DailyAgenda struct {
-	Date     time.Time
-	Location *string
-	Mood     *string
-	Weather  *string
-	Today    []domain.Entry
+	Date      time.Time
+	Location  *string
+	Mood      *string
+	Weather   *string
+	Today     []domain.Entry
+	FutureLog []domain.Entry
 }
 
 type DayEntries struct {
@@ -133,8 +171,9 @@ type DayEntries struct {
 }
 
 type MultiDayAgenda struct {
-	Overdue []domain.Entry
-	Days    []DayEntries
+	Overdue      []domain.Entry
+	OverdueItems []OverdueListItem
+	Days         []DayEntries
 }
 
 func (s *BujoService) GetDailyAgenda(ctx context.Context, date time.Time) (*DailyAgenda, error) {
@@ -152,11 +191,30 @@ func (s *BujoService) GetDailyAgenda(ctx context.Context, date time.Time) (*Dail
 		agenda.Weather = dayCtx.Weather
 	}
 
+	if reachesToday(date) {
+		if err := s.materializeRecurrences(ctx); err != nil {
+			return nil, err
+		}
+	}
+	projected, err := s.projectedRecurrences(ctx, date, date)
+	if err != nil {
+		return nil, err
+	}
+
 	today, err := s.entryRepo.GetByDate(ctx, date)
 	if err != nil {
 		return nil, err
 	}
-	agenda.Today = today
+	agenda.Today = append(today, projected...)
+
+	// The first day of a month brings up what the future log holds for it.
+	if date.Day() == 1 {
+		futureLog, err := s.entryRepo.GetByMonth(ctx, date)
+		if err != nil {
+			return nil, err
+		}
+		agenda.FutureLog = futureLog
+	}
 
 	return agenda, nil
 }
@@ -164,12 +222,19 @@ func (s *BujoService) GetDailyAgenda(ctx context.Context, date time.Time) (*Dail
 func (s *BujoService) GetMultiDayAgenda(ctx context.Context, from, to time.Time) (*MultiDayAgenda, error) {
 	agenda := &MultiDayAgenda{}
 
-	overdue, err := s.entryRepo.GetOverdue(ctx)
+	overdue, err := s.GetOverdue(ctx)
 	if err != nil {
 		return nil, err
 	}
 	agenda.Overdue = overdue
 
+	if s.listRepo != nil && s.listItemRepo != nil {
+		agenda.OverdueItems, err = overdueListItems(ctx, s.listRepo, s.listItemRepo, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	days, err := s.GetDayEntries(ctx, from, to)
 	if err != nil {
 		return nil, err
@@ -180,10 +245,21 @@ func (s *BujoService) GetMultiDayAgenda(ctx context.Context, from, to time.Time)
 }
 
 func (s *BujoService) GetDayEntries(ctx context.Context, from, to time.Time) ([]DayEntries, error) {
+	if reachesToday(to) {
+		if err := s.materializeRecurrences(ctx); err != nil {
+			return nil, err
+		}
+	}
+	projected, err := s.projectedRecurrences(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
 	entries, err := s.entryRepo.GetByDateRange(ctx, from, to)
 	if err != nil {
 		return nil, err
 	}
+	entries = append(entries, projected...)
 
 	dayContexts, err := s.dayCtxRepo.GetRange(ctx, from, to)
 	if err != nil {
@@ -221,7 +297,103 @@ func (s *BujoService) GetDayEntries(ctx context.Context, from, to time.Time) ([]
 	return days, nil
 }
 
+// projectedRecurrences projects the occurrences of each recurrence in
+// [from, to] that fall after today and have not been stored, without
+// storing them. Occurrences up to today are stored by
+// materializeRecurrences instead.
+func (s *BujoService) projectedRecurrences(ctx context.Context, from, to time.Time) ([]domain.Entry, error) {
+	if s.recurrenceRepo == nil {
+		return nil, nil
+	}
+
+	today := time.Now().Format("2006-01-02")
+	if to.Format("2006-01-02") <= today {
+		return nil, nil
+	}
+
+	recurrences, err := s.recurrenceRepo.GetActive(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	var projected []domain.Entry
+	for _, recurrence := range recurrences {
+		materialized, err := s.recurrenceRepo.GetMaterializedDates(ctx, recurrence.ID, from, to)
+		if err != nil {
+			return nil, err
+		}
+		done := make(map[string]bool, len(materialized))
+		for _, d := range materialized {
+			done[d.Format("2006-01-02")] = true
+		}
+
+		for _, date := range recurrence.Occurrences(from, to) {
+			if date.Format("2006-01-02") <= today || done[date.Format("2006-01-02")] {
+				continue
+			}
+			entry := recurrence.ToEntry(date)
+			entry.Projected = true
+			projected = append(projected, entry)
+		}
+	}
+	return projected, nil
+}
+
+// materializeRecurrences stores the occurrences of each recurrence that
+// have come due since its last stored one, up to today, so they can be
+// worked on like any other entry. It starts no earlier than the day the
+// recurrence was created, so a start date in the past is not back-filled,
+// and each occurrence is only ever stored once, so deleting or migrating it
+// does not bring it back.
+func (s *BujoService) materializeRecurrences(ctx context.Context) error {
+	if s.recurrenceRepo == nil {
+		return nil
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	recurrences, err := s.recurrenceRepo.GetActive(ctx, today, today)
+	if err != nil {
+		return err
+	}
+
+	var due []domain.RecurrenceOccurrence
+	for _, recurrence := range recurrences {
+		from := time.Date(recurrence.CreatedAt.Year(), recurrence.CreatedAt.Month(), recurrence.CreatedAt.Day(), 0, 0, 0, 0, time.UTC)
+		if recurrence.StartDate.After(from) {
+			from = recurrence.StartDate
+		}
+		materialized, err := s.recurrenceRepo.GetMaterializedDates(ctx, recurrence.ID, recurrence.StartDate, today)
+		if err != nil {
+			return err
+		}
+		if len(materialized) > 0 {
+			if next := materialized[len(materialized)-1].AddDate(0, 0, 1); next.After(from) {
+				from = next
+			}
+		}
+
+		for _, date := range recurrence.Occurrences(from, today) {
+			due = append(due, domain.RecurrenceOccurrence{Recurrence: recurrence, Date: date})
+		}
+	}
+
+	if len(due) == 0 {
+		return nil
+	}
+	return s.recurrenceRepo.Materialize(ctx, due)
+}
+
+// reachesToday reports whether a view ending on to includes today, which
+// is when the occurrences due by now need storing first.
+func reachesToday(to time.Time) bool {
+	return to.Format("2006-01-02") >= time.Now().Format("2006-01-02")
+}
+
 func (s *BujoService) GetOverdue(ctx context.Context) ([]domain.Entry, error) {
+	if err := s.materializeRecurrences(ctx); err != nil {
+		return nil, err
+	}
 	return s.entryRepo.GetOverdue(ctx)
 }
 
@@ -231,7 +403,7 @@ func (s *BujoService) getEntry(ctx context.Context, id int64) (*domain.Entry, er
 		return nil, err
 	}
 	if entry == nil {
-		return nil, fmt.Errorf("entry %d not found", id)
+		return nil, fmt.Errorf("entry %d %w", id, domain.ErrNotFound)
 	}
 	return entry, nil
 }
@@ -241,6 +413,9 @@ func (s *BujoService) GetEntry(ctx context.Context, id int64) (*domain.Entry, er
 }
 
 func (s *BujoService) SetLocation(ctx context.Context, date time.Time, location string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set location for %s", date.Format("2006-01-02")))
+	defer end()
+
 	dayCtx, err := s.dayCtxRepo.GetByDate(ctx, date)
 	if err != nil {
 		return err
@@ -333,10 +508,16 @@ func (s *BujoService) GetLocation(ctx context.Context, date time.Time) (*string,
 }
 
 func (s *BujoService) ClearLocation(ctx context.Context, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Clear location for %s", date.Format("2006-01-02")))
+	defer end()
+
 	return s.dayCtxRepo.Delete(ctx, date)
 }
 
 func (s *BujoService) SetMood(ctx context.Context, date time.Time, mood string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set mood for %s", date.Format("2006-01-02")))
+	defer end()
+
 	dayCtx, err := s.dayCtxRepo.GetByDate(ctx, date)
 	if err != nil {
 		return err
@@ -365,6 +546,9 @@ func (s *BujoService) GetMoodHistory(ctx context.Context, from, to time.Time) ([
 }
 
 func (s *BujoService) ClearMood(ctx context.Context, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Clear mood for %s", date.Format("2006-01-02")))
+	defer end()
+
 	dayCtx, err := s.dayCtxRepo.GetByDate(ctx, date)
 	if err != nil {
 		return err
@@ -377,6 +561,9 @@ func (s *BujoService) ClearMood(ctx context.Context, date time.Time) error {
 }
 
 func (s *BujoService) SetWeather(ctx context.Context, date time.Time, weather string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set weather for %s", date.Format("2006-01-02")))
+	defer end()
+
 	dayCtx, err := s.dayCtxRepo.GetByDate(ctx, date)
 	if err != nil {
 		return err
@@ -405,6 +592,9 @@ func (s *BujoService) GetWeatherHistory(ctx context.Context, from, to time.Time)
 }
 
 func (s *BujoService) ClearWeather(ctx context.Context, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Clear weather for %s", date.Format("2006-01-02")))
+	defer end()
+
 	dayCtx, err := s.dayCtxRepo.GetByDate(ctx, date)
 	if err != nil {
 		return err
@@ -417,13 +607,16 @@ func (s *BujoService) ClearWeather(ctx context.Context, date time.Time) error {
 }
 
 func (s *BujoService) MarkDone(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark entry %d done", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if entry.Type != domain.EntryTypeTask {
-		return fmt.Errorf("only tasks can be marked done, this is a %s", entry.Type)
+		return domain.Invalidf("only tasks can be marked done, this is a %s", entry.Type)
 	}
 
 	entry.Type = domain.EntryTypeDone
@@ -433,6 +626,9 @@ func (s *BujoService) MarkDone(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) Undo(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark entry %d incomplete", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -444,6 +640,9 @@ func (s *BujoService) Undo(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) CancelEntry(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Cancel entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -467,6 +666,9 @@ func (s *BujoService) CancelEntry(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) UncancelEntry(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Uncancel entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -481,12 +683,15 @@ func (s *BujoService) UncancelEntry(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) RetypeEntry(ctx context.Context, id int64, newType domain.EntryType) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Change entry %d to %s", id, newType))
+	defer end()
+
 	if !newType.IsValid() {
-		return fmt.Errorf("invalid entry type: %s", newType)
+		return domain.Invalidf("invalid entry type: %s", newType)
 	}
 
 	if newType == domain.EntryTypeDone || newType == domain.EntryTypeMigrated || newType == domain.EntryTypeCancelled {
-		return fmt.Errorf("cannot retype to %s, use the appropriate command instead", newType)
+		return domain.Invalidf("cannot retype to %s, use the appropriate command instead", newType)
 	}
 
 	entry, err := s.getEntry(ctx, id)
@@ -505,28 +710,31 @@ func (s *BujoService) RetypeEntry(ctx context.Context, id int64, newType domain.
 func (s *BujoService) retypeErrorMessage(entryType domain.EntryType) error {
 	switch entryType {
 	case domain.EntryTypeCancelled:
-		return fmt.Errorf("cannot change type of cancelled entry: uncancel it first")
+		return domain.Invalidf("cannot change type of cancelled entry: uncancel it first")
 	case domain.EntryTypeDone:
-		return fmt.Errorf("cannot change type of completed entry: use undo to reopen it first")
+		return domain.Invalidf("cannot change type of completed entry: use undo to reopen it first")
 	case domain.EntryTypeMigrated:
-		return fmt.Errorf("cannot change type of migrated entry: the entry has moved to a new date")
+		return domain.Invalidf("cannot change type of migrated entry: the entry has moved to a new date")
 	case domain.EntryTypeAnswered:
-		return fmt.Errorf("cannot change type of answered entry: reopen the question first")
+		return domain.Invalidf("cannot change type of answered entry: reopen the question first")
 	case domain.EntryTypeAnswer:
-		return fmt.Errorf("cannot change type of answer entry: answers are tied to their parent question")
+		return domain.Invalidf("cannot change type of answer entry: answers are tied to their parent question")
 	default:
-		return fmt.Errorf("cannot change type of %s entry", entryType)
+		return domain.Invalidf("cannot change type of %s entry", entryType)
 	}
 }
 
 func (s *BujoService) EditEntry(ctx context.Context, id int64, newContent string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Edit entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if !entry.CanEdit() {
-		return fmt.Errorf("cannot edit cancelled entry: uncancel it first to make changes")
+		return domain.Invalidf("cannot edit cancelled entry: uncancel it first to make changes")
 	}
 
 	entry.Content = newContent
@@ -562,6 +770,9 @@ func (s *BujoService) EditEntry(ctx context.Context, id int64, newContent string
 }
 
 func (s *BujoService) EditEntryPriority(ctx context.Context, id int64, priority domain.Priority) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set priority of entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -572,6 +783,9 @@ func (s *BujoService) EditEntryPriority(ctx context.Context, id int64, priority
 }
 
 func (s *BujoService) CyclePriority(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Change priority of entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -582,6 +796,9 @@ func (s *BujoService) CyclePriority(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) DeleteEntry(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -616,6 +833,9 @@ func (s *BujoService) DeleteEntry(ctx context.Context, id int64) error {
 }
 
 func (s *BujoService) DeleteEntryAndReparent(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -650,13 +870,22 @@ func (s *BujoService) HasChildren(ctx context.Context, id int64) (bool, error) {
 }
 
 func (s *BujoService) MigrateEntry(ctx context.Context, id int64, toDate time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Migrate entry %d to %s", id, toDate.Format("2006-01-02")))
+	defer end()
+
+	return s.migrateEntry(ctx, id, toDate, domain.ScheduleDay)
+}
+
+// migrateEntry marks the task and its children as migrated and recreates
+// them scheduled for toDate at the given granularity.
+func (s *BujoService) migrateEntry(ctx context.Context, id int64, toDate time.Time, granularity domain.ScheduleGranularity) (int64, error) {
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return 0, err
 	}
 
 	if entry.Type != domain.EntryTypeTask {
-		return 0, fmt.Errorf("only tasks can be migrated, this is a %s", entry.Type)
+		return 0, domain.Invalidf("only tasks can be migrated, this is a %s", entry.Type)
 	}
 
 	tree, err := s.entryRepo.GetWithChildren(ctx, id)
@@ -698,6 +927,7 @@ func (s *BujoService) MigrateEntry(ctx context.Context, id int64, toDate time.Ti
 		Priority:          entry.Priority,
 		MigrationCount:    entry.MigrationCount + 1,
 		ScheduledDate:     &toDate,
+		Granularity:       granularity,
 		CreatedAt:         time.Now(),
 		OriginalCreatedAt: originalCreatedAt,
 	}
@@ -729,6 +959,7 @@ func (s *BujoService) MigrateEntry(ctx context.Context, id int64, toDate time.Ti
 			ParentID:          &newChildParentID,
 			Depth:             child.Depth,
 			ScheduledDate:     &toDate,
+			Granularity:       granularity,
 			CreatedAt:         time.Now(),
 			OriginalCreatedAt: childOrigCreatedAt,
 		}
@@ -739,6 +970,14 @@ func (s *BujoService) MigrateEntry(ctx context.Context, id int64, toDate time.Ti
 		idMap[child.ID] = newChildID
 	}
 
+	if s.goalLinkRepo != nil {
+		for oldID, newID := range idMap {
+			if err := s.goalLinkRepo.CopyEntryLinks(ctx, oldID, newID); err != nil {
+				return 0, err
+			}
+		}
+	}
+
 	return newParentID, nil
 }
 
@@ -749,6 +988,9 @@ type MoveOptions struct {
 }
 
 func (s *BujoService) MoveEntry(ctx context.Context, id int64, opts MoveOptions) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move entry %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -768,7 +1010,7 @@ func (s *BujoService) MoveEntry(ctx context.Context, id int64, opts MoveOptions)
 			return err
 		}
 		if parent == nil {
-			return fmt.Errorf("parent %d not found", *opts.NewParentID)
+			return fmt.Errorf("parent %d %w", *opts.NewParentID, domain.ErrNotFound)
 		}
 		entry.ParentID = opts.NewParentID
 		entry.Depth = parent.Depth + 1
@@ -776,6 +1018,7 @@ func (s *BujoService) MoveEntry(ctx context.Context, id int64, opts MoveOptions)
 
 	if opts.NewLoggedDate != nil {
 		entry.ScheduledDate = opts.NewLoggedDate
+		entry.Granularity = domain.ScheduleDay
 	}
 
 	if err := s.entryRepo.Update(ctx, *entry); err != nil {
@@ -825,6 +1068,7 @@ func (s *BujoService) updateChildrenDates(ctx context.Context, parentID int64, n
 
 	for _, child := range children {
 		child.ScheduledDate = &newDate
+		child.Granularity = domain.ScheduleDay
 		if err := s.entryRepo.Update(ctx, child); err != nil {
 			return err
 		}
@@ -1027,21 +1271,24 @@ func (s *BujoService) GetEntriesAncestorsMap(ctx context.Context, ids []int64) (
 }
 
 func (s *BujoService) MarkAnswered(ctx context.Context, id int64, answerText string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Answer question %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
 	}
 
 	if entry.Type != domain.EntryTypeQuestion {
-		return fmt.Errorf("only questions can be marked answered, this is a %s", entry.Type)
+		return domain.Invalidf("only questions can be marked answered, this is a %s", entry.Type)
 	}
 
 	if answerText == "" {
-		return fmt.Errorf("answer text is required")
+		return domain.Invalidf("answer text is required")
 	}
 
 	if len(answerText) > 512 {
-		return fmt.Errorf("answer text too long (max 512 characters, got %d)", len(answerText))
+		return domain.Invalidf("answer text too long (max 512 characters, got %d)", len(answerText))
 	}
 
 	entry.Type = domain.EntryTypeAnswered
@@ -1054,7 +1301,7 @@ func (s *BujoService) MarkAnswered(ctx context.Context, id int64, answerText str
 		return err
 	}
 	if updated == nil {
-		return fmt.Errorf("question entry not found after update")
+		return fmt.Errorf("question entry %w after update", domain.ErrNotFound)
 	}
 
 	answerEntry := domain.Entry{
@@ -1074,6 +1321,9 @@ func (s *BujoService) MarkAnswered(ctx context.Context, id int64, answerText str
 }
 
 func (s *BujoService) ReopenQuestion(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Reopen question %d", id))
+	defer end()
+
 	entry, err := s.getEntry(ctx, id)
 	if err != nil {
 		return err
@@ -1106,7 +1356,7 @@ func (s *BujoService) ExportEntryMarkdown(ctx context.Context, id int64) (string
 		return "", fmt.Errorf("failed to get entry: %w", err)
 	}
 	if len(entries) == 0 {
-		return "", fmt.Errorf("entry %d not found", id)
+		return "", fmt.Errorf("entry %d %w", id, domain.ErrNotFound)
 	}
 
 	return formatEntriesAsMarkdown(entries), nil
@@ -1176,13 +1426,16 @@ func (s *BujoService) GetAttentionScores(ctx context.Context, ids []int64) (map[
 }
 
 func (s *BujoService) MoveEntryToList(ctx context.Context, entryID int64, listID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move entry %d to list %d", entryID, listID))
+	defer end()
+
 	entry, err := s.getEntry(ctx, entryID)
 	if err != nil {
 		return err
 	}
 
 	if !entry.CanMoveToList() {
-		return fmt.Errorf("only tasks can be moved to lists, this is a %s", entry.Type)
+		return domain.Invalidf("only tasks can be moved to lists, this is a %s", entry.Type)
 	}
 
 	children, err := s.entryRepo.GetChildren(ctx, entry.ID)
@@ -1190,7 +1443,7 @@ func (s *BujoService) MoveEntryToList(ctx context.Context, entryID int64, listID
 		return fmt.Errorf("failed to check for children: %w", err)
 	}
 	if len(children) > 0 {
-		return fmt.Errorf("cannot move entry with children to a list")
+		return domain.Invalidf("cannot move entry with children to a list")
 	}
 
 	list, err := s.listRepo.GetByID(ctx, listID)
@@ -1198,7 +1451,7 @@ func (s *BujoService) MoveEntryToList(ctx context.Context, entryID int64, listID
 		return err
 	}
 	if list == nil {
-		return fmt.Errorf("list not found: %d", listID)
+		return fmt.Errorf("list %w: %d", domain.ErrNotFound, listID)
 	}
 
 	if err := s.entryToListMover.MoveEntryToList(ctx, *entry, list.EntityID); err != nil {
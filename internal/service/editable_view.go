@@ -15,6 +15,8 @@ type EditableViewService struct {
 	listRepo         domain.ListRepository
 	tagRepo          domain.TagRepository
 	mentionRepo      domain.MentionRepository
+	journal          *OperationJournal
+	transactor       domain.Transactor
 }
 
 func NewEditableViewService(entryRepo domain.EntryRepository, entryToListMover domain.EntryToListMover, listRepo domain.ListRepository, tagRepo domain.TagRepository, mentionRepo domain.MentionRepository) *EditableViewService {
@@ -27,6 +29,16 @@ func NewEditableViewService(entryRepo domain.EntryRepository, entryToListMover d
 	}
 }
 
+func (s *EditableViewService) WithOperationJournal(journal *OperationJournal) *EditableViewService {
+	s.journal = journal
+	return s
+}
+
+func (s *EditableViewService) WithTransactor(transactor domain.Transactor) *EditableViewService {
+	s.transactor = transactor
+	return s
+}
+
 func (s *EditableViewService) GetEditableDocument(ctx context.Context, date time.Time) (string, error) {
 	entries, err := s.entryRepo.GetByDate(ctx, date)
 	if err != nil {
@@ -103,134 +115,203 @@ func (s *EditableViewService) ValidateDocument(doc string) ValidationResult {
 }
 
 type ApplyChangesResult struct {
-	Inserted int
-	Deleted  int
+	Inserted   int
+	Updated    int
+	Reparented int
+	Deleted    int
+	Changes    []domain.DocumentChange
 }
 
-func (s *EditableViewService) ApplyChanges(ctx context.Context, doc string, date time.Time) (*ApplyChangesResult, error) {
+func newApplyChangesResult(diff *domain.DocumentDiff) *ApplyChangesResult {
+	result := &ApplyChangesResult{Changes: diff.Changes}
+	for _, change := range diff.Changes {
+		switch change.Kind {
+		case domain.DocumentChangeInsert:
+			result.Inserted++
+		case domain.DocumentChangeUpdate:
+			result.Updated++
+		case domain.DocumentChangeReparent:
+			result.Reparented++
+		case domain.DocumentChangeDelete:
+			result.Deleted++
+		}
+	}
+	return result
+}
+
+func (s *EditableViewService) diffDocument(ctx context.Context, doc string, date time.Time) (*domain.DocumentDiff, error) {
 	validation := s.ValidateDocument(doc)
 	if !validation.IsValid {
-		return nil, fmt.Errorf("validation failed: %s", validation.Errors[0].Message)
+		return nil, domain.Invalidf("validation failed: %s", validation.Errors[0].Message)
 	}
 
 	existing, err := s.entryRepo.GetByDate(ctx, date)
 	if err != nil {
 		return nil, err
 	}
-	deletedCount := len(existing)
 
-	err = s.entryRepo.DeleteByDate(ctx, date)
+	return domain.DiffDocument(existing, &domain.EditableDocument{Lines: validation.ParsedLines}), nil
+}
+
+// PreviewChanges reports what ApplyChanges would do with doc without
+// writing anything.
+func (s *EditableViewService) PreviewChanges(ctx context.Context, doc string, date time.Time) (*ApplyChangesResult, error) {
+	diff, err := s.diffDocument(ctx, doc, date)
 	if err != nil {
 		return nil, err
 	}
+	return newApplyChangesResult(diff), nil
+}
 
-	result := &ApplyChangesResult{Deleted: deletedCount}
+// ApplyChanges updates the day to match doc. Lines that map back to an
+// existing entry update it in place, so its entity ID, creation time,
+// migration count and completion time survive the edit. The changes are
+// made in one transaction, so a failure part way leaves the day untouched.
+func (s *EditableViewService) ApplyChanges(ctx context.Context, doc string, date time.Time) (*ApplyChangesResult, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Edit %s", date.Format("2006-01-02")))
+	defer end()
+
+	var result *ApplyChangesResult
+	err := inTransaction(ctx, s.transactor, func(ctx context.Context) error {
+		var err error
+		result, err = s.applyChanges(ctx, doc, date)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
 
-	type insertedEntry struct {
-		id       int64
-		symbol   domain.EntryType
-		depth    int
-		parentID *int64
+func (s *EditableViewService) applyChanges(ctx context.Context, doc string, date time.Time) (*ApplyChangesResult, error) {
+	diff, err := s.diffDocument(ctx, doc, date)
+	if err != nil {
+		return nil, err
 	}
 
-	var depthStack []int64
-	var inserted []insertedEntry
-	for _, line := range validation.ParsedLines {
-		if !line.IsValid || line.IsHeader {
-			continue
+	existing := make(map[int64]domain.Entry)
+	entries, err := s.entryRepo.GetByDate(ctx, date)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		existing[entry.ID] = entry
+	}
+
+	now := time.Now()
+	ids := make([]int64, len(diff.Lines))
+	for i, line := range diff.Lines {
+		var parentID *int64
+		if line.ParentIndex >= 0 {
+			parentID = &ids[line.ParentIndex]
 		}
 
-		entry := domain.Entry{
-			Type:          line.Symbol,
-			Content:       line.Content,
-			Priority:      line.Priority,
-			Depth:         line.Depth,
-			ScheduledDate: &date,
-			CreatedAt:     time.Now(),
+		if line.EntryID == 0 {
+			rowID, err := s.entryRepo.Insert(ctx, domain.Entry{
+				Type:          line.Type,
+				Content:       line.Line.Content,
+				Priority:      line.Line.Priority,
+				ParentID:      parentID,
+				Depth:         line.Line.Depth,
+				ScheduledDate: &date,
+				SortOrder:     i + 1,
+				CreatedAt:     now,
+			})
+			if err != nil {
+				return nil, err
+			}
+			ids[i] = rowID
+			if err := s.insertTagsAndMentions(ctx, rowID, line.Line.Content); err != nil {
+				return nil, err
+			}
+			continue
 		}
 
-		if line.Depth > 0 && len(depthStack) > line.Depth-1 {
-			parentID := depthStack[line.Depth-1]
-			entry.ParentID = &parentID
+		ids[i] = line.EntryID
+		before := existing[line.EntryID]
+		after := before
+		after.Type = line.Type
+		after.Content = line.Line.Content
+		after.Priority = line.Line.Priority
+		after.ParentID = parentID
+		after.Depth = line.Line.Depth
+		after.SortOrder = i + 1
+		if after.Type == domain.EntryTypeDone && before.Type != domain.EntryTypeDone {
+			after.CompletedAt = &now
+		} else if after.Type != domain.EntryTypeDone {
+			after.CompletedAt = nil
 		}
 
-		rowID, err := s.entryRepo.Insert(ctx, entry)
-		if err != nil {
+		if after.Type == before.Type && after.Content == before.Content && after.Priority == before.Priority &&
+			sameInt64Ptr(after.ParentID, before.ParentID) && after.Depth == before.Depth && after.SortOrder == before.SortOrder {
+			continue
+		}
+		if err := s.entryRepo.Update(ctx, after); err != nil {
 			return nil, err
 		}
 
-		if s.tagRepo != nil {
-			tags := domain.ExtractTags(line.Content)
-			if len(tags) > 0 {
-				if err := s.tagRepo.InsertEntryTags(ctx, rowID, tags); err != nil {
-					return nil, err
-				}
+		if after.Content != before.Content {
+			if err := s.deleteTagsAndMentions(ctx, line.EntryID); err != nil {
+				return nil, err
 			}
-		}
-
-		if s.mentionRepo != nil {
-			mentions := domain.ExtractMentions(line.Content)
-			if len(mentions) > 0 {
-				if err := s.mentionRepo.InsertEntryMentions(ctx, rowID, mentions); err != nil {
-					return nil, err
-				}
+			if err := s.insertTagsAndMentions(ctx, line.EntryID, after.Content); err != nil {
+				return nil, err
 			}
 		}
+	}
 
-		inserted = append(inserted, insertedEntry{
-			id:       rowID,
-			symbol:   line.Symbol,
-			depth:    line.Depth,
-			parentID: entry.ParentID,
-		})
-
-		if line.Depth >= len(depthStack) {
-			depthStack = append(depthStack, rowID)
-		} else {
-			depthStack[line.Depth] = rowID
-			depthStack = depthStack[:line.Depth+1]
+	// Deleted entries come in document order, so go backwards to remove
+	// children before their parents.
+	for i := len(diff.Deleted) - 1; i >= 0; i-- {
+		if err := s.entryRepo.Delete(ctx, diff.Deleted[i].ID); err != nil {
+			return nil, err
 		}
-
-		result.Inserted++
 	}
 
-	questionsWithChildren := make(map[int64]bool)
-	for _, ie := range inserted {
-		if ie.parentID != nil {
-			questionsWithChildren[*ie.parentID] = true
+	return newApplyChangesResult(diff), nil
+}
+
+func (s *EditableViewService) insertTagsAndMentions(ctx context.Context, entryID int64, content string) error {
+	if s.tagRepo != nil {
+		tags := domain.ExtractTags(content)
+		if len(tags) > 0 {
+			if err := s.tagRepo.InsertEntryTags(ctx, entryID, tags); err != nil {
+				return err
+			}
 		}
 	}
 
-	for _, ie := range inserted {
-		if ie.symbol == domain.EntryTypeQuestion && questionsWithChildren[ie.id] {
-			entry, err := s.entryRepo.GetByID(ctx, ie.id)
-			if err != nil {
-				return nil, err
-			}
-			entry.Type = domain.EntryTypeAnswered
-			if err := s.entryRepo.Update(ctx, *entry); err != nil {
-				return nil, err
+	if s.mentionRepo != nil {
+		mentions := domain.ExtractMentions(content)
+		if len(mentions) > 0 {
+			if err := s.mentionRepo.InsertEntryMentions(ctx, entryID, mentions); err != nil {
+				return err
 			}
 		}
+	}
 
-		if ie.parentID != nil {
-			for _, parent := range inserted {
-				if parent.id == *ie.parentID && parent.symbol == domain.EntryTypeQuestion {
-					entry, err := s.entryRepo.GetByID(ctx, ie.id)
-					if err != nil {
-						return nil, err
-					}
-					entry.Type = domain.EntryTypeAnswer
-					if err := s.entryRepo.Update(ctx, *entry); err != nil {
-						return nil, err
-					}
-					break
-				}
-			}
+	return nil
+}
+
+func (s *EditableViewService) deleteTagsAndMentions(ctx context.Context, entryID int64) error {
+	if s.tagRepo != nil {
+		if err := s.tagRepo.DeleteByEntryID(ctx, entryID); err != nil {
+			return err
+		}
+	}
+	if s.mentionRepo != nil {
+		if err := s.mentionRepo.DeleteByEntryID(ctx, entryID); err != nil {
+			return err
 		}
 	}
+	return nil
+}
 
-	return result, nil
+func sameInt64Ptr(a, b *int64) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
 }
 
 type ApplyActions struct {
@@ -239,6 +320,9 @@ type ApplyActions struct {
 }
 
 func (s *EditableViewService) ApplyChangesWithActions(ctx context.Context, doc string, date time.Time, actions ApplyActions) (*ApplyChangesResult, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Edit %s", date.Format("2006-01-02")))
+	defer end()
+
 	result, err := s.ApplyChanges(ctx, doc, date)
 	if err != nil {
 		return nil, err
@@ -311,7 +395,7 @@ func (s *EditableViewService) ApplyChangesWithActions(ctx context.Context, doc s
 	if actions.ListID != nil && s.listRepo != nil && s.entryToListMover != nil {
 		list, err := s.listRepo.GetByID(ctx, *actions.ListID)
 		if err != nil {
-			return nil, fmt.Errorf("list not found: %w", err)
+			return nil, fmt.Errorf("list %w: %w", domain.ErrNotFound, err)
 		}
 		for _, entry := range entries {
 			if entry.Type == domain.EntryTypeMovedToList {
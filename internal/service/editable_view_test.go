@@ -2,10 +2,12 @@ package service
 
 import (
 	"context"
+	"errors"
 	"strings"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/repository/sqlite"
@@ -190,8 +192,9 @@ func TestApplyChanges_ReplacesExistingEntries(t *testing.T) {
 	result, err := svc.ApplyChanges(ctx, ". New task\no New event", date)
 
 	require.NoError(t, err)
-	require.Equal(t, 2, result.Inserted)
-	require.Equal(t, 2, result.Deleted)
+	require.Equal(t, 1, result.Updated)
+	require.Equal(t, 1, result.Inserted)
+	require.Equal(t, 1, result.Deleted)
 
 	entries, err := entryRepo.GetByDate(ctx, date)
 	require.NoError(t, err)
@@ -284,6 +287,126 @@ func TestApplyChanges_ReorderPreserved(t *testing.T) {
 	require.Contains(t, lines[2], "Second")
 }
 
+func TestApplyChanges_EditPreservesEntryIdentity(t *testing.T) {
+	svc, bujoSvc, entryRepo := setupEditableViewService(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := bujoSvc.LogEntries(ctx, ". Write report\n- Call dentist", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	original, err := entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+
+	result, err := svc.ApplyChanges(ctx, "x Write the report\n- Call dentist", date)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Updated)
+	require.Equal(t, 0, result.Inserted)
+	require.Equal(t, 0, result.Deleted)
+
+	edited, err := entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	require.Equal(t, "Write the report", edited.Content)
+	require.Equal(t, domain.EntryTypeDone, edited.Type)
+	require.NotNil(t, edited.CompletedAt)
+	require.Equal(t, original.EntityID, edited.EntityID)
+	require.True(t, original.CreatedAt.Equal(edited.CreatedAt))
+}
+
+func TestApplyChanges_ReparentKeepsEntry(t *testing.T) {
+	svc, bujoSvc, entryRepo := setupEditableViewService(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := bujoSvc.LogEntries(ctx, ". Project\n. Standalone task", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	result, err := svc.ApplyChanges(ctx, ". Project\n  . Standalone task", date)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Reparented)
+	require.Equal(t, 0, result.Inserted)
+
+	child, err := entryRepo.GetByID(ctx, ids[1])
+	require.NoError(t, err)
+	require.NotNil(t, child.ParentID)
+	require.Equal(t, ids[0], *child.ParentID)
+	require.Equal(t, 1, child.Depth)
+}
+
+func TestApplyChanges_ReorderKeepsEntries(t *testing.T) {
+	svc, bujoSvc, entryRepo := setupEditableViewService(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := bujoSvc.LogEntries(ctx, ". First\n. Second", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	result, err := svc.ApplyChanges(ctx, ". Second\n. First", date)
+
+	require.NoError(t, err)
+	require.Equal(t, 0, result.Inserted)
+	require.Equal(t, 0, result.Deleted)
+
+	entries, err := entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	require.Equal(t, ids[1], entries[0].ID)
+	require.Equal(t, ids[0], entries[1].ID)
+
+	_, err = bujoSvc.LogEntries(ctx, ". Third", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	doc, err := svc.GetEditableDocument(ctx, date)
+	require.NoError(t, err)
+	require.Equal(t, ". Second\n. First\n. Third", doc)
+}
+
+func TestApplyChanges_DeletingParentKeepsPromotedChild(t *testing.T) {
+	svc, bujoSvc, entryRepo := setupEditableViewService(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := bujoSvc.LogEntries(ctx, ". Parent\n  - Child note", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	result, err := svc.ApplyChanges(ctx, "- Child note", date)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Deleted)
+	require.Equal(t, 1, result.Reparented)
+
+	entries, err := entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	require.Equal(t, ids[1], entries[0].ID)
+	require.Nil(t, entries[0].ParentID)
+}
+
+func TestPreviewChanges_DoesNotWrite(t *testing.T) {
+	svc, bujoSvc, _ := setupEditableViewService(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	_, err := bujoSvc.LogEntries(ctx, ". Keep me\n. Drop me", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	result, err := svc.PreviewChanges(ctx, ". Keep me\no Team lunch", date)
+
+	require.NoError(t, err)
+	require.Equal(t, 1, result.Inserted)
+	require.Equal(t, 1, result.Deleted)
+	require.Len(t, result.Changes, 2)
+	require.Equal(t, domain.DocumentChangeInsert, result.Changes[0].Kind)
+	require.Equal(t, "o Team lunch", result.Changes[0].After)
+	require.Equal(t, domain.DocumentChangeDelete, result.Changes[1].Kind)
+	require.Equal(t, ". Drop me", result.Changes[1].Before)
+
+	doc, err := svc.GetEditableDocument(ctx, date)
+	require.NoError(t, err)
+	require.Equal(t, ". Keep me\n. Drop me", doc)
+}
+
 func TestApplyChanges_ValidationErrors(t *testing.T) {
 	svc, _, _ := setupEditableViewService(t)
 	ctx := context.Background()
@@ -797,6 +920,37 @@ func TestApplyChanges_ReplacesTagsOnReapply(t *testing.T) {
 	require.Equal(t, []string{"errands", "food"}, tags[entries[0].ID])
 }
 
+type failingTagRepository struct {
+	*sqlite.TagRepository
+}
+
+func (r failingTagRepository) InsertEntryTags(ctx context.Context, entryID int64, tags []string) error {
+	return errors.New("tags unavailable")
+}
+
+func TestApplyChanges_RollsBackWhenAChangeFails(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	svc := NewEditableViewService(entryRepo, nil, nil, failingTagRepository{sqlite.NewTagRepository(db)}, nil).
+		WithTransactor(sqlite.NewTransactor(db))
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	_, err = svc.ApplyChanges(ctx, ". Buy milk", date)
+	require.NoError(t, err)
+
+	_, err = svc.ApplyChanges(ctx, ". Buy oat milk\n. Call mum #family", date)
+	require.Error(t, err)
+
+	entries, err := entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Buy milk", entries[0].Content)
+}
+
 func splitNonEmpty(s string) []string {
 	var result []string
 	for _, line := range strings.Split(s, "\n") {
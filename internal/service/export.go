@@ -36,6 +36,15 @@ type ExportGoalRepository interface {
 	GetAll(ctx context.Context) ([]domain.Goal, error)
 }
 
+type ExportKeyResultRepository interface {
+	GetAll(ctx context.Context) ([]domain.KeyResult, error)
+	GetAllCheckIns(ctx context.Context) ([]domain.KeyResultCheckIn, error)
+}
+
+type ExportListTemplateRepository interface {
+	GetAll(ctx context.Context) ([]domain.ListTemplate, error)
+}
+
 type ExportService struct {
 	entryRepo      ExportEntryRepository
 	habitRepo      ExportHabitRepository
@@ -44,6 +53,8 @@ type ExportService struct {
 	listRepo       ExportListRepository
 	listItemRepo   ExportListItemRepository
 	goalRepo       ExportGoalRepository
+	keyResultRepo  ExportKeyResultRepository
+	templateRepo   ExportListTemplateRepository
 }
 
 func NewExportService(
@@ -104,6 +115,19 @@ type ImportGoalRepository interface {
 	DeleteAll(ctx context.Context) error
 }
 
+type ImportKeyResultRepository interface {
+	Insert(ctx context.Context, kr domain.KeyResult) (int64, error)
+	GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.KeyResult, error)
+	InsertCheckIn(ctx context.Context, checkIn domain.KeyResultCheckIn) (int64, error)
+	DeleteAll(ctx context.Context) error
+}
+
+type ImportListTemplateRepository interface {
+	Insert(ctx context.Context, template domain.ListTemplate) (int64, error)
+	GetByName(ctx context.Context, name string) (*domain.ListTemplate, error)
+	DeleteAll(ctx context.Context) error
+}
+
 type ImportService struct {
 	entryRepo      ImportEntryRepository
 	habitRepo      ImportHabitRepository
@@ -112,6 +136,8 @@ type ImportService struct {
 	listRepo       ImportListRepository
 	listItemRepo   ImportListItemRepository
 	goalRepo       ImportGoalRepository
+	keyResultRepo  ImportKeyResultRepository
+	templateRepo   ImportListTemplateRepository
 }
 
 func NewImportService(
@@ -134,6 +160,19 @@ func NewImportService(
 	}
 }
 
+// WithKeyResults includes the key results of goals and their check-ins in
+// the import.
+func (s *ImportService) WithKeyResults(keyResultRepo ImportKeyResultRepository) *ImportService {
+	s.keyResultRepo = keyResultRepo
+	return s
+}
+
+// WithListTemplates includes list templates in the import.
+func (s *ImportService) WithListTemplates(templateRepo ImportListTemplateRepository) *ImportService {
+	s.templateRepo = templateRepo
+	return s
+}
+
 func (s *ImportService) Import(ctx context.Context, data *domain.ExportData, opts domain.ImportOptions) error {
 	if opts.Mode == domain.ImportModeReplace {
 		if err := s.clearAllData(ctx); err != nil {
@@ -216,10 +255,87 @@ func (s *ImportService) Import(ctx context.Context, data *domain.ExportData, opt
 		}
 	}
 
+	if err := s.importKeyResults(ctx, data, opts); err != nil {
+		return err
+	}
+	return s.importListTemplates(ctx, data, opts)
+}
+
+// importListTemplates inserts list templates. When merging, a template whose
+// name is already taken is kept as it is.
+func (s *ImportService) importListTemplates(ctx context.Context, data *domain.ExportData, opts domain.ImportOptions) error {
+	if s.templateRepo == nil {
+		return nil
+	}
+
+	for _, template := range data.ListTemplates {
+		if opts.Mode == domain.ImportModeMerge {
+			existing, err := s.templateRepo.GetByName(ctx, template.Name)
+			if err != nil {
+				return err
+			}
+			if existing != nil {
+				continue
+			}
+		}
+
+		if _, err := s.templateRepo.Insert(ctx, template); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// importKeyResults inserts key results, and the check-ins of those it
+// inserted, so merging an export twice does not duplicate their history.
+func (s *ImportService) importKeyResults(ctx context.Context, data *domain.ExportData, opts domain.ImportOptions) error {
+	if s.keyResultRepo == nil {
+		return nil
+	}
+
+	inserted := make(map[domain.EntityID]bool)
+	for _, kr := range data.KeyResults {
+		shouldInsert := true
+		if opts.Mode == domain.ImportModeMerge {
+			existing, err := s.keyResultRepo.GetByEntityID(ctx, kr.EntityID)
+			if err != nil {
+				return err
+			}
+			shouldInsert = existing == nil
+		}
+
+		if shouldInsert {
+			if _, err := s.keyResultRepo.Insert(ctx, kr); err != nil {
+				return err
+			}
+			inserted[kr.EntityID] = true
+		}
+	}
+
+	for _, checkIn := range data.KeyResultCheckIns {
+		if !inserted[checkIn.KeyResultEntityID] {
+			continue
+		}
+		if _, err := s.keyResultRepo.InsertCheckIn(ctx, checkIn); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
 func (s *ImportService) clearAllData(ctx context.Context) error {
+	if s.templateRepo != nil {
+		if err := s.templateRepo.DeleteAll(ctx); err != nil {
+			return err
+		}
+	}
+	if s.keyResultRepo != nil {
+		if err := s.keyResultRepo.DeleteAll(ctx); err != nil {
+			return err
+		}
+	}
 	if err := s.listItemRepo.DeleteAll(ctx); err != nil {
 		return err
 	}
@@ -244,6 +360,19 @@ func (s *ImportService) clearAllData(ctx context.Context) error {
 	return nil
 }
 
+// WithKeyResults includes the key results of goals and their check-ins in
+// the export.
+func (s *ExportService) WithKeyResults(keyResultRepo ExportKeyResultRepository) *ExportService {
+	s.keyResultRepo = keyResultRepo
+	return s
+}
+
+// WithListTemplates includes list templates in the export.
+func (s *ExportService) WithListTemplates(templateRepo ExportListTemplateRepository) *ExportService {
+	s.templateRepo = templateRepo
+	return s
+}
+
 func (s *ExportService) Export(ctx context.Context, opts domain.ExportOptions) (*domain.ExportData, error) {
 	data := &domain.ExportData{
 		Version:    domain.ExportVersion,
@@ -311,5 +440,30 @@ func (s *ExportService) Export(ctx context.Context, opts domain.ExportOptions) (
 		data.Goals = []domain.Goal{}
 	}
 
+	data.KeyResults = []domain.KeyResult{}
+	data.KeyResultCheckIns = []domain.KeyResultCheckIn{}
+	if s.keyResultRepo != nil {
+		keyResults, err := s.keyResultRepo.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		data.KeyResults = append(data.KeyResults, keyResults...)
+
+		checkIns, err := s.keyResultRepo.GetAllCheckIns(ctx)
+		if err != nil {
+			return nil, err
+		}
+		data.KeyResultCheckIns = append(data.KeyResultCheckIns, checkIns...)
+	}
+
+	data.ListTemplates = []domain.ListTemplate{}
+	if s.templateRepo != nil {
+		templates, err := s.templateRepo.GetAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		data.ListTemplates = append(data.ListTemplates, templates...)
+	}
+
 	return data, nil
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"strings"
 	"testing"
 	"time"
 
@@ -435,3 +436,202 @@ func TestImportService_Import_ReplaceMode(t *testing.T) {
 		t.Errorf("Expected 1 inserted habit, got %d", len(habitRepo.inserted))
 	}
 }
+
+type mockKeyResultRepoForExport struct {
+	keyResults []domain.KeyResult
+	checkIns   []domain.KeyResultCheckIn
+}
+
+func (m *mockKeyResultRepoForExport) GetAll(ctx context.Context) ([]domain.KeyResult, error) {
+	return m.keyResults, nil
+}
+
+func (m *mockKeyResultRepoForExport) GetAllCheckIns(ctx context.Context) ([]domain.KeyResultCheckIn, error) {
+	return m.checkIns, nil
+}
+
+func TestExportService_Export_KeyResults(t *testing.T) {
+	ctx := context.Background()
+	kr := domain.KeyResult{EntityID: domain.NewEntityID(), GoalEntityID: domain.NewEntityID(), Name: "Subscribers", Target: 1000}
+	krRepo := &mockKeyResultRepoForExport{
+		keyResults: []domain.KeyResult{kr},
+		checkIns:   []domain.KeyResultCheckIn{{KeyResultEntityID: kr.EntityID, Value: 250}},
+	}
+
+	svc := NewExportService(&mockEntryRepoForExport{}, &mockHabitRepoForExport{}, &mockHabitLogRepoForExport{},
+		&mockDayContextRepoForExport{}, &mockListRepoForExport{},
+		&mockListItemRepoForExport{}, &mockGoalRepoForExport{}).WithKeyResults(krRepo)
+
+	data, err := svc.Export(ctx, domain.NewExportOptions())
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	if len(data.KeyResults) != 1 {
+		t.Errorf("Expected 1 key result, got %d", len(data.KeyResults))
+	}
+	if len(data.KeyResultCheckIns) != 1 {
+		t.Errorf("Expected 1 key result check-in, got %d", len(data.KeyResultCheckIns))
+	}
+}
+
+type mockImportKeyResultRepo struct {
+	existing         map[domain.EntityID]bool
+	inserted         []domain.KeyResult
+	insertedCheckIns []domain.KeyResultCheckIn
+	cleared          bool
+}
+
+func (m *mockImportKeyResultRepo) Insert(ctx context.Context, kr domain.KeyResult) (int64, error) {
+	m.inserted = append(m.inserted, kr)
+	return int64(len(m.inserted)), nil
+}
+
+func (m *mockImportKeyResultRepo) GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.KeyResult, error) {
+	if m.existing[entityID] {
+		return &domain.KeyResult{EntityID: entityID}, nil
+	}
+	return nil, nil
+}
+
+func (m *mockImportKeyResultRepo) InsertCheckIn(ctx context.Context, checkIn domain.KeyResultCheckIn) (int64, error) {
+	m.insertedCheckIns = append(m.insertedCheckIns, checkIn)
+	return int64(len(m.insertedCheckIns)), nil
+}
+
+func (m *mockImportKeyResultRepo) DeleteAll(ctx context.Context) error {
+	m.cleared = true
+	m.existing = make(map[domain.EntityID]bool)
+	return nil
+}
+
+func TestImportService_Import_KeyResults(t *testing.T) {
+	ctx := context.Background()
+
+	existingKR := domain.NewEntityID()
+	newKR := domain.NewEntityID()
+	data := &domain.ExportData{
+		Version: domain.ExportVersion,
+		KeyResults: []domain.KeyResult{
+			{EntityID: existingKR, Name: "Existing", Target: 10},
+			{EntityID: newKR, Name: "New", Target: 10},
+		},
+		KeyResultCheckIns: []domain.KeyResultCheckIn{
+			{KeyResultEntityID: existingKR, Value: 3},
+			{KeyResultEntityID: newKR, Value: 4},
+		},
+	}
+
+	newImportService := func(krRepo *mockImportKeyResultRepo) *ImportService {
+		return NewImportService(&mockImportEntryRepo{}, &mockImportHabitRepo{existing: make(map[domain.EntityID]bool)},
+			&mockImportHabitLogRepo{}, &mockImportDayContextRepo{}, &mockImportListRepo{existing: make(map[domain.EntityID]bool)},
+			&mockImportListItemRepo{}, &mockImportGoalRepo{existing: make(map[domain.EntityID]bool)}).WithKeyResults(krRepo)
+	}
+
+	merged := &mockImportKeyResultRepo{existing: map[domain.EntityID]bool{existingKR: true}}
+	if err := newImportService(merged).Import(ctx, data, domain.NewImportOptions(domain.ImportModeMerge)); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(merged.inserted) != 1 || merged.inserted[0].EntityID != newKR {
+		t.Errorf("Expected only the new key result to be inserted, got %v", merged.inserted)
+	}
+	if len(merged.insertedCheckIns) != 1 || merged.insertedCheckIns[0].Value != 4 {
+		t.Errorf("Expected only the new key result's check-in to be inserted, got %v", merged.insertedCheckIns)
+	}
+
+	replaced := &mockImportKeyResultRepo{existing: map[domain.EntityID]bool{existingKR: true}}
+	if err := newImportService(replaced).Import(ctx, data, domain.NewImportOptions(domain.ImportModeReplace)); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if !replaced.cleared {
+		t.Error("Expected key results to be cleared in replace mode")
+	}
+	if len(replaced.inserted) != 2 || len(replaced.insertedCheckIns) != 2 {
+		t.Errorf("Expected 2 key results and 2 check-ins, got %d and %d", len(replaced.inserted), len(replaced.insertedCheckIns))
+	}
+}
+
+type mockListTemplateRepo struct {
+	templates []domain.ListTemplate
+	inserted  []domain.ListTemplate
+	cleared   bool
+}
+
+func (m *mockListTemplateRepo) GetAll(ctx context.Context) ([]domain.ListTemplate, error) {
+	return m.templates, nil
+}
+
+func (m *mockListTemplateRepo) Insert(ctx context.Context, template domain.ListTemplate) (int64, error) {
+	m.inserted = append(m.inserted, template)
+	return int64(len(m.inserted)), nil
+}
+
+func (m *mockListTemplateRepo) GetByName(ctx context.Context, name string) (*domain.ListTemplate, error) {
+	for _, template := range m.templates {
+		if strings.EqualFold(template.Name, name) {
+			return &template, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockListTemplateRepo) DeleteAll(ctx context.Context) error {
+	m.cleared = true
+	m.templates = nil
+	return nil
+}
+
+func TestExportService_Export_ListTemplates(t *testing.T) {
+	ctx := context.Background()
+	templateRepo := &mockListTemplateRepo{templates: []domain.ListTemplate{{Name: "Release", Content: ". Tag {{date}}\n"}}}
+
+	svc := NewExportService(&mockEntryRepoForExport{}, &mockHabitRepoForExport{}, &mockHabitLogRepoForExport{},
+		&mockDayContextRepoForExport{}, &mockListRepoForExport{},
+		&mockListItemRepoForExport{}, &mockGoalRepoForExport{}).WithListTemplates(templateRepo)
+
+	data, err := svc.Export(ctx, domain.NewExportOptions())
+	if err != nil {
+		t.Fatalf("Export failed: %v", err)
+	}
+
+	if len(data.ListTemplates) != 1 || data.ListTemplates[0].Name != "Release" {
+		t.Errorf("Expected the Release template, got %v", data.ListTemplates)
+	}
+	if data.KeyResults == nil {
+		t.Error("Expected key results to be an empty list without a key result repository")
+	}
+}
+
+func TestImportService_Import_ListTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	data := &domain.ExportData{
+		Version: domain.ExportVersion,
+		ListTemplates: []domain.ListTemplate{
+			{Name: "Release", Content: ". Tag"},
+			{Name: "Packing", Content: ". Socks"},
+		},
+	}
+
+	newImportService := func(templateRepo *mockListTemplateRepo) *ImportService {
+		return NewImportService(&mockImportEntryRepo{}, &mockImportHabitRepo{existing: make(map[domain.EntityID]bool)},
+			&mockImportHabitLogRepo{}, &mockImportDayContextRepo{}, &mockImportListRepo{existing: make(map[domain.EntityID]bool)},
+			&mockImportListItemRepo{}, &mockImportGoalRepo{existing: make(map[domain.EntityID]bool)}).WithListTemplates(templateRepo)
+	}
+
+	merged := &mockListTemplateRepo{templates: []domain.ListTemplate{{Name: "release", Content: ". Mine"}}}
+	if err := newImportService(merged).Import(ctx, data, domain.NewImportOptions(domain.ImportModeMerge)); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if len(merged.inserted) != 1 || merged.inserted[0].Name != "Packing" {
+		t.Errorf("Expected only the Packing template to be inserted, got %v", merged.inserted)
+	}
+
+	replaced := &mockListTemplateRepo{templates: []domain.ListTemplate{{Name: "release", Content: ". Mine"}}}
+	if err := newImportService(replaced).Import(ctx, data, domain.NewImportOptions(domain.ImportModeReplace)); err != nil {
+		t.Fatalf("Import failed: %v", err)
+	}
+	if !replaced.cleared || len(replaced.inserted) != 2 {
+		t.Errorf("Expected templates to be cleared and both inserted, got cleared=%v inserted=%d", replaced.cleared, len(replaced.inserted))
+	}
+}
@@ -0,0 +1,64 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type FutureLogMonth struct {
+	Month   time.Time
+	Entries []domain.Entry
+}
+
+// FutureLog holds the entries scheduled for a month rather than a day,
+// grouped by month, and those deferred to someday.
+type FutureLog struct {
+	Months  []FutureLogMonth
+	Someday []domain.Entry
+}
+
+func (s *BujoService) GetFutureLog(ctx context.Context) (*FutureLog, error) {
+	entries, err := s.entryRepo.GetFutureLog(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	log := &FutureLog{}
+	for _, entry := range entries {
+		if entry.Granularity == domain.ScheduleSomeday {
+			log.Someday = append(log.Someday, entry)
+			continue
+		}
+
+		month := *entry.ScheduledDate
+		if n := len(log.Months); n == 0 || !log.Months[n-1].Month.Equal(month) {
+			log.Months = append(log.Months, FutureLogMonth{Month: month})
+		}
+		last := &log.Months[len(log.Months)-1]
+		last.Entries = append(last.Entries, entry)
+	}
+
+	return log, nil
+}
+
+// MigrateEntryToMonth moves a task into the future log for the given month.
+func (s *BujoService) MigrateEntryToMonth(ctx context.Context, id int64, month time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Migrate entry %d to %s", id, month.Format("January 2006")))
+	defer end()
+
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	return s.migrateEntry(ctx, id, month, domain.ScheduleMonth)
+}
+
+// MigrateEntryToSomeday moves a task into the future log with no date.
+func (s *BujoService) MigrateEntryToSomeday(ctx context.Context, id int64) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Migrate entry %d to someday", id))
+	defer end()
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return s.migrateEntry(ctx, id, today, domain.ScheduleSomeday)
+}
@@ -0,0 +1,139 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestBujoService_MigrateEntryToMonth(t *testing.T) {
+	service, entryRepo, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 0, 0, 0, 0, time.UTC)
+	march := time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC)
+
+	ids, err := service.LogEntries(ctx, ". Book flights\n  - Check prices", LogEntriesOptions{Date: today})
+	require.NoError(t, err)
+
+	newID, err := service.MigrateEntryToMonth(ctx, ids[0], march)
+	require.NoError(t, err)
+
+	oldEntry, err := entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.EntryTypeMigrated, oldEntry.Type)
+
+	monthEntries, err := entryRepo.GetByMonth(ctx, march)
+	require.NoError(t, err)
+	require.Len(t, monthEntries, 2)
+	assert.Equal(t, newID, monthEntries[0].ID)
+	assert.Equal(t, "2026-03-01", monthEntries[0].ScheduledDate.Format("2006-01-02"))
+	assert.Equal(t, domain.ScheduleMonth, monthEntries[1].Granularity)
+	assert.Equal(t, newID, *monthEntries[1].ParentID)
+
+	firstOfMarch, err := entryRepo.GetByDate(ctx, time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	assert.Empty(t, firstOfMarch)
+}
+
+func TestBujoService_MigrateEntryToSomeday(t *testing.T) {
+	service, _, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	ids, err := service.LogEntries(ctx, ". Learn piano", LogEntriesOptions{Date: time.Now()})
+	require.NoError(t, err)
+
+	_, err = service.MigrateEntryToSomeday(ctx, ids[0])
+	require.NoError(t, err)
+
+	log, err := service.GetFutureLog(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, log.Months)
+	require.Len(t, log.Someday, 1)
+	assert.Equal(t, "Learn piano", log.Someday[0].Content)
+}
+
+func TestBujoService_GetFutureLog_GroupsByMonth(t *testing.T) {
+	service, _, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	april := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := service.LogEntries(ctx, ". Spring clean", LogEntriesOptions{Date: april, Granularity: domain.ScheduleMonth})
+	require.NoError(t, err)
+	_, err = service.LogEntries(ctx, ". Book flights\n. Renew passport", LogEntriesOptions{Date: march, Granularity: domain.ScheduleMonth})
+	require.NoError(t, err)
+
+	log, err := service.GetFutureLog(ctx)
+	require.NoError(t, err)
+	require.Len(t, log.Months, 2)
+	assert.Equal(t, march, log.Months[0].Month)
+	assert.Len(t, log.Months[0].Entries, 2)
+	assert.Equal(t, april, log.Months[1].Month)
+	assert.Len(t, log.Months[1].Entries, 1)
+}
+
+func TestBujoService_GetDailyAgenda_SurfacesFutureLogOnFirstOfMonth(t *testing.T) {
+	service, _, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := service.LogEntries(ctx, ". Book flights", LogEntriesOptions{Date: march, Granularity: domain.ScheduleMonth})
+	require.NoError(t, err)
+	_, err = service.LogEntries(ctx, ". Day task", LogEntriesOptions{Date: march})
+	require.NoError(t, err)
+
+	agenda, err := service.GetDailyAgenda(ctx, march)
+	require.NoError(t, err)
+	require.Len(t, agenda.Today, 1)
+	assert.Equal(t, "Day task", agenda.Today[0].Content)
+	require.Len(t, agenda.FutureLog, 1)
+	assert.Equal(t, "Book flights", agenda.FutureLog[0].Content)
+
+	agenda, err = service.GetDailyAgenda(ctx, march.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	assert.Empty(t, agenda.FutureLog)
+}
+
+func TestBujoService_LogEntries_ChildOfFutureLogEntryStaysInFutureLog(t *testing.T) {
+	service, entryRepo, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	ids, err := service.LogEntries(ctx, ". Book flights", LogEntriesOptions{Date: march, Granularity: domain.ScheduleMonth})
+	require.NoError(t, err)
+
+	childIDs, err := service.LogEntries(ctx, "- Window seat", LogEntriesOptions{Date: time.Now(), ParentID: &ids[0]})
+	require.NoError(t, err)
+
+	child, err := entryRepo.GetByID(ctx, childIDs[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.ScheduleMonth, child.Granularity)
+	assert.Equal(t, "2026-03-01", child.ScheduledDate.Format("2006-01-02"))
+}
+
+func TestBujoService_MoveEntry_ToDateLeavesFutureLog(t *testing.T) {
+	service, entryRepo, _ := setupBujoService(t)
+	ctx := context.Background()
+
+	march := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	day := time.Date(2026, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	ids, err := service.LogEntries(ctx, ". Book flights", LogEntriesOptions{Date: march, Granularity: domain.ScheduleMonth})
+	require.NoError(t, err)
+
+	err = service.MoveEntry(ctx, ids[0], MoveOptions{NewLoggedDate: &day})
+	require.NoError(t, err)
+
+	entries, err := entryRepo.GetByDate(ctx, day)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, domain.ScheduleDay, entries[0].Granularity)
+}
@@ -11,7 +11,9 @@ import (
 type GoalRepository interface {
 	Insert(ctx context.Context, goal domain.Goal) (int64, error)
 	GetByID(ctx context.Context, id int64) (*domain.Goal, error)
+	GetByEntityID(ctx context.Context, entityID domain.EntityID) (*domain.Goal, error)
 	GetByMonth(ctx context.Context, month time.Time) ([]domain.Goal, error)
+	GetInRange(ctx context.Context, from, to time.Time) ([]domain.Goal, error)
 	GetAll(ctx context.Context) ([]domain.Goal, error)
 	Update(ctx context.Context, goal domain.Goal) error
 	Delete(ctx context.Context, id int64) error
@@ -19,7 +21,11 @@ type GoalRepository interface {
 }
 
 type GoalService struct {
-	goalRepo GoalRepository
+	goalRepo  GoalRepository
+	linkRepo  domain.GoalLinkRepository
+	entryRepo domain.EntryRepository
+	krRepo    domain.KeyResultRepository
+	journal   *OperationJournal
 }
 
 func NewGoalService(goalRepo GoalRepository) *GoalService {
@@ -28,10 +34,44 @@ func NewGoalService(goalRepo GoalRepository) *GoalService {
 	}
 }
 
+func (s *GoalService) WithOperationJournal(journal *OperationJournal) *GoalService {
+	s.journal = journal
+	return s
+}
+
+// WithEntryLinks lets journal tasks be linked to goals, so a goal's
+// progress can be computed from the tasks that serve it.
+func (s *GoalService) WithEntryLinks(linkRepo domain.GoalLinkRepository, entryRepo domain.EntryRepository) *GoalService {
+	s.linkRepo = linkRepo
+	s.entryRepo = entryRepo
+	return s
+}
+
 func (s *GoalService) CreateGoal(ctx context.Context, content string, month time.Time) (int64, error) {
+	return s.CreateGoalWithOptions(ctx, content, CreateGoalOptions{Horizon: domain.GoalHorizonMonth, Period: month})
+}
+
+// CreateGoalOptions sets the horizon and period of a new goal, and
+// optionally the longer-horizon goal it cascades from.
+type CreateGoalOptions struct {
+	Horizon  domain.GoalHorizon
+	Period   time.Time
+	ParentID int64
+}
+
+func (s *GoalService) CreateGoalWithOptions(ctx context.Context, content string, opts CreateGoalOptions) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, "Create goal")
+	defer end()
+
+	horizon := opts.Horizon
+	if horizon == "" {
+		horizon = domain.GoalHorizonMonth
+	}
+
 	goal := domain.Goal{
 		Content:   content,
-		Month:     month,
+		Month:     domain.GoalPeriodStart(horizon, opts.Period),
+		Horizon:   horizon,
 		Status:    domain.GoalStatusActive,
 		CreatedAt: time.Now(),
 	}
@@ -40,9 +80,57 @@ func (s *GoalService) CreateGoal(ctx context.Context, content string, month time
 		return 0, err
 	}
 
+	if opts.ParentID != 0 {
+		parent, err := s.parentFor(ctx, goal, opts.ParentID)
+		if err != nil {
+			return 0, err
+		}
+		goal.ParentEntityID = &parent.EntityID
+	}
+
 	return s.goalRepo.Insert(ctx, goal)
 }
 
+// SetGoalParent makes a goal cascade from a longer-horizon goal whose period
+// contains it. A parentID of 0 detaches the goal.
+func (s *GoalService) SetGoalParent(ctx context.Context, id, parentID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set parent of goal %d", id))
+	defer end()
+
+	goal, err := s.goalRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if goal == nil {
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
+	}
+
+	goal.ParentEntityID = nil
+	if parentID != 0 {
+		parent, err := s.parentFor(ctx, *goal, parentID)
+		if err != nil {
+			return err
+		}
+		goal.ParentEntityID = &parent.EntityID
+	}
+
+	return s.goalRepo.Update(ctx, *goal)
+}
+
+func (s *GoalService) parentFor(ctx context.Context, goal domain.Goal, parentID int64) (*domain.Goal, error) {
+	parent, err := s.goalRepo.GetByID(ctx, parentID)
+	if err != nil {
+		return nil, err
+	}
+	if parent == nil {
+		return nil, fmt.Errorf("goal %w: %d", domain.ErrNotFound, parentID)
+	}
+	if err := parent.CanParent(goal); err != nil {
+		return nil, err
+	}
+	return parent, nil
+}
+
 func (s *GoalService) GetGoal(ctx context.Context, id int64) (*domain.Goal, error) {
 	return s.goalRepo.GetByID(ctx, id)
 }
@@ -51,6 +139,28 @@ func (s *GoalService) GetGoalsForMonth(ctx context.Context, month time.Time) ([]
 	return s.goalRepo.GetByMonth(ctx, month)
 }
 
+// GetGoalsForPeriod returns the goals of one horizon for the period
+// containing period.
+func (s *GoalService) GetGoalsForPeriod(ctx context.Context, horizon domain.GoalHorizon, period time.Time) ([]domain.Goal, error) {
+	if horizon == domain.GoalHorizonMonth {
+		return s.goalRepo.GetByMonth(ctx, period)
+	}
+
+	start := domain.GoalPeriodStart(horizon, period)
+	goals, err := s.goalRepo.GetInRange(ctx, start, domain.GoalPeriodEnd(horizon, start))
+	if err != nil {
+		return nil, err
+	}
+
+	var result []domain.Goal
+	for _, goal := range goals {
+		if goal.GetHorizon() == horizon {
+			result = append(result, goal)
+		}
+	}
+	return result, nil
+}
+
 func (s *GoalService) GetCurrentMonthGoals(ctx context.Context) ([]domain.Goal, error) {
 	now := time.Now()
 	currentMonth := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
@@ -62,12 +172,15 @@ func (s *GoalService) GetAllGoals(ctx context.Context) ([]domain.Goal, error) {
 }
 
 func (s *GoalService) MarkDone(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark goal %d done", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	updated := goal.MarkDone()
@@ -75,12 +188,15 @@ func (s *GoalService) MarkDone(ctx context.Context, id int64) error {
 }
 
 func (s *GoalService) MarkActive(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark goal %d active", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	updated := goal.MarkActive()
@@ -88,36 +204,45 @@ func (s *GoalService) MarkActive(ctx context.Context, id int64) error {
 }
 
 func (s *GoalService) MoveToMonth(ctx context.Context, id int64, newMonth time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move goal %d to %s", id, newMonth.Format("2006-01")))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
-	return s.goalRepo.MoveToMonth(ctx, id, newMonth)
+	return s.goalRepo.MoveToMonth(ctx, id, domain.GoalPeriodStart(goal.GetHorizon(), newMonth))
 }
 
 func (s *GoalService) DeleteGoal(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete goal %d", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	return s.goalRepo.Delete(ctx, id)
 }
 
 func (s *GoalService) UpdateGoal(ctx context.Context, id int64, content string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Edit goal %d", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	updated := goal.UpdateContent(content)
@@ -125,36 +250,135 @@ func (s *GoalService) UpdateGoal(ctx context.Context, id int64, content string)
 }
 
 func (s *GoalService) MigrateGoal(ctx context.Context, id int64, toMonth time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Migrate goal %d to %s", id, toMonth.Format("2006-01")))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return 0, err
 	}
 	if goal == nil {
-		return 0, fmt.Errorf("goal not found: %d", id)
+		return 0, fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
+	}
+
+	return s.migrateGoal(ctx, *goal, toMonth)
+}
+
+// MigrateUnfinishedGoals carries the active goals of one horizon's period
+// over to the next period, returning the IDs of the new goals.
+func (s *GoalService) MigrateUnfinishedGoals(ctx context.Context, horizon domain.GoalHorizon, period time.Time) ([]int64, error) {
+	start := domain.GoalPeriodStart(horizon, period)
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Migrate unfinished goals of %s", domain.FormatGoalPeriod(horizon, start)))
+	defer end()
+
+	goals, err := s.GetGoalsForPeriod(ctx, horizon, start)
+	if err != nil {
+		return nil, err
 	}
 
-	migrated := goal.MarkMigrated(toMonth)
+	var ids []int64
+	for _, goal := range goals {
+		if goal.Status != domain.GoalStatusActive {
+			continue
+		}
+		id, err := s.migrateGoal(ctx, goal, goal.PeriodEnd())
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// migrateGoal marks the goal migrated and recreates it in the period of its
+// horizon containing to. The new goal keeps the parent while the parent's
+// period still contains it, and takes over the key results and the active
+// child goals.
+func (s *GoalService) migrateGoal(ctx context.Context, goal domain.Goal, to time.Time) (int64, error) {
+	to = domain.GoalPeriodStart(goal.GetHorizon(), to)
+
+	migrated := goal.MarkMigrated(to)
 	if err := s.goalRepo.Update(ctx, migrated); err != nil {
 		return 0, err
 	}
 
 	newGoal := domain.Goal{
 		Content:   goal.Content,
-		Month:     toMonth,
+		Month:     to,
+		Horizon:   goal.GetHorizon(),
 		Status:    domain.GoalStatusActive,
 		CreatedAt: time.Now(),
 	}
 
-	return s.goalRepo.Insert(ctx, newGoal)
+	if goal.ParentEntityID != nil {
+		parent, err := s.goalRepo.GetByEntityID(ctx, *goal.ParentEntityID)
+		if err != nil {
+			return 0, err
+		}
+		if parent != nil && parent.Contains(to) {
+			newGoal.ParentEntityID = goal.ParentEntityID
+		}
+	}
+
+	newID, err := s.goalRepo.Insert(ctx, newGoal)
+	if err != nil {
+		return 0, err
+	}
+
+	inserted, err := s.goalRepo.GetByID(ctx, newID)
+	if err != nil {
+		return 0, err
+	}
+	if err := s.reparentChildren(ctx, goal, inserted.EntityID); err != nil {
+		return 0, err
+	}
+	if s.linkRepo != nil {
+		if err := s.linkRepo.CopyGoalLinks(ctx, goal.EntityID, inserted.EntityID); err != nil {
+			return 0, err
+		}
+	}
+	if err := s.moveKeyResults(ctx, goal.EntityID, inserted.EntityID); err != nil {
+		return 0, err
+	}
+
+	return newID, nil
+}
+
+// reparentChildren moves the active child goals of a migrated goal onto the
+// goal that replaces it, so they still cascade from an open goal and keep
+// their parent when they are migrated on into its period themselves.
+func (s *GoalService) reparentChildren(ctx context.Context, goal domain.Goal, newParent domain.EntityID) error {
+	if goal.GetHorizon() == domain.GoalHorizonMonth {
+		return nil
+	}
+
+	goals, err := s.goalRepo.GetInRange(ctx, goal.Month, goal.PeriodEnd())
+	if err != nil {
+		return err
+	}
+
+	for _, child := range goals {
+		if child.ParentEntityID == nil || *child.ParentEntityID != goal.EntityID || child.Status != domain.GoalStatusActive {
+			continue
+		}
+		child.ParentEntityID = &newParent
+		if err := s.goalRepo.Update(ctx, child); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (s *GoalService) CancelGoal(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Cancel goal %d", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	updated := goal.MarkCancelled()
@@ -162,14 +386,132 @@ func (s *GoalService) CancelGoal(ctx context.Context, id int64) error {
 }
 
 func (s *GoalService) UncancelGoal(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Uncancel goal %d", id))
+	defer end()
+
 	goal, err := s.goalRepo.GetByID(ctx, id)
 	if err != nil {
 		return err
 	}
 	if goal == nil {
-		return fmt.Errorf("goal not found: %d", id)
+		return fmt.Errorf("goal %w: %d", domain.ErrNotFound, id)
 	}
 
 	updated := goal.MarkActive()
 	return s.goalRepo.Update(ctx, updated)
 }
+
+func (s *GoalService) LinkEntry(ctx context.Context, goalID, entryID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Link entry %d to goal %d", entryID, goalID))
+	defer end()
+
+	goal, err := s.linkableGoal(ctx, goalID)
+	if err != nil {
+		return err
+	}
+
+	entry, err := s.entryRepo.GetByID(ctx, entryID)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("entry %w: %d", domain.ErrNotFound, entryID)
+	}
+	if entry.Type != domain.EntryTypeTask && entry.Type != domain.EntryTypeDone {
+		return domain.Invalidf("only tasks can be linked to goals: entry %d is a %s", entryID, entry.Type)
+	}
+
+	return s.linkRepo.LinkEntry(ctx, goal.EntityID, entryID)
+}
+
+func (s *GoalService) UnlinkEntry(ctx context.Context, goalID, entryID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Unlink entry %d from goal %d", entryID, goalID))
+	defer end()
+
+	goal, err := s.linkableGoal(ctx, goalID)
+	if err != nil {
+		return err
+	}
+
+	links, err := s.linkRepo.GetEntryIDs(ctx, []domain.EntityID{goal.EntityID})
+	if err != nil {
+		return err
+	}
+	for _, id := range links[goal.EntityID] {
+		if id == entryID {
+			return s.linkRepo.UnlinkEntry(ctx, goal.EntityID, entryID)
+		}
+	}
+	return domain.Invalidf("entry %d is not linked to goal %d", entryID, goalID)
+}
+
+func (s *GoalService) linkableGoal(ctx context.Context, goalID int64) (*domain.Goal, error) {
+	if s.linkRepo == nil || s.entryRepo == nil {
+		return nil, fmt.Errorf("goal links are not available")
+	}
+
+	goal, err := s.goalRepo.GetByID(ctx, goalID)
+	if err != nil {
+		return nil, err
+	}
+	if goal == nil {
+		return nil, fmt.Errorf("goal %w: %d", domain.ErrNotFound, goalID)
+	}
+	return goal, nil
+}
+
+// GetGoalTasks returns the journal tasks linked to each goal, keyed by goal
+// entity. Migrated copies of a task are left out in favour of the task they
+// were migrated to.
+func (s *GoalService) GetGoalTasks(ctx context.Context, goals []domain.Goal) (map[domain.EntityID][]domain.Entry, error) {
+	result := make(map[domain.EntityID][]domain.Entry)
+	if s.linkRepo == nil || s.entryRepo == nil || len(goals) == 0 {
+		return result, nil
+	}
+
+	entityIDs := make([]domain.EntityID, len(goals))
+	for i, goal := range goals {
+		entityIDs[i] = goal.EntityID
+	}
+
+	links, err := s.linkRepo.GetEntryIDs(ctx, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make(map[int64]*domain.Entry)
+	for goalEntityID, entryIDs := range links {
+		for _, id := range entryIDs {
+			entry, ok := entries[id]
+			if !ok {
+				entry, err = s.entryRepo.GetByID(ctx, id)
+				if err != nil {
+					return nil, err
+				}
+				entries[id] = entry
+			}
+			if entry == nil || entry.Type == domain.EntryTypeMigrated {
+				continue
+			}
+			result[goalEntityID] = append(result[goalEntityID], *entry)
+		}
+	}
+
+	return result, nil
+}
+
+func (s *GoalService) GetGoalProgress(ctx context.Context, goalID int64) (domain.GoalProgress, error) {
+	goal, err := s.goalRepo.GetByID(ctx, goalID)
+	if err != nil {
+		return domain.GoalProgress{}, err
+	}
+	if goal == nil {
+		return domain.GoalProgress{}, fmt.Errorf("goal %w: %d", domain.ErrNotFound, goalID)
+	}
+
+	tasks, err := s.GetGoalTasks(ctx, []domain.Goal{*goal})
+	if err != nil {
+		return domain.GoalProgress{}, err
+	}
+	return domain.CalculateGoalProgress(tasks[goal.EntityID]), nil
+}
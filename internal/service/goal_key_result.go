@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// WithKeyResults lets goals carry measurable key results, from which their
+// percentage completion is derived.
+func (s *GoalService) WithKeyResults(krRepo domain.KeyResultRepository) *GoalService {
+	s.krRepo = krRepo
+	return s
+}
+
+// KeyResultOptions describes a new key result. It starts out at Start.
+type KeyResultOptions struct {
+	Name   string
+	Start  float64
+	Target float64
+	Unit   string
+}
+
+func (s *GoalService) AddKeyResult(ctx context.Context, goalID int64, opts KeyResultOptions) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Add key result to goal %d", goalID))
+	defer end()
+
+	if s.krRepo == nil {
+		return 0, fmt.Errorf("key results are not available")
+	}
+
+	goal, err := s.goalRepo.GetByID(ctx, goalID)
+	if err != nil {
+		return 0, err
+	}
+	if goal == nil {
+		return 0, fmt.Errorf("goal %w: %d", domain.ErrNotFound, goalID)
+	}
+
+	kr := domain.KeyResult{
+		GoalEntityID: goal.EntityID,
+		Name:         opts.Name,
+		Start:        opts.Start,
+		Target:       opts.Target,
+		Current:      opts.Start,
+		Unit:         opts.Unit,
+		CreatedAt:    time.Now(),
+	}
+	if err := kr.Validate(); err != nil {
+		return 0, err
+	}
+
+	return s.krRepo.Insert(ctx, kr)
+}
+
+// UpdateKeyResultOptions changes a key result. Only the fields that are set
+// change; a new Current value is recorded as a check-in.
+type UpdateKeyResultOptions struct {
+	Name    *string
+	Current *float64
+	Target  *float64
+	At      time.Time
+}
+
+func (s *GoalService) UpdateKeyResult(ctx context.Context, id int64, opts UpdateKeyResultOptions) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Update key result %d", id))
+	defer end()
+
+	kr, err := s.keyResult(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if opts.Name != nil {
+		kr.Name = *opts.Name
+	}
+	if opts.Target != nil {
+		kr.Target = *opts.Target
+	}
+	if opts.Current != nil {
+		kr.Current = *opts.Current
+	}
+	if err := kr.Validate(); err != nil {
+		return err
+	}
+
+	if err := s.krRepo.Update(ctx, *kr); err != nil {
+		return err
+	}
+
+	if opts.Current == nil {
+		return nil
+	}
+	checkedAt := opts.At
+	if checkedAt.IsZero() {
+		checkedAt = time.Now()
+	}
+	_, err = s.krRepo.InsertCheckIn(ctx, domain.KeyResultCheckIn{
+		KeyResultEntityID: kr.EntityID,
+		Value:             *opts.Current,
+		CheckedAt:         checkedAt,
+	})
+	return err
+}
+
+func (s *GoalService) DeleteKeyResult(ctx context.Context, id int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete key result %d", id))
+	defer end()
+
+	if _, err := s.keyResult(ctx, id); err != nil {
+		return err
+	}
+	return s.krRepo.Delete(ctx, id)
+}
+
+func (s *GoalService) GetKeyResult(ctx context.Context, id int64) (*domain.KeyResult, error) {
+	return s.keyResult(ctx, id)
+}
+
+// GetKeyResults returns the key results of each goal, keyed by goal entity.
+func (s *GoalService) GetKeyResults(ctx context.Context, goals []domain.Goal) (map[domain.EntityID][]domain.KeyResult, error) {
+	if s.krRepo == nil || len(goals) == 0 {
+		return make(map[domain.EntityID][]domain.KeyResult), nil
+	}
+
+	entityIDs := make([]domain.EntityID, len(goals))
+	for i, goal := range goals {
+		entityIDs[i] = goal.EntityID
+	}
+	return s.krRepo.GetByGoals(ctx, entityIDs)
+}
+
+// GetKeyResultHistory returns the check-ins of a key result, oldest first.
+func (s *GoalService) GetKeyResultHistory(ctx context.Context, id int64) ([]domain.KeyResultCheckIn, error) {
+	kr, err := s.keyResult(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.krRepo.GetCheckIns(ctx, kr.EntityID)
+}
+
+func (s *GoalService) keyResult(ctx context.Context, id int64) (*domain.KeyResult, error) {
+	if s.krRepo == nil {
+		return nil, fmt.Errorf("key results are not available")
+	}
+
+	kr, err := s.krRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if kr == nil {
+		return nil, fmt.Errorf("key result %w: %d", domain.ErrNotFound, id)
+	}
+	return kr, nil
+}
+
+// moveKeyResults hands a migrated goal's key results, with their history,
+// to the goal it was migrated to.
+func (s *GoalService) moveKeyResults(ctx context.Context, from, to domain.EntityID) error {
+	if s.krRepo == nil {
+		return nil
+	}
+
+	byGoal, err := s.krRepo.GetByGoals(ctx, []domain.EntityID{from})
+	if err != nil {
+		return err
+	}
+	for _, kr := range byGoal[from] {
+		kr.GoalEntityID = to
+		if err := s.krRepo.Update(ctx, kr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
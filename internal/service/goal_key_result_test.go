@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+func setupGoalServiceWithKeyResults(t *testing.T) *GoalService {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return NewGoalService(sqlite.NewGoalRepository(db)).WithKeyResults(sqlite.NewKeyResultRepository(db))
+}
+
+func TestGoalService_AddKeyResult(t *testing.T) {
+	service := setupGoalServiceWithKeyResults(t)
+	ctx := context.Background()
+	month := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, err := service.CreateGoal(ctx, "Grow the newsletter", month)
+	require.NoError(t, err)
+
+	krID, err := service.AddKeyResult(ctx, goalID, KeyResultOptions{Name: "Subscribers", Start: 200, Target: 1000, Unit: "subscribers"})
+	require.NoError(t, err)
+
+	kr, err := service.GetKeyResult(ctx, krID)
+	require.NoError(t, err)
+	assert.Equal(t, 200.0, kr.Current, "a new key result starts at its start value")
+
+	_, err = service.AddKeyResult(ctx, goalID, KeyResultOptions{Name: "Flat", Start: 5, Target: 5})
+	assert.Error(t, err)
+
+	_, err = service.AddKeyResult(ctx, 999, KeyResultOptions{Name: "Orphan", Target: 5})
+	assert.Error(t, err)
+}
+
+func TestGoalService_UpdateKeyResult_RecordsCheckIns(t *testing.T) {
+	service := setupGoalServiceWithKeyResults(t)
+	ctx := context.Background()
+	month := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, err := service.CreateGoal(ctx, "Grow the newsletter", month)
+	require.NoError(t, err)
+	krID, err := service.AddKeyResult(ctx, goalID, KeyResultOptions{Name: "Subscribers", Target: 1000})
+	require.NoError(t, err)
+
+	first, second := 300.0, 600.0
+	require.NoError(t, service.UpdateKeyResult(ctx, krID, UpdateKeyResultOptions{Current: &first, At: month}))
+	require.NoError(t, service.UpdateKeyResult(ctx, krID, UpdateKeyResultOptions{Current: &second, At: month.AddDate(0, 0, 7)}))
+
+	target := 1200.0
+	require.NoError(t, service.UpdateKeyResult(ctx, krID, UpdateKeyResultOptions{Target: &target}))
+
+	history, err := service.GetKeyResultHistory(ctx, krID)
+	require.NoError(t, err)
+	require.Len(t, history, 2, "changing only the target is not a check-in")
+	assert.Equal(t, 300.0, history[0].Value)
+	assert.Equal(t, 600.0, history[1].Value)
+
+	goal, err := service.GetGoal(ctx, goalID)
+	require.NoError(t, err)
+	krs, err := service.GetKeyResults(ctx, []domain.Goal{*goal})
+	require.NoError(t, err)
+	require.Len(t, krs[goal.EntityID], 1)
+	assert.InDelta(t, 0.5, domain.KeyResultsProgress(krs[goal.EntityID]), 0.0001)
+}
+
+func TestGoalService_DeleteKeyResult(t *testing.T) {
+	service := setupGoalServiceWithKeyResults(t)
+	ctx := context.Background()
+
+	goalID, err := service.CreateGoal(ctx, "Ship", time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	krID, err := service.AddKeyResult(ctx, goalID, KeyResultOptions{Name: "Releases", Target: 3})
+	require.NoError(t, err)
+
+	require.NoError(t, service.DeleteKeyResult(ctx, krID))
+	assert.Error(t, service.DeleteKeyResult(ctx, krID))
+}
+
+func TestGoalService_MigrateGoal_MovesKeyResults(t *testing.T) {
+	service := setupGoalServiceWithKeyResults(t)
+	ctx := context.Background()
+	july := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, err := service.CreateGoal(ctx, "Grow the newsletter", july)
+	require.NoError(t, err)
+	krID, err := service.AddKeyResult(ctx, goalID, KeyResultOptions{Name: "Subscribers", Target: 1000})
+	require.NoError(t, err)
+	current := 400.0
+	require.NoError(t, service.UpdateKeyResult(ctx, krID, UpdateKeyResultOptions{Current: &current}))
+
+	newID, err := service.MigrateGoal(ctx, goalID, july.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	newGoal, err := service.GetGoal(ctx, newID)
+	require.NoError(t, err)
+	krs, err := service.GetKeyResults(ctx, []domain.Goal{*newGoal})
+	require.NoError(t, err)
+	require.Len(t, krs[newGoal.EntityID], 1)
+	assert.Equal(t, 400.0, krs[newGoal.EntityID][0].Current)
+
+	history, err := service.GetKeyResultHistory(ctx, krID)
+	require.NoError(t, err)
+	assert.Len(t, history, 1)
+}
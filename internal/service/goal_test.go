@@ -22,6 +22,20 @@ func setupGoalService(t *testing.T) *GoalService {
 	return NewGoalService(goalRepo)
 }
 
+func setupGoalServiceWithLinks(t *testing.T) (*GoalService, *BujoService) {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	linkRepo := sqlite.NewGoalLinkRepository(db)
+	goalService := NewGoalService(sqlite.NewGoalRepository(db)).WithEntryLinks(linkRepo, entryRepo)
+	bujoService := NewBujoService(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser()).WithGoalLinks(linkRepo)
+
+	return goalService, bujoService
+}
+
 func TestGoalService_CreateGoal(t *testing.T) {
 	service := setupGoalService(t)
 	ctx := context.Background()
@@ -215,3 +229,228 @@ func TestGoalService_UncancelGoal(t *testing.T) {
 	assert.False(t, goal.IsCancelled())
 	assert.Equal(t, domain.GoalStatusActive, goal.Status)
 }
+
+func TestGoalService_LinkEntry(t *testing.T) {
+	service, bujo := setupGoalServiceWithLinks(t)
+	ctx := context.Background()
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, _ := service.CreateGoal(ctx, "Write the novel", jan)
+	ids, err := bujo.LogEntries(ctx, ". Draft chapter one\n. Draft chapter two", LogEntriesOptions{Date: jan})
+	require.NoError(t, err)
+
+	for _, id := range ids {
+		require.NoError(t, service.LinkEntry(ctx, goalID, id))
+	}
+	require.NoError(t, bujo.MarkDone(ctx, ids[0]))
+
+	progress, err := service.GetGoalProgress(ctx, goalID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.GoalProgress{Done: 1, Total: 2}, progress)
+}
+
+func TestGoalService_LinkEntry_OnlyTasks(t *testing.T) {
+	service, bujo := setupGoalServiceWithLinks(t)
+	ctx := context.Background()
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, _ := service.CreateGoal(ctx, "Write the novel", jan)
+	ids, err := bujo.LogEntries(ctx, "- Publishers want a synopsis", LogEntriesOptions{Date: jan})
+	require.NoError(t, err)
+
+	err = service.LinkEntry(ctx, goalID, ids[0])
+
+	assert.ErrorContains(t, err, "only tasks can be linked")
+}
+
+func TestGoalService_LinkEntry_GoalNotFound(t *testing.T) {
+	service, bujo := setupGoalServiceWithLinks(t)
+	ctx := context.Background()
+
+	ids, err := bujo.LogEntries(ctx, ". Draft chapter one", LogEntriesOptions{Date: time.Now()})
+	require.NoError(t, err)
+
+	err = service.LinkEntry(ctx, 999, ids[0])
+
+	assert.ErrorContains(t, err, "goal not found")
+}
+
+func TestGoalService_UnlinkEntry(t *testing.T) {
+	service, bujo := setupGoalServiceWithLinks(t)
+	ctx := context.Background()
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, _ := service.CreateGoal(ctx, "Write the novel", jan)
+	ids, err := bujo.LogEntries(ctx, ". Draft chapter one", LogEntriesOptions{Date: jan})
+	require.NoError(t, err)
+	require.NoError(t, service.LinkEntry(ctx, goalID, ids[0]))
+
+	require.NoError(t, service.UnlinkEntry(ctx, goalID, ids[0]))
+
+	progress, err := service.GetGoalProgress(ctx, goalID)
+	require.NoError(t, err)
+	assert.Equal(t, 0, progress.Total)
+	assert.ErrorContains(t, service.UnlinkEntry(ctx, goalID, ids[0]), "not linked")
+}
+
+func TestGoalService_LinksFollowMigration(t *testing.T) {
+	service, bujo := setupGoalServiceWithLinks(t)
+	ctx := context.Background()
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	goalID, _ := service.CreateGoal(ctx, "Write the novel", jan)
+	ids, err := bujo.LogEntries(ctx, ". Draft chapter one", LogEntriesOptions{Date: jan})
+	require.NoError(t, err)
+	require.NoError(t, service.LinkEntry(ctx, goalID, ids[0]))
+
+	newEntryID, err := bujo.MigrateEntry(ctx, ids[0], jan.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	newGoalID, err := service.MigrateGoal(ctx, goalID, feb)
+	require.NoError(t, err)
+
+	newGoal, err := service.GetGoal(ctx, newGoalID)
+	require.NoError(t, err)
+	tasks, err := service.GetGoalTasks(ctx, []domain.Goal{*newGoal})
+	require.NoError(t, err)
+	require.Len(t, tasks[newGoal.EntityID], 1)
+	assert.Equal(t, newEntryID, tasks[newGoal.EntityID][0].ID)
+}
+
+func TestGoalService_CreateGoalWithOptions_Cascade(t *testing.T) {
+	service := setupGoalService(t)
+	ctx := context.Background()
+	aug := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	yearID, err := service.CreateGoalWithOptions(ctx, "Grow revenue", CreateGoalOptions{Horizon: domain.GoalHorizonYear, Period: aug})
+	require.NoError(t, err)
+	quarterID, err := service.CreateGoalWithOptions(ctx, "Launch v2", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: aug, ParentID: yearID})
+	require.NoError(t, err)
+	monthID, err := service.CreateGoalWithOptions(ctx, "Ship the beta", CreateGoalOptions{Horizon: domain.GoalHorizonMonth, Period: aug, ParentID: quarterID})
+	require.NoError(t, err)
+
+	quarter, _ := service.GetGoal(ctx, quarterID)
+	assert.Equal(t, "2026-07", quarter.MonthKey())
+	month, _ := service.GetGoal(ctx, monthID)
+	require.NotNil(t, month.ParentEntityID)
+	assert.Equal(t, quarter.EntityID, *month.ParentEntityID)
+
+	_, err = service.CreateGoalWithOptions(ctx, "Plan Q4", CreateGoalOptions{Horizon: domain.GoalHorizonMonth, Period: aug.AddDate(0, 2, 0), ParentID: quarterID})
+	assert.ErrorContains(t, err, "not within")
+
+	monthGoals, err := service.GetGoalsForMonth(ctx, aug)
+	require.NoError(t, err)
+	assert.Len(t, monthGoals, 1, "monthly listings only hold monthly goals")
+}
+
+func TestGoalService_GetGoalTree(t *testing.T) {
+	service := setupGoalService(t)
+	ctx := context.Background()
+	jul := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+	aug := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	yearID, _ := service.CreateGoalWithOptions(ctx, "Grow revenue", CreateGoalOptions{Horizon: domain.GoalHorizonYear, Period: aug})
+	quarterID, _ := service.CreateGoalWithOptions(ctx, "Launch v2", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: aug, ParentID: yearID})
+	julyID, _ := service.CreateGoalWithOptions(ctx, "Finish the design", CreateGoalOptions{Period: jul, ParentID: quarterID})
+	augustID, _ := service.CreateGoalWithOptions(ctx, "Ship the beta", CreateGoalOptions{Period: aug, ParentID: quarterID})
+	_, _ = service.CreateGoal(ctx, "Read 2 books", aug)
+	require.NoError(t, service.MarkDone(ctx, julyID))
+
+	tree, err := service.GetGoalTree(ctx, domain.GoalHorizonMonth, aug)
+	require.NoError(t, err)
+	require.Len(t, tree, 2)
+	assert.Equal(t, "Grow revenue", tree[0].Goal.Content)
+	require.Len(t, tree[0].Children, 1)
+	quarter := tree[0].Children[0]
+	assert.Equal(t, "Launch v2", quarter.Goal.Content)
+	require.Len(t, quarter.Children, 1, "only August's goals are in August's tree")
+	assert.Equal(t, augustID, quarter.Children[0].Goal.ID)
+	assert.Equal(t, 2, quarter.Children[0].Depth)
+	assert.Equal(t, "Read 2 books", tree[1].Goal.Content)
+
+	quarterTree, err := service.GetGoalTree(ctx, domain.GoalHorizonQuarter, aug)
+	require.NoError(t, err)
+	launch := quarterTree[0].Children[0]
+	assert.Len(t, launch.Children, 2)
+	assert.Equal(t, domain.GoalProgress{Done: 1, Total: 2}, launch.Progress())
+
+	flat := FlattenGoalTree(quarterTree)
+	assert.Len(t, flat, 5)
+}
+
+func TestGoalService_MigrateUnfinishedGoals(t *testing.T) {
+	service := setupGoalService(t)
+	ctx := context.Background()
+	q3 := time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC)
+
+	yearID, _ := service.CreateGoalWithOptions(ctx, "Grow revenue", CreateGoalOptions{Horizon: domain.GoalHorizonYear, Period: q3})
+	openID, _ := service.CreateGoalWithOptions(ctx, "Launch v2", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: q3, ParentID: yearID})
+	doneID, _ := service.CreateGoalWithOptions(ctx, "Hire a designer", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: q3})
+	require.NoError(t, service.MarkDone(ctx, doneID))
+
+	ids, err := service.MigrateUnfinishedGoals(ctx, domain.GoalHorizonQuarter, q3)
+	require.NoError(t, err)
+	require.Len(t, ids, 1)
+
+	original, _ := service.GetGoal(ctx, openID)
+	assert.True(t, original.IsMigrated())
+
+	migrated, _ := service.GetGoal(ctx, ids[0])
+	assert.Equal(t, domain.GoalHorizonQuarter, migrated.Horizon)
+	assert.Equal(t, "Q4 2026", migrated.PeriodLabel())
+	require.NotNil(t, migrated.ParentEntityID, "the yearly parent still contains Q4")
+	assert.Equal(t, *original.ParentEntityID, *migrated.ParentEntityID)
+
+	done, _ := service.GetGoal(ctx, doneID)
+	assert.True(t, done.IsDone())
+}
+
+func TestGoalService_MigrateGoal_ReparentsActiveChildren(t *testing.T) {
+	service := setupGoalService(t)
+	ctx := context.Background()
+	jan := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	quarterID, _ := service.CreateGoalWithOptions(ctx, "Launch v2", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: jan})
+	openID, _ := service.CreateGoalWithOptions(ctx, "Ship the beta", CreateGoalOptions{Period: feb, ParentID: quarterID})
+	doneID, _ := service.CreateGoalWithOptions(ctx, "Write the spec", CreateGoalOptions{Period: jan, ParentID: quarterID})
+	require.NoError(t, service.MarkDone(ctx, doneID))
+
+	newQuarterID, err := service.MigrateGoal(ctx, quarterID, time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	original, _ := service.GetGoal(ctx, quarterID)
+	newQuarter, _ := service.GetGoal(ctx, newQuarterID)
+
+	open, _ := service.GetGoal(ctx, openID)
+	require.NotNil(t, open.ParentEntityID)
+	assert.Equal(t, newQuarter.EntityID, *open.ParentEntityID)
+
+	done, _ := service.GetGoal(ctx, doneID)
+	require.NotNil(t, done.ParentEntityID)
+	assert.Equal(t, original.EntityID, *done.ParentEntityID)
+
+	movedID, err := service.MigrateGoal(ctx, openID, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	moved, _ := service.GetGoal(ctx, movedID)
+	require.NotNil(t, moved.ParentEntityID, "the new quarter contains May")
+	assert.Equal(t, newQuarter.EntityID, *moved.ParentEntityID)
+}
+
+func TestGoalService_SetGoalParent(t *testing.T) {
+	service := setupGoalService(t)
+	ctx := context.Background()
+	aug := time.Date(2026, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	quarterID, _ := service.CreateGoalWithOptions(ctx, "Launch v2", CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: aug})
+	monthID, _ := service.CreateGoal(ctx, "Ship the beta", aug)
+
+	require.NoError(t, service.SetGoalParent(ctx, monthID, quarterID))
+	goal, _ := service.GetGoal(ctx, monthID)
+	require.NotNil(t, goal.ParentEntityID)
+
+	require.NoError(t, service.SetGoalParent(ctx, monthID, 0))
+	goal, _ = service.GetGoal(ctx, monthID)
+	assert.Nil(t, goal.ParentEntityID)
+
+	assert.ErrorContains(t, service.SetGoalParent(ctx, quarterID, monthID), "cannot be the parent")
+}
@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// GoalNode is a goal in a tree of goals, with the goals cascading from it.
+// Depth is 0 for the goals at the root of the tree.
+type GoalNode struct {
+	Goal     domain.Goal
+	Depth    int
+	Children []GoalNode
+}
+
+// Progress rolls the status of the goal's children up into the goal.
+func (n GoalNode) Progress() domain.GoalProgress {
+	children := make([]domain.Goal, len(n.Children))
+	for i, child := range n.Children {
+		children[i] = child.Goal
+	}
+	return domain.RollUpGoals(children)
+}
+
+// GetGoalTree returns the goals of every horizon whose period overlaps the
+// given one, nested under their parents: for a month, that is the year's
+// goals, the quarter's goals and the month's goals. A goal whose parent is
+// outside the period sits at the root.
+func (s *GoalService) GetGoalTree(ctx context.Context, horizon domain.GoalHorizon, period time.Time) ([]GoalNode, error) {
+	start := domain.GoalPeriodStart(horizon, period)
+	end := domain.GoalPeriodEnd(horizon, start)
+	yearStart := domain.GoalPeriodStart(domain.GoalHorizonYear, start)
+
+	goals, err := s.goalRepo.GetInRange(ctx, yearStart, domain.GoalPeriodEnd(domain.GoalHorizonYear, yearStart))
+	if err != nil {
+		return nil, err
+	}
+
+	var included []domain.Goal
+	byEntity := make(map[domain.EntityID]bool)
+	for _, goal := range goals {
+		if goal.Overlaps(start, end) {
+			included = append(included, goal)
+			byEntity[goal.EntityID] = true
+		}
+	}
+	sort.SliceStable(included, func(i, j int) bool {
+		return included[i].GetHorizon().Rank() < included[j].GetHorizon().Rank()
+	})
+
+	var roots []domain.Goal
+	children := make(map[domain.EntityID][]domain.Goal)
+	for _, goal := range included {
+		if goal.ParentEntityID != nil && byEntity[*goal.ParentEntityID] {
+			children[*goal.ParentEntityID] = append(children[*goal.ParentEntityID], goal)
+			continue
+		}
+		roots = append(roots, goal)
+	}
+
+	return buildGoalNodes(roots, children, 0), nil
+}
+
+func buildGoalNodes(goals []domain.Goal, children map[domain.EntityID][]domain.Goal, depth int) []GoalNode {
+	nodes := make([]GoalNode, len(goals))
+	for i, goal := range goals {
+		nodes[i] = GoalNode{
+			Goal:     goal,
+			Depth:    depth,
+			Children: buildGoalNodes(children[goal.EntityID], children, depth+1),
+		}
+	}
+	return nodes
+}
+
+// FlattenGoalTree lists the nodes of a goal tree in display order, each
+// parent followed by its children.
+func FlattenGoalTree(nodes []GoalNode) []GoalNode {
+	var flat []GoalNode
+	for _, node := range nodes {
+		flat = append(flat, node)
+		flat = append(flat, FlattenGoalTree(node.Children)...)
+	}
+	return flat
+}
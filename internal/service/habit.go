@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"math"
+	"strings"
 	"time"
 
 	"github.com/typingincolor/bujo/internal/domain"
@@ -11,6 +13,8 @@ import (
 type HabitService struct {
 	habitRepo domain.HabitRepository
 	logRepo   domain.HabitLogRepository
+	pauseRepo domain.HabitPauseRepository
+	journal   *OperationJournal
 }
 
 func NewHabitService(habitRepo domain.HabitRepository, logRepo domain.HabitLogRepository) *HabitService {
@@ -20,13 +24,25 @@ func NewHabitService(habitRepo domain.HabitRepository, logRepo domain.HabitLogRe
 	}
 }
 
+func (s *HabitService) WithOperationJournal(journal *OperationJournal) *HabitService {
+	s.journal = journal
+	return s
+}
+
+// WithPauseRepository enables habit pauses and skips. Without it, habits
+// are never paused.
+func (s *HabitService) WithPauseRepository(pauseRepo domain.HabitPauseRepository) *HabitService {
+	s.pauseRepo = pauseRepo
+	return s
+}
+
 func (s *HabitService) getHabitByID(ctx context.Context, id int64) (*domain.Habit, error) {
 	habit, err := s.habitRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if habit == nil {
-		return nil, fmt.Errorf("habit not found: %d", id)
+		return nil, fmt.Errorf("habit %w: %d", domain.ErrNotFound, id)
 	}
 	return habit, nil
 }
@@ -37,7 +53,7 @@ func (s *HabitService) getHabitByName(ctx context.Context, name string) (*domain
 		return nil, err
 	}
 	if habit == nil {
-		return nil, fmt.Errorf("habit not found: %s", name)
+		return nil, fmt.Errorf("habit %w: %s", domain.ErrNotFound, name)
 	}
 	return habit, nil
 }
@@ -51,6 +67,9 @@ func (s *HabitService) HabitExists(ctx context.Context, name string) (bool, erro
 }
 
 func (s *HabitService) CreateHabit(ctx context.Context, name string) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Create habit %s", name))
+	defer end()
+
 	habit, err := s.habitRepo.GetOrCreate(ctx, name, 1)
 	if err != nil {
 		return 0, err
@@ -63,20 +82,15 @@ func (s *HabitService) LogHabit(ctx context.Context, name string, count int) err
 }
 
 func (s *HabitService) LogHabitForDate(ctx context.Context, name string, count int, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Log habit %s", name))
+	defer end()
+
 	habit, err := s.habitRepo.GetOrCreate(ctx, name, 1)
 	if err != nil {
 		return err
 	}
 
-	log := domain.HabitLog{
-		HabitID:       habit.ID,
-		HabitEntityID: habit.EntityID,
-		Count:         count,
-		LoggedAt:      date,
-	}
-
-	_, err = s.logRepo.Insert(ctx, log)
-	return err
+	return s.insertCountLog(ctx, habit, count, date)
 }
 
 func (s *HabitService) LogHabitByID(ctx context.Context, habitID int64, count int) error {
@@ -84,11 +98,68 @@ func (s *HabitService) LogHabitByID(ctx context.Context, habitID int64, count in
 }
 
 func (s *HabitService) LogHabitByIDForDate(ctx context.Context, habitID int64, count int, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Log habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+
+	return s.insertCountLog(ctx, habit, count, date)
+}
+
+// LogHabitValueForDate logs a measured amount such as 5.2 km. A habit that
+// does not exist yet is created as a measured habit in the given unit.
+func (s *HabitService) LogHabitValueForDate(ctx context.Context, name string, value float64, unit string, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Log habit %s", name))
+	defer end()
+
+	habit, err := s.habitRepo.GetByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	if habit == nil {
+		habit = &domain.Habit{
+			Name:       name,
+			GoalPerDay: 1,
+			Kind:       domain.HabitKindMeasured,
+			Unit:       unit,
+			TargetType: domain.HabitTargetAtLeast,
+			CreatedAt:  time.Now(),
+			EntityID:   domain.NewEntityID(),
+		}
+		habit.ID, err = s.habitRepo.Insert(ctx, *habit)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.insertValueLog(ctx, habit, value, unit, date)
+}
+
+func (s *HabitService) LogHabitValueByIDForDate(ctx context.Context, habitID int64, value float64, unit string, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Log habit %d", habitID))
+	defer end()
+
 	habit, err := s.getHabitByID(ctx, habitID)
 	if err != nil {
 		return err
 	}
 
+	return s.insertValueLog(ctx, habit, value, unit, date)
+}
+
+// insertCountLog logs count completions. For a measured habit the count is
+// taken as the measured value.
+func (s *HabitService) insertCountLog(ctx context.Context, habit *domain.Habit, count int, date time.Time) error {
+	if habit.IsArchived() {
+		return domain.Invalidf("habit %s is archived; unarchive it to log it", habit.Name)
+	}
+	if habit.IsMeasured() {
+		return s.insertValueLog(ctx, habit, float64(count), "", date)
+	}
+
 	log := domain.HabitLog{
 		HabitID:       habit.ID,
 		HabitEntityID: habit.EntityID,
@@ -96,11 +167,43 @@ func (s *HabitService) LogHabitByIDForDate(ctx context.Context, habitID int64, c
 		LoggedAt:      date,
 	}
 
-	_, err = s.logRepo.Insert(ctx, log)
+	_, err := s.logRepo.Insert(ctx, log)
+	return err
+}
+
+func (s *HabitService) insertValueLog(ctx context.Context, habit *domain.Habit, value float64, unit string, date time.Time) error {
+	if habit.IsArchived() {
+		return domain.Invalidf("habit %s is archived; unarchive it to log it", habit.Name)
+	}
+	if !habit.IsMeasured() {
+		if unit != "" || value != math.Trunc(value) {
+			return domain.Invalidf("habit %s counts completions; set a target with a unit to log amounts", habit.Name)
+		}
+		return s.insertCountLog(ctx, habit, int(value), date)
+	}
+	if unit != "" && habit.Unit != "" && !strings.EqualFold(unit, habit.Unit) {
+		return domain.Invalidf("habit %s is measured in %s, not %s", habit.Name, habit.Unit, unit)
+	}
+
+	log := domain.HabitLog{
+		HabitID:       habit.ID,
+		HabitEntityID: habit.EntityID,
+		Count:         1,
+		Value:         value,
+		LoggedAt:      date,
+	}
+	if err := log.Validate(); err != nil {
+		return err
+	}
+
+	_, err := s.logRepo.Insert(ctx, log)
 	return err
 }
 
 func (s *HabitService) UndoLastLog(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Undo last log of habit %s", name))
+	defer end()
+
 	habit, err := s.getHabitByName(ctx, name)
 	if err != nil {
 		return err
@@ -110,6 +213,9 @@ func (s *HabitService) UndoLastLog(ctx context.Context, name string) error {
 }
 
 func (s *HabitService) UndoLastLogByID(ctx context.Context, habitID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Undo last log of habit %d", habitID))
+	defer end()
+
 	if _, err := s.getHabitByID(ctx, habitID); err != nil {
 		return err
 	}
@@ -123,25 +229,31 @@ func (s *HabitService) undoLastLogForHabit(ctx context.Context, habitID int64) e
 		return err
 	}
 	if lastLog == nil {
-		return fmt.Errorf("no logs to undo")
+		return domain.Invalidf("no logs to undo")
 	}
 
 	return s.logRepo.Delete(ctx, lastLog.ID)
 }
 
 func (s *HabitService) DeleteLog(ctx context.Context, logID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete habit log %d", logID))
+	defer end()
+
 	log, err := s.logRepo.GetByID(ctx, logID)
 	if err != nil {
 		return err
 	}
 	if log == nil {
-		return fmt.Errorf("log not found: %d", logID)
+		return fmt.Errorf("log %w: %d", domain.ErrNotFound, logID)
 	}
 
 	return s.logRepo.Delete(ctx, logID)
 }
 
 func (s *HabitService) RemoveHabitLogForDateByID(ctx context.Context, habitID int64, date time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Remove log of habit %d for %s", habitID, date.Format("2006-01-02")))
+	defer end()
+
 	habit, err := s.getHabitByID(ctx, habitID)
 	if err != nil {
 		return err
@@ -157,7 +269,7 @@ func (s *HabitService) RemoveHabitLogForDateByID(ctx context.Context, habitID in
 
 	dayLogs := domain.GetLogsForDay(logs, date)
 	if len(dayLogs) == 0 {
-		return fmt.Errorf("no logs to remove for this date")
+		return domain.Invalidf("no logs to remove for this date")
 	}
 
 	latestLog := dayLogs[0]
@@ -171,6 +283,9 @@ func (s *HabitService) RemoveHabitLogForDateByID(ctx context.Context, habitID in
 }
 
 func (s *HabitService) DeleteHabit(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete habit %s", name))
+	defer end()
+
 	habit, err := s.getHabitByName(ctx, name)
 	if err != nil {
 		return err
@@ -180,6 +295,9 @@ func (s *HabitService) DeleteHabit(ctx context.Context, name string) error {
 }
 
 func (s *HabitService) DeleteHabitByID(ctx context.Context, habitID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete habit %d", habitID))
+	defer end()
+
 	habit, err := s.getHabitByID(ctx, habitID)
 	if err != nil {
 		return err
@@ -189,6 +307,9 @@ func (s *HabitService) DeleteHabitByID(ctx context.Context, habitID int64) error
 }
 
 func (s *HabitService) RenameHabit(ctx context.Context, oldName, newName string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Rename habit %s to %s", oldName, newName))
+	defer end()
+
 	habit, err := s.getHabitByName(ctx, oldName)
 	if err != nil {
 		return err
@@ -199,6 +320,9 @@ func (s *HabitService) RenameHabit(ctx context.Context, oldName, newName string)
 }
 
 func (s *HabitService) RenameHabitByID(ctx context.Context, habitID int64, newName string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Rename habit %d to %s", habitID, newName))
+	defer end()
+
 	habit, err := s.getHabitByID(ctx, habitID)
 	if err != nil {
 		return err
@@ -209,8 +333,11 @@ func (s *HabitService) RenameHabitByID(ctx context.Context, habitID int64, newNa
 }
 
 func (s *HabitService) SetHabitGoal(ctx context.Context, name string, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set daily goal of habit %s", name))
+	defer end()
+
 	if goal < 1 {
-		return fmt.Errorf("goal must be at least 1")
+		return domain.Invalidf("goal must be at least 1")
 	}
 
 	habit, err := s.getHabitByName(ctx, name)
@@ -223,8 +350,11 @@ func (s *HabitService) SetHabitGoal(ctx context.Context, name string, goal int)
 }
 
 func (s *HabitService) SetHabitGoalByID(ctx context.Context, habitID int64, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set daily goal of habit %d", habitID))
+	defer end()
+
 	if goal < 1 {
-		return fmt.Errorf("goal must be at least 1")
+		return domain.Invalidf("goal must be at least 1")
 	}
 
 	habit, err := s.getHabitByID(ctx, habitID)
@@ -237,8 +367,11 @@ func (s *HabitService) SetHabitGoalByID(ctx context.Context, habitID int64, goal
 }
 
 func (s *HabitService) SetHabitWeeklyGoal(ctx context.Context, name string, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set weekly goal of habit %s", name))
+	defer end()
+
 	if goal < 0 {
-		return fmt.Errorf("weekly goal cannot be negative")
+		return domain.Invalidf("weekly goal cannot be negative")
 	}
 
 	habit, err := s.getHabitByName(ctx, name)
@@ -251,8 +384,11 @@ func (s *HabitService) SetHabitWeeklyGoal(ctx context.Context, name string, goal
 }
 
 func (s *HabitService) SetHabitWeeklyGoalByID(ctx context.Context, habitID int64, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set weekly goal of habit %d", habitID))
+	defer end()
+
 	if goal < 0 {
-		return fmt.Errorf("weekly goal cannot be negative")
+		return domain.Invalidf("weekly goal cannot be negative")
 	}
 
 	habit, err := s.getHabitByID(ctx, habitID)
@@ -265,8 +401,11 @@ func (s *HabitService) SetHabitWeeklyGoalByID(ctx context.Context, habitID int64
 }
 
 func (s *HabitService) SetHabitMonthlyGoal(ctx context.Context, name string, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set monthly goal of habit %s", name))
+	defer end()
+
 	if goal < 0 {
-		return fmt.Errorf("monthly goal cannot be negative")
+		return domain.Invalidf("monthly goal cannot be negative")
 	}
 
 	habit, err := s.getHabitByName(ctx, name)
@@ -279,8 +418,11 @@ func (s *HabitService) SetHabitMonthlyGoal(ctx context.Context, name string, goa
 }
 
 func (s *HabitService) SetHabitMonthlyGoalByID(ctx context.Context, habitID int64, goal int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set monthly goal of habit %d", habitID))
+	defer end()
+
 	if goal < 0 {
-		return fmt.Errorf("monthly goal cannot be negative")
+		return domain.Invalidf("monthly goal cannot be negative")
 	}
 
 	habit, err := s.getHabitByID(ctx, habitID)
@@ -292,16 +434,327 @@ func (s *HabitService) SetHabitMonthlyGoalByID(ctx context.Context, habitID int6
 	return s.habitRepo.Update(ctx, *habit)
 }
 
+func (s *HabitService) SetHabitSchedule(ctx context.Context, name string, spec string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set schedule of habit %s", name))
+	defer end()
+
+	schedule, err := domain.ParseHabitSchedule(spec, time.Now())
+	if err != nil {
+		return err
+	}
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	habit.Schedule = schedule
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+func (s *HabitService) SetHabitScheduleByID(ctx context.Context, habitID int64, spec string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set schedule of habit %d", habitID))
+	defer end()
+
+	schedule, err := domain.ParseHabitSchedule(spec, time.Now())
+	if err != nil {
+		return err
+	}
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+
+	habit.Schedule = schedule
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+func (s *HabitService) PauseHabit(ctx context.Context, name string, from, to time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Pause habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return s.insertPause(ctx, domain.HabitPause{HabitEntityID: habit.EntityID, From: from, To: to})
+}
+
+func (s *HabitService) PauseHabitByID(ctx context.Context, habitID int64, from, to time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Pause habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return 0, err
+	}
+	return s.insertPause(ctx, domain.HabitPause{HabitEntityID: habit.EntityID, From: from, To: to})
+}
+
+// PauseAllHabits pauses every habit, including habits created during the
+// pause, e.g. for a holiday.
+func (s *HabitService) PauseAllHabits(ctx context.Context, from, to time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, "Pause all habits")
+	defer end()
+
+	return s.insertPause(ctx, domain.HabitPause{From: from, To: to})
+}
+
+func (s *HabitService) SkipHabit(ctx context.Context, name string, date time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Skip habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return 0, err
+	}
+	return s.insertPause(ctx, domain.HabitPause{HabitEntityID: habit.EntityID, From: date, To: date})
+}
+
+func (s *HabitService) SkipHabitByID(ctx context.Context, habitID int64, date time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Skip habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return 0, err
+	}
+	return s.insertPause(ctx, domain.HabitPause{HabitEntityID: habit.EntityID, From: date, To: date})
+}
+
+func (s *HabitService) insertPause(ctx context.Context, pause domain.HabitPause) (int64, error) {
+	if s.pauseRepo == nil {
+		return 0, fmt.Errorf("habit pauses are not available")
+	}
+	if err := pause.Validate(); err != nil {
+		return 0, err
+	}
+	return s.pauseRepo.Insert(ctx, pause)
+}
+
+func (s *HabitService) getPauses(ctx context.Context, start, end time.Time) ([]domain.HabitPause, error) {
+	if s.pauseRepo == nil {
+		return nil, nil
+	}
+	return s.pauseRepo.GetRange(ctx, start, end)
+}
+
+// SetHabitTarget makes the habit a measured habit with a daily target such
+// as "5km" or "2.5 L". With atMost the target is a maximum rather than a
+// minimum. A target without a unit keeps the habit's current unit.
+func (s *HabitService) SetHabitTarget(ctx context.Context, name string, target string, atMost bool) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set target of habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.setTarget(ctx, habit, target, atMost)
+}
+
+func (s *HabitService) SetHabitTargetByID(ctx context.Context, habitID int64, target string, atMost bool) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set target of habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+	return s.setTarget(ctx, habit, target, atMost)
+}
+
+func (s *HabitService) setTarget(ctx context.Context, habit *domain.Habit, target string, atMost bool) error {
+	value, unit, err := domain.ParseHabitAmount(target)
+	if err != nil {
+		return err
+	}
+
+	habit.Kind = domain.HabitKindMeasured
+	habit.Target = value
+	if unit != "" {
+		habit.Unit = unit
+	}
+	habit.TargetType = domain.HabitTargetAtLeast
+	if atMost {
+		habit.TargetType = domain.HabitTargetAtMost
+	}
+	if err := habit.Validate(); err != nil {
+		return err
+	}
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+// SetHabitLimit turns the habit into a limit habit: the limit becomes a ceiling
+// per day, week or month, and a period is clean when the habit is logged at
+// most that many times in it. A limit of zero means not at all.
+func (s *HabitService) SetHabitLimit(ctx context.Context, name string, limit int, period domain.StreakPeriod) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set limit of habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.setLimit(ctx, habit, limit, period)
+}
+
+func (s *HabitService) SetHabitLimitByID(ctx context.Context, habitID int64, limit int, period domain.StreakPeriod) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set limit of habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+	return s.setLimit(ctx, habit, limit, period)
+}
+
+func (s *HabitService) setLimit(ctx context.Context, habit *domain.Habit, limit int, period domain.StreakPeriod) error {
+	if limit < 0 {
+		return domain.Invalidf("limit cannot be negative")
+	}
+	if habit.IsMeasured() {
+		return domain.Invalidf("habit %s is measured; use a target with --at-most to limit it", habit.Name)
+	}
+
+	habit.TargetType = domain.HabitTargetAtMost
+	habit.GoalPerDay, habit.GoalPerWeek, habit.GoalPerMonth = 0, 0, 0
+	switch period {
+	case domain.StreakPeriodDay, "":
+		habit.GoalPerDay = limit
+	case domain.StreakPeriodWeek:
+		habit.GoalPerWeek = limit
+	case domain.StreakPeriodMonth:
+		habit.GoalPerMonth = limit
+	default:
+		return domain.Invalidf("invalid limit period: %s", period)
+	}
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+// ClearHabitLimit turns a limit habit back into a habit with goals to reach.
+// A habit left without a goal gets a daily goal of one.
+func (s *HabitService) ClearHabitLimit(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Clear limit of habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.clearLimit(ctx, habit)
+}
+
+func (s *HabitService) ClearHabitLimitByID(ctx context.Context, habitID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Clear limit of habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+	return s.clearLimit(ctx, habit)
+}
+
+func (s *HabitService) clearLimit(ctx context.Context, habit *domain.Habit) error {
+	if !habit.IsLimit() {
+		return domain.Invalidf("habit %s has no limit", habit.Name)
+	}
+
+	habit.TargetType = domain.HabitTargetAtLeast
+	if !habit.IsMeasured() && habit.GoalPerDay == 0 && habit.GoalPerWeek == 0 && habit.GoalPerMonth == 0 {
+		habit.GoalPerDay = 1
+	}
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+// ArchiveHabit retires a habit without deleting it. Its logs are kept for
+// stats and exports, but it drops out of the tracker and can't be logged.
+func (s *HabitService) ArchiveHabit(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Archive habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.archive(ctx, habit)
+}
+
+func (s *HabitService) ArchiveHabitByID(ctx context.Context, habitID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Archive habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+	return s.archive(ctx, habit)
+}
+
+func (s *HabitService) archive(ctx context.Context, habit *domain.Habit) error {
+	if habit.IsArchived() {
+		return domain.Invalidf("habit %s is already archived", habit.Name)
+	}
+
+	now := time.Now()
+	habit.ArchivedAt = &now
+	return s.habitRepo.Update(ctx, *habit)
+}
+
+func (s *HabitService) UnarchiveHabit(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Unarchive habit %s", name))
+	defer end()
+
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.unarchive(ctx, habit)
+}
+
+func (s *HabitService) UnarchiveHabitByID(ctx context.Context, habitID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Unarchive habit %d", habitID))
+	defer end()
+
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return err
+	}
+	return s.unarchive(ctx, habit)
+}
+
+func (s *HabitService) unarchive(ctx context.Context, habit *domain.Habit) error {
+	if !habit.IsArchived() {
+		return domain.Invalidf("habit %s is not archived", habit.Name)
+	}
+
+	habit.ArchivedAt = nil
+	return s.habitRepo.Update(ctx, *habit)
+}
+
 type HabitDetails struct {
 	ID                int64
 	Name              string
 	GoalPerDay        int
 	GoalPerWeek       int
 	GoalPerMonth      int
+	Kind              domain.HabitKind
+	Unit              string
+	Target            float64
+	TargetType        domain.HabitTargetType
+	Schedule          string
 	CurrentStreak     int
+	StreakPeriod      domain.StreakPeriod
 	CompletionPercent float64
 	WeeklyProgress    float64
 	MonthlyProgress   float64
+	Values            domain.HabitValueSummary
+	Ceiling           float64
+	OverLimitDays     []time.Time
+	ArchivedAt        *time.Time
 	Logs              []domain.HabitLog
 }
 
@@ -330,7 +783,8 @@ func (s *HabitService) inspectHabitByHabit(ctx context.Context, habit *domain.Ha
 	}
 
 	todayStart := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
-	streakLogs, err := s.logRepo.GetRangeByEntityID(ctx, habit.EntityID, todayStart.AddDate(0, 0, -365), todayStart.Add(24*time.Hour))
+	streakStart := todayStart.AddDate(0, 0, -365)
+	streakLogs, err := s.logRepo.GetRangeByEntityID(ctx, habit.EntityID, streakStart, todayStart.Add(24*time.Hour))
 	if err != nil {
 		return nil, err
 	}
@@ -347,16 +801,53 @@ func (s *HabitService) inspectHabitByHabit(ctx context.Context, habit *domain.Ha
 		return nil, err
 	}
 
+	pauseStart := todayStart.AddDate(0, 0, -365)
+	if from.Before(pauseStart) {
+		pauseStart = from
+	}
+	habit.Pauses, err = s.getPauses(ctx, pauseStart, todayStart.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
+	}
+
+	// The week or month the range starts in is judged on all its logs, and
+	// a day can be over a weekly or monthly ceiling through logs from before
+	// the range, so look back a month.
+	lookbackLogs, err := s.logRepo.GetRangeByEntityID(ctx, habit.EntityID, from.AddDate(0, -1, 0), to.Add(24*time.Hour))
+	if err != nil {
+		return nil, err
+	}
+
+	var overLimitDays []time.Time
+	if habit.IsLimit() {
+		fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+		for day := fromDay; !day.After(to); day = day.AddDate(0, 0, 1) {
+			if habit.OverLimit(lookbackLogs, day) {
+				overLimitDays = append(overLimitDays, day)
+			}
+		}
+	}
+
 	return &HabitDetails{
 		ID:                habit.ID,
 		Name:              habit.Name,
 		GoalPerDay:        habit.GoalPerDay,
 		GoalPerWeek:       habit.GoalPerWeek,
 		GoalPerMonth:      habit.GoalPerMonth,
-		CurrentStreak:     domain.CalculateStreak(streakLogs, todayStart),
-		CompletionPercent: domain.CalculateCompletion(logs, int(to.Sub(from).Hours()/24)+1, todayStart),
-		WeeklyProgress:    domain.CalculateWeeklyProgress(progressLogs, habit.GoalPerWeek, todayStart),
-		MonthlyProgress:   domain.CalculateMonthlyProgress(progressLogs, habit.GoalPerMonth, todayStart),
+		Kind:              habitKind(*habit),
+		Unit:              habit.Unit,
+		Target:            habit.Target,
+		TargetType:        habit.TargetType,
+		Schedule:          habit.Schedule.Describe(),
+		CurrentStreak:     domain.CalculateGoalStreakSince(*habit, streakLogs, streakStart, todayStart),
+		StreakPeriod:      habit.StreakPeriod(),
+		CompletionPercent: domain.CalculateGoalCompletion(*habit, lookbackLogs, int(to.Sub(from).Hours()/24)+1, todayStart),
+		WeeklyProgress:    domain.CalculateGoalWeeklyProgress(*habit, progressLogs, todayStart),
+		MonthlyProgress:   domain.CalculateGoalMonthlyProgress(*habit, progressLogs, todayStart),
+		Values:            domain.SummarizeHabitValues(*habit, logs),
+		Ceiling:           habit.Ceiling(),
+		OverLimitDays:     overLimitDays,
+		ArchivedAt:        habit.ArchivedAt,
 		Logs:              logs,
 	}, nil
 }
@@ -367,29 +858,65 @@ type HabitStatus struct {
 	GoalPerDay        int
 	GoalPerWeek       int
 	GoalPerMonth      int
+	Kind              domain.HabitKind
+	Unit              string
+	Target            float64
+	TargetType        domain.HabitTargetType
+	Schedule          string
 	CurrentStreak     int
+	StreakPeriod      domain.StreakPeriod
 	CompletionPercent float64
 	WeeklyProgress    float64
 	MonthlyProgress   float64
 	TodayCount        int
+	TodayValue        float64
+	Ceiling           float64
+	PeriodTotal       float64
+	OverLimit         bool
+	ArchivedAt        *time.Time
 	DayHistory        []DayStatus
 }
 
+// DayStatus is one day of a habit's history. Rest days fall outside the
+// habit's schedule and paused days are covered by a pause or skip; not
+// logging on either is not a miss. A limit habit's day is completed when it
+// is clean, and over when the habit had gone over its ceiling by its end.
 type DayStatus struct {
 	Date      time.Time
 	Completed bool
 	Count     int
+	Value     float64
+	Rest      bool
+	Paused    bool
+	Over      bool
 }
 
 type TrackerStatus struct {
 	Habits []HabitStatus
 }
 
+// GetTrackerStatus returns the status of the habits being tracked. Archived
+// habits are left out; see GetArchivedTrackerStatus.
 func (s *HabitService) GetTrackerStatus(ctx context.Context, today time.Time, days int) (*TrackerStatus, error) {
-	habits, err := s.habitRepo.GetAll(ctx)
+	return s.trackerStatus(ctx, today, days, false)
+}
+
+// GetArchivedTrackerStatus is GetTrackerStatus for archived habits only.
+func (s *HabitService) GetArchivedTrackerStatus(ctx context.Context, today time.Time, days int) (*TrackerStatus, error) {
+	return s.trackerStatus(ctx, today, days, true)
+}
+
+func (s *HabitService) trackerStatus(ctx context.Context, today time.Time, days int, archived bool) (*TrackerStatus, error) {
+	all, err := s.habitRepo.GetAll(ctx)
 	if err != nil {
 		return nil, err
 	}
+	var habits []domain.Habit
+	for _, habit := range all {
+		if habit.IsArchived() == archived {
+			habits = append(habits, habit)
+		}
+	}
 
 	status := &TrackerStatus{
 		Habits: make([]HabitStatus, 0, len(habits)),
@@ -399,16 +926,20 @@ func (s *HabitService) GetTrackerStatus(ctx context.Context, today time.Time, da
 	startDate := todayStart.AddDate(0, 0, -(days - 1))
 	endDate := todayStart.Add(24 * time.Hour)
 
-	weekStart := todayStart.AddDate(0, 0, -6)
-	year, month, _ := todayStart.Date()
-	monthStart := time.Date(year, month, 1, 0, 0, 0, 0, todayStart.Location())
+	// Streaks look back up to a year, as in InspectHabit.
+	logStartDate := todayStart.AddDate(0, 0, -365)
+	if startDate.Before(logStartDate) {
+		logStartDate = startDate
+	}
 
-	logStartDate := startDate
-	if weekStart.Before(logStartDate) {
-		logStartDate = weekStart
+	// The month is included so monthly progress sees pauses in it.
+	pauseStartDate := logStartDate
+	if monthStart := time.Date(todayStart.Year(), todayStart.Month(), 1, 0, 0, 0, 0, todayStart.Location()); monthStart.Before(pauseStartDate) {
+		pauseStartDate = monthStart
 	}
-	if monthStart.Before(logStartDate) {
-		logStartDate = monthStart
+	pauses, err := s.getPauses(ctx, pauseStartDate, todayStart.AddDate(0, 1, 0))
+	if err != nil {
+		return nil, err
 	}
 
 	for _, habit := range habits {
@@ -416,6 +947,7 @@ func (s *HabitService) GetTrackerStatus(ctx context.Context, today time.Time, da
 		if err != nil {
 			return nil, err
 		}
+		habit.Pauses = pauses
 
 		habitStatus := HabitStatus{
 			ID:                habit.ID,
@@ -423,12 +955,23 @@ func (s *HabitService) GetTrackerStatus(ctx context.Context, today time.Time, da
 			GoalPerDay:        habit.GoalPerDay,
 			GoalPerWeek:       habit.GoalPerWeek,
 			GoalPerMonth:      habit.GoalPerMonth,
-			CurrentStreak:     domain.CalculateStreak(logs, todayStart),
-			CompletionPercent: domain.CalculateCompletion(logs, days, todayStart),
-			WeeklyProgress:    domain.CalculateWeeklyProgress(logs, habit.GoalPerWeek, todayStart),
-			MonthlyProgress:   domain.CalculateMonthlyProgress(logs, habit.GoalPerMonth, todayStart),
+			Kind:              habitKind(habit),
+			Unit:              habit.Unit,
+			Target:            habit.Target,
+			TargetType:        habit.TargetType,
+			Schedule:          habit.Schedule.Describe(),
+			CurrentStreak:     domain.CalculateGoalStreakSince(habit, logs, logStartDate, todayStart),
+			StreakPeriod:      habit.StreakPeriod(),
+			CompletionPercent: domain.CalculateGoalCompletion(habit, logs, days, todayStart),
+			WeeklyProgress:    domain.CalculateGoalWeeklyProgress(habit, logs, todayStart),
+			MonthlyProgress:   domain.CalculateGoalMonthlyProgress(habit, logs, todayStart),
 			TodayCount:        domain.SumCountForDay(logs, todayStart),
-			DayHistory:        buildDayHistory(logs, todayStart, days),
+			TodayValue:        domain.SumValueForDay(habit, logs, todayStart),
+			Ceiling:           habit.Ceiling(),
+			PeriodTotal:       habit.PeriodTotal(logs, todayStart),
+			OverLimit:         habit.OverLimit(logs, todayStart),
+			ArchivedAt:        habit.ArchivedAt,
+			DayHistory:        buildDayHistory(habit, logs, todayStart, days),
 		}
 
 		status.Habits = append(status.Habits, habitStatus)
@@ -437,8 +980,9 @@ func (s *HabitService) GetTrackerStatus(ctx context.Context, today time.Time, da
 	return status, nil
 }
 
-func buildDayHistory(logs []domain.HabitLog, today time.Time, numDays int) []DayStatus {
+func buildDayHistory(habit domain.Habit, logs []domain.HabitLog, today time.Time, numDays int) []DayStatus {
 	history := make([]DayStatus, numDays)
+	createdDay := time.Date(habit.CreatedAt.Year(), habit.CreatedAt.Month(), habit.CreatedAt.Day(), 0, 0, 0, 0, today.Location())
 
 	for i := 0; i < numDays; i++ {
 		day := today.AddDate(0, 0, -i)
@@ -448,13 +992,31 @@ func buildDayHistory(logs []domain.HabitLog, today time.Time, numDays int) []Day
 		for _, log := range dayLogs {
 			count += log.Count
 		}
+		value := domain.SumValueForDay(habit, dayLogs, day)
+
+		completed := len(dayLogs) > 0
+		over := habit.OverLimit(logs, day)
+		if habit.IsLimit() {
+			completed = !over && (completed || !day.Before(createdDay))
+		}
 
 		history[i] = DayStatus{
 			Date:      day,
-			Completed: len(dayLogs) > 0,
+			Completed: completed,
 			Count:     count,
+			Value:     value,
+			Rest:      !habit.Schedule.IsScheduled(day),
+			Paused:    habit.IsPaused(day),
+			Over:      over,
 		}
 	}
 
 	return history
 }
+
+func habitKind(habit domain.Habit) domain.HabitKind {
+	if habit.Kind == "" {
+		return domain.HabitKindCount
+	}
+	return habit.Kind
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/repository/sqlite"
 )
 
@@ -19,7 +20,7 @@ func setupHabitService(t *testing.T) *HabitService {
 	habitRepo := sqlite.NewHabitRepository(db)
 	logRepo := sqlite.NewHabitLogRepository(db)
 
-	return NewHabitService(habitRepo, logRepo)
+	return NewHabitService(habitRepo, logRepo).WithPauseRepository(sqlite.NewHabitPauseRepository(db))
 }
 
 func TestHabitService_LogHabit_CreatesNewHabit(t *testing.T) {
@@ -98,6 +99,42 @@ func TestHabitService_GetTrackerStatus_CalculatesStreak(t *testing.T) {
 	assert.Equal(t, 3, status.Habits[0].CurrentStreak)
 }
 
+func TestHabitService_GetTrackerStatus_StreakMeetsDailyGoal(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	require.NoError(t, service.LogHabitForDate(ctx, "Water", 8, today))
+	require.NoError(t, service.LogHabitForDate(ctx, "Water", 3, today.AddDate(0, 0, -1)))
+	require.NoError(t, service.SetHabitGoal(ctx, "Water", 8))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Habits[0].CurrentStreak)
+	assert.Equal(t, domain.StreakPeriodDay, status.Habits[0].StreakPeriod)
+}
+
+func TestHabitService_GetTrackerStatus_WeeklyHabitStreakInWeeks(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	// Tuesday
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	for _, daysAgo := range []int{7, 5, 3, 14, 12, 10} {
+		require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today.AddDate(0, 0, -daysAgo)))
+	}
+	require.NoError(t, service.SetHabitWeeklyGoal(ctx, "Gym", 3))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Habits[0].CurrentStreak)
+	assert.Equal(t, domain.StreakPeriodWeek, status.Habits[0].StreakPeriod)
+}
+
 func TestHabitService_GetTrackerStatus_CalculatesCompletion(t *testing.T) {
 	service := setupHabitService(t)
 	ctx := context.Background()
@@ -135,6 +172,36 @@ func TestHabitService_GetTrackerStatus_DayHistory(t *testing.T) {
 	assert.InDelta(t, 100.0, status.Habits[0].CompletionPercent, 0.1)
 }
 
+func TestHabitService_GetTrackerStatus_MarksRestDays(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	// Tuesday
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+
+	for _, daysAgo := range []int{1, 4, 6} {
+		require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today.AddDate(0, 0, -daysAgo)))
+	}
+	require.NoError(t, service.SetHabitSchedule(ctx, "Gym", "mon,wed,fri"))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, "mon,wed,fri", habit.Schedule)
+	assert.Equal(t, 3, habit.CurrentStreak)
+	assert.InDelta(t, 100.0, habit.CompletionPercent, 0.1)
+
+	var rest []time.Weekday
+	for _, day := range habit.DayHistory {
+		if day.Rest {
+			assert.False(t, day.Completed)
+			rest = append(rest, day.Date.Weekday())
+		}
+	}
+	assert.Equal(t, []time.Weekday{time.Tuesday, time.Sunday, time.Saturday, time.Thursday}, rest)
+}
+
 func TestHabitService_GetTrackerStatus_IncludesHabitID(t *testing.T) {
 	service := setupHabitService(t)
 	ctx := context.Background()
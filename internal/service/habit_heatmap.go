@@ -0,0 +1,237 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// HeatmapDay is one cell of a year heatmap. Intensity is the share of the
+// daily goal met, from 0 to 1. Inactive days come before the habit was
+// tracked or after today, and are left blank.
+type HeatmapDay struct {
+	Date      time.Time
+	Intensity float64
+	Count     int
+	Value     float64
+	Rest      bool
+	Paused    bool
+	Over      bool
+	Inactive  bool
+}
+
+// Met reports whether the day's goal was met in full.
+func (d HeatmapDay) Met() bool {
+	return !d.Inactive && !d.Over && d.Intensity >= 1
+}
+
+// Level buckets the intensity into the five shades of a contribution graph:
+// 0 for nothing done up to 4 for the goal met in full.
+func (d HeatmapDay) Level() int {
+	switch {
+	case d.Intensity <= 0:
+		return 0
+	case d.Intensity >= 1:
+		return 4
+	default:
+		return 1 + int(d.Intensity*3)
+	}
+}
+
+// HabitHeatmap is a habit's year at a glance, from January 1 to December 31,
+// with its longest streak of met days in that year. Rest and paused days
+// neither add to nor break the streak. The overlay of all habits has no ID.
+type HabitHeatmap struct {
+	HabitID            int64
+	Name               string
+	Unit               string
+	Year               int
+	Days               []HeatmapDay
+	LongestStreak      int
+	LongestStreakStart time.Time
+	LongestStreakEnd   time.Time
+}
+
+// Weeks lays the year out in Monday-to-Sunday columns, as in a contribution
+// graph. Cells outside the year have a zero Date.
+func (h HabitHeatmap) Weeks() [][7]HeatmapDay {
+	var weeks [][7]HeatmapDay
+	var week [7]HeatmapDay
+	for i, day := range h.Days {
+		row := (int(day.Date.Weekday()) + 6) % 7
+		if row == 0 && i > 0 {
+			weeks = append(weeks, week)
+			week = [7]HeatmapDay{}
+		}
+		week[row] = day
+	}
+	if len(h.Days) > 0 {
+		weeks = append(weeks, week)
+	}
+	return weeks
+}
+
+// MonthLabels puts each month's abbreviation over the week column its first
+// day falls in, one character per column.
+func (h HabitHeatmap) MonthLabels() string {
+	weeks := h.Weeks()
+	labels := []rune(strings.Repeat(" ", len(weeks)+3))
+	for col, week := range weeks {
+		for _, day := range week {
+			if day.Date.IsZero() || day.Date.Day() != 1 {
+				continue
+			}
+			if col > 0 && labels[col-1] != ' ' {
+				continue
+			}
+			copy(labels[col:], []rune(day.Date.Format("Jan")))
+		}
+	}
+	return strings.TrimRight(string(labels), " ")
+}
+
+func (s *HabitService) GetHabitHeatmap(ctx context.Context, name string, year int, today time.Time) (*HabitHeatmap, error) {
+	habit, err := s.getHabitByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return s.heatmapForHabit(ctx, *habit, year, today)
+}
+
+func (s *HabitService) GetHabitHeatmapByID(ctx context.Context, habitID int64, year int, today time.Time) (*HabitHeatmap, error) {
+	habit, err := s.getHabitByID(ctx, habitID)
+	if err != nil {
+		return nil, err
+	}
+	return s.heatmapForHabit(ctx, *habit, year, today)
+}
+
+// GetHabitHeatmaps returns the year heatmap of every habit being tracked.
+func (s *HabitService) GetHabitHeatmaps(ctx context.Context, year int, today time.Time) ([]HabitHeatmap, error) {
+	habits, err := s.habitRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	heatmaps := make([]HabitHeatmap, 0, len(habits))
+	for _, habit := range habits {
+		if habit.IsArchived() {
+			continue
+		}
+		heatmap, err := s.heatmapForHabit(ctx, habit, year, today)
+		if err != nil {
+			return nil, err
+		}
+		heatmaps = append(heatmaps, *heatmap)
+	}
+	return heatmaps, nil
+}
+
+func (s *HabitService) heatmapForHabit(ctx context.Context, habit domain.Habit, year int, today time.Time) (*HabitHeatmap, error) {
+	start := time.Date(year, time.January, 1, 0, 0, 0, 0, today.Location())
+	end := start.AddDate(1, 0, 0)
+
+	// Weekly and monthly limits need the logs of the period before January.
+	logs, err := s.logRepo.GetRangeByEntityID(ctx, habit.EntityID, start.AddDate(0, -1, 0), end)
+	if err != nil {
+		return nil, err
+	}
+	habit.Pauses, err = s.getPauses(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	todayStart := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, today.Location())
+	tracked := time.Date(habit.CreatedAt.Year(), habit.CreatedAt.Month(), habit.CreatedAt.Day(), 0, 0, 0, 0, today.Location())
+	for _, log := range logs {
+		if log.LoggedAt.Before(tracked) {
+			tracked = time.Date(log.LoggedAt.Year(), log.LoggedAt.Month(), log.LoggedAt.Day(), 0, 0, 0, 0, today.Location())
+		}
+	}
+
+	heatmap := &HabitHeatmap{
+		HabitID: habit.ID,
+		Name:    habit.Name,
+		Unit:    habit.Unit,
+		Year:    year,
+	}
+	for day := start; day.Before(end); day = day.AddDate(0, 0, 1) {
+		dayLogs := domain.GetLogsForDay(logs, day)
+		count := 0
+		for _, log := range dayLogs {
+			count += log.Count
+		}
+		heatmap.Days = append(heatmap.Days, HeatmapDay{
+			Date:      day,
+			Intensity: habit.DayProgress(logs, day),
+			Count:     count,
+			Value:     domain.SumValueForDay(habit, dayLogs, day),
+			Rest:      !habit.Schedule.IsScheduled(day),
+			Paused:    habit.IsPaused(day),
+			Over:      habit.OverLimit(logs, day),
+			Inactive:  day.After(todayStart) || (day.Before(tracked) && len(dayLogs) == 0),
+		})
+	}
+	heatmap.findLongestStreak()
+	return heatmap, nil
+}
+
+// OverlayHeatmaps combines habit heatmaps into one for all habits. A day's
+// intensity is the average over the habits active on it, and it is met when
+// every one of them met its goal.
+func OverlayHeatmaps(heatmaps []HabitHeatmap, year int) HabitHeatmap {
+	overlay := HabitHeatmap{Name: "All habits", Year: year}
+	if len(heatmaps) == 0 {
+		return overlay
+	}
+
+	for i, first := range heatmaps[0].Days {
+		day := HeatmapDay{Date: first.Date, Inactive: true, Rest: true}
+		active, total := 0, 0.0
+		for _, heatmap := range heatmaps {
+			d := heatmap.Days[i]
+			if d.Inactive {
+				continue
+			}
+			day.Inactive = false
+			day.Count += d.Count
+			if d.Rest || d.Paused {
+				continue
+			}
+			day.Rest = false
+			active++
+			total += d.Intensity
+		}
+		if active > 0 {
+			day.Intensity = total / float64(active)
+		}
+		overlay.Days = append(overlay.Days, day)
+	}
+	overlay.findLongestStreak()
+	return overlay
+}
+
+func (h *HabitHeatmap) findLongestStreak() {
+	run := 0
+	var runStart, runEnd time.Time
+	for _, day := range h.Days {
+		switch {
+		case day.Met():
+			if run == 0 {
+				runStart = day.Date
+			}
+			run++
+			runEnd = day.Date
+			if run > h.LongestStreak {
+				h.LongestStreak = run
+				h.LongestStreakStart = runStart
+				h.LongestStreakEnd = runEnd
+			}
+		case !day.Inactive && (day.Rest || day.Paused):
+		default:
+			run = 0
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
 )
 
 func TestHabitService_RenameHabitByID(t *testing.T) {
@@ -281,6 +282,116 @@ func TestHabitService_SetHabitMonthlyGoal(t *testing.T) {
 	assert.Equal(t, 15, status.Habits[0].GoalPerMonth)
 }
 
+func TestHabitService_SetHabitSchedule(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	err := service.LogHabit(ctx, "Stretch", 1)
+	require.NoError(t, err)
+
+	err = service.SetHabitSchedule(ctx, "Stretch", "every 2 days")
+	require.NoError(t, err)
+
+	status, err := service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, "every 2 days", status.Habits[0].Schedule)
+
+	err = service.SetHabitScheduleByID(ctx, status.Habits[0].ID, "daily")
+	require.NoError(t, err)
+
+	status, err = service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, "daily", status.Habits[0].Schedule)
+}
+
+func TestHabitService_SetHabitSchedule_Invalid(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	err := service.LogHabit(ctx, "Stretch", 1)
+	require.NoError(t, err)
+
+	err = service.SetHabitSchedule(ctx, "Stretch", "mon,funday")
+	assert.Error(t, err)
+}
+
+func TestHabitService_PauseHabit_KeepsStreak(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	for _, daysAgo := range []int{0, 1, 5} {
+		require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today.AddDate(0, 0, -daysAgo)))
+	}
+
+	_, err := service.PauseHabit(ctx, "Gym", today.AddDate(0, 0, -4), today.AddDate(0, 0, -2))
+	require.NoError(t, err)
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, 3, habit.CurrentStreak)
+
+	var paused int
+	for _, day := range habit.DayHistory {
+		if day.Paused {
+			paused++
+		}
+	}
+	assert.Equal(t, 3, paused)
+}
+
+func TestHabitService_SkipHabit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today))
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today.AddDate(0, 0, -2)))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	_, err = service.SkipHabitByID(ctx, status.Habits[0].ID, today.AddDate(0, 0, -1))
+	require.NoError(t, err)
+
+	status, err = service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	assert.Equal(t, 2, status.Habits[0].CurrentStreak)
+	assert.True(t, status.Habits[0].DayHistory[1].Paused)
+	assert.False(t, status.Habits[0].DayHistory[0].Paused)
+}
+
+func TestHabitService_PauseAllHabits(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, today.AddDate(0, 0, -3)))
+	require.NoError(t, service.LogHabitForDate(ctx, "Read", 1, today.AddDate(0, 0, -3)))
+
+	_, err := service.PauseAllHabits(ctx, today.AddDate(0, 0, -2), today)
+	require.NoError(t, err)
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	require.Len(t, status.Habits, 2)
+	for _, habit := range status.Habits {
+		assert.Equal(t, 1, habit.CurrentStreak, habit.Name)
+		assert.True(t, habit.DayHistory[0].Paused, habit.Name)
+	}
+}
+
+func TestHabitService_PauseHabit_RejectsBackwardsRange(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Gym", 1))
+
+	today := time.Now()
+	_, err := service.PauseHabit(ctx, "Gym", today, today.AddDate(0, 0, -1))
+	assert.Error(t, err)
+}
+
 func TestHabitService_RemoveHabitLogForDateByID(t *testing.T) {
 	service := setupHabitService(t)
 	ctx := context.Background()
@@ -388,3 +499,298 @@ func TestHabitService_CreateHabit_ExistingHabitReturnsID(t *testing.T) {
 
 	assert.Equal(t, firstID, secondID)
 }
+
+func TestHabitService_LogHabitValue_CreatesMeasuredHabit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Run", 3, "km", today))
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Run", 2.2, "KM", today))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, domain.HabitKindMeasured, habit.Kind)
+	assert.Equal(t, "km", habit.Unit)
+	assert.InDelta(t, 5.2, habit.TodayValue, 0.001)
+	assert.InDelta(t, 5.2, habit.DayHistory[0].Value, 0.001)
+}
+
+func TestHabitService_LogHabitValue_UnitMismatch(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Run", 3, "km", time.Now()))
+
+	err := service.LogHabitValueForDate(ctx, "Run", 30, "min", time.Now())
+	assert.Error(t, err)
+}
+
+func TestHabitService_LogHabitValue_CountHabitRejectsUnits(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Gym", 1))
+
+	assert.Error(t, service.LogHabitValueForDate(ctx, "Gym", 2.5, "", time.Now()))
+	assert.Error(t, service.LogHabitValueForDate(ctx, "Gym", 2, "km", time.Now()))
+	assert.NoError(t, service.LogHabitValueForDate(ctx, "Gym", 2, "", time.Now()))
+}
+
+func TestHabitService_SetHabitTarget(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, service.LogHabitForDate(ctx, "Reading", 1, today))
+
+	require.NoError(t, service.SetHabitTarget(ctx, "Reading", "30 min", false))
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Reading", 20, "min", today.AddDate(0, 0, -1)))
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Reading", 35, "", today))
+
+	details, err := service.InspectHabit(ctx, "Reading", today.AddDate(0, 0, -7), today, today)
+	require.NoError(t, err)
+	assert.Equal(t, domain.HabitKindMeasured, details.Kind)
+	assert.Equal(t, 30.0, details.Target)
+	assert.Equal(t, "min", details.Unit)
+	assert.Equal(t, 1, details.CurrentStreak)
+	assert.Equal(t, 55.0, details.Values.Total)
+	assert.Equal(t, 35.0, details.Values.Max)
+}
+
+func TestHabitService_SetHabitTarget_AtMost(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Date(2026, 1, 6, 12, 0, 0, 0, time.UTC)
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Screen time", 1.5, "h", today))
+	require.NoError(t, service.SetHabitTarget(ctx, "Screen time", "2", true))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, domain.HabitTargetAtMost, habit.TargetType)
+	assert.Equal(t, "h", habit.Unit)
+	assert.True(t, habit.DayHistory[0].Completed)
+}
+
+func TestHabitService_SetHabitTarget_Invalid(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Run", 1))
+
+	assert.Error(t, service.SetHabitTarget(ctx, "Run", "far", false))
+}
+
+func TestHabitService_SetHabitLimit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	today := time.Now()
+	require.NoError(t, service.LogHabitForDate(ctx, "Coffee", 3, today.AddDate(0, 0, -1)))
+	require.NoError(t, service.SetHabitLimit(ctx, "Coffee", 2, domain.StreakPeriodDay))
+	require.NoError(t, service.LogHabitForDate(ctx, "Coffee", 1, today))
+
+	status, err := service.GetTrackerStatus(ctx, today, 7)
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, domain.HabitTargetAtMost, habit.TargetType)
+	assert.Equal(t, 2.0, habit.Ceiling)
+	assert.Equal(t, 1.0, habit.PeriodTotal)
+	assert.False(t, habit.OverLimit)
+	assert.Equal(t, 1, habit.CurrentStreak)
+	assert.True(t, habit.DayHistory[0].Completed)
+	assert.True(t, habit.DayHistory[1].Over)
+	assert.False(t, habit.DayHistory[1].Completed)
+
+	details, err := service.InspectHabit(ctx, "Coffee", today.AddDate(0, 0, -7), today, today)
+	require.NoError(t, err)
+	require.Len(t, details.OverLimitDays, 1)
+	assert.Equal(t, today.AddDate(0, 0, -1).Format("2006-01-02"), details.OverLimitDays[0].Format("2006-01-02"))
+}
+
+func TestHabitService_SetHabitLimit_Zero(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	id, err := service.CreateHabit(ctx, "Alcohol")
+	require.NoError(t, err)
+	require.NoError(t, service.SetHabitLimitByID(ctx, id, 0, domain.StreakPeriodDay))
+
+	status, err := service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, 1, status.Habits[0].CurrentStreak)
+
+	require.NoError(t, service.LogHabit(ctx, "Alcohol", 1))
+
+	status, err = service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	assert.Equal(t, 0, status.Habits[0].CurrentStreak)
+	assert.True(t, status.Habits[0].OverLimit)
+}
+
+func TestHabitService_ClearHabitLimit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Snacks", 1))
+	require.NoError(t, service.SetHabitLimit(ctx, "Snacks", 1, domain.StreakPeriodWeek))
+	require.NoError(t, service.ClearHabitLimit(ctx, "Snacks"))
+
+	status, err := service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	habit := status.Habits[0]
+	assert.Equal(t, domain.HabitTargetAtLeast, habit.TargetType)
+	assert.Equal(t, 1, habit.GoalPerWeek)
+
+	assert.Error(t, service.ClearHabitLimit(ctx, "Snacks"))
+}
+
+func TestHabitService_SetHabitLimit_Invalid(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Coffee", 1))
+	require.NoError(t, service.LogHabitValueForDate(ctx, "Run", 5, "km", time.Now()))
+
+	assert.Error(t, service.SetHabitLimit(ctx, "Coffee", -1, domain.StreakPeriodDay))
+	assert.Error(t, service.SetHabitLimit(ctx, "Coffee", 1, "year"))
+	assert.Error(t, service.SetHabitLimit(ctx, "Run", 1, domain.StreakPeriodDay))
+}
+
+func TestHabitService_GetHabitHeatmap(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	march := func(day int) time.Time { return time.Date(2025, time.March, day, 12, 0, 0, 0, time.Local) }
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 2, march(1)))
+	require.NoError(t, service.SetHabitGoal(ctx, "Gym", 2))
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 2, march(2)))
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, march(3)))
+
+	heatmap, err := service.GetHabitHeatmap(ctx, "Gym", 2025, time.Date(2025, time.December, 31, 12, 0, 0, 0, time.Local))
+	require.NoError(t, err)
+	require.Len(t, heatmap.Days, 365)
+
+	day := func(t time.Time) HeatmapDay { return heatmap.Days[t.YearDay()-1] }
+	assert.True(t, day(march(1).AddDate(0, 0, -1)).Inactive)
+	assert.Equal(t, 1.0, day(march(1)).Intensity)
+	assert.Equal(t, 4, day(march(2)).Level())
+	assert.Equal(t, 0.5, day(march(3)).Intensity)
+	assert.Equal(t, 2, day(march(3)).Level())
+	assert.Equal(t, 1, day(march(3)).Count)
+	assert.False(t, day(march(4)).Inactive)
+	assert.Equal(t, 0, day(march(4)).Level())
+
+	assert.Equal(t, 2, heatmap.LongestStreak)
+	assert.Equal(t, "2025-03-01", heatmap.LongestStreakStart.Format("2006-01-02"))
+	assert.Equal(t, "2025-03-02", heatmap.LongestStreakEnd.Format("2006-01-02"))
+}
+
+func TestHabitService_GetHabitHeatmap_RestDaysKeepStreak(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	// 2025-03-03 is a Monday.
+	march := func(day int) time.Time { return time.Date(2025, time.March, day, 12, 0, 0, 0, time.Local) }
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, march(3)))
+	require.NoError(t, service.SetHabitSchedule(ctx, "Gym", "mon,wed,fri"))
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, march(5)))
+	require.NoError(t, service.LogHabitForDate(ctx, "Gym", 1, march(7)))
+
+	heatmap, err := service.GetHabitHeatmap(ctx, "Gym", 2025, march(31))
+	require.NoError(t, err)
+
+	assert.True(t, heatmap.Days[march(4).YearDay()-1].Rest)
+	assert.True(t, heatmap.Days[march(31).YearDay()].Inactive)
+	assert.Equal(t, 3, heatmap.LongestStreak)
+	assert.Equal(t, "2025-03-07", heatmap.LongestStreakEnd.Format("2006-01-02"))
+}
+
+func TestOverlayHeatmaps(t *testing.T) {
+	jan := func(day int) time.Time { return time.Date(2026, time.January, day, 0, 0, 0, 0, time.UTC) }
+	gym := HabitHeatmap{Days: []HeatmapDay{
+		{Date: jan(1), Intensity: 1},
+		{Date: jan(2), Intensity: 1},
+		{Date: jan(3), Intensity: 0},
+	}}
+	read := HabitHeatmap{Days: []HeatmapDay{
+		{Date: jan(1), Inactive: true},
+		{Date: jan(2), Intensity: 0.5},
+		{Date: jan(3), Rest: true},
+	}}
+
+	overlay := OverlayHeatmaps([]HabitHeatmap{gym, read}, 2026)
+
+	assert.Equal(t, "All habits", overlay.Name)
+	require.Len(t, overlay.Days, 3)
+	assert.Equal(t, 1.0, overlay.Days[0].Intensity)
+	assert.Equal(t, 0.75, overlay.Days[1].Intensity)
+	assert.Equal(t, 0.0, overlay.Days[2].Intensity)
+	assert.Equal(t, 1, overlay.LongestStreak)
+}
+
+func TestHabitService_ArchiveHabit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Gym", 1))
+	require.NoError(t, service.LogHabit(ctx, "Reading", 1))
+	require.NoError(t, service.ArchiveHabit(ctx, "Gym"))
+
+	status, err := service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	require.Len(t, status.Habits, 1)
+	assert.Equal(t, "Reading", status.Habits[0].Name)
+
+	archived, err := service.GetArchivedTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	require.Len(t, archived.Habits, 1)
+	assert.Equal(t, "Gym", archived.Habits[0].Name)
+	assert.NotNil(t, archived.Habits[0].ArchivedAt)
+	assert.Equal(t, 1, archived.Habits[0].TodayCount)
+
+	err = service.LogHabit(ctx, "Gym", 1)
+	assert.ErrorContains(t, err, "archived")
+
+	heatmaps, err := service.GetHabitHeatmaps(ctx, time.Now().Year(), time.Now())
+	require.NoError(t, err)
+	assert.Len(t, heatmaps, 1)
+
+	details, err := service.InspectHabit(ctx, "Gym", time.Now().AddDate(0, 0, -7), time.Now(), time.Now())
+	require.NoError(t, err)
+	assert.NotNil(t, details.ArchivedAt)
+	assert.Len(t, details.Logs, 1)
+}
+
+func TestHabitService_ArchiveHabit_AlreadyArchived(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	id, err := service.CreateHabit(ctx, "Gym")
+	require.NoError(t, err)
+	require.NoError(t, service.ArchiveHabitByID(ctx, id))
+
+	err = service.ArchiveHabit(ctx, "Gym")
+	assert.ErrorContains(t, err, "already archived")
+}
+
+func TestHabitService_UnarchiveHabit(t *testing.T) {
+	service := setupHabitService(t)
+	ctx := context.Background()
+
+	require.NoError(t, service.LogHabit(ctx, "Gym", 1))
+	require.NoError(t, service.ArchiveHabit(ctx, "Gym"))
+	require.NoError(t, service.UnarchiveHabit(ctx, "Gym"))
+
+	status, err := service.GetTrackerStatus(ctx, time.Now(), 7)
+	require.NoError(t, err)
+	require.Len(t, status.Habits, 1)
+	assert.Nil(t, status.Habits[0].ArchivedAt)
+	require.NoError(t, service.LogHabit(ctx, "Gym", 1))
+
+	err = service.UnarchiveHabit(ctx, "Gym")
+	assert.ErrorContains(t, err, "not archived")
+}
@@ -3,12 +3,67 @@ package service
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/typingincolor/bujo/internal/domain"
 )
 
+type HistoryGoalRepository interface {
+	GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.GoalVersion, error)
+	GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.GoalVersion, error)
+	RestoreVersion(ctx context.Context, entityID domain.EntityID, version int) error
+}
+
+type HistoryHabitRepository interface {
+	GetHistory(ctx context.Context, entityID domain.EntityID) ([]domain.HabitVersion, error)
+	GetAtVersion(ctx context.Context, entityID domain.EntityID, version int) (*domain.HabitVersion, error)
+	RestoreVersion(ctx context.Context, entityID domain.EntityID, version int) error
+}
+
+type HistoryKind string
+
+const (
+	HistoryKindEntry    HistoryKind = "entry"
+	HistoryKindGoal     HistoryKind = "goal"
+	HistoryKindHabit    HistoryKind = "habit"
+	HistoryKindListItem HistoryKind = "list item"
+)
+
+// FieldChange is one field that differs from the previous version.
+type FieldChange struct {
+	Field  string
+	Before string
+	After  string
+}
+
+type HistoryVersion struct {
+	domain.VersionInfo
+	Summary string
+	Changes []FieldChange
+}
+
+// EntityHistory is the version history of any versioned entity, with each
+// version compared against the one before it.
+type EntityHistory struct {
+	Kind     HistoryKind
+	EntityID domain.EntityID
+	Versions []HistoryVersion
+}
+
+type historyField struct {
+	name  string
+	value string
+}
+
 type HistoryService struct {
-	listItemRepo domain.ListItemRepository
+	listItemRepo     domain.ListItemRepository
+	entryRepo        domain.EntryRepository
+	entryHistoryRepo domain.EntryHistoryRepository
+	tagRepo          domain.TagRepository
+	mentionRepo      domain.MentionRepository
+	goalRepo         HistoryGoalRepository
+	habitRepo        HistoryHabitRepository
+	journal          *OperationJournal
 }
 
 func NewHistoryService(listItemRepo domain.ListItemRepository) *HistoryService {
@@ -17,6 +72,29 @@ func NewHistoryService(listItemRepo domain.ListItemRepository) *HistoryService {
 	}
 }
 
+func (s *HistoryService) WithEntries(entryRepo domain.EntryRepository, entryHistoryRepo domain.EntryHistoryRepository, tagRepo domain.TagRepository, mentionRepo domain.MentionRepository) *HistoryService {
+	s.entryRepo = entryRepo
+	s.entryHistoryRepo = entryHistoryRepo
+	s.tagRepo = tagRepo
+	s.mentionRepo = mentionRepo
+	return s
+}
+
+func (s *HistoryService) WithGoals(goalRepo HistoryGoalRepository) *HistoryService {
+	s.goalRepo = goalRepo
+	return s
+}
+
+func (s *HistoryService) WithHabits(habitRepo HistoryHabitRepository) *HistoryService {
+	s.habitRepo = habitRepo
+	return s
+}
+
+func (s *HistoryService) WithOperationJournal(journal *OperationJournal) *HistoryService {
+	s.journal = journal
+	return s
+}
+
 func (s *HistoryService) GetItemHistory(ctx context.Context, entityID domain.EntityID) ([]domain.ListItem, error) {
 	return s.listItemRepo.GetHistory(ctx, entityID)
 }
@@ -26,12 +104,15 @@ func (s *HistoryService) GetItemAtVersion(ctx context.Context, entityID domain.E
 }
 
 func (s *HistoryService) RestoreItem(ctx context.Context, entityID domain.EntityID, version int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Restore list item %s to version %d", entityID, version))
+	defer end()
+
 	oldVersion, err := s.listItemRepo.GetAtVersion(ctx, entityID, version)
 	if err != nil {
 		return err
 	}
 	if oldVersion == nil {
-		return fmt.Errorf("version not found: %d", version)
+		return fmt.Errorf("version %w: %d", domain.ErrNotFound, version)
 	}
 
 	current, err := s.listItemRepo.GetByEntityID(ctx, entityID)
@@ -39,10 +120,451 @@ func (s *HistoryService) RestoreItem(ctx context.Context, entityID domain.Entity
 		return err
 	}
 	if current == nil {
-		return fmt.Errorf("entity not found: %s", entityID)
+		return fmt.Errorf("entity %w: %s", domain.ErrNotFound, entityID)
 	}
 
-	current.Content = oldVersion.Content
+	// The item keeps its current place in the list: an old position may
+	// refer to siblings that have since moved or been removed.
+	current.SetContent(oldVersion.Content)
 	current.Type = oldVersion.Type
+	current.Priority = oldVersion.Priority
 	return s.listItemRepo.Update(ctx, *current)
 }
+
+func (s *HistoryService) GetEntryHistory(ctx context.Context, entityID domain.EntityID) ([]domain.EntryVersion, error) {
+	return s.entryHistoryRepo.GetHistory(ctx, entityID)
+}
+
+// ResolveEntryEntityID finds the entity behind an entry ID, so history can
+// be looked up by the IDs shown in the journal, even after a delete.
+func (s *HistoryService) ResolveEntryEntityID(ctx context.Context, entryID int64) (domain.EntityID, error) {
+	if s.entryHistoryRepo == nil {
+		return "", fmt.Errorf("entry history is not available")
+	}
+	entityID, err := s.entryHistoryRepo.FindEntityID(ctx, entryID)
+	if err != nil {
+		return "", err
+	}
+	if entityID.IsEmpty() {
+		return "", domain.Invalidf("no history for entry %d", entryID)
+	}
+	return entityID, nil
+}
+
+// RestoreEntry reverts an entry's type, content and priority to an earlier
+// version. If the entry has been deleted it is recreated under its old ID,
+// along with the children that were deleted with it.
+func (s *HistoryService) RestoreEntry(ctx context.Context, entityID domain.EntityID, version int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Restore entry %s to version %d", entityID, version))
+	defer end()
+
+	target, err := s.entryHistoryRepo.GetAtVersion(ctx, entityID, version)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return fmt.Errorf("version %w: %d", domain.ErrNotFound, version)
+	}
+	if target.IsDeleted() {
+		return domain.Invalidf("version %d records a delete; choose an earlier version", version)
+	}
+
+	history, err := s.entryHistoryRepo.GetHistory(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if history[len(history)-1].IsDeleted() {
+		return s.restoreDeletedEntry(ctx, entityID, target.Entry)
+	}
+
+	entry, err := s.entryRepo.GetByID(ctx, target.Entry.ID)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		return fmt.Errorf("entry %w: %d", domain.ErrNotFound, target.Entry.ID)
+	}
+
+	entry.Type = target.Entry.Type
+	entry.Content = target.Entry.Content
+	entry.Priority = target.Entry.Priority
+	if err := s.entryRepo.Update(ctx, *entry); err != nil {
+		return err
+	}
+	if err := s.deleteTagsAndMentions(ctx, entry.ID); err != nil {
+		return err
+	}
+	return s.insertTagsAndMentions(ctx, entry.ID, entry.Content)
+}
+
+func (s *HistoryService) restoreDeletedEntry(ctx context.Context, entityID domain.EntityID, target domain.Entry) error {
+	tree, err := s.entryHistoryRepo.GetDeletedTree(ctx, entityID)
+	if err != nil {
+		return err
+	}
+	if len(tree) == 0 {
+		return fmt.Errorf("entity %w: %s", domain.ErrNotFound, entityID)
+	}
+
+	root := tree[0].Entry
+	root.Type = target.Type
+	root.Content = target.Content
+	root.Priority = target.Priority
+
+	// Reattach to the old parent if it still exists, otherwise restore
+	// the entry at the top level of its day.
+	oldDepth := root.Depth
+	root.ParentID = nil
+	root.Depth = 0
+	if root.ParentEntityID != nil {
+		parent, err := s.currentEntry(ctx, *root.ParentEntityID)
+		if err != nil {
+			return err
+		}
+		if parent != nil {
+			root.ParentID = &parent.ID
+			root.Depth = parent.Depth + 1
+		}
+	}
+	depthDelta := root.Depth - oldDepth
+
+	ids := map[domain.EntityID]int64{root.EntityID: root.ID}
+	entries := []domain.Entry{root}
+	for _, v := range tree[1:] {
+		child := v.Entry
+		parentID, ok := ids[*child.ParentEntityID]
+		if !ok {
+			continue
+		}
+		child.ParentID = &parentID
+		child.Depth += depthDelta
+		ids[child.EntityID] = child.ID
+		entries = append(entries, child)
+	}
+
+	if err := s.entryHistoryRepo.Restore(ctx, entries); err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := s.insertTagsAndMentions(ctx, entry.ID, entry.Content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// currentEntry returns the live entry for an entity, or nil if it has been
+// deleted.
+func (s *HistoryService) currentEntry(ctx context.Context, entityID domain.EntityID) (*domain.Entry, error) {
+	history, err := s.entryHistoryRepo.GetHistory(ctx, entityID)
+	if err != nil || len(history) == 0 {
+		return nil, err
+	}
+	latest := history[len(history)-1]
+	if latest.IsDeleted() {
+		return nil, nil
+	}
+	return s.entryRepo.GetByID(ctx, latest.Entry.ID)
+}
+
+func (s *HistoryService) RestoreGoal(ctx context.Context, entityID domain.EntityID, version int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Restore goal %s to version %d", entityID, version))
+	defer end()
+
+	target, err := s.goalRepo.GetAtVersion(ctx, entityID, version)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return fmt.Errorf("version %w: %d", domain.ErrNotFound, version)
+	}
+	if target.IsDeleted() {
+		return domain.Invalidf("version %d records a delete; choose an earlier version", version)
+	}
+	return s.goalRepo.RestoreVersion(ctx, entityID, version)
+}
+
+func (s *HistoryService) RestoreHabit(ctx context.Context, entityID domain.EntityID, version int) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Restore habit %s to version %d", entityID, version))
+	defer end()
+
+	target, err := s.habitRepo.GetAtVersion(ctx, entityID, version)
+	if err != nil {
+		return err
+	}
+	if target == nil {
+		return fmt.Errorf("version %w: %d", domain.ErrNotFound, version)
+	}
+	if target.IsDeleted() {
+		return domain.Invalidf("version %d records a delete; choose an earlier version", version)
+	}
+	return s.habitRepo.RestoreVersion(ctx, entityID, version)
+}
+
+// historyKinds is the order kinds are looked up in when the caller does not
+// say which one it means.
+var historyKinds = []HistoryKind{HistoryKindListItem, HistoryKindEntry, HistoryKindGoal, HistoryKindHabit}
+
+// ParseHistoryKind reads a kind as it is given on the command line.
+func ParseHistoryKind(s string) (HistoryKind, error) {
+	switch s {
+	case "entry":
+		return HistoryKindEntry, nil
+	case "goal":
+		return HistoryKindGoal, nil
+	case "habit":
+		return HistoryKindHabit, nil
+	case "item", "list-item":
+		return HistoryKindListItem, nil
+	}
+	return "", domain.Invalidf("invalid kind: %s (use entry, goal, habit or item)", s)
+}
+
+// GetHistory returns the history of the entity as the given kind, or nil if
+// it has none. With no kind, it looks the entity up as every kind; a list
+// item scheduled into the journal shares its entity ID with the entry it
+// became, so an entity with history as more than one kind is rejected
+// rather than guessed at.
+func (s *HistoryService) GetHistory(ctx context.Context, kind HistoryKind, entityID domain.EntityID) (*EntityHistory, error) {
+	if kind != "" {
+		return s.historyOf(ctx, kind, entityID)
+	}
+
+	var found []*EntityHistory
+	for _, k := range historyKinds {
+		history, err := s.historyOf(ctx, k, entityID)
+		if err != nil {
+			return nil, err
+		}
+		if history != nil {
+			found = append(found, history)
+		}
+	}
+	switch len(found) {
+	case 0:
+		return nil, nil
+	case 1:
+		return found[0], nil
+	}
+	return nil, domain.Invalidf("%s has history as more than one kind (%s and %s); say which one to use", entityID, found[0].Kind, found[1].Kind)
+}
+
+func (s *HistoryService) historyOf(ctx context.Context, kind HistoryKind, entityID domain.EntityID) (*EntityHistory, error) {
+	switch kind {
+	case HistoryKindListItem:
+		items, err := s.listItemRepo.GetHistory(ctx, entityID)
+		if err != nil || len(items) == 0 {
+			return nil, err
+		}
+		infos := make([]domain.VersionInfo, len(items))
+		fields := make([][]historyField, len(items))
+		for i, item := range items {
+			infos[i] = item.VersionInfo
+			fields[i] = listItemHistoryFields(item)
+		}
+		return buildHistory(HistoryKindListItem, entityID, infos, fields), nil
+
+	case HistoryKindEntry:
+		if s.entryHistoryRepo == nil {
+			return nil, nil
+		}
+		entries, err := s.entryHistoryRepo.GetHistory(ctx, entityID)
+		if err != nil || len(entries) == 0 {
+			return nil, err
+		}
+		infos := make([]domain.VersionInfo, len(entries))
+		fields := make([][]historyField, len(entries))
+		for i, v := range entries {
+			infos[i] = v.VersionInfo
+			fields[i] = entryHistoryFields(v.Entry)
+		}
+		return buildHistory(HistoryKindEntry, entityID, infos, fields), nil
+
+	case HistoryKindGoal:
+		if s.goalRepo == nil {
+			return nil, nil
+		}
+		goals, err := s.goalRepo.GetHistory(ctx, entityID)
+		if err != nil || len(goals) == 0 {
+			return nil, err
+		}
+		infos := make([]domain.VersionInfo, len(goals))
+		fields := make([][]historyField, len(goals))
+		for i, v := range goals {
+			infos[i] = v.VersionInfo
+			fields[i] = []historyField{
+				{"content", v.Goal.Content},
+				{"month", v.Goal.Month.Format("2006-01")},
+				{"status", string(v.Goal.Status)},
+			}
+		}
+		return buildHistory(HistoryKindGoal, entityID, infos, fields), nil
+
+	case HistoryKindHabit:
+		if s.habitRepo == nil {
+			return nil, nil
+		}
+		habits, err := s.habitRepo.GetHistory(ctx, entityID)
+		if err != nil || len(habits) == 0 {
+			return nil, err
+		}
+		infos := make([]domain.VersionInfo, len(habits))
+		fields := make([][]historyField, len(habits))
+		for i, v := range habits {
+			infos[i] = v.VersionInfo
+			fields[i] = []historyField{
+				{"name", v.Habit.Name},
+				{"daily goal", strconv.Itoa(v.Habit.GoalPerDay)},
+				{"weekly goal", strconv.Itoa(v.Habit.GoalPerWeek)},
+				{"monthly goal", strconv.Itoa(v.Habit.GoalPerMonth)},
+				{"schedule", v.Habit.Schedule.Describe()},
+			}
+		}
+		return buildHistory(HistoryKindHabit, entityID, infos, fields), nil
+	}
+	return nil, domain.Invalidf("invalid kind: %s", kind)
+}
+
+// Restore reverts a versioned entity to an earlier version, looking it up
+// as GetHistory does. A version of 0 picks the one before the latest
+// change, or the state just before the entity was deleted. It returns the
+// version restored.
+func (s *HistoryService) Restore(ctx context.Context, kind HistoryKind, entityID domain.EntityID, version int) (int, error) {
+	history, err := s.GetHistory(ctx, kind, entityID)
+	if err != nil {
+		return 0, err
+	}
+	if history == nil {
+		return 0, domain.Invalidf("no history found for %s", entityID)
+	}
+
+	if version == 0 {
+		version = history.defaultRestoreVersion()
+		if version == 0 {
+			return 0, domain.Invalidf("no earlier version of this %s to restore", history.Kind)
+		}
+	}
+
+	switch history.Kind {
+	case HistoryKindListItem:
+		err = s.RestoreItem(ctx, entityID, version)
+	case HistoryKindEntry:
+		err = s.RestoreEntry(ctx, entityID, version)
+	case HistoryKindGoal:
+		err = s.RestoreGoal(ctx, entityID, version)
+	case HistoryKindHabit:
+		err = s.RestoreHabit(ctx, entityID, version)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return version, nil
+}
+
+func (h *EntityHistory) defaultRestoreVersion() int {
+	for i := len(h.Versions) - 2; i >= 0; i-- {
+		if !h.Versions[i].IsDeleted() {
+			return h.Versions[i].Version
+		}
+	}
+	return 0
+}
+
+func buildHistory(kind HistoryKind, entityID domain.EntityID, infos []domain.VersionInfo, fields [][]historyField) *EntityHistory {
+	history := &EntityHistory{Kind: kind, EntityID: entityID}
+	for i, info := range infos {
+		v := HistoryVersion{VersionInfo: info, Summary: historySummary(fields[i])}
+		if i > 0 && !info.IsDeleted() {
+			for f, field := range fields[i] {
+				if before := fields[i-1][f].value; before != field.value {
+					v.Changes = append(v.Changes, FieldChange{Field: field.name, Before: before, After: field.value})
+				}
+			}
+		}
+		history.Versions = append(history.Versions, v)
+	}
+	return history
+}
+
+// historySummary is the field that names a version in listings: an entry
+// or goal's content, or a habit's name.
+func historySummary(fields []historyField) string {
+	for _, field := range fields {
+		if field.name == "content" || field.name == "name" {
+			return field.value
+		}
+	}
+	return ""
+}
+
+func entryHistoryFields(entry domain.Entry) []historyField {
+	date := ""
+	if entry.ScheduledDate != nil {
+		date = entry.ScheduledDate.Format("2006-01-02")
+	}
+	parent := ""
+	if entry.ParentEntityID != nil {
+		parent = entry.ParentEntityID.String()
+	}
+	priority := string(entry.Priority)
+	if priority == "" {
+		priority = string(domain.PriorityNone)
+	}
+	return []historyField{
+		{"type", string(entry.Type)},
+		{"content", entry.Content},
+		{"priority", priority},
+		{"date", date},
+		{"parent", parent},
+	}
+}
+
+func listItemHistoryFields(item domain.ListItem) []historyField {
+	parent := ""
+	if item.ParentEntityID != nil {
+		parent = item.ParentEntityID.String()
+	}
+	priority := string(item.Priority)
+	if priority == "" {
+		priority = string(domain.PriorityNone)
+	}
+	return []historyField{
+		{"type", string(item.Type)},
+		{"content", item.Content},
+		{"priority", priority},
+		{"parent", parent},
+		{"order", strconv.Itoa(item.SortOrder)},
+	}
+}
+
+func (s *HistoryService) insertTagsAndMentions(ctx context.Context, entryID int64, content string) error {
+	if s.tagRepo != nil {
+		if tags := domain.ExtractTags(content); len(tags) > 0 {
+			if err := s.tagRepo.InsertEntryTags(ctx, entryID, tags); err != nil {
+				return err
+			}
+		}
+	}
+	if s.mentionRepo != nil {
+		if mentions := domain.ExtractMentions(content); len(mentions) > 0 {
+			if err := s.mentionRepo.InsertEntryMentions(ctx, entryID, mentions); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *HistoryService) deleteTagsAndMentions(ctx context.Context, entryID int64) error {
+	if s.tagRepo != nil {
+		if err := s.tagRepo.DeleteByEntryID(ctx, entryID); err != nil {
+			return err
+		}
+	}
+	if s.mentionRepo != nil {
+		if err := s.mentionRepo.DeleteByEntryID(ctx, entryID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
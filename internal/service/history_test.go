@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -123,6 +124,31 @@ func TestHistoryService_RestoreItem_CreatesNewVersionWithOldContent(t *testing.T
 	assert.Equal(t, 3, current.Version)
 }
 
+func TestHistoryService_RestoreItem_RestoresPriority(t *testing.T) {
+	svc, repo := setupHistoryService(t)
+	ctx := context.Background()
+
+	item := domain.NewListItem(domain.NewEntityID(), domain.ListItemTypeTask, "Buy milk")
+	_, err := repo.Insert(ctx, item)
+	require.NoError(t, err)
+
+	loaded, err := repo.GetByEntityID(ctx, item.EntityID)
+	require.NoError(t, err)
+	loaded.Priority = domain.PriorityHigh
+	require.NoError(t, repo.Update(ctx, *loaded))
+
+	history, err := svc.GetHistory(ctx, "", item.EntityID)
+	require.NoError(t, err)
+	require.Len(t, history.Versions, 2)
+	assert.Contains(t, history.Versions[1].Changes, FieldChange{Field: "priority", Before: "none", After: "high"})
+
+	require.NoError(t, svc.RestoreItem(ctx, item.EntityID, 1))
+
+	current, err := repo.GetByEntityID(ctx, item.EntityID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityNone, current.Priority)
+}
+
 func TestHistoryService_RestoreItem_FailsForInvalidVersion(t *testing.T) {
 	svc, repo := setupHistoryService(t)
 	ctx := context.Background()
@@ -137,3 +163,225 @@ func TestHistoryService_RestoreItem_FailsForInvalidVersion(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "version not found")
 }
+
+type versionedServices struct {
+	history   *HistoryService
+	bujo      *BujoService
+	list      *ListService
+	goal      *GoalService
+	habit     *HabitService
+	entry     *sqlite.EntryRepository
+	tagRepo   *sqlite.TagRepository
+	goalRepo  *sqlite.GoalRepository
+	habitRepo *sqlite.HabitRepository
+}
+
+func setupVersionedServices(t *testing.T) versionedServices {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	listRepo := sqlite.NewListRepository(db)
+	listItemRepo := sqlite.NewListItemRepository(db)
+	tagRepo := sqlite.NewTagRepository(db)
+	mentionRepo := sqlite.NewMentionRepository(db)
+	goalRepo := sqlite.NewGoalRepository(db)
+	habitRepo := sqlite.NewHabitRepository(db)
+
+	return versionedServices{
+		history: NewHistoryService(listItemRepo).
+			WithEntries(entryRepo, sqlite.NewEntryHistoryRepository(db), tagRepo, mentionRepo).
+			WithGoals(goalRepo).
+			WithHabits(habitRepo),
+		bujo: NewBujoServiceWithLists(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser(),
+			listRepo, listItemRepo, sqlite.NewEntryToListMover(db), tagRepo, mentionRepo),
+		list:      NewListService(listRepo, listItemRepo).WithEntryMover(sqlite.NewListItemToEntryMover(db)),
+		goal:      NewGoalService(goalRepo),
+		habit:     NewHabitService(habitRepo, sqlite.NewHabitLogRepository(db)),
+		entry:     entryRepo,
+		tagRepo:   tagRepo,
+		goalRepo:  goalRepo,
+		habitRepo: habitRepo,
+	}
+}
+
+func TestHistoryService_GetHistory_ShowsEntryChanges(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Buy milk", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.EditEntry(ctx, ids[0], "Buy oat milk"))
+	require.NoError(t, s.bujo.MarkDone(ctx, ids[0]))
+
+	entityID, err := s.history.ResolveEntryEntityID(ctx, ids[0])
+	require.NoError(t, err)
+	history, err := s.history.GetHistory(ctx, "", entityID)
+	require.NoError(t, err)
+	require.NotNil(t, history)
+
+	assert.Equal(t, HistoryKindEntry, history.Kind)
+	require.Len(t, history.Versions, 3)
+	assert.Empty(t, history.Versions[0].Changes)
+	assert.Equal(t, []FieldChange{{Field: "content", Before: "Buy milk", After: "Buy oat milk"}}, history.Versions[1].Changes)
+	assert.Equal(t, []FieldChange{{Field: "type", Before: "task", After: "done"}}, history.Versions[2].Changes)
+}
+
+func TestHistoryService_Restore_RevertsEntryEdit(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Call #dentist", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.EditEntry(ctx, ids[0], "oops"))
+
+	entityID, err := s.history.ResolveEntryEntityID(ctx, ids[0])
+	require.NoError(t, err)
+	version, err := s.history.Restore(ctx, "", entityID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+
+	entry, err := s.entry.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Call #dentist", entry.Content)
+	tags, err := s.tagRepo.GetTagsForEntries(ctx, []int64{ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"dentist"}, tags[ids[0]])
+}
+
+func TestHistoryService_Restore_RecoversDeletedSubtree(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Keep\n  . Project #work\n    - Detail\n      . Subtask", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	before, err := s.entry.GetByDate(ctx, date)
+	require.NoError(t, err)
+
+	entityID, err := s.history.ResolveEntryEntityID(ctx, ids[1])
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.DeleteEntry(ctx, ids[1]))
+
+	_, err = s.history.Restore(ctx, "", entityID, 0)
+	require.NoError(t, err)
+
+	after, err := s.entry.GetByDate(ctx, date)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+	tags, err := s.tagRepo.GetTagsForEntries(ctx, []int64{ids[1]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tags[ids[1]])
+}
+
+func TestHistoryService_Restore_RecoversDeletedChildAtTopLevel(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Project\n  - Detail\n    . Subtask", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	detailEntity, err := s.history.ResolveEntryEntityID(ctx, ids[1])
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.DeleteEntry(ctx, ids[0]))
+
+	_, err = s.history.Restore(ctx, "", detailEntity, 0)
+	require.NoError(t, err)
+
+	detail, err := s.entry.GetByID(ctx, ids[1])
+	require.NoError(t, err)
+	assert.Nil(t, detail.ParentID)
+	assert.Equal(t, 0, detail.Depth)
+	subtask, err := s.entry.GetByID(ctx, ids[2])
+	require.NoError(t, err)
+	assert.Equal(t, 1, subtask.Depth)
+	project, err := s.entry.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Nil(t, project)
+}
+
+func TestHistoryService_ScheduledItemEntryHasItsOwnHistory(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	list, err := s.list.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	itemID, err := s.list.AddItem(ctx, list.ID, domain.EntryTypeTask, "Renew passport")
+	require.NoError(t, err)
+	entryID, err := s.list.ScheduleItem(ctx, itemID, date)
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.EditEntry(ctx, entryID, "Renew passport online"))
+
+	entityID, err := s.history.ResolveEntryEntityID(ctx, entryID)
+	require.NoError(t, err)
+
+	_, err = s.history.GetHistory(ctx, "", entityID)
+	assert.Error(t, err, "the entity has history as a list item and an entry")
+
+	history, err := s.history.GetHistory(ctx, HistoryKindEntry, entityID)
+	require.NoError(t, err)
+	require.NotNil(t, history)
+	assert.Equal(t, HistoryKindEntry, history.Kind)
+	require.Len(t, history.Versions, 2)
+	assert.Equal(t, []FieldChange{{Field: "content", Before: "Renew passport", After: "Renew passport online"}}, history.Versions[1].Changes)
+
+	version, err := s.history.Restore(ctx, HistoryKindEntry, entityID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+	entry, err := s.entry.GetByID(ctx, entryID)
+	require.NoError(t, err)
+	assert.Equal(t, "Renew passport", entry.Content)
+
+	items, err := s.history.GetHistory(ctx, HistoryKindListItem, entityID)
+	require.NoError(t, err)
+	require.NotNil(t, items)
+	assert.Equal(t, HistoryKindListItem, items.Kind)
+}
+
+func TestHistoryService_Restore_GoalAndHabit(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+
+	goalID, err := s.goal.CreateGoal(ctx, "Run 5k", time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	goal, err := s.goalRepo.GetByID(ctx, goalID)
+	require.NoError(t, err)
+	require.NoError(t, s.goal.UpdateGoal(ctx, goalID, "Run 10k"))
+
+	version, err := s.history.Restore(ctx, "", goal.EntityID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, 1, version)
+	restored, err := s.goalRepo.GetByEntityID(ctx, goal.EntityID)
+	require.NoError(t, err)
+	assert.Equal(t, "Run 5k", restored.Content)
+
+	_, err = s.habit.CreateHabit(ctx, "Gym")
+	require.NoError(t, err)
+	require.NoError(t, s.habit.RenameHabit(ctx, "Gym", "Workout"))
+
+	habit, err := s.habitRepo.GetByName(ctx, "Workout")
+	require.NoError(t, err)
+	history, err := s.history.GetHistory(ctx, "", habit.EntityID)
+	require.NoError(t, err)
+	assert.Equal(t, HistoryKindHabit, history.Kind)
+	assert.Equal(t, []FieldChange{{Field: "name", Before: "Gym", After: "Workout"}}, history.Versions[1].Changes)
+}
+
+func TestHistoryService_Restore_NothingEarlier(t *testing.T) {
+	s := setupVersionedServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Only version", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	entityID, err := s.history.ResolveEntryEntityID(ctx, ids[0])
+	require.NoError(t, err)
+
+	_, err = s.history.Restore(ctx, "", entityID, 0)
+	assert.EqualError(t, err, "no earlier version of this entry to restore")
+}
@@ -3,13 +3,18 @@ package service
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/typingincolor/bujo/internal/domain"
 )
 
 type ListService struct {
-	listRepo     domain.ListRepository
-	listItemRepo domain.ListItemRepository
+	listRepo      domain.ListRepository
+	listItemRepo  domain.ListItemRepository
+	templateRepo  domain.ListTemplateRepository
+	entryMover    domain.ListItemToEntryMover
+	entrySearcher SmartListEntrySearcher
+	journal       *OperationJournal
 }
 
 func NewListService(listRepo domain.ListRepository, listItemRepo domain.ListItemRepository) *ListService {
@@ -19,13 +24,24 @@ func NewListService(listRepo domain.ListRepository, listItemRepo domain.ListItem
 	}
 }
 
+func (s *ListService) WithOperationJournal(journal *OperationJournal) *ListService {
+	s.journal = journal
+	return s
+}
+
+// WithEntryMover lets list items be scheduled onto a day in the journal.
+func (s *ListService) WithEntryMover(entryMover domain.ListItemToEntryMover) *ListService {
+	s.entryMover = entryMover
+	return s
+}
+
 func (s *ListService) getListByID(ctx context.Context, id int64) (*domain.List, error) {
 	list, err := s.listRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 	if list == nil {
-		return nil, fmt.Errorf("list not found: %d", id)
+		return nil, fmt.Errorf("list %w: %d", domain.ErrNotFound, id)
 	}
 	return list, nil
 }
@@ -36,12 +52,23 @@ func (s *ListService) getItemByID(ctx context.Context, id int64) (*domain.ListIt
 		return nil, err
 	}
 	if item == nil {
-		return nil, fmt.Errorf("item not found: %d", id)
+		return nil, fmt.Errorf("item %w: %d", domain.ErrNotFound, id)
+	}
+
+	list, err := s.listRepo.GetByEntityID(ctx, item.ListEntityID)
+	if err != nil {
+		return nil, err
+	}
+	if list != nil && list.IsSmart() {
+		return nil, smartListItemsError(list)
 	}
 	return item, nil
 }
 
 func (s *ListService) CreateList(ctx context.Context, name string) (*domain.List, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Create list %s", name))
+	defer end()
+
 	return s.listRepo.Create(ctx, name)
 }
 
@@ -55,7 +82,7 @@ func (s *ListService) GetListByName(ctx context.Context, name string) (*domain.L
 		return nil, err
 	}
 	if list == nil {
-		return nil, fmt.Errorf("list not found: %s", name)
+		return nil, fmt.Errorf("list %w: %s", domain.ErrNotFound, name)
 	}
 	return list, nil
 }
@@ -65,6 +92,9 @@ func (s *ListService) GetAllLists(ctx context.Context) ([]domain.List, error) {
 }
 
 func (s *ListService) RenameList(ctx context.Context, id int64, newName string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Rename list %d to %s", id, newName))
+	defer end()
+
 	if _, err := s.getListByID(ctx, id); err != nil {
 		return err
 	}
@@ -72,6 +102,9 @@ func (s *ListService) RenameList(ctx context.Context, id int64, newName string)
 }
 
 func (s *ListService) DeleteList(ctx context.Context, id int64, force bool) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete list %d", id))
+	defer end()
+
 	if _, err := s.getListByID(ctx, id); err != nil {
 		return err
 	}
@@ -86,7 +119,7 @@ func (s *ListService) DeleteList(ctx context.Context, id int64, force bool) erro
 	}
 
 	if !force {
-		return fmt.Errorf("list has items (%d); use force to delete anyway", count)
+		return domain.Invalidf("list has items (%d); use force to delete anyway", count)
 	}
 
 	items, err := s.listItemRepo.GetByListID(ctx, id)
@@ -104,14 +137,32 @@ func (s *ListService) DeleteList(ctx context.Context, id int64, force bool) erro
 }
 
 func (s *ListService) AddItem(ctx context.Context, listID int64, entryType domain.EntryType, content string) (int64, error) {
+	return s.AddItemWithOptions(ctx, listID, entryType, content, ListItemOptions{})
+}
+
+// ListItemOptions optionally nests a new list item under another item of the
+// same list and sets its priority and due date.
+type ListItemOptions struct {
+	ParentID int64
+	Priority domain.Priority
+	DueDate  *time.Time
+}
+
+func (s *ListService) AddItemWithOptions(ctx context.Context, listID int64, entryType domain.EntryType, content string, opts ListItemOptions) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Add item to list %d", listID))
+	defer end()
+
 	if entryType != domain.EntryTypeTask && entryType != domain.EntryTypeDone {
-		return 0, fmt.Errorf("only tasks can be added to lists")
+		return 0, domain.Invalidf("only tasks can be added to lists")
 	}
 
 	list, err := s.getListByID(ctx, listID)
 	if err != nil {
 		return 0, err
 	}
+	if list.IsSmart() {
+		return 0, smartListItemsError(list)
+	}
 
 	itemType := domain.ListItemTypeTask
 	if entryType == domain.EntryTypeDone {
@@ -119,21 +170,73 @@ func (s *ListService) AddItem(ctx context.Context, listID int64, entryType domai
 	}
 
 	item := domain.NewListItem(list.EntityID, itemType, content)
+	if opts.Priority != "" {
+		if !opts.Priority.IsValid() {
+			return 0, domain.Invalidf("invalid priority: %s", opts.Priority)
+		}
+		item.Priority = opts.Priority
+	}
+	item.DueDate = dueDay(opts.DueDate)
+
+	if opts.ParentID != 0 {
+		parent, err := s.getItemByID(ctx, opts.ParentID)
+		if err != nil {
+			return 0, err
+		}
+		if parent.ListEntityID != list.EntityID {
+			return 0, domain.Invalidf("item %d is not in list %d", opts.ParentID, listID)
+		}
+		item.ParentEntityID = &parent.EntityID
+	}
+
 	return s.listItemRepo.Insert(ctx, item)
 }
 
+// GetListItems returns a list's items in outline order: each item is
+// followed by its sub-items. A smart list's items are the journal entries
+// that currently match its query.
 func (s *ListService) GetListItems(ctx context.Context, listID int64) ([]domain.ListItem, error) {
-	return s.listItemRepo.GetByListID(ctx, listID)
+	list, err := s.listRepo.GetByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if list != nil && list.IsSmart() {
+		return s.smartListItems(ctx, *list)
+	}
+
+	items, err := s.listItemRepo.GetByListID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.OrderListItems(items), nil
 }
 
+// RemoveItem removes an item together with its sub-items.
 func (s *ListService) RemoveItem(ctx context.Context, itemID int64) error {
-	if _, err := s.getItemByID(ctx, itemID); err != nil {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Remove list item %d", itemID))
+	defer end()
+
+	item, err := s.getItemByID(ctx, itemID)
+	if err != nil {
 		return err
 	}
+
+	items, err := s.orderedItems(ctx, item.ListEntityID)
+	if err != nil {
+		return err
+	}
+	for _, child := range listItemDescendants(items, item.EntityID) {
+		if err := s.listItemRepo.Delete(ctx, child.RowID); err != nil {
+			return err
+		}
+	}
 	return s.listItemRepo.Delete(ctx, itemID)
 }
 
 func (s *ListService) MarkDone(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark list item %d done", itemID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
@@ -144,6 +247,9 @@ func (s *ListService) MarkDone(ctx context.Context, itemID int64) error {
 }
 
 func (s *ListService) MarkUndone(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Mark list item %d undone", itemID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
@@ -154,6 +260,9 @@ func (s *ListService) MarkUndone(ctx context.Context, itemID int64) error {
 }
 
 func (s *ListService) Cancel(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Cancel list item %d", itemID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
@@ -164,6 +273,9 @@ func (s *ListService) Cancel(ctx context.Context, itemID int64) error {
 }
 
 func (s *ListService) Uncancel(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Uncancel list item %d", itemID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
@@ -173,7 +285,11 @@ func (s *ListService) Uncancel(ctx context.Context, itemID int64) error {
 	return s.listItemRepo.Update(ctx, *item)
 }
 
+// MoveItem moves an item and its sub-items to the end of another list.
 func (s *ListService) MoveItem(ctx context.Context, itemID int64, targetListID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move list item %d to list %d", itemID, targetListID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
@@ -183,24 +299,370 @@ func (s *ListService) MoveItem(ctx context.Context, itemID int64, targetListID i
 	if err != nil {
 		return err
 	}
+	if targetList.IsSmart() {
+		return smartListItemsError(targetList)
+	}
+	if targetList.EntityID == item.ListEntityID {
+		return nil
+	}
+
+	items, err := s.orderedItems(ctx, item.ListEntityID)
+	if err != nil {
+		return err
+	}
 
 	item.ListEntityID = targetList.EntityID
+	item.ParentEntityID = nil
+	item.SortOrder = 0
+	if err := s.listItemRepo.Update(ctx, *item); err != nil {
+		return err
+	}
+
+	for _, child := range listItemDescendants(items, item.EntityID) {
+		child.ListEntityID = targetList.EntityID
+		if err := s.listItemRepo.Update(ctx, child); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ScheduleItem moves a task from its list onto date in the journal and
+// returns the new entry's ID. The item stays in the list, marked scheduled,
+// and its sub-items stay where they are.
+func (s *ListService) ScheduleItem(ctx context.Context, itemID int64, date time.Time) (int64, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Schedule list item %d for %s", itemID, date.Format("2006-01-02")))
+	defer end()
+
+	if s.entryMover == nil {
+		return 0, fmt.Errorf("scheduling list items is not available")
+	}
+
+	item, err := s.getItemByID(ctx, itemID)
+	if err != nil {
+		return 0, err
+	}
+	if !item.CanSchedule() {
+		return 0, domain.Invalidf("only open tasks can be scheduled, this item is %s", item.Type)
+	}
+
+	entryID, err := s.entryMover.MoveListItemToEntry(ctx, *item, date)
+	if err != nil {
+		return 0, fmt.Errorf("failed to schedule list item: %w", err)
+	}
+	return entryID, nil
+}
+
+// MoveItemBefore places an item, with its sub-items, just before another
+// item of the same list, at that item's level.
+func (s *ListService) MoveItemBefore(ctx context.Context, itemID, targetID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move list item %d before %d", itemID, targetID))
+	defer end()
+
+	return s.moveItemNextTo(ctx, itemID, targetID, false)
+}
+
+// MoveItemAfter places an item, with its sub-items, just after another item
+// of the same list, at that item's level.
+func (s *ListService) MoveItemAfter(ctx context.Context, itemID, targetID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move list item %d after %d", itemID, targetID))
+	defer end()
+
+	return s.moveItemNextTo(ctx, itemID, targetID, true)
+}
+
+func (s *ListService) moveItemNextTo(ctx context.Context, itemID, targetID int64, after bool) error {
+	item, target, items, err := s.getItemPair(ctx, itemID, targetID)
+	if err != nil {
+		return err
+	}
+
+	parent := listItemParent(*target)
+	siblings := removeListItem(listItemChildren(items, parent), item.EntityID)
+	pos := indexOfListItem(siblings, target.EntityID)
+	if after {
+		pos++
+	}
+
+	item.ParentEntityID = parent
+	return s.placeItem(ctx, siblings, *item, pos)
+}
+
+// SetItemParent nests an item, with its sub-items, as the last sub-item of
+// another item of the same list. A parentID of 0 makes it a top-level item.
+func (s *ListService) SetItemParent(ctx context.Context, itemID, parentID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set parent of list item %d", itemID))
+	defer end()
+
+	if parentID == 0 {
+		item, err := s.getItemByID(ctx, itemID)
+		if err != nil {
+			return err
+		}
+		items, err := s.orderedItems(ctx, item.ListEntityID)
+		if err != nil {
+			return err
+		}
+		siblings := removeListItem(listItemChildren(items, nil), item.EntityID)
+		item.ParentEntityID = nil
+		return s.placeItem(ctx, siblings, *item, len(siblings))
+	}
+
+	item, parent, items, err := s.getItemPair(ctx, itemID, parentID)
+	if err != nil {
+		return err
+	}
+
+	siblings := removeListItem(listItemChildren(items, &parent.EntityID), item.EntityID)
+	item.ParentEntityID = &parent.EntityID
+	return s.placeItem(ctx, siblings, *item, len(siblings))
+}
+
+// IndentItem nests an item under the sibling just above it.
+func (s *ListService) IndentItem(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Indent list item %d", itemID))
+	defer end()
+
+	item, items, err := s.getOrderedItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	siblings := listItemChildren(items, listItemParent(*item))
+	pos := indexOfListItem(siblings, item.EntityID)
+	if pos <= 0 {
+		return domain.Invalidf("item %d has no item above it to indent under", itemID)
+	}
+	parent := siblings[pos-1]
+
+	children := listItemChildren(items, &parent.EntityID)
+	item.ParentEntityID = &parent.EntityID
+	return s.placeItem(ctx, children, *item, len(children))
+}
+
+// OutdentItem moves a sub-item up a level, just after its parent.
+func (s *ListService) OutdentItem(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Outdent list item %d", itemID))
+	defer end()
+
+	item, items, err := s.getOrderedItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+	if item.Depth == 0 {
+		return domain.Invalidf("item %d is already a top-level item", itemID)
+	}
+
+	idx := indexOfListItem(items, *item.ParentEntityID)
+	parent := items[idx]
+	grandparent := listItemParent(parent)
+
+	siblings := removeListItem(listItemChildren(items, grandparent), item.EntityID)
+	item.ParentEntityID = grandparent
+	return s.placeItem(ctx, siblings, *item, indexOfListItem(siblings, parent.EntityID)+1)
+}
+
+// MoveItemUp swaps an item with the sibling above it.
+func (s *ListService) MoveItemUp(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move list item %d up", itemID))
+	defer end()
+
+	return s.shiftItem(ctx, itemID, -1)
+}
+
+// MoveItemDown swaps an item with the sibling below it.
+func (s *ListService) MoveItemDown(ctx context.Context, itemID int64) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Move list item %d down", itemID))
+	defer end()
+
+	return s.shiftItem(ctx, itemID, 1)
+}
+
+func (s *ListService) shiftItem(ctx context.Context, itemID int64, offset int) error {
+	item, items, err := s.getOrderedItem(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	siblings := listItemChildren(items, listItemParent(*item))
+	pos := indexOfListItem(siblings, item.EntityID) + offset
+	if pos < 0 || pos >= len(siblings) {
+		return nil
+	}
+	return s.placeItem(ctx, removeListItem(siblings, item.EntityID), *item, pos)
+}
+
+func (s *ListService) SetItemPriority(ctx context.Context, itemID int64, priority domain.Priority) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set priority of list item %d", itemID))
+	defer end()
+
+	if !priority.IsValid() {
+		return domain.Invalidf("invalid priority: %s", priority)
+	}
+
+	item, err := s.getItemByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	item.Priority = priority
 	return s.listItemRepo.Update(ctx, *item)
 }
 
 func (s *ListService) EditItem(ctx context.Context, itemID int64, content string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Edit list item %d", itemID))
+	defer end()
+
 	item, err := s.getItemByID(ctx, itemID)
 	if err != nil {
 		return err
 	}
 
-	item.Content = content
+	item.SetContent(content)
 	return s.listItemRepo.Update(ctx, *item)
 }
 
+func (s *ListService) orderedItems(ctx context.Context, listEntityID domain.EntityID) ([]domain.ListItem, error) {
+	items, err := s.listItemRepo.GetByListEntityID(ctx, listEntityID)
+	if err != nil {
+		return nil, err
+	}
+	return domain.OrderListItems(items), nil
+}
+
+// getOrderedItem returns an item, with its depth set, and the ordered items
+// of its list.
+func (s *ListService) getOrderedItem(ctx context.Context, itemID int64) (*domain.ListItem, []domain.ListItem, error) {
+	item, err := s.getItemByID(ctx, itemID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	items, err := s.orderedItems(ctx, item.ListEntityID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	idx := indexOfListItem(items, item.EntityID)
+	if idx < 0 {
+		return nil, nil, fmt.Errorf("item %w: %d", domain.ErrNotFound, itemID)
+	}
+	ordered := items[idx]
+	return &ordered, items, nil
+}
+
+// getItemPair returns an item and another item of the same list that it is
+// being placed relative to. The other item cannot be the item itself or one
+// of its sub-items.
+func (s *ListService) getItemPair(ctx context.Context, itemID, otherID int64) (*domain.ListItem, *domain.ListItem, []domain.ListItem, error) {
+	item, items, err := s.getOrderedItem(ctx, itemID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	other, err := s.getItemByID(ctx, otherID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if other.ListEntityID != item.ListEntityID {
+		return nil, nil, nil, domain.Invalidf("items %d and %d are in different lists", itemID, otherID)
+	}
+	if other.EntityID == item.EntityID {
+		return nil, nil, nil, domain.Invalidf("cannot place item %d relative to itself", itemID)
+	}
+	for _, child := range listItemDescendants(items, item.EntityID) {
+		if child.EntityID == other.EntityID {
+			return nil, nil, nil, domain.Invalidf("cannot place item %d relative to its own sub-item %d", itemID, otherID)
+		}
+	}
+
+	ordered := items[indexOfListItem(items, other.EntityID)]
+	return item, &ordered, items, nil
+}
+
+// placeItem inserts item into siblings at pos and renumbers the siblings,
+// saving only those whose position changed.
+func (s *ListService) placeItem(ctx context.Context, siblings []domain.ListItem, item domain.ListItem, pos int) error {
+	placed := make([]domain.ListItem, 0, len(siblings)+1)
+	placed = append(placed, siblings[:pos]...)
+	placed = append(placed, item)
+	placed = append(placed, siblings[pos:]...)
+
+	for i, sibling := range placed {
+		order := i + 1
+		if sibling.EntityID != item.EntityID && sibling.SortOrder == order {
+			continue
+		}
+		sibling.SortOrder = order
+		if err := s.listItemRepo.Update(ctx, sibling); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listItemParent returns the parent an ordered item is displayed under, or
+// nil for a top-level item.
+func listItemParent(item domain.ListItem) *domain.EntityID {
+	if item.Depth == 0 {
+		return nil
+	}
+	return item.ParentEntityID
+}
+
+// listItemChildren returns the ordered items directly under parent, or the
+// top-level items when parent is nil.
+func listItemChildren(items []domain.ListItem, parent *domain.EntityID) []domain.ListItem {
+	var children []domain.ListItem
+	for _, item := range items {
+		p := listItemParent(item)
+		if (parent == nil && p == nil) || (parent != nil && p != nil && *p == *parent) {
+			children = append(children, item)
+		}
+	}
+	return children
+}
+
+// listItemDescendants returns the sub-items of an item, at any depth.
+func listItemDescendants(items []domain.ListItem, entityID domain.EntityID) []domain.ListItem {
+	idx := indexOfListItem(items, entityID)
+	if idx < 0 {
+		return nil
+	}
+
+	var descendants []domain.ListItem
+	for _, item := range items[idx+1:] {
+		if item.Depth <= items[idx].Depth {
+			break
+		}
+		descendants = append(descendants, item)
+	}
+	return descendants
+}
+
+func indexOfListItem(items []domain.ListItem, entityID domain.EntityID) int {
+	for i, item := range items {
+		if item.EntityID == entityID {
+			return i
+		}
+	}
+	return -1
+}
+
+func removeListItem(items []domain.ListItem, entityID domain.EntityID) []domain.ListItem {
+	var rest []domain.ListItem
+	for _, item := range items {
+		if item.EntityID != entityID {
+			rest = append(rest, item)
+		}
+	}
+	return rest
+}
+
 type ListSummary struct {
 	ID         int64
 	Name       string
+	Query      string
 	TotalItems int
 	DoneItems  int
 }
@@ -210,6 +672,9 @@ func (s *ListService) GetListSummary(ctx context.Context, listID int64) (*ListSu
 	if err != nil {
 		return nil, err
 	}
+	if list.IsSmart() {
+		return s.smartListSummary(ctx, *list)
+	}
 
 	total, err := s.listRepo.GetItemCount(ctx, listID)
 	if err != nil {
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// SetItemDueDate sets the day a list item is due. A nil due date clears it.
+func (s *ListService) SetItemDueDate(ctx context.Context, itemID int64, due *time.Time) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set due date of list item %d", itemID))
+	defer end()
+
+	item, err := s.getItemByID(ctx, itemID)
+	if err != nil {
+		return err
+	}
+
+	item.DueDate = dueDay(due)
+	return s.listItemRepo.Update(ctx, *item)
+}
+
+// OverdueListItem is an open list item that was due before today, with
+// the name of its list.
+type OverdueListItem struct {
+	domain.ListItem
+	ListName string
+}
+
+// GetOverdueItems returns the open items of every list that were due
+// before today, the earliest due first.
+func (s *ListService) GetOverdueItems(ctx context.Context, today time.Time) ([]OverdueListItem, error) {
+	return overdueListItems(ctx, s.listRepo, s.listItemRepo, today)
+}
+
+func overdueListItems(ctx context.Context, listRepo domain.ListRepository, listItemRepo domain.ListItemRepository, today time.Time) ([]OverdueListItem, error) {
+	items, err := listItemRepo.GetOverdue(ctx, today)
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, nil
+	}
+
+	lists, err := listRepo.GetAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[domain.EntityID]string, len(lists))
+	for _, list := range lists {
+		names[list.EntityID] = list.Name
+	}
+
+	overdue := make([]OverdueListItem, 0, len(items))
+	for _, item := range items {
+		overdue = append(overdue, OverdueListItem{ListItem: item, ListName: names[item.ListEntityID]})
+	}
+	return overdue, nil
+}
+
+// dueDay keeps only the calendar date of a due date, as that is all that
+// is stored.
+func dueDay(due *time.Time) *time.Time {
+	if due == nil {
+		return nil
+	}
+	day := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.UTC)
+	return &day
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestListService_AddItemWithOptions_DueDate(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+	due := time.Date(2026, 10, 23, 18, 45, 0, 0, time.Local)
+
+	list, err := svc.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", ListItemOptions{DueDate: &due})
+	require.NoError(t, err)
+
+	items, err := svc.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	require.NotNil(t, items[0].DueDate)
+	assert.Equal(t, "2026-10-23", items[0].DueDate.Format("2006-01-02"))
+}
+
+func TestListService_SetItemDueDate(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	due := time.Date(2026, 10, 23, 0, 0, 0, 0, time.UTC)
+
+	list, err := s.list.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	ids := addListItems(t, s.list, list.ID, "Renew passport")
+
+	require.NoError(t, s.list.SetItemDueDate(ctx, ids[0], &due))
+	items, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.NotNil(t, items[0].DueDate)
+	assert.Equal(t, "2026-10-23", items[0].DueDate.Format("2006-01-02"))
+
+	require.NoError(t, s.list.SetItemDueDate(ctx, items[0].RowID, nil))
+	items, err = s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Nil(t, items[0].DueDate)
+
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+	items, err = s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.NotNil(t, items[0].DueDate)
+	assert.Equal(t, "2026-10-23", items[0].DueDate.Format("2006-01-02"))
+}
+
+func TestListService_GetOverdueItems(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+	today := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+	lastWeek := today.AddDate(0, 0, -7)
+	nextWeek := today.AddDate(0, 0, 7)
+
+	errands, err := svc.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	work, err := svc.CreateList(ctx, "Work")
+	require.NoError(t, err)
+	_, err = svc.AddItemWithOptions(ctx, errands.ID, domain.EntryTypeTask, "Renew passport", ListItemOptions{DueDate: &lastWeek})
+	require.NoError(t, err)
+	_, err = svc.AddItemWithOptions(ctx, work.ID, domain.EntryTypeTask, "Submit expenses", ListItemOptions{DueDate: &nextWeek})
+	require.NoError(t, err)
+
+	overdue, err := svc.GetOverdueItems(ctx, today)
+
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, "Renew passport", overdue[0].Content)
+	assert.Equal(t, "Errands", overdue[0].ListName)
+}
+
+func TestBujoService_GetMultiDayAgenda_IncludesOverdueListItems(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	lastWeek := time.Now().AddDate(0, 0, -7)
+
+	list, err := s.list.CreateList(ctx, "Errands")
+	require.NoError(t, err)
+	_, err = s.list.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", ListItemOptions{DueDate: &lastWeek})
+	require.NoError(t, err)
+
+	agenda, err := s.bujo.GetMultiDayAgenda(ctx, lastWeek, time.Now())
+
+	require.NoError(t, err)
+	require.Len(t, agenda.OverdueItems, 1)
+	assert.Equal(t, "Renew passport", agenda.OverdueItems[0].Content)
+	assert.Equal(t, "Errands", agenda.OverdueItems[0].ListName)
+}
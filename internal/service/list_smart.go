@@ -0,0 +1,105 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// smartListLimit caps how many entries a smart list shows.
+const smartListLimit = 500
+
+type SmartListEntrySearcher interface {
+	Search(ctx context.Context, opts domain.SearchOptions) ([]domain.Entry, error)
+}
+
+// WithSmartLists lets lists be defined by a saved search query, with the
+// matching journal entries as their items.
+func (s *ListService) WithSmartLists(searcher SmartListEntrySearcher) *ListService {
+	s.entrySearcher = searcher
+	return s
+}
+
+// CreateSmartList creates a list whose items are the journal entries that
+// match query, written in the search query language.
+func (s *ListService) CreateSmartList(ctx context.Context, name, query string) (*domain.List, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Create smart list %s", name))
+	defer end()
+
+	if s.entrySearcher == nil {
+		return nil, fmt.Errorf("smart lists are not available")
+	}
+
+	list := domain.NewSmartList(name, query)
+	if !list.IsSmart() {
+		return nil, domain.Invalidf("smart list query cannot be empty")
+	}
+	if err := list.Validate(); err != nil {
+		return nil, err
+	}
+
+	id, err := s.listRepo.InsertWithEntityID(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+	list.ID = id
+	return &list, nil
+}
+
+// SetSmartListQuery changes the query a smart list's items come from.
+func (s *ListService) SetSmartListQuery(ctx context.Context, listID int64, query string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Set query of list %d", listID))
+	defer end()
+
+	list, err := s.getListByID(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if !list.IsSmart() {
+		return domain.Invalidf("list %s is not a smart list", list.Name)
+	}
+	if err := domain.ValidateSmartListQuery(query); err != nil {
+		return err
+	}
+	return s.listRepo.UpdateQuery(ctx, listID, query)
+}
+
+// smartListItems runs a smart list's query and shows the matching entries
+// as its items.
+func (s *ListService) smartListItems(ctx context.Context, list domain.List) ([]domain.ListItem, error) {
+	if s.entrySearcher == nil {
+		return nil, fmt.Errorf("smart lists are not available")
+	}
+
+	entries, err := s.entrySearcher.Search(ctx, domain.NewSearchOptions(list.Query).WithLimit(smartListLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed to run query of smart list %s: %w", list.Name, err)
+	}
+
+	items := make([]domain.ListItem, len(entries))
+	for i, entry := range entries {
+		items[i] = domain.ListItemFromEntry(list.EntityID, entry)
+		items[i].SortOrder = i + 1
+	}
+	return items, nil
+}
+
+func (s *ListService) smartListSummary(ctx context.Context, list domain.List) (*ListSummary, error) {
+	items, err := s.smartListItems(ctx, list)
+	if err != nil {
+		return nil, err
+	}
+
+	summary := &ListSummary{ID: list.ID, Name: list.Name, Query: list.Query, TotalItems: len(items)}
+	for _, item := range items {
+		if item.IsComplete() {
+			summary.DoneItems++
+		}
+	}
+	return summary, nil
+}
+
+func smartListItemsError(list *domain.List) error {
+	return domain.Invalidf("list %s is a smart list: its items come from the query %q", list.Name, list.Query)
+}
@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+func TestListService_SmartList_ShowsMatchingEntries(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Chase invoice #waiting\n. ! Hear back from @sam #waiting\n. Buy milk", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+
+	list, err := s.list.CreateSmartList(ctx, "Waiting on others", "#waiting status:open")
+	require.NoError(t, err)
+	assert.True(t, list.IsSmart())
+
+	items, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 2)
+	assert.ElementsMatch(t, []int64{ids[0], ids[1]}, []int64{items[0].EntryID, items[1].EntryID})
+	assert.Zero(t, items[0].RowID)
+
+	require.NoError(t, s.bujo.MarkDone(ctx, ids[0]))
+
+	items, err = s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, ids[1], items[0].EntryID)
+	assert.Equal(t, domain.PriorityLow, items[0].Priority)
+	assert.Equal(t, []string{"sam"}, items[0].Mentions)
+}
+
+func TestListService_SmartList_Summary(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Book venue #party\n. Send invites #party", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.MarkDone(ctx, ids[0]))
+
+	list, err := s.list.CreateSmartList(ctx, "Party", "#party")
+	require.NoError(t, err)
+
+	summary, err := s.list.GetListSummary(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, 2, summary.TotalItems)
+	assert.Equal(t, 1, summary.DoneItems)
+
+	lists, err := s.list.GetAllLists(ctx)
+	require.NoError(t, err)
+	require.Len(t, lists, 1)
+	assert.Equal(t, "#party", lists[0].Query)
+}
+
+func TestListService_CreateSmartList_RejectsBadQuery(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	_, err := s.list.CreateSmartList(ctx, "Urgent", "priority:urgent")
+	assert.ErrorContains(t, err, "invalid priority")
+
+	_, err = s.list.CreateSmartList(ctx, "Everything", "  ")
+	assert.ErrorContains(t, err, "query cannot be empty")
+
+	lists, err := s.list.GetAllLists(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+}
+
+func TestListService_SmartList_HasNoItemsOfItsOwn(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	smart, err := s.list.CreateSmartList(ctx, "Urgent", "priority:high status:open")
+	require.NoError(t, err)
+	_, err = s.list.AddItem(ctx, smart.ID, domain.EntryTypeTask, "Something")
+	assert.ErrorContains(t, err, "is a smart list")
+
+	list, err := s.list.CreateList(ctx, "Inbox")
+	require.NoError(t, err)
+	itemID, err := s.list.AddItem(ctx, list.ID, domain.EntryTypeTask, "Something")
+	require.NoError(t, err)
+	assert.ErrorContains(t, s.list.MoveItem(ctx, itemID, smart.ID), "is a smart list")
+}
+
+func TestListService_SetSmartListQuery(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	smart, err := s.list.CreateSmartList(ctx, "Urgent", "priority:high")
+	require.NoError(t, err)
+	require.NoError(t, s.list.SetSmartListQuery(ctx, smart.ID, "priority:high status:open"))
+
+	updated, err := s.list.GetListByID(ctx, smart.ID)
+	require.NoError(t, err)
+	assert.Equal(t, "priority:high status:open", updated.Query)
+	assert.Equal(t, "Urgent", updated.Name)
+
+	assert.Error(t, s.list.SetSmartListQuery(ctx, updated.ID, "status:someday"))
+
+	list, err := s.list.CreateList(ctx, "Inbox")
+	require.NoError(t, err)
+	assert.ErrorContains(t, s.list.SetSmartListQuery(ctx, list.ID, "#work"), "not a smart list")
+}
+
+func TestListService_CreateSmartList_Undo(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	_, err := s.list.CreateSmartList(ctx, "Urgent", "priority:high")
+	require.NoError(t, err)
+
+	op, err := s.journal.Undo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Create smart list Urgent", op.Description)
+
+	lists, err := s.list.GetAllLists(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+}
+
+func TestListService_SmartList_ItemsCannotBeChanged(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	list, err := s.list.CreateSmartList(ctx, "Waiting", "#waiting")
+	require.NoError(t, err)
+	itemID, err := s.list.listItemRepo.Insert(ctx, domain.NewListItem(list.EntityID, domain.ListItemTypeTask, "Stray item"))
+	require.NoError(t, err)
+
+	for name, change := range map[string]func() error{
+		"done":   func() error { return s.list.MarkDone(ctx, itemID) },
+		"cancel": func() error { return s.list.Cancel(ctx, itemID) },
+		"edit":   func() error { return s.list.EditItem(ctx, itemID, "Edited") },
+		"remove": func() error { return s.list.RemoveItem(ctx, itemID) },
+	} {
+		err := change()
+		require.Error(t, err, name)
+		assert.Contains(t, err.Error(), "smart list", name)
+	}
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// WithTemplates lets lists be saved as reusable templates and created from
+// them.
+func (s *ListService) WithTemplates(templateRepo domain.ListTemplateRepository) *ListService {
+	s.templateRepo = templateRepo
+	return s
+}
+
+// SaveTemplate captures a list's items as a template, replacing any template
+// with the same name. An empty name saves the template under the list's name.
+func (s *ListService) SaveTemplate(ctx context.Context, listID int64, name string) (*domain.ListTemplate, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Save list %d as a template", listID))
+	defer end()
+
+	if s.templateRepo == nil {
+		return nil, fmt.Errorf("list templates are not available")
+	}
+
+	list, err := s.getListByID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(name) == "" {
+		name = list.Name
+	}
+
+	items, err := s.GetListItems(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+
+	template := domain.ListTemplate{Name: strings.TrimSpace(name), Content: domain.ListTemplateContent(items)}
+	if err := template.Validate(); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.templateRepo.GetByName(ctx, template.Name)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		existing.Content = template.Content
+		if err := s.templateRepo.Update(ctx, *existing); err != nil {
+			return nil, err
+		}
+		return s.templateRepo.GetByName(ctx, existing.Name)
+	}
+
+	if _, err := s.templateRepo.Insert(ctx, template); err != nil {
+		return nil, err
+	}
+	return s.templateRepo.GetByName(ctx, template.Name)
+}
+
+func (s *ListService) GetTemplates(ctx context.Context) ([]domain.ListTemplate, error) {
+	if s.templateRepo == nil {
+		return nil, nil
+	}
+	return s.templateRepo.GetAll(ctx)
+}
+
+func (s *ListService) GetTemplate(ctx context.Context, name string) (*domain.ListTemplate, error) {
+	if s.templateRepo == nil {
+		return nil, fmt.Errorf("list templates are not available")
+	}
+
+	template, err := s.templateRepo.GetByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if template == nil {
+		return nil, fmt.Errorf("template %w: %s", domain.ErrNotFound, name)
+	}
+	return template, nil
+}
+
+func (s *ListService) DeleteTemplate(ctx context.Context, name string) error {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Delete template %s", name))
+	defer end()
+
+	template, err := s.GetTemplate(ctx, name)
+	if err != nil {
+		return err
+	}
+	return s.templateRepo.Delete(ctx, template.ID)
+}
+
+// ExpandTemplate returns a template's content with its variables filled in
+// from vars, in the journal's entry syntax.
+func (s *ListService) ExpandTemplate(ctx context.Context, name string, vars map[string]string) (string, error) {
+	template, err := s.GetTemplate(ctx, name)
+	if err != nil {
+		return "", err
+	}
+	return domain.ExpandTemplate(template.Content, vars)
+}
+
+// CreateListFromTemplate creates a list holding a template's items, with
+// its variables filled in from vars.
+func (s *ListService) CreateListFromTemplate(ctx context.Context, listName, templateName string, vars map[string]string) (*domain.List, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Create list %s from template %s", listName, templateName))
+	defer end()
+
+	content, err := s.ExpandTemplate(ctx, templateName, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := domain.NewTreeParser().Parse(content)
+	if err != nil {
+		return nil, err
+	}
+
+	list, err := s.CreateList(ctx, listName)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parsed entries refer to their parent by its index in entries.
+	entityIDs := make([]domain.EntityID, len(entries))
+	for i, entry := range entries {
+		itemType := domain.ListItemTypeTask
+		if entry.Type == domain.EntryTypeDone {
+			itemType = domain.ListItemTypeDone
+		}
+
+		item := domain.NewListItem(list.EntityID, itemType, entry.Content)
+		item.Priority = entry.Priority
+		if entry.ParentID != nil {
+			parent := entityIDs[*entry.ParentID]
+			item.ParentEntityID = &parent
+		}
+		if _, err := s.listItemRepo.Insert(ctx, item); err != nil {
+			return nil, err
+		}
+		entityIDs[i] = item.EntityID
+	}
+
+	return list, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+func setupListTemplateService(t *testing.T) *ListService {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	return NewListService(sqlite.NewListRepository(db), sqlite.NewListItemRepository(db)).
+		WithTemplates(sqlite.NewListTemplateRepository(db))
+}
+
+func TestListService_SaveTemplate_CapturesOutline(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Release")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Update changelog", "Tag {{version}}")
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeDone, "Bump version", ListItemOptions{ParentID: ids[0], Priority: domain.PriorityHigh})
+	require.NoError(t, err)
+
+	template, err := svc.SaveTemplate(ctx, list.ID, "")
+
+	require.NoError(t, err)
+	assert.Equal(t, "Release", template.Name)
+	assert.Equal(t, ". Update changelog\n  . !!! Bump version\n. Tag {{version}}\n", template.Content)
+}
+
+func TestListService_SaveTemplate_ReplacesTemplateWithSameName(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Socks")
+	_, err = svc.SaveTemplate(ctx, list.ID, "trip")
+	require.NoError(t, err)
+
+	require.NoError(t, svc.EditItem(ctx, ids[0], "Passport"))
+	_, err = svc.SaveTemplate(ctx, list.ID, "Trip")
+	require.NoError(t, err)
+
+	templates, err := svc.GetTemplates(ctx)
+	require.NoError(t, err)
+	require.Len(t, templates, 1)
+	assert.Equal(t, ". Passport\n", templates[0].Content)
+}
+
+func TestListService_SaveTemplate_EmptyListErrors(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Empty")
+	require.NoError(t, err)
+
+	_, err = svc.SaveTemplate(ctx, list.ID, "")
+
+	assert.Error(t, err)
+}
+
+func TestListService_CreateListFromTemplate(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	source, err := svc.CreateList(ctx, "Release")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, source.ID, "Update changelog", "Tag {{version}} on {{date}}")
+	_, err = svc.AddItemWithOptions(ctx, source.ID, domain.EntryTypeTask, "Bump version", ListItemOptions{ParentID: ids[0], Priority: domain.PriorityLow})
+	require.NoError(t, err)
+	_, err = svc.SaveTemplate(ctx, source.ID, "release")
+	require.NoError(t, err)
+
+	vars := domain.TemplateVariables(time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC))
+	vars["version"] = "1.4.0"
+	list, err := svc.CreateListFromTemplate(ctx, "Release 1.4.0", "release", vars)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Update changelog@0", "Bump version@1", "Tag 1.4.0 on 2026-10-17@0"}, listItemOutline(t, svc, list.ID))
+	items, err := svc.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityLow, items[1].Priority)
+}
+
+func TestListService_CreateListFromTemplate_MissingVariableCreatesNothing(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	source, err := svc.CreateList(ctx, "Release")
+	require.NoError(t, err)
+	addListItems(t, svc, source.ID, "Tag {{version}}")
+	_, err = svc.SaveTemplate(ctx, source.ID, "")
+	require.NoError(t, err)
+
+	_, err = svc.CreateListFromTemplate(ctx, "Next release", "Release", domain.TemplateVariables(time.Now()))
+	require.Error(t, err)
+
+	_, err = svc.GetListByName(ctx, "Next release")
+	assert.Error(t, err)
+}
+
+func TestListService_DeleteTemplate(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	addListItems(t, svc, list.ID, "Socks")
+	_, err = svc.SaveTemplate(ctx, list.ID, "")
+	require.NoError(t, err)
+
+	require.NoError(t, svc.DeleteTemplate(ctx, "packing"))
+
+	_, err = svc.GetTemplate(ctx, "Packing")
+	assert.Error(t, err)
+	assert.Error(t, svc.DeleteTemplate(ctx, "Packing"))
+}
+
+func TestListService_ExpandTemplate_ParsesAsJournalEntries(t *testing.T) {
+	svc := setupListTemplateService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Onboarding")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Set up laptop for @{{name}}")
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Install Go", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+	_, err = svc.SaveTemplate(ctx, list.ID, "")
+	require.NoError(t, err)
+
+	vars := domain.TemplateVariables(time.Now())
+	vars["name"] = "sam"
+	content, err := svc.ExpandTemplate(ctx, "Onboarding", vars)
+	require.NoError(t, err)
+
+	entries, err := domain.NewTreeParser().Parse(content)
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, domain.EntryTypeTask, entries[0].Type)
+	assert.Equal(t, []string{"sam"}, entries[0].Mentions)
+	require.NotNil(t, entries[1].ParentID)
+	assert.Equal(t, int64(0), *entries[1].ParentID)
+}
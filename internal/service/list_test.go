@@ -2,7 +2,9 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -477,3 +479,301 @@ func TestListService_Uncancel_NotFound(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "item not found")
 }
+
+func addListItems(t *testing.T, svc *ListService, listID int64, contents ...string) []int64 {
+	t.Helper()
+	ids := make([]int64, len(contents))
+	for i, content := range contents {
+		id, err := svc.AddItem(context.Background(), listID, domain.EntryTypeTask, content)
+		require.NoError(t, err)
+		ids[i] = id
+	}
+	return ids
+}
+
+// listItemOutline renders items as "content@depth" so tests can compare the
+// whole order and nesting of a list at once.
+func listItemOutline(t *testing.T, svc *ListService, listID int64) []string {
+	t.Helper()
+	items, err := svc.GetListItems(context.Background(), listID)
+	require.NoError(t, err)
+	outline := make([]string, len(items))
+	for i, item := range items {
+		outline[i] = fmt.Sprintf("%s@%d", item.Content, item.Depth)
+	}
+	return outline
+}
+
+func TestListService_AddItemWithOptions_NestsUnderParent(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes", "Toiletries")
+
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0], Priority: domain.PriorityHigh})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"Clothes@0", "Socks@1", "Toiletries@0"}, listItemOutline(t, svc, list.ID))
+	items, err := svc.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityHigh, items[1].Priority)
+}
+
+func TestListService_AddItemWithOptions_RejectsParentFromAnotherList(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list1, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	list2, err := svc.CreateList(ctx, "Work")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list1.ID, "Clothes")
+
+	_, err = svc.AddItemWithOptions(ctx, list2.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0]})
+
+	assert.Error(t, err)
+}
+
+func TestListService_MoveItemBefore(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Shopping")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Milk", "Bread", "Eggs")
+
+	require.NoError(t, svc.MoveItemBefore(ctx, ids[2], ids[0]))
+
+	assert.Equal(t, []string{"Eggs@0", "Milk@0", "Bread@0"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_MoveItemAfter_TakesTargetsLevelWithSubItems(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes", "Toiletries")
+	sockID, err := svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Toothbrush", ListItemOptions{ParentID: ids[1]})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.MoveItemAfter(ctx, ids[1], sockID))
+
+	assert.Equal(t, []string{"Clothes@0", "Socks@1", "Toiletries@1", "Toothbrush@2"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_MoveItemBefore_RejectsOwnSubItem(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes")
+	sockID, err := svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+
+	assert.Error(t, svc.MoveItemBefore(ctx, ids[0], sockID))
+	assert.Error(t, svc.MoveItemBefore(ctx, ids[0], ids[0]))
+}
+
+func TestListService_MoveItemBefore_RejectsItemInAnotherList(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list1, err := svc.CreateList(ctx, "Shopping")
+	require.NoError(t, err)
+	list2, err := svc.CreateList(ctx, "Work")
+	require.NoError(t, err)
+	ids1 := addListItems(t, svc, list1.ID, "Milk")
+	ids2 := addListItems(t, svc, list2.ID, "Report")
+
+	assert.Error(t, svc.MoveItemBefore(ctx, ids1[0], ids2[0]))
+}
+
+func TestListService_IndentAndOutdentItem(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes", "Socks", "Toiletries")
+
+	require.NoError(t, svc.IndentItem(ctx, ids[1]))
+	assert.Equal(t, []string{"Clothes@0", "Socks@1", "Toiletries@0"}, listItemOutline(t, svc, list.ID))
+
+	require.NoError(t, svc.OutdentItem(ctx, ids[1]))
+	assert.Equal(t, []string{"Clothes@0", "Socks@0", "Toiletries@0"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_IndentItem_FirstItemErrors(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes")
+
+	assert.Error(t, svc.IndentItem(ctx, ids[0]))
+	assert.Error(t, svc.OutdentItem(ctx, ids[0]))
+}
+
+func TestListService_MoveItemUpAndDown(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Shopping")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Milk", "Bread", "Eggs")
+
+	require.NoError(t, svc.MoveItemUp(ctx, ids[1]))
+	assert.Equal(t, []string{"Bread@0", "Milk@0", "Eggs@0"}, listItemOutline(t, svc, list.ID))
+
+	require.NoError(t, svc.MoveItemDown(ctx, ids[1]))
+	require.NoError(t, svc.MoveItemDown(ctx, ids[1]))
+	require.NoError(t, svc.MoveItemDown(ctx, ids[1]))
+	assert.Equal(t, []string{"Milk@0", "Eggs@0", "Bread@0"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_SetItemParent(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes", "Toiletries", "Socks")
+
+	require.NoError(t, svc.SetItemParent(ctx, ids[2], ids[0]))
+	assert.Equal(t, []string{"Clothes@0", "Socks@1", "Toiletries@0"}, listItemOutline(t, svc, list.ID))
+
+	assert.Error(t, svc.SetItemParent(ctx, ids[0], ids[2]))
+
+	require.NoError(t, svc.SetItemParent(ctx, ids[2], 0))
+	assert.Equal(t, []string{"Clothes@0", "Toiletries@0", "Socks@0"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_RemoveItem_RemovesSubItems(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Clothes", "Toiletries")
+	_, err = svc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.RemoveItem(ctx, ids[0]))
+
+	assert.Equal(t, []string{"Toiletries@0"}, listItemOutline(t, svc, list.ID))
+}
+
+func TestListService_MoveItem_MovesSubItems(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list1, err := svc.CreateList(ctx, "Packing")
+	require.NoError(t, err)
+	list2, err := svc.CreateList(ctx, "Holiday")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list1.ID, "Clothes")
+	addListItems(t, svc, list2.ID, "Book hotel")
+	_, err = svc.AddItemWithOptions(ctx, list1.ID, domain.EntryTypeTask, "Socks", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+
+	require.NoError(t, svc.MoveItem(ctx, ids[0], list2.ID))
+
+	assert.Empty(t, listItemOutline(t, svc, list1.ID))
+	assert.Equal(t, []string{"Book hotel@0", "Clothes@0", "Socks@1"}, listItemOutline(t, svc, list2.ID))
+}
+
+func TestListService_SetItemPriority(t *testing.T) {
+	svc := setupListService(t)
+	ctx := context.Background()
+
+	list, err := svc.CreateList(ctx, "Shopping")
+	require.NoError(t, err)
+	ids := addListItems(t, svc, list.ID, "Milk")
+
+	require.NoError(t, svc.SetItemPriority(ctx, ids[0], domain.PriorityMedium))
+	assert.Error(t, svc.SetItemPriority(ctx, ids[0], "urgent"))
+
+	items, err := svc.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PriorityMedium, items[0].Priority)
+}
+
+func TestListService_ScheduleItem(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	list, err := s.list.CreateList(ctx, "Backlog")
+	require.NoError(t, err)
+	ids := addListItems(t, s.list, list.ID, "Write release notes", "Plan retro")
+	_, err = s.list.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Collect PR links", ListItemOptions{ParentID: ids[0]})
+	require.NoError(t, err)
+	item, err := s.list.getItemByID(ctx, ids[0])
+	require.NoError(t, err)
+
+	entryID, err := s.list.ScheduleItem(ctx, ids[0], date)
+	require.NoError(t, err)
+
+	entry, err := s.entryRepo.GetByID(ctx, entryID)
+	require.NoError(t, err)
+	require.NotNil(t, entry)
+	assert.Equal(t, domain.EntryTypeTask, entry.Type)
+	assert.Equal(t, "Write release notes", entry.Content)
+	assert.Equal(t, item.EntityID, entry.EntityID)
+	assert.Equal(t, "2026-10-18", entry.ScheduledDate.Format("2006-01-02"))
+
+	items, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 3)
+	assert.Equal(t, domain.ListItemTypeScheduled, items[0].Type)
+	assert.Equal(t, "Collect PR links", items[1].Content)
+	assert.Equal(t, 1, items[1].Depth)
+}
+
+func TestListService_ScheduleItem_OnlyOpenTasks(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	list, err := s.list.CreateList(ctx, "Backlog")
+	require.NoError(t, err)
+	ids := addListItems(t, s.list, list.ID, "Write release notes")
+	require.NoError(t, s.list.MarkDone(ctx, ids[0]))
+	items, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+
+	_, err = s.list.ScheduleItem(ctx, items[0].RowID, date)
+
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only open tasks")
+}
+
+func TestListService_ScheduleItem_Undo(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 10, 18, 0, 0, 0, 0, time.UTC)
+
+	list, err := s.list.CreateList(ctx, "Backlog")
+	require.NoError(t, err)
+	ids := addListItems(t, s.list, list.ID, "Write release notes")
+	entryID, err := s.list.ScheduleItem(ctx, ids[0], date)
+	require.NoError(t, err)
+
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+
+	entry, err := s.entryRepo.GetByID(ctx, entryID)
+	require.NoError(t, err)
+	assert.Nil(t, entry)
+	items, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, domain.ListItemTypeTask, items[0].Type)
+}
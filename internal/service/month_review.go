@@ -0,0 +1,219 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type MonthReviewAction string
+
+const (
+	MonthReviewKeep      MonthReviewAction = "keep"
+	MonthReviewMigrate   MonthReviewAction = "migrate"
+	MonthReviewFutureLog MonthReviewAction = "future"
+	MonthReviewMoveList  MonthReviewAction = "list"
+	MonthReviewCancel    MonthReviewAction = "cancel"
+)
+
+// MonthReviewItem is an open task or an active goal left at the end of a
+// month. Exactly one of Entry and Goal is set.
+type MonthReviewItem struct {
+	Entry *domain.Entry
+	Goal  *domain.Goal
+}
+
+func (i MonthReviewItem) Content() string {
+	if i.Goal != nil {
+		return i.Goal.Content
+	}
+	return i.Entry.Content
+}
+
+// Actions lists what can be done with the item; goals can only be kept,
+// migrated to the next month or cancelled.
+func (i MonthReviewItem) Actions() []MonthReviewAction {
+	if i.Goal != nil {
+		return []MonthReviewAction{MonthReviewKeep, MonthReviewMigrate, MonthReviewCancel}
+	}
+	actions := []MonthReviewAction{MonthReviewKeep, MonthReviewMigrate, MonthReviewFutureLog}
+	if i.Entry.CanMoveToList() {
+		actions = append(actions, MonthReviewMoveList)
+	}
+	return append(actions, MonthReviewCancel)
+}
+
+type MonthReview struct {
+	Month time.Time
+	Items []MonthReviewItem
+}
+
+// MonthReviewDecision says what to do with one item. Date is where a task
+// is migrated to; Granularity and Date say where it goes in the future log.
+// Goals are always migrated to the month after the review.
+type MonthReviewDecision struct {
+	Item        MonthReviewItem
+	Action      MonthReviewAction
+	Date        time.Time
+	Granularity domain.ScheduleGranularity
+	ListID      int64
+}
+
+type MonthReviewFailure struct {
+	Item MonthReviewItem
+	Err  error
+}
+
+type MonthReviewSummary struct {
+	Kept        int
+	Migrated    int
+	FutureLog   int
+	MovedToList int
+	Cancelled   int
+	Failed      []MonthReviewFailure
+}
+
+func (s MonthReviewSummary) Total() int {
+	return s.Kept + s.Migrated + s.FutureLog + s.MovedToList + s.Cancelled + len(s.Failed)
+}
+
+type MonthReviewService struct {
+	bujoService *BujoService
+	goalService *GoalService
+	journal     *OperationJournal
+	transactor  domain.Transactor
+}
+
+func NewMonthReviewService(bujoService *BujoService, goalService *GoalService) *MonthReviewService {
+	return &MonthReviewService{
+		bujoService: bujoService,
+		goalService: goalService,
+	}
+}
+
+// WithOperationJournal records each applied review as one operation, so the
+// whole review can be undone at once.
+func (s *MonthReviewService) WithOperationJournal(journal *OperationJournal) *MonthReviewService {
+	s.journal = journal
+	return s
+}
+
+// WithTransactor applies each review in one transaction.
+func (s *MonthReviewService) WithTransactor(transactor domain.Transactor) *MonthReviewService {
+	s.transactor = transactor
+	return s
+}
+
+// GetReview collects the open tasks and active goals of the month. Open
+// tasks under an open parent task are left out, since they move with it.
+func (s *MonthReviewService) GetReview(ctx context.Context, month time.Time) (*MonthReview, error) {
+	month = time.Date(month.Year(), month.Month(), 1, 0, 0, 0, 0, month.Location())
+	review := &MonthReview{Month: month}
+
+	tasks, err := s.bujoService.GetOutstandingTasks(ctx, month, month.AddDate(0, 1, -1))
+	if err != nil {
+		return nil, err
+	}
+	open := make(map[int64]bool, len(tasks))
+	for _, task := range tasks {
+		open[task.ID] = true
+	}
+	for i := range tasks {
+		if tasks[i].ParentID != nil && open[*tasks[i].ParentID] {
+			continue
+		}
+		review.Items = append(review.Items, MonthReviewItem{Entry: &tasks[i]})
+	}
+
+	goals, err := s.goalService.GetGoalsForMonth(ctx, month)
+	if err != nil {
+		return nil, err
+	}
+	for i := range goals {
+		if goals[i].Status == domain.GoalStatusActive {
+			review.Items = append(review.Items, MonthReviewItem{Goal: &goals[i]})
+		}
+	}
+
+	return review, nil
+}
+
+// Apply carries out every decision as a single operation, in one
+// transaction. An item that cannot be handled has its own changes rolled
+// back and is reported in the summary rather than stopping the rest of the
+// review.
+func (s *MonthReviewService) Apply(ctx context.Context, month time.Time, decisions []MonthReviewDecision) (*MonthReviewSummary, error) {
+	ctx, end := s.journal.Begin(ctx, fmt.Sprintf("Review %s", month.Format("January 2006")))
+	defer end()
+
+	var summary *MonthReviewSummary
+	err := inTransaction(ctx, s.transactor, func(ctx context.Context) error {
+		summary = &MonthReviewSummary{}
+		for _, d := range decisions {
+			err := inTransaction(ctx, s.transactor, func(ctx context.Context) error {
+				return s.apply(ctx, month, d)
+			})
+			if err != nil {
+				summary.Failed = append(summary.Failed, MonthReviewFailure{Item: d.Item, Err: err})
+				continue
+			}
+			switch d.Action {
+			case MonthReviewKeep:
+				summary.Kept++
+			case MonthReviewMigrate:
+				summary.Migrated++
+			case MonthReviewFutureLog:
+				summary.FutureLog++
+			case MonthReviewMoveList:
+				summary.MovedToList++
+			case MonthReviewCancel:
+				summary.Cancelled++
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return summary, nil
+}
+
+func (s *MonthReviewService) apply(ctx context.Context, month time.Time, d MonthReviewDecision) error {
+	if d.Item.Goal != nil {
+		id := d.Item.Goal.ID
+		switch d.Action {
+		case MonthReviewKeep:
+			return nil
+		case MonthReviewMigrate:
+			nextMonth := time.Date(month.Year(), month.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			_, err := s.goalService.MigrateGoal(ctx, id, nextMonth)
+			return err
+		case MonthReviewCancel:
+			return s.goalService.CancelGoal(ctx, id)
+		}
+		return domain.Invalidf("goals cannot be handled with %q", d.Action)
+	}
+
+	id := d.Item.Entry.ID
+	switch d.Action {
+	case MonthReviewKeep:
+		return nil
+	case MonthReviewMigrate:
+		_, err := s.bujoService.MigrateEntry(ctx, id, d.Date)
+		return err
+	case MonthReviewFutureLog:
+		if d.Granularity == domain.ScheduleSomeday {
+			_, err := s.bujoService.MigrateEntryToSomeday(ctx, id)
+			return err
+		}
+		_, err := s.bujoService.MigrateEntryToMonth(ctx, id, d.Date)
+		return err
+	case MonthReviewMoveList:
+		return s.bujoService.MoveEntryToList(ctx, id, d.ListID)
+	case MonthReviewCancel:
+		return s.bujoService.CancelEntry(ctx, id)
+	}
+	return domain.Invalidf("unknown review action %q", d.Action)
+}
@@ -0,0 +1,193 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+type monthReviewServices struct {
+	review    *MonthReviewService
+	bujo      *BujoService
+	goal      *GoalService
+	list      *ListService
+	journal   *OperationJournal
+	entryRepo *sqlite.EntryRepository
+}
+
+func setupMonthReviewService(t *testing.T) monthReviewServices {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	listRepo := sqlite.NewListRepository(db)
+	listItemRepo := sqlite.NewListItemRepository(db)
+	journal := NewOperationJournal(sqlite.NewOperationLogRepository(db))
+
+	bujo := NewBujoServiceWithLists(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser(),
+		listRepo, listItemRepo, sqlite.NewEntryToListMover(db), sqlite.NewTagRepository(db), sqlite.NewMentionRepository(db)).
+		WithOperationJournal(journal)
+	goal := NewGoalService(sqlite.NewGoalRepository(db)).WithOperationJournal(journal)
+
+	return monthReviewServices{
+		review:    NewMonthReviewService(bujo, goal).WithTransactor(sqlite.NewTransactor(db)).WithOperationJournal(journal),
+		bujo:      bujo,
+		goal:      goal,
+		list:      NewListService(listRepo, listItemRepo).WithOperationJournal(journal),
+		journal:   journal,
+		entryRepo: entryRepo,
+	}
+}
+
+func TestMonthReviewService_GetReview(t *testing.T) {
+	s := setupMonthReviewService(t)
+	ctx := context.Background()
+	january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.bujo.LogEntries(ctx, ". Plan trip\n  . Book hotel\n- A note", LogEntriesOptions{Date: january.AddDate(0, 0, 4)})
+	require.NoError(t, err)
+	_, err = s.bujo.LogEntries(ctx, ". February task", LogEntriesOptions{Date: january.AddDate(0, 1, 2)})
+	require.NoError(t, err)
+	_, err = s.goal.CreateGoal(ctx, "Run 50km", january)
+	require.NoError(t, err)
+	doneID, err := s.goal.CreateGoal(ctx, "Read a book", january)
+	require.NoError(t, err)
+	require.NoError(t, s.goal.MarkDone(ctx, doneID))
+
+	review, err := s.review.GetReview(ctx, january.AddDate(0, 0, 20))
+	require.NoError(t, err)
+
+	assert.Equal(t, january, review.Month)
+	require.Len(t, review.Items, 2)
+	assert.Equal(t, "Plan trip", review.Items[0].Content())
+	assert.NotNil(t, review.Items[0].Entry)
+	assert.Equal(t, "Run 50km", review.Items[1].Content())
+	assert.Equal(t, []MonthReviewAction{MonthReviewKeep, MonthReviewMigrate, MonthReviewCancel}, review.Items[1].Actions())
+}
+
+func TestMonthReviewService_Apply(t *testing.T) {
+	s := setupMonthReviewService(t)
+	ctx := context.Background()
+	january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	february := january.AddDate(0, 1, 0)
+
+	_, err := s.bujo.LogEntries(ctx, ". Keep me\n. Migrate me\n. Someday me\n. List me\n. Cancel me", LogEntriesOptions{Date: january.AddDate(0, 0, 9)})
+	require.NoError(t, err)
+	_, err = s.goal.CreateGoal(ctx, "Run 50km", january)
+	require.NoError(t, err)
+	list, err := s.list.CreateList(ctx, "Backlog")
+	require.NoError(t, err)
+
+	review, err := s.review.GetReview(ctx, january)
+	require.NoError(t, err)
+	require.Len(t, review.Items, 6)
+
+	items := review.Items
+	decisions := []MonthReviewDecision{
+		{Item: items[0], Action: MonthReviewKeep},
+		{Item: items[1], Action: MonthReviewMigrate, Date: february},
+		{Item: items[2], Action: MonthReviewFutureLog, Granularity: domain.ScheduleSomeday},
+		{Item: items[3], Action: MonthReviewMoveList, ListID: list.ID},
+		{Item: items[4], Action: MonthReviewCancel},
+		{Item: items[5], Action: MonthReviewMigrate},
+	}
+
+	summary, err := s.review.Apply(ctx, january, decisions)
+	require.NoError(t, err)
+	assert.Equal(t, 1, summary.Kept)
+	assert.Equal(t, 2, summary.Migrated)
+	assert.Equal(t, 1, summary.FutureLog)
+	assert.Equal(t, 1, summary.MovedToList)
+	assert.Equal(t, 1, summary.Cancelled)
+	assert.Empty(t, summary.Failed)
+	assert.Equal(t, 6, summary.Total())
+
+	febEntries, err := s.entryRepo.GetByDate(ctx, february)
+	require.NoError(t, err)
+	require.Len(t, febEntries, 1)
+	assert.Equal(t, "Migrate me", febEntries[0].Content)
+
+	futureLog, err := s.bujo.GetFutureLog(ctx)
+	require.NoError(t, err)
+	require.Len(t, futureLog.Someday, 1)
+	assert.Equal(t, "Someday me", futureLog.Someday[0].Content)
+
+	listItems, err := s.list.GetListItems(ctx, list.ID)
+	require.NoError(t, err)
+	require.Len(t, listItems, 1)
+	assert.Equal(t, "List me", listItems[0].Content)
+
+	febGoals, err := s.goal.GetGoalsForMonth(ctx, february)
+	require.NoError(t, err)
+	require.Len(t, febGoals, 1)
+	assert.Equal(t, "Run 50km", febGoals[0].Content)
+
+	remaining, err := s.review.GetReview(ctx, january)
+	require.NoError(t, err)
+	require.Len(t, remaining.Items, 1)
+	assert.Equal(t, "Keep me", remaining.Items[0].Content())
+}
+
+func TestMonthReviewService_Apply_IsUndoneAsOneOperation(t *testing.T) {
+	s := setupMonthReviewService(t)
+	ctx := context.Background()
+	january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.bujo.LogEntries(ctx, ". Migrate me\n. Cancel me", LogEntriesOptions{Date: january.AddDate(0, 0, 9)})
+	require.NoError(t, err)
+	_, err = s.goal.CreateGoal(ctx, "Run 50km", january)
+	require.NoError(t, err)
+
+	review, err := s.review.GetReview(ctx, january)
+	require.NoError(t, err)
+	require.Len(t, review.Items, 3)
+
+	_, err = s.review.Apply(ctx, january, []MonthReviewDecision{
+		{Item: review.Items[0], Action: MonthReviewMigrate, Date: january.AddDate(0, 1, 0)},
+		{Item: review.Items[1], Action: MonthReviewCancel},
+		{Item: review.Items[2], Action: MonthReviewCancel},
+	})
+	require.NoError(t, err)
+
+	op, err := s.journal.Undo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Review January 2026", op.Description)
+
+	restored, err := s.review.GetReview(ctx, january)
+	require.NoError(t, err)
+	assert.Len(t, restored.Items, 3)
+}
+
+func TestMonthReviewService_Apply_ReportsFailures(t *testing.T) {
+	s := setupMonthReviewService(t)
+	ctx := context.Background()
+	january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	_, err := s.bujo.LogEntries(ctx, ". Cancel me\n. Nowhere to go", LogEntriesOptions{Date: january.AddDate(0, 0, 9)})
+	require.NoError(t, err)
+	_, err = s.goal.CreateGoal(ctx, "Run 50km", january)
+	require.NoError(t, err)
+
+	review, err := s.review.GetReview(ctx, january)
+	require.NoError(t, err)
+	require.Len(t, review.Items, 3)
+
+	summary, err := s.review.Apply(ctx, january, []MonthReviewDecision{
+		{Item: review.Items[0], Action: MonthReviewCancel},
+		{Item: review.Items[1], Action: MonthReviewMoveList, ListID: 999},
+		{Item: review.Items[2], Action: MonthReviewFutureLog},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, summary.Cancelled)
+	require.Len(t, summary.Failed, 2)
+	assert.Equal(t, "Nowhere to go", summary.Failed[0].Item.Content())
+	assert.Equal(t, "Run 50km", summary.Failed[1].Item.Content())
+}
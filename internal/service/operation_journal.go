@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type operationContextKey struct{}
+
+// OperationJournal groups the rows changed by one service call into an
+// operation that can be undone and redone as a unit.
+type OperationJournal struct {
+	repo domain.OperationLogRepository
+	mu   sync.Mutex
+}
+
+func NewOperationJournal(repo domain.OperationLogRepository) *OperationJournal {
+	return &OperationJournal{repo: repo}
+}
+
+// Begin opens an operation and returns a context for the rest of the call
+// and a func that closes the operation. Calls made with a context that is
+// already inside an operation join it rather than starting their own, and a
+// nil journal records nothing.
+func (j *OperationJournal) Begin(ctx context.Context, description string) (context.Context, func()) {
+	if j == nil || ctx.Value(operationContextKey{}) != nil {
+		return ctx, func() {}
+	}
+
+	j.mu.Lock()
+	opCtx, id, err := j.repo.Begin(ctx, description)
+	if err != nil {
+		// Failing to journal must not stop the change itself.
+		j.mu.Unlock()
+		return ctx, func() {}
+	}
+
+	opCtx = context.WithValue(opCtx, operationContextKey{}, id)
+	return opCtx, func() {
+		_, _ = j.repo.End(context.WithoutCancel(opCtx), id)
+		j.mu.Unlock()
+	}
+}
+
+func (j *OperationJournal) Undo(ctx context.Context) (*domain.Operation, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	op, err := j.repo.Undo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to undo: %w", err)
+	}
+	if op == nil {
+		return nil, domain.Invalidf("nothing to undo")
+	}
+	return op, nil
+}
+
+func (j *OperationJournal) Redo(ctx context.Context) (*domain.Operation, error) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	op, err := j.repo.Redo(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to redo: %w", err)
+	}
+	if op == nil {
+		return nil, domain.Invalidf("nothing to redo")
+	}
+	return op, nil
+}
+
+func (j *OperationJournal) History(ctx context.Context, limit int) ([]domain.Operation, error) {
+	return j.repo.GetRecent(ctx, limit)
+}
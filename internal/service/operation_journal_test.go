@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+type journaledServices struct {
+	journal   *OperationJournal
+	bujo      *BujoService
+	habit     *HabitService
+	list      *ListService
+	entryRepo *sqlite.EntryRepository
+	tagRepo   *sqlite.TagRepository
+	habitRepo *sqlite.HabitRepository
+}
+
+func setupJournaledServices(t *testing.T) journaledServices {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	listRepo := sqlite.NewListRepository(db)
+	listItemRepo := sqlite.NewListItemRepository(db)
+	tagRepo := sqlite.NewTagRepository(db)
+	habitRepo := sqlite.NewHabitRepository(db)
+	journal := NewOperationJournal(sqlite.NewOperationLogRepository(db))
+
+	return journaledServices{
+		journal: journal,
+		bujo: NewBujoServiceWithLists(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser(),
+			listRepo, listItemRepo, sqlite.NewEntryToListMover(db), tagRepo, sqlite.NewMentionRepository(db)).
+			WithOperationJournal(journal),
+		habit:     NewHabitService(habitRepo, sqlite.NewHabitLogRepository(db)).WithOperationJournal(journal),
+		list:      NewListService(listRepo, listItemRepo).WithOperationJournal(journal).WithEntryMover(sqlite.NewListItemToEntryMover(db)).WithSmartLists(entryRepo),
+		entryRepo: entryRepo,
+		tagRepo:   tagRepo,
+		habitRepo: habitRepo,
+	}
+}
+
+func TestOperationJournal_UndoAndRedoEdit(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Buy milk #shopping", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.EditEntry(ctx, ids[0], "Buy oat milk #groceries"))
+
+	op, err := s.journal.Undo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Edit entry 1", op.Description)
+
+	entry, err := s.entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Buy milk #shopping", entry.Content)
+	tags, err := s.tagRepo.GetTagsForEntries(ctx, []int64{ids[0]})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"shopping"}, tags[ids[0]])
+
+	_, err = s.journal.Redo(ctx)
+	require.NoError(t, err)
+
+	entry, err = s.entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Buy oat milk #groceries", entry.Content)
+}
+
+func TestOperationJournal_UndoDeleteRestoresTree(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Project\n  - Detail\n    . Subtask", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	before, err := s.entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+
+	require.NoError(t, s.bujo.DeleteEntry(ctx, ids[0]))
+	remaining, err := s.entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Empty(t, remaining)
+
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+
+	after, err := s.entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	assert.Equal(t, before, after)
+}
+
+func TestOperationJournal_UndoMigration(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	today := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Write report", LogEntriesOptions{Date: today})
+	require.NoError(t, err)
+	_, err = s.bujo.MigrateEntry(ctx, ids[0], tomorrow)
+	require.NoError(t, err)
+
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+
+	entry, err := s.entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, domain.EntryTypeTask, entry.Type)
+	migrated, err := s.entryRepo.GetByDate(ctx, tomorrow)
+	require.NoError(t, err)
+	assert.Empty(t, migrated)
+}
+
+func TestOperationJournal_MultiLevelUndoAcrossServices(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	_, err := s.habit.CreateHabit(ctx, "Gym")
+	require.NoError(t, err)
+	require.NoError(t, s.habit.RenameHabit(ctx, "Gym", "Workout"))
+	list, err := s.list.CreateList(ctx, "Shopping")
+	require.NoError(t, err)
+
+	history, err := s.journal.History(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	assert.Equal(t, "Create list Shopping", history[0].Description)
+
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+	_, err = s.journal.Undo(ctx)
+	require.NoError(t, err)
+
+	lists, err := s.list.GetAllLists(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, lists)
+	habit, err := s.habitRepo.GetByName(ctx, "Gym")
+	require.NoError(t, err)
+	require.NotNil(t, habit)
+
+	op, err := s.journal.Redo(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "Rename habit Gym to Workout", op.Description)
+	habit, err = s.habitRepo.GetByName(ctx, "Workout")
+	require.NoError(t, err)
+	assert.NotNil(t, habit)
+
+	_, err = s.list.CreateList(ctx, "Groceries")
+	require.NoError(t, err)
+	_, err = s.journal.Redo(ctx)
+	assert.EqualError(t, err, "nothing to redo")
+
+	_, err = s.list.GetListByID(ctx, list.ID)
+	assert.Error(t, err)
+}
+
+func TestOperationJournal_IgnoresWritesFromOtherConnections(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(filepath.Join(t.TempDir(), "bujo.db"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	journal := NewOperationJournal(sqlite.NewOperationLogRepository(db))
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	opCtx, end := journal.Begin(ctx, "Add entry")
+	_, err = entryRepo.Insert(opCtx, domain.Entry{Type: domain.EntryTypeTask, Content: "Inside", ScheduledDate: &date, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	_, err = entryRepo.Insert(ctx, domain.Entry{Type: domain.EntryTypeTask, Content: "Outside", ScheduledDate: &date, CreatedAt: time.Now()})
+	require.NoError(t, err)
+	end()
+
+	_, err = journal.Undo(ctx)
+	require.NoError(t, err)
+
+	entries, err := entryRepo.GetByDate(ctx, date)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "Outside", entries[0].Content)
+}
+
+func TestOperationJournal_UndoRefusesRowsChangedSince(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+	date := time.Date(2026, 1, 28, 0, 0, 0, 0, time.UTC)
+
+	ids, err := s.bujo.LogEntries(ctx, ". Buy milk", LogEntriesOptions{Date: date})
+	require.NoError(t, err)
+	require.NoError(t, s.bujo.EditEntry(ctx, ids[0], "Buy oat milk"))
+
+	entry, err := s.entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	entry.Content = "Buy soy milk"
+	require.NoError(t, s.entryRepo.Update(ctx, *entry))
+
+	_, err = s.journal.Undo(ctx)
+	assert.ErrorContains(t, err, "since")
+
+	entry, err = s.entryRepo.GetByID(ctx, ids[0])
+	require.NoError(t, err)
+	assert.Equal(t, "Buy soy milk", entry.Content)
+}
+
+func TestOperationJournal_FailedCallRecordsNothing(t *testing.T) {
+	s := setupJournaledServices(t)
+	ctx := context.Background()
+
+	require.Error(t, s.bujo.MarkDone(ctx, 999))
+
+	_, err := s.journal.Undo(ctx)
+	assert.EqualError(t, err, "nothing to undo")
+}
+
+func TestOperationJournal_NilJournalRecordsNothing(t *testing.T) {
+	var journal *OperationJournal
+	ctx := context.Background()
+
+	got, end := journal.Begin(ctx, "anything")
+	end()
+
+	assert.Equal(t, ctx, got)
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type RecurrenceService struct {
+	recurrenceRepo domain.RecurrenceRepository
+	entryRepo      domain.EntryRepository
+}
+
+func NewRecurrenceService(recurrenceRepo domain.RecurrenceRepository, entryRepo domain.EntryRepository) *RecurrenceService {
+	return &RecurrenceService{
+		recurrenceRepo: recurrenceRepo,
+		entryRepo:      entryRepo,
+	}
+}
+
+type CreateRecurrenceOptions struct {
+	EntryType domain.EntryType
+	Content   string
+	Priority  domain.Priority
+	Every     string
+	StartDate time.Time
+	EndDate   *time.Time
+}
+
+func (s *RecurrenceService) Create(ctx context.Context, opts CreateRecurrenceOptions) (int64, error) {
+	rule, err := domain.ParseEvery(opts.Every)
+	if err != nil {
+		return 0, err
+	}
+
+	recurrence := domain.NewRecurrence(opts.EntryType, opts.Content, rule, opts.StartDate)
+	recurrence.EndDate = opts.EndDate
+	if opts.Priority != "" {
+		recurrence.Priority = opts.Priority
+	}
+
+	if err := recurrence.Validate(); err != nil {
+		return 0, err
+	}
+
+	return s.recurrenceRepo.Insert(ctx, recurrence)
+}
+
+// CreateFromEntry turns an existing journal entry into the first occurrence
+// of a new recurrence starting on the entry's date.
+func (s *RecurrenceService) CreateFromEntry(ctx context.Context, entryID int64, every string) (int64, error) {
+	entry, err := s.entryRepo.GetByID(ctx, entryID)
+	if err != nil {
+		return 0, err
+	}
+	if entry == nil {
+		return 0, fmt.Errorf("entry %w: %d", domain.ErrNotFound, entryID)
+	}
+
+	rule, err := domain.ParseEvery(every)
+	if err != nil {
+		return 0, err
+	}
+
+	start := time.Now()
+	if entry.ScheduledDate != nil {
+		start = *entry.ScheduledDate
+	}
+
+	entryType := entry.Type
+	if entryType == domain.EntryTypeDone || entryType == domain.EntryTypeMigrated || entryType == domain.EntryTypeCancelled {
+		entryType = domain.EntryTypeTask
+	}
+
+	recurrence := domain.NewRecurrence(entryType, entry.Content, rule, start)
+	recurrence.Priority = entry.Priority
+	if err := recurrence.Validate(); err != nil {
+		return 0, err
+	}
+
+	id, err := s.recurrenceRepo.Insert(ctx, recurrence)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := s.recurrenceRepo.MarkMaterialized(ctx, id, recurrence.StartDate, entry.ID); err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (s *RecurrenceService) GetRecurrence(ctx context.Context, id int64) (*domain.Recurrence, error) {
+	return s.getRecurrence(ctx, id)
+}
+
+func (s *RecurrenceService) GetAll(ctx context.Context) ([]domain.Recurrence, error) {
+	return s.recurrenceRepo.GetAll(ctx)
+}
+
+// Delete stops a recurrence. Entries already materialized stay in the journal.
+func (s *RecurrenceService) Delete(ctx context.Context, id int64) error {
+	if _, err := s.getRecurrence(ctx, id); err != nil {
+		return err
+	}
+	return s.recurrenceRepo.Delete(ctx, id)
+}
+
+func (s *RecurrenceService) SkipOccurrence(ctx context.Context, id int64, date time.Time) error {
+	recurrence, err := s.getRecurrence(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !recurrence.OccursOn(date) {
+		return domain.Invalidf("recurrence %d does not occur on %s", id, date.Format("2006-01-02"))
+	}
+	return s.recurrenceRepo.AddException(ctx, id, date)
+}
+
+func (s *RecurrenceService) getRecurrence(ctx context.Context, id int64) (*domain.Recurrence, error) {
+	recurrence, err := s.recurrenceRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if recurrence == nil {
+		return nil, fmt.Errorf("recurrence %w: %d", domain.ErrNotFound, id)
+	}
+	return recurrence, nil
+}
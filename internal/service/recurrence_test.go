@@ -0,0 +1,291 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+func setupRecurrenceService(t *testing.T) (*RecurrenceService, *BujoService) {
+	t.Helper()
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	dayCtxRepo := sqlite.NewDayContextRepository(db)
+	recurrenceRepo := sqlite.NewRecurrenceRepository(db)
+	tagRepo := sqlite.NewTagRepository(db)
+	mentionRepo := sqlite.NewMentionRepository(db)
+
+	bujo := NewBujoServiceWithLists(entryRepo, dayCtxRepo, domain.NewTreeParser(), nil, nil, nil, tagRepo, mentionRepo).
+		WithRecurrences(recurrenceRepo)
+	return NewRecurrenceService(recurrenceRepo, entryRepo), bujo
+}
+
+func TestRecurrenceService_Create_InvalidRule(t *testing.T) {
+	recurrences, _ := setupRecurrenceService(t)
+
+	_, err := recurrences.Create(context.Background(), CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Water plants",
+		Every:     "sometimes",
+		StartDate: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.Error(t, err)
+}
+
+func startOfToday() time.Time {
+	now := time.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+func TestBujoService_GetDayEntries_MaterializesRecurrences(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	_, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeEvent,
+		Content:   "Standup #work",
+		Every:     "daily",
+		StartDate: today,
+	})
+	require.NoError(t, err)
+
+	days, err := bujo.GetDayEntries(ctx, today, today)
+	require.NoError(t, err)
+
+	require.Len(t, days, 1)
+	require.Len(t, days[0].Entries, 1)
+	assert.Equal(t, "Standup #work", days[0].Entries[0].Content)
+	assert.Equal(t, domain.EntryTypeEvent, days[0].Entries[0].Type)
+	assert.NotZero(t, days[0].Entries[0].ID)
+	assert.False(t, days[0].Entries[0].Projected)
+
+	tags, err := bujo.GetAllTags(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"work"}, tags)
+
+	// A second fetch must not duplicate occurrences.
+	days, err = bujo.GetDayEntries(ctx, today, today)
+	require.NoError(t, err)
+	assert.Len(t, days[0].Entries, 1)
+}
+
+func TestBujoService_GetDailyAgenda_MaterializesRecurrences(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	_, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Pay rent",
+		Every:     "daily",
+		StartDate: today,
+	})
+	require.NoError(t, err)
+
+	agenda, err := bujo.GetDailyAgenda(ctx, today)
+	require.NoError(t, err)
+	require.Len(t, agenda.Today, 1)
+	assert.Equal(t, "Pay rent", agenda.Today[0].Content)
+	assert.NotZero(t, agenda.Today[0].ID)
+}
+
+func TestBujoService_PastRangesDoNotBackfillRecurrences(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	_, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Water plants",
+		Every:     "daily",
+		StartDate: today.AddDate(0, 0, -7),
+	})
+	require.NoError(t, err)
+
+	lastWeek, err := bujo.GetDayEntries(ctx, today.AddDate(0, 0, -7), today.AddDate(0, 0, -1))
+	require.NoError(t, err)
+	for _, day := range lastWeek {
+		assert.Empty(t, day.Entries)
+	}
+
+	// Occurrences from before the recurrence was created are not stored
+	// once today is viewed either.
+	overdue, err := bujo.GetOverdue(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+
+	agenda, err := bujo.GetDailyAgenda(ctx, today)
+	require.NoError(t, err)
+	assert.Len(t, agenda.Today, 1)
+}
+
+func TestBujoService_GetDayEntries_ProjectsFutureRecurrences(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	start := startOfToday()
+	_, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Water plants",
+		Every:     "daily",
+		StartDate: start,
+	})
+	require.NoError(t, err)
+
+	from := start.AddDate(0, 0, 1)
+	to := start.AddDate(0, 0, 3)
+	days, err := bujo.GetDayEntries(ctx, from, to)
+	require.NoError(t, err)
+	require.Len(t, days, 3)
+	for _, day := range days {
+		require.Len(t, day.Entries, 1)
+		assert.Equal(t, "Water plants", day.Entries[0].Content)
+		assert.Zero(t, day.Entries[0].ID, "future occurrences are not stored")
+		assert.True(t, day.Entries[0].Projected)
+	}
+
+	agenda, err := bujo.GetDailyAgenda(ctx, from)
+	require.NoError(t, err)
+	require.Len(t, agenda.Today, 1)
+	assert.Zero(t, agenda.Today[0].ID)
+	assert.True(t, agenda.Today[0].Projected)
+}
+
+func TestBujoService_DeletedOccurrenceIsNotRecreated(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	_, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Water plants",
+		Every:     "daily",
+		StartDate: today,
+	})
+	require.NoError(t, err)
+
+	agenda, err := bujo.GetDailyAgenda(ctx, today)
+	require.NoError(t, err)
+	require.Len(t, agenda.Today, 1)
+
+	require.NoError(t, bujo.DeleteEntry(ctx, agenda.Today[0].ID))
+
+	agenda, err = bujo.GetDailyAgenda(ctx, today)
+	require.NoError(t, err)
+	assert.Empty(t, agenda.Today)
+}
+
+func TestRecurrenceService_SkipOccurrence(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	id, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Gym",
+		Every:     "every 2 days",
+		StartDate: today,
+	})
+	require.NoError(t, err)
+
+	later := today.AddDate(0, 0, 2)
+	require.NoError(t, recurrences.SkipOccurrence(ctx, id, later))
+
+	agenda, err := bujo.GetDailyAgenda(ctx, later)
+	require.NoError(t, err)
+	assert.Empty(t, agenda.Today)
+
+	err = recurrences.SkipOccurrence(ctx, id, today.AddDate(0, 0, 1))
+	assert.Error(t, err)
+}
+
+func TestRecurrenceService_CreateFromEntry(t *testing.T) {
+	recurrences, bujo := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	today := startOfToday()
+	start := today.AddDate(0, 0, -2)
+	ids, err := bujo.LogEntries(ctx, ". Water plants", LogEntriesOptions{Date: start})
+	require.NoError(t, err)
+
+	_, err = recurrences.CreateFromEntry(ctx, ids[0], "daily")
+	require.NoError(t, err)
+
+	// The original entry is the first occurrence. The recurrence was only
+	// created today, so the day in between is not back-filled.
+	overdue, err := bujo.GetOverdue(ctx)
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, ids[0], overdue[0].ID)
+
+	days, err := bujo.GetDayEntries(ctx, start, today)
+	require.NoError(t, err)
+	require.Len(t, days, 3)
+	assert.Len(t, days[0].Entries, 1)
+	assert.Empty(t, days[1].Entries)
+	require.Len(t, days[2].Entries, 1)
+	assert.Equal(t, "Water plants", days[2].Entries[0].Content)
+}
+
+func TestBujoService_GetOverdue_CatchesUpOnRecurrences(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := context.Background()
+
+	recurrenceRepo := sqlite.NewRecurrenceRepository(db)
+	bujo := NewBujoService(sqlite.NewEntryRepository(db), sqlite.NewDayContextRepository(db), domain.NewTreeParser()).
+		WithRecurrences(recurrenceRepo)
+
+	today := startOfToday()
+	recurrence := domain.NewRecurrence(domain.EntryTypeTask, "Water plants", domain.RecurrenceRule{Frequency: domain.RecurrenceDaily, Interval: 1}, today.AddDate(0, 0, -3))
+	recurrence.CreatedAt = time.Now().AddDate(0, 0, -3)
+	id, err := recurrenceRepo.Insert(ctx, recurrence)
+	require.NoError(t, err)
+	require.NoError(t, recurrenceRepo.MarkMaterialized(ctx, id, today.AddDate(0, 0, -3), 0))
+
+	// The two days since the last stored occurrence were missed, and show
+	// up as overdue; today's occurrence is stored too.
+	overdue, err := bujo.GetOverdue(ctx)
+	require.NoError(t, err)
+	require.Len(t, overdue, 2)
+	for _, entry := range overdue {
+		assert.Equal(t, "Water plants", entry.Content)
+	}
+
+	agenda, err := bujo.GetDailyAgenda(ctx, today)
+	require.NoError(t, err)
+	require.Len(t, agenda.Today, 1)
+	assert.NotZero(t, agenda.Today[0].ID)
+}
+
+func TestRecurrenceService_Delete(t *testing.T) {
+	recurrences, _ := setupRecurrenceService(t)
+	ctx := context.Background()
+
+	id, err := recurrences.Create(ctx, CreateRecurrenceOptions{
+		EntryType: domain.EntryTypeTask,
+		Content:   "Gym",
+		Every:     "daily",
+		StartDate: time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, recurrences.Delete(ctx, id))
+
+	all, err := recurrences.GetAll(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, all)
+
+	assert.Error(t, recurrences.Delete(ctx, id))
+}
@@ -0,0 +1,66 @@
+package service
+
+import (
+	"context"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+type SearchService struct {
+	searchRepo  domain.SearchRepository
+	tagRepo     domain.TagRepository
+	mentionRepo domain.MentionRepository
+}
+
+func NewSearchService(searchRepo domain.SearchRepository, tagRepo domain.TagRepository, mentionRepo domain.MentionRepository) *SearchService {
+	return &SearchService{
+		searchRepo:  searchRepo,
+		tagRepo:     tagRepo,
+		mentionRepo: mentionRepo,
+	}
+}
+
+// Search returns ranked results across entries, list items and goals. The
+// query accepts the search query language described on domain.SearchQuery.
+func (s *SearchService) Search(ctx context.Context, opts domain.SearchOptions) ([]domain.SearchResult, error) {
+	results, err := s.searchRepo.Search(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []int64
+	for _, r := range results {
+		if r.Entry != nil {
+			ids = append(ids, r.Entry.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return results, nil
+	}
+
+	if s.tagRepo != nil {
+		tagMap, err := s.tagRepo.GetTagsForEntries(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			if results[i].Entry != nil {
+				results[i].Entry.Tags = tagMap[results[i].Entry.ID]
+			}
+		}
+	}
+
+	if s.mentionRepo != nil {
+		mentionMap, err := s.mentionRepo.GetMentionsForEntries(ctx, ids)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
+			if results[i].Entry != nil {
+				results[i].Entry.Mentions = mentionMap[results[i].Entry.ID]
+			}
+		}
+	}
+
+	return results, nil
+}
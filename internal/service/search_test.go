@@ -0,0 +1,36 @@
+package service
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
+)
+
+func TestSearchService_Search_LoadsTagsAndMentions(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	tagRepo := sqlite.NewTagRepository(db)
+	mentionRepo := sqlite.NewMentionRepository(db)
+	bujo := NewBujoServiceWithLists(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser(), nil, nil, nil, tagRepo, mentionRepo)
+	search := NewSearchService(sqlite.NewSearchRepository(db), tagRepo, mentionRepo)
+	ctx := context.Background()
+
+	_, err = bujo.LogEntries(ctx, ". Review budget with @alice #finance", LogEntriesOptions{Date: time.Now()})
+	require.NoError(t, err)
+
+	results, err := search.Search(ctx, domain.NewSearchOptions("budget @alice"))
+
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.NotNil(t, results[0].Entry)
+	assert.Equal(t, []string{"finance"}, results[0].Entry.Tags)
+	assert.Equal(t, []string{"alice"}, results[0].Entry.Mentions)
+}
@@ -20,10 +20,25 @@ type StatsHabitLogRepository interface {
 	GetByHabitID(ctx context.Context, habitID int64) ([]domain.HabitLog, error)
 }
 
+type StatsHabitPauseRepository interface {
+	GetAll(ctx context.Context) ([]domain.HabitPause, error)
+}
+
+type StatsGoalRepository interface {
+	GetInRange(ctx context.Context, from, to time.Time) ([]domain.Goal, error)
+}
+
+type StatsKeyResultRepository interface {
+	GetByGoals(ctx context.Context, goalEntityIDs []domain.EntityID) (map[domain.EntityID][]domain.KeyResult, error)
+}
+
 type StatsService struct {
-	entryRepo    StatsEntryRepository
-	habitRepo    StatsHabitRepository
-	habitLogRepo StatsHabitLogRepository
+	entryRepo     StatsEntryRepository
+	habitRepo     StatsHabitRepository
+	habitLogRepo  StatsHabitLogRepository
+	pauseRepo     StatsHabitPauseRepository
+	goalRepo      StatsGoalRepository
+	keyResultRepo StatsKeyResultRepository
 }
 
 func NewStatsService(
@@ -38,6 +53,21 @@ func NewStatsService(
 	}
 }
 
+// WithPauseRepository lets habit streaks carry over paused days, as they do
+// in the habit views.
+func (s *StatsService) WithPauseRepository(pauseRepo StatsHabitPauseRepository) *StatsService {
+	s.pauseRepo = pauseRepo
+	return s
+}
+
+// WithGoals adds the progress of goals measured by key results to the
+// stats.
+func (s *StatsService) WithGoals(goalRepo StatsGoalRepository, keyResultRepo StatsKeyResultRepository) *StatsService {
+	s.goalRepo = goalRepo
+	s.keyResultRepo = keyResultRepo
+	return s
+}
+
 func (s *StatsService) GetStats(ctx context.Context, from, to time.Time) (*domain.Stats, error) {
 	entries, err := s.entryRepo.GetByDateRange(ctx, from, to)
 	if err != nil {
@@ -72,9 +102,61 @@ func (s *StatsService) GetStats(ctx context.Context, from, to time.Time) (*domai
 	}
 	stats.HabitStats = habitStats
 
+	goalStats, err := s.calculateGoalStats(ctx, from, to)
+	if err != nil {
+		return nil, err
+	}
+	stats.Goals = goalStats
+
 	return stats, nil
 }
 
+// calculateGoalStats reports the goals overlapping the period that have key
+// results. Migrated and cancelled goals are left out.
+func (s *StatsService) calculateGoalStats(ctx context.Context, from, to time.Time) ([]domain.GoalStat, error) {
+	if s.goalRepo == nil || s.keyResultRepo == nil {
+		return nil, nil
+	}
+
+	end := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, to.Location()).AddDate(0, 0, 1)
+	goals, err := s.goalRepo.GetInRange(ctx,
+		domain.GoalPeriodStart(domain.GoalHorizonYear, from),
+		domain.GoalPeriodEnd(domain.GoalHorizonMonth, domain.GoalPeriodStart(domain.GoalHorizonMonth, to)),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var included []domain.Goal
+	entityIDs := make([]domain.EntityID, 0, len(goals))
+	for _, goal := range goals {
+		if goal.IsMigrated() || goal.IsCancelled() || !goal.Overlaps(from, end) {
+			continue
+		}
+		included = append(included, goal)
+		entityIDs = append(entityIDs, goal.EntityID)
+	}
+
+	keyResults, err := s.keyResultRepo.GetByGoals(ctx, entityIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	var goalStats []domain.GoalStat
+	for _, goal := range included {
+		krs := keyResults[goal.EntityID]
+		if len(krs) == 0 {
+			continue
+		}
+		goalStats = append(goalStats, domain.GoalStat{
+			Content:    goal.Content,
+			Period:     goal.PeriodLabel(),
+			KeyResults: krs,
+		})
+	}
+	return goalStats, nil
+}
+
 func (s *StatsService) countEntries(entries []domain.Entry) domain.EntryCounts {
 	counts := domain.EntryCounts{
 		Total: len(entries),
@@ -197,7 +279,6 @@ func (s *StatsService) calculateProductivity(entries []domain.Entry) domain.Prod
 
 func (s *StatsService) calculateHabitStats(ctx context.Context, habits []domain.Habit, logs []domain.HabitLog, today time.Time) (domain.HabitStats, error) {
 	stats := domain.HabitStats{
-		Active:    len(habits),
 		TotalLogs: len(logs),
 	}
 
@@ -205,16 +286,46 @@ func (s *StatsService) calculateHabitStats(ctx context.Context, habits []domain.
 		return stats, nil
 	}
 
+	// Archived habits still count towards logs, but not as active habits
+	// with a current streak.
+	for _, h := range habits {
+		if !h.IsArchived() {
+			stats.Active++
+		}
+	}
+
+	// Logs keep the ID of the habit version they were made against, so
+	// they are counted by entity to include those made before a habit was
+	// renamed or archived.
 	logCounts := make(map[int64]int)
+	entityLogCounts := make(map[domain.EntityID]int)
+	periodLogs := make(map[domain.EntityID][]domain.HabitLog)
 	for _, l := range logs {
-		logCounts[l.HabitID] += l.Count
+		if l.HabitEntityID.IsEmpty() {
+			logCounts[l.HabitID] += l.Count
+		} else {
+			entityLogCounts[l.HabitEntityID] += l.Count
+		}
+		periodLogs[l.HabitEntityID] = append(periodLogs[l.HabitEntityID], l)
+	}
+
+	var pauses []domain.HabitPause
+	if s.pauseRepo != nil {
+		var err error
+		pauses, err = s.pauseRepo.GetAll(ctx)
+		if err != nil {
+			return domain.HabitStats{}, err
+		}
 	}
 
 	var maxCount int
-	var maxStreak int
+	var maxStreakDays int
 
 	for _, h := range habits {
 		count := logCounts[h.ID]
+		if !h.EntityID.IsEmpty() {
+			count += entityLogCounts[h.EntityID]
+		}
 		if count > maxCount {
 			maxCount = count
 			stats.MostLogged = domain.HabitLogCount{
@@ -223,20 +334,47 @@ func (s *StatsService) calculateHabitStats(ctx context.Context, habits []domain.
 			}
 		}
 
+		if h.IsMeasured() {
+			stats.Measured = append(stats.Measured, domain.HabitMeasure{
+				HabitName: h.Name,
+				Unit:      h.Unit,
+				Values:    domain.SummarizeHabitValues(h, periodLogs[h.EntityID]),
+			})
+		}
+
+		if h.IsArchived() {
+			continue
+		}
+
 		habitLogs, err := s.habitLogRepo.GetByHabitID(ctx, h.ID)
 		if err != nil {
 			return domain.HabitStats{}, err
 		}
 
-		streak := domain.CalculateStreak(habitLogs, today)
-		if streak > maxStreak {
-			maxStreak = streak
+		h.Pauses = pauses
+		streak := domain.CalculateGoalStreak(h, habitLogs, today)
+		if days := streakSpanDays(streak, h.StreakPeriod()); days > maxStreakDays {
+			maxStreakDays = days
 			stats.BestStreak = domain.HabitStreak{
 				HabitName: h.Name,
 				Days:      streak,
+				Period:    h.StreakPeriod(),
 			}
 		}
 	}
 
 	return stats, nil
 }
+
+// streakSpanDays approximates how long a streak has lasted, so streaks
+// measured in different periods can be compared.
+func streakSpanDays(streak int, period domain.StreakPeriod) int {
+	switch period {
+	case domain.StreakPeriodWeek:
+		return streak * 7
+	case domain.StreakPeriodMonth:
+		return streak * 30
+	default:
+		return streak
+	}
+}
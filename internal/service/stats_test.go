@@ -61,6 +61,42 @@ func (m *mockStatsHabitLogRepo) GetByHabitID(ctx context.Context, habitID int64)
 	return result, nil
 }
 
+type mockStatsHabitPauseRepo struct {
+	pauses []domain.HabitPause
+}
+
+func (m *mockStatsHabitPauseRepo) GetAll(ctx context.Context) ([]domain.HabitPause, error) {
+	return m.pauses, nil
+}
+
+func TestStatsService_GetStats_BestStreakCarriesOverPauses(t *testing.T) {
+	today := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	habits := []domain.Habit{{ID: 1, EntityID: "h1", Name: "Exercise"}}
+	logs := []domain.HabitLog{
+		{ID: 1, HabitID: 1, Count: 1, LoggedAt: today},
+		{ID: 2, HabitID: 1, Count: 1, LoggedAt: today.AddDate(0, 0, -1)},
+		{ID: 3, HabitID: 1, Count: 1, LoggedAt: today.AddDate(0, 0, -4)},
+		{ID: 4, HabitID: 1, Count: 1, LoggedAt: today.AddDate(0, 0, -5)},
+	}
+	pauses := []domain.HabitPause{{HabitEntityID: "h1", From: today.AddDate(0, 0, -3), To: today.AddDate(0, 0, -2)}}
+
+	svc := NewStatsService(
+		&mockStatsEntryRepo{},
+		&mockStatsHabitRepo{habits: habits},
+		&mockStatsHabitLogRepo{logs: logs},
+	).WithPauseRepository(&mockStatsHabitPauseRepo{pauses: pauses})
+
+	stats, err := svc.GetStats(context.Background(), today.AddDate(0, 0, -29), today)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stats.HabitStats.BestStreak.Days != 4 {
+		t.Errorf("expected the pause to keep a 4 day streak, got %d", stats.HabitStats.BestStreak.Days)
+	}
+}
+
 func TestStatsService_GetStats_EntryCounts(t *testing.T) {
 	today := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
 	from := today.AddDate(0, 0, -29)
@@ -226,6 +262,48 @@ func TestStatsService_GetStats_HabitStats(t *testing.T) {
 	}
 }
 
+func TestStatsService_GetStats_ArchivedHabit(t *testing.T) {
+	today := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -29)
+	archivedAt := today.AddDate(0, 0, -1)
+
+	habits := []domain.Habit{
+		{ID: 1, EntityID: "h1", Name: "Exercise"},
+		{ID: 5, EntityID: "h2", Name: "Read", ArchivedAt: &archivedAt},
+	}
+
+	// Read's logs were made against the version before it was archived.
+	logs := []domain.HabitLog{
+		{ID: 1, HabitID: 1, HabitEntityID: "h1", Count: 1, LoggedAt: today},
+		{ID: 2, HabitID: 2, HabitEntityID: "h2", Count: 1, LoggedAt: today.AddDate(0, 0, -2)},
+		{ID: 3, HabitID: 2, HabitEntityID: "h2", Count: 1, LoggedAt: today.AddDate(0, 0, -3)},
+	}
+
+	svc := NewStatsService(
+		&mockStatsEntryRepo{},
+		&mockStatsHabitRepo{habits: habits},
+		&mockStatsHabitLogRepo{logs: logs},
+	)
+
+	stats, err := svc.GetStats(context.Background(), from, today)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if stats.HabitStats.Active != 1 {
+		t.Errorf("expected 1 active habit, got %d", stats.HabitStats.Active)
+	}
+	if stats.HabitStats.TotalLogs != 3 {
+		t.Errorf("expected 3 total logs, got %d", stats.HabitStats.TotalLogs)
+	}
+	if stats.HabitStats.MostLogged.HabitName != "Read" {
+		t.Errorf("expected most logged habit 'Read', got '%s'", stats.HabitStats.MostLogged.HabitName)
+	}
+	if stats.HabitStats.BestStreak.HabitName != "Exercise" {
+		t.Errorf("expected best streak habit 'Exercise', got '%s'", stats.HabitStats.BestStreak.HabitName)
+	}
+}
+
 func TestStatsService_GetStats_EmptyData(t *testing.T) {
 	today := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
 	from := today.AddDate(0, 0, -29)
@@ -273,3 +351,73 @@ func TestStatsService_GetStats_GetByHabitIDError(t *testing.T) {
 		t.Errorf("expected error to wrap %v, got %v", repoErr, err)
 	}
 }
+
+type mockStatsGoalRepo struct {
+	goals []domain.Goal
+}
+
+func (m *mockStatsGoalRepo) GetInRange(ctx context.Context, from, to time.Time) ([]domain.Goal, error) {
+	var result []domain.Goal
+	for _, g := range m.goals {
+		if g.Month.Format("2006-01") >= from.Format("2006-01") && g.Month.Format("2006-01") < to.Format("2006-01") {
+			result = append(result, g)
+		}
+	}
+	return result, nil
+}
+
+type mockStatsKeyResultRepo struct {
+	keyResults map[domain.EntityID][]domain.KeyResult
+}
+
+func (m *mockStatsKeyResultRepo) GetByGoals(ctx context.Context, goalEntityIDs []domain.EntityID) (map[domain.EntityID][]domain.KeyResult, error) {
+	result := make(map[domain.EntityID][]domain.KeyResult)
+	for _, id := range goalEntityIDs {
+		if krs, ok := m.keyResults[id]; ok {
+			result[id] = krs
+		}
+	}
+	return result, nil
+}
+
+func TestStatsService_GetStats_GoalKeyResults(t *testing.T) {
+	today := time.Date(2026, 7, 20, 0, 0, 0, 0, time.UTC)
+	from := today.AddDate(0, 0, -29)
+
+	yearly := domain.Goal{EntityID: domain.NewEntityID(), Content: "Grow the newsletter", Month: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Horizon: domain.GoalHorizonYear, Status: domain.GoalStatusActive}
+	july := domain.Goal{EntityID: domain.NewEntityID(), Content: "Ship v2", Month: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Horizon: domain.GoalHorizonMonth, Status: domain.GoalStatusActive}
+	noKeyResults := domain.Goal{EntityID: domain.NewEntityID(), Content: "Read more", Month: time.Date(2026, 7, 1, 0, 0, 0, 0, time.UTC), Status: domain.GoalStatusActive}
+	migrated := domain.Goal{EntityID: domain.NewEntityID(), Content: "Old", Month: time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC), Status: domain.GoalStatusMigrated}
+	tooEarly := domain.Goal{EntityID: domain.NewEntityID(), Content: "Spring clean", Month: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC), Status: domain.GoalStatusActive}
+
+	krRepo := &mockStatsKeyResultRepo{keyResults: map[domain.EntityID][]domain.KeyResult{
+		yearly.EntityID:   {{Name: "Subscribers", Start: 0, Target: 1000, Current: 600}},
+		july.EntityID:     {{Name: "Releases", Start: 0, Target: 2, Current: 1}, {Name: "Bugs", Start: 10, Target: 0, Current: 0}},
+		migrated.EntityID: {{Name: "Stale", Start: 0, Target: 1, Current: 0}},
+		tooEarly.EntityID: {{Name: "Rooms", Start: 0, Target: 5, Current: 5}},
+	}}
+
+	svc := NewStatsService(
+		&mockStatsEntryRepo{},
+		&mockStatsHabitRepo{},
+		&mockStatsHabitLogRepo{},
+	).WithGoals(&mockStatsGoalRepo{goals: []domain.Goal{yearly, july, noKeyResults, migrated, tooEarly}}, krRepo)
+
+	stats, err := svc.GetStats(context.Background(), from, today)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stats.Goals) != 2 {
+		t.Fatalf("expected 2 goals with key results, got %d", len(stats.Goals))
+	}
+	if stats.Goals[0].Content != "Grow the newsletter" || stats.Goals[0].Period != "2026" {
+		t.Errorf("unexpected first goal: %+v", stats.Goals[0])
+	}
+	if got := stats.Goals[0].Progress(); got != 0.6 {
+		t.Errorf("expected 0.6 progress, got %v", got)
+	}
+	if got := stats.Goals[1].Progress(); got != 0.75 {
+		t.Errorf("expected 0.75 progress, got %v", got)
+	}
+}
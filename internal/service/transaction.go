@@ -0,0 +1,16 @@
+package service
+
+import (
+	"context"
+
+	"github.com/typingincolor/bujo/internal/domain"
+)
+
+// inTransaction runs fn in a transaction of transactor, or just runs it
+// when there is no transactor.
+func inTransaction(ctx context.Context, transactor domain.Transactor, fn func(ctx context.Context) error) error {
+	if transactor == nil {
+		return fn(ctx)
+	}
+	return transactor.InTransaction(ctx, fn)
+}
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/typingincolor/bujo/internal/domain"
 	"github.com/typingincolor/bujo/internal/service"
 )
 
@@ -1020,3 +1021,139 @@ func TestUAT_JournalView_MigrateToGoal_OnlyWorksOnIncompleteTasks(t *testing.T)
 		t.Error("migrate to goal should not activate for completed tasks")
 	}
 }
+
+func TestUAT_GoalsView_ShowsLinkedTasks(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, goalSvc := setupTestServices(t)
+	ctx := context.Background()
+
+	goalID, err := goalSvc.CreateGoal(ctx, "Write the novel", time.Now())
+	if err != nil {
+		t.Fatalf("failed to create goal: %v", err)
+	}
+	ids, err := bujoSvc.LogEntries(ctx, ". Draft chapter one\n. Draft chapter two", service.LogEntriesOptions{Date: time.Now()})
+	if err != nil {
+		t.Fatalf("failed to log entries: %v", err)
+	}
+	for _, id := range ids {
+		if err := goalSvc.LinkEntry(ctx, goalID, id); err != nil {
+			t.Fatalf("failed to link entry: %v", err)
+		}
+	}
+	if err := bujoSvc.MarkDone(ctx, ids[0]); err != nil {
+		t.Fatalf("failed to mark done: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+		GoalService:  goalSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}})
+	model = newModel.(Model)
+	if cmd != nil {
+		newModel, _ = model.Update(cmd())
+		model = newModel.(Model)
+	}
+
+	view := model.View()
+
+	if !strings.Contains(view, "(1/2 tasks)") {
+		t.Error("goals view should show progress from linked tasks")
+	}
+	if !strings.Contains(view, "Draft chapter two") {
+		t.Error("goals view should list linked tasks beneath the goal")
+	}
+}
+
+func TestUAT_GoalsView_ShowsGoalTree(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, goalSvc := setupTestServices(t)
+	ctx := context.Background()
+
+	quarterID, err := goalSvc.CreateGoalWithOptions(ctx, "Launch v2", service.CreateGoalOptions{Horizon: domain.GoalHorizonQuarter, Period: time.Now()})
+	if err != nil {
+		t.Fatalf("failed to create quarterly goal: %v", err)
+	}
+	if _, err := goalSvc.CreateGoalWithOptions(ctx, "Ship the beta", service.CreateGoalOptions{Period: time.Now(), ParentID: quarterID}); err != nil {
+		t.Fatalf("failed to create monthly goal: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+		GoalService:  goalSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}})
+	model = newModel.(Model)
+	if cmd != nil {
+		newModel, _ = model.Update(cmd())
+		model = newModel.(Model)
+	}
+
+	if len(model.goalState.goals) != 2 {
+		t.Fatalf("expected quarterly and monthly goals to be selectable, got %d", len(model.goalState.goals))
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Launch v2 [Q") {
+		t.Error("goals view should label the quarterly goal with its quarter")
+	}
+	if !strings.Contains(view, "(0/1 goals)") {
+		t.Error("goals view should roll up child goals into the parent")
+	}
+	if !strings.Contains(view, "    #") {
+		t.Error("goals view should indent child goals under their parent")
+	}
+}
+
+func TestUAT_GoalsView_ShowsKeyResultProgress(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, goalSvc := setupTestServices(t)
+	ctx := context.Background()
+
+	goalID, err := goalSvc.CreateGoal(ctx, "Grow the newsletter", time.Now())
+	if err != nil {
+		t.Fatalf("failed to create goal: %v", err)
+	}
+	krID, err := goalSvc.AddKeyResult(ctx, goalID, service.KeyResultOptions{Name: "Subscribers", Target: 1000, Unit: "subscribers"})
+	if err != nil {
+		t.Fatalf("failed to add key result: %v", err)
+	}
+	current := 600.0
+	if err := goalSvc.UpdateKeyResult(ctx, krID, service.UpdateKeyResultOptions{Current: &current}); err != nil {
+		t.Fatalf("failed to update key result: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+		GoalService:  goalSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'7'}})
+	model = newModel.(Model)
+	if cmd != nil {
+		newModel, _ = model.Update(cmd())
+		model = newModel.(Model)
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Grow the newsletter (60%)") {
+		t.Error("goals view should show the goal's completion from its key results")
+	}
+	if !strings.Contains(view, "██████░░░░") {
+		t.Error("goals view should draw a progress bar for each key result")
+	}
+	if !strings.Contains(view, "600/1000 subscribers") {
+		t.Error("goals view should show the key result's value against its target")
+	}
+}
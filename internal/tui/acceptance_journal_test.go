@@ -8,6 +8,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/typingincolor/bujo/internal/domain"
+	"github.com/typingincolor/bujo/internal/repository/sqlite"
 	"github.com/typingincolor/bujo/internal/service"
 )
 
@@ -48,6 +49,43 @@ func TestUAT_JournalView_ShowsTodaysEntries(t *testing.T) {
 	}
 }
 
+func TestUAT_JournalView_ShowsOverdueListItems(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	list, err := listSvc.CreateList(ctx, "Errands")
+	if err != nil {
+		t.Fatalf("failed to create list: %v", err)
+	}
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	if _, err := listSvc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", service.ListItemOptions{DueDate: &lastWeek}); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, cmd := model.Update(model.Init()())
+	model = newModel.(Model)
+	for cmd != nil {
+		newModel, cmd = model.Update(cmd())
+		model = newModel.(Model)
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "Overdue List Items") {
+		t.Errorf("journal should have an overdue list items section, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Renew passport  (Errands, overdue, due "+lastWeek.Format("Jan 2")+")") {
+		t.Errorf("journal should show the overdue item with its list, got:\n%s", view)
+	}
+}
+
 func TestUAT_JournalView_Navigation_UpDown(t *testing.T) {
 	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
 	ctx := context.Background()
@@ -1335,3 +1373,79 @@ func TestUAT_HabitsView_BackspaceRemovesOccurrence(t *testing.T) {
 // =============================================================================
 // UAT Section: Markdown Rendering in AI Summaries (#132)
 // =============================================================================
+
+func TestUAT_JournalView_RepeatEntry(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	if err != nil {
+		t.Fatalf("failed to open test db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+
+	entryRepo := sqlite.NewEntryRepository(db)
+	recurrenceRepo := sqlite.NewRecurrenceRepository(db)
+	bujoSvc := service.NewBujoService(entryRepo, sqlite.NewDayContextRepository(db), domain.NewTreeParser()).
+		WithRecurrences(recurrenceRepo)
+	recurrenceSvc := service.NewRecurrenceService(recurrenceRepo, entryRepo)
+	ctx := context.Background()
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	if _, err := bujoSvc.LogEntries(ctx, ". Water plants", service.LogEntriesOptions{Date: today}); err != nil {
+		t.Fatalf("failed to create task: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:       bujoSvc,
+		RecurrenceService: recurrenceSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, _ := model.Update(model.loadDaysCmd()())
+	model = newModel.(Model)
+	if len(model.entries) == 0 {
+		t.Fatal("expected at least one entry in journal")
+	}
+
+	newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'E'}})
+	model = newModel.(Model)
+	if !model.repeatMode.active {
+		t.Fatal("pressing 'E' should activate repeat mode")
+	}
+	if !strings.Contains(model.View(), "Repeat: Water plants") {
+		t.Error("repeat prompt should show the entry content")
+	}
+
+	for _, r := range "daily" {
+		newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		model = newModel.(Model)
+	}
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	model = newModel.(Model)
+	if model.repeatMode.active {
+		t.Fatal("pressing Enter should deactivate repeat mode")
+	}
+	if cmd == nil {
+		t.Fatal("should return a command to create the recurrence")
+	}
+	if msg, ok := cmd().(errMsg); ok {
+		t.Fatalf("unexpected error: %v", msg.err)
+	}
+
+	recurrences, err := recurrenceSvc.GetAll(ctx)
+	if err != nil {
+		t.Fatalf("failed to get recurrences: %v", err)
+	}
+	if len(recurrences) != 1 {
+		t.Fatalf("expected 1 recurrence, got %d", len(recurrences))
+	}
+
+	agenda, err := bujoSvc.GetDailyAgenda(ctx, today.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("failed to get agenda: %v", err)
+	}
+	if len(agenda.Today) != 1 || agenda.Today[0].Content != "Water plants" {
+		t.Errorf("expected tomorrow to contain the repeated task, got %v", agenda.Today)
+	}
+}
@@ -794,3 +794,242 @@ func TestUAT_ListItemsView_MoveItem(t *testing.T) {
 		t.Errorf("Work list item should be 'Buy milk', got '%s'", items2[0].Content)
 	}
 }
+
+func TestUAT_ListItemsView_IndentReorderAndPriority(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	list, err := listSvc.CreateList(ctx, "Packing")
+	if err != nil {
+		t.Fatalf("failed to create list: %v", err)
+	}
+	for _, content := range []string{"Clothes", "Socks", "Toiletries"} {
+		if _, err := listSvc.AddItem(ctx, list.ID, domain.EntryTypeTask, content); err != nil {
+			t.Fatalf("failed to add item: %v", err)
+		}
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	// runKey presses a key and processes the commands it triggers until the
+	// list items have reloaded.
+	runKey := func(msg tea.KeyMsg) {
+		t.Helper()
+		newModel, cmd := model.Update(msg)
+		model = newModel.(Model)
+		for cmd != nil {
+			newModel, cmd = model.Update(cmd())
+			model = newModel.(Model)
+		}
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}})
+	runKey(tea.KeyMsg{Type: tea.KeyEnter})
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+
+	// Indent "Socks" under "Clothes"
+	runKey(tea.KeyMsg{Type: tea.KeyTab})
+	if model.listState.items[1].Content != "Socks" || model.listState.items[1].Depth != 1 {
+		t.Fatalf("tab should indent Socks under Clothes, got %+v", model.listState.items[1])
+	}
+	if model.listState.selectedItemIdx != 1 {
+		t.Errorf("selection should stay on Socks, got index %d", model.listState.selectedItemIdx)
+	}
+	if !strings.Contains(model.View(), "  . Socks") {
+		t.Error("indented item should be rendered with indentation")
+	}
+
+	// Move "Toiletries" above "Clothes", taking the selection with it
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}})
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'K'}})
+	if model.listState.items[0].Content != "Toiletries" {
+		t.Fatalf("K should move Toiletries up, got %q first", model.listState.items[0].Content)
+	}
+	if model.listState.selectedItemIdx != 0 {
+		t.Errorf("selection should follow Toiletries, got index %d", model.listState.selectedItemIdx)
+	}
+
+	// Cycle the priority of "Toiletries"
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'!'}})
+	if model.listState.items[0].Priority != domain.PriorityLow {
+		t.Errorf("! should cycle priority to low, got %q", model.listState.items[0].Priority)
+	}
+}
+
+func TestUAT_ListItemsView_ScheduleItemOntoDay(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	list, err := listSvc.CreateList(ctx, "Backlog")
+	if err != nil {
+		t.Fatalf("failed to create list: %v", err)
+	}
+	if _, err := listSvc.AddItem(ctx, list.ID, domain.EntryTypeTask, "Write release notes"); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	runKey := func(msg tea.KeyMsg) {
+		t.Helper()
+		newModel, cmd := model.Update(msg)
+		model = newModel.(Model)
+		for cmd != nil {
+			newModel, cmd = model.Update(cmd())
+			model = newModel.(Model)
+		}
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}})
+	runKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'>'}})
+	if !model.migrateMode.active || model.migrateMode.listItem == nil {
+		t.Fatal("> should ask for the date to schedule the item on")
+	}
+	if !strings.Contains(model.View(), "Schedule on date") {
+		t.Error("prompt should say the item is being scheduled")
+	}
+
+	model.migrateMode.input.SetValue("tomorrow")
+	runKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	if model.err != nil {
+		t.Fatalf("scheduling should succeed, got %v", model.err)
+	}
+	if !model.listState.items[0].IsScheduled() {
+		t.Fatalf("item should be marked scheduled, got %q", model.listState.items[0].Type)
+	}
+
+	tomorrow := time.Now().AddDate(0, 0, 1)
+	entries, err := bujoSvc.GetDayEntries(ctx, tomorrow, tomorrow)
+	if err != nil {
+		t.Fatalf("failed to get entries: %v", err)
+	}
+	found := false
+	for _, day := range entries {
+		for _, entry := range day.Entries {
+			if entry.Content == "Write release notes" && entry.Type == domain.EntryTypeTask {
+				found = true
+			}
+		}
+	}
+	if !found {
+		t.Error("scheduled item should be a task on tomorrow's page")
+	}
+}
+
+func TestUAT_ListItemsView_SmartListTicksOffEntries(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	if _, err := bujoSvc.LogEntries(ctx, ". !!! Renew passport\n. Water plants", service.LogEntriesOptions{Date: time.Now()}); err != nil {
+		t.Fatalf("failed to log entries: %v", err)
+	}
+	if _, err := listSvc.CreateSmartList(ctx, "Urgent", "priority:high status:open"); err != nil {
+		t.Fatalf("failed to create smart list: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	runKey := func(msg tea.KeyMsg) {
+		t.Helper()
+		newModel, cmd := model.Update(msg)
+		model = newModel.(Model)
+		for cmd != nil {
+			newModel, cmd = model.Update(cmd())
+			model = newModel.(Model)
+		}
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}})
+	if !strings.Contains(model.View(), "priority:high status:open") {
+		t.Error("lists view should show the smart list's query")
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(model.listState.items) != 1 || model.listState.items[0].Content != "Renew passport" {
+		t.Fatalf("smart list should show the matching entry, got %v", model.listState.items)
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}})
+	if model.addMode.active {
+		t.Error("a should not add items to a smart list")
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{' '}})
+	if model.err != nil {
+		t.Fatalf("ticking off should succeed, got %v", model.err)
+	}
+	if len(model.listState.items) != 0 {
+		t.Errorf("done entry should drop out of the smart list, got %v", model.listState.items)
+	}
+	if !strings.Contains(model.View(), "No entries match") {
+		t.Error("empty smart list should say nothing matches its query")
+	}
+}
+
+func TestUAT_ListItemsView_ShowsDueDates(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	list, err := listSvc.CreateList(ctx, "Errands")
+	if err != nil {
+		t.Fatalf("failed to create list: %v", err)
+	}
+	lastWeek := time.Now().AddDate(0, 0, -7)
+	if _, err := listSvc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Renew passport", service.ListItemOptions{DueDate: &lastWeek}); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+	today := time.Now()
+	if _, err := listSvc.AddItemWithOptions(ctx, list.ID, domain.EntryTypeTask, "Return books", service.ListItemOptions{DueDate: &today}); err != nil {
+		t.Fatalf("failed to add item: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	runKey := func(msg tea.KeyMsg) {
+		t.Helper()
+		newModel, cmd := model.Update(msg)
+		model = newModel.(Model)
+		for cmd != nil {
+			newModel, cmd = model.Update(cmd())
+			model = newModel.(Model)
+		}
+	}
+
+	runKey(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'6'}})
+	runKey(tea.KeyMsg{Type: tea.KeyEnter})
+
+	view := model.View()
+	if !strings.Contains(view, "Renew passport  (overdue, due "+lastWeek.Format("Jan 2")+")") {
+		t.Errorf("overdue item should say it is overdue, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Return books  (due today)") {
+		t.Errorf("item due today should say so, got:\n%s", view)
+	}
+}
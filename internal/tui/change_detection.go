@@ -60,6 +60,8 @@ func (m Model) reloadCurrentViewCmd() tea.Cmd {
 		return m.loadPendingTasksCmd()
 	case ViewTypeQuestions:
 		return m.loadQuestionsCmd()
+	case ViewTypeFutureLog:
+		return m.loadFutureLogCmd()
 	case ViewTypeStats:
 		return m.loadStatsCmd()
 	default:
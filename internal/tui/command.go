@@ -96,6 +96,26 @@ func DefaultCommands() *CommandRegistry {
 		},
 	})
 
+	registry.Register(Command{
+		Name:        "Switch to Future Log",
+		Description: "View entries scheduled for a month or someday",
+		Keybinding:  "F",
+		Action: func(m Model) (Model, tea.Cmd) {
+			m.currentView = ViewTypeFutureLog
+			m.futureLogState.loading = true
+			return m, m.loadFutureLogCmd()
+		},
+	})
+
+	registry.Register(Command{
+		Name:        "Review Month",
+		Description: "Migrate, schedule, move, cancel or keep each open item of the month",
+		Keybinding:  "",
+		Action: func(m Model) (Model, tea.Cmd) {
+			return m, m.loadMonthReviewCmd(m.viewDate)
+		},
+	})
+
 	registry.Register(Command{
 		Name:        "Capture",
 		Description: "Open external editor for entry capture",
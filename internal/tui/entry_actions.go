@@ -1,8 +1,42 @@
 package tui
 
-import "github.com/typingincolor/bujo/internal/domain"
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/typingincolor/bujo/internal/domain"
+)
 
+// entryActionBindings are the bindings that act on the selected entry.
+func entryActionBindings(km *KeyMap) []*key.Binding {
+	return []*key.Binding{
+		&km.Done,
+		&km.CancelEntry,
+		&km.UncancelEntry,
+		&km.Edit,
+		&km.Retype,
+		&km.AddChild,
+		&km.Migrate,
+		&km.Repeat,
+		&km.MigrateToGoal,
+		&km.MoveToList,
+		&km.MoveToRoot,
+		&km.Answer,
+		&km.Priority,
+		&km.Delete,
+	}
+}
+
+// UpdateKeyMapForEntry enables the actions that apply to entry. Projected
+// recurrence entries are not stored yet, so none apply to them.
 func UpdateKeyMapForEntry(km *KeyMap, entry domain.Entry) {
+	ResetKeyMapEnabled(km)
+	if entry.Projected {
+		for _, binding := range entryActionBindings(km) {
+			binding.SetEnabled(false)
+		}
+		return
+	}
+
 	km.CancelEntry.SetEnabled(entry.CanCancel())
 	km.UncancelEntry.SetEnabled(entry.CanUncancel())
 	km.Edit.SetEnabled(entry.CanEdit())
@@ -17,17 +51,9 @@ func UpdateKeyMapForEntry(km *KeyMap, entry domain.Entry) {
 }
 
 func ResetKeyMapEnabled(km *KeyMap) {
-	km.CancelEntry.SetEnabled(true)
-	km.UncancelEntry.SetEnabled(true)
-	km.Edit.SetEnabled(true)
-	km.Retype.SetEnabled(true)
-	km.AddChild.SetEnabled(true)
-	km.Migrate.SetEnabled(true)
-	km.MoveToList.SetEnabled(true)
-	km.MoveToRoot.SetEnabled(true)
-	km.Answer.SetEnabled(true)
-	km.Priority.SetEnabled(true)
-	km.Delete.SetEnabled(true)
+	for _, binding := range entryActionBindings(km) {
+		binding.SetEnabled(true)
+	}
 }
 
 func (m Model) syncKeyMapToSelection() Model {
@@ -39,3 +65,17 @@ func (m Model) syncKeyMapToSelection() Model {
 	}
 	return m
 }
+
+// actsOnProjectedEntry reports whether msg would act on a projected
+// entry, which the journal view ignores.
+func (m Model) actsOnProjectedEntry(msg tea.KeyMsg) bool {
+	if len(m.entries) == 0 || m.selectedIdx >= len(m.entries) || !m.entries[m.selectedIdx].Entry.Projected {
+		return false
+	}
+	for _, binding := range entryActionBindings(&m.keyMap) {
+		if key.Matches(msg, *binding) {
+			return true
+		}
+	}
+	return false
+}
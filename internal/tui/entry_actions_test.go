@@ -128,3 +128,22 @@ func TestResetKeyMapEnabled_IncludesMoveToRoot(t *testing.T) {
 		t.Error("ResetKeyMapEnabled should enable MoveToRoot")
 	}
 }
+
+func TestUpdateKeyMapForEntry_Projected_DisablesEntryActions(t *testing.T) {
+	km := DefaultKeyMap()
+
+	entry := domain.NewEntry(domain.EntryTypeTask, "Water plants", nil)
+	entry.Projected = true
+	UpdateKeyMapForEntry(&km, entry)
+
+	for _, binding := range entryActionBindings(&km) {
+		if binding.Enabled() {
+			t.Errorf("%s should be disabled for a projected entry", binding.Help().Desc)
+		}
+	}
+
+	UpdateKeyMapForEntry(&km, domain.NewEntry(domain.EntryTypeTask, "Call bank", nil))
+	if !km.Done.Enabled() || !km.Edit.Enabled() {
+		t.Error("actions should be enabled again for a stored entry")
+	}
+}
@@ -28,12 +28,14 @@ func setupTestServices(t *testing.T) (*service.BujoService, *service.HabitServic
 	goalRepo := sqlite.NewGoalRepository(db)
 	dayContextRepo := sqlite.NewDayContextRepository(db)
 	entryToListMover := sqlite.NewEntryToListMover(db)
+	goalLinkRepo := sqlite.NewGoalLinkRepository(db)
 	parser := domain.NewTreeParser()
 
-	bujoService := service.NewBujoServiceWithLists(entryRepo, dayContextRepo, parser, listRepo, listItemRepo, entryToListMover, nil, nil)
+	bujoService := service.NewBujoServiceWithLists(entryRepo, dayContextRepo, parser, listRepo, listItemRepo, entryToListMover, nil, nil).
+		WithGoalLinks(goalLinkRepo)
 	habitService := service.NewHabitService(habitRepo, habitLogRepo)
-	listService := service.NewListService(listRepo, listItemRepo)
-	goalService := service.NewGoalService(goalRepo)
+	listService := service.NewListService(listRepo, listItemRepo).WithEntryMover(sqlite.NewListItemToEntryMover(db)).WithSmartLists(entryRepo)
+	goalService := service.NewGoalService(goalRepo).WithEntryLinks(goalLinkRepo, entryRepo).WithKeyResults(sqlite.NewKeyResultRepository(db))
 
 	return bujoService, habitService, listService, goalService
 }
@@ -870,6 +872,149 @@ func TestIntegration_QuestionsView_LoadsOpenQuestions(t *testing.T) {
 	}
 }
 
+func TestIntegration_FutureLogView_ListsMonthsAndSomeday(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
+	ctx := context.Background()
+
+	month := time.Date(2030, 3, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := bujoSvc.LogEntries(ctx, ". Book flights", service.LogEntriesOptions{Date: month, Granularity: domain.ScheduleMonth}); err != nil {
+		t.Fatalf("failed to add entry: %v", err)
+	}
+	if _, err := bujoSvc.LogEntries(ctx, ". Learn piano", service.LogEntriesOptions{Date: time.Now(), Granularity: domain.ScheduleSomeday}); err != nil {
+		t.Fatalf("failed to add entry: %v", err)
+	}
+	if _, err := bujoSvc.LogEntries(ctx, ". Today's task", service.LogEntriesOptions{Date: time.Now()}); err != nil {
+		t.Fatalf("failed to add entry: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+	})
+	model.width = 80
+	model.height = 24
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'F'}})
+	model = newModel.(Model)
+
+	if model.currentView != ViewTypeFutureLog {
+		t.Fatalf("expected ViewTypeFutureLog, got %v", model.currentView)
+	}
+	if cmd == nil {
+		t.Fatal("expected a command to load the future log")
+	}
+
+	newModel, _ = model.Update(cmd())
+	model = newModel.(Model)
+
+	if len(model.futureLogState.entries) != 2 {
+		t.Errorf("expected 2 future log entries, got %d", len(model.futureLogState.entries))
+	}
+
+	view := model.View()
+	if !strings.Contains(view, "March 2030") || !strings.Contains(view, "Book flights") {
+		t.Error("view should show March 2030 with 'Book flights'")
+	}
+	if !strings.Contains(view, "Someday") || !strings.Contains(view, "Learn piano") {
+		t.Error("view should show someday with 'Learn piano'")
+	}
+	if strings.Contains(view, "Today's task") {
+		t.Error("view should NOT contain day entries")
+	}
+}
+
+func TestIntegration_MonthReview_AppliesDecisionsAndShowsSummary(t *testing.T) {
+	bujoSvc, habitSvc, listSvc, goalSvc := setupTestServices(t)
+	ctx := context.Background()
+
+	january := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	if _, err := bujoSvc.LogEntries(ctx, ". Keep me\n. Someday me\n. List me", service.LogEntriesOptions{Date: january.AddDate(0, 0, 9)}); err != nil {
+		t.Fatalf("failed to add entries: %v", err)
+	}
+	if _, err := goalSvc.CreateGoal(ctx, "Run 50km", january); err != nil {
+		t.Fatalf("failed to add goal: %v", err)
+	}
+	if _, err := listSvc.CreateList(ctx, "Backlog"); err != nil {
+		t.Fatalf("failed to create list: %v", err)
+	}
+
+	model := NewWithConfig(Config{
+		BujoService:  bujoSvc,
+		HabitService: habitSvc,
+		ListService:  listSvc,
+		GoalService:  goalSvc,
+		MonthReview:  service.NewMonthReviewService(bujoSvc, goalSvc),
+	})
+	model.width = 80
+	model.height = 40
+	model.viewDate = january.AddDate(0, 0, 20)
+
+	commands := model.commandRegistry.Filter("Review Month")
+	if len(commands) == 0 {
+		t.Fatal("expected a Review Month command")
+	}
+	model, cmd := commands[0].Action(model)
+	newModel, _ := model.Update(cmd())
+	model = newModel.(Model)
+
+	if !model.monthReviewMode.active {
+		t.Fatal("expected month review to be active")
+	}
+	if !strings.Contains(model.View(), "Review January 2026") {
+		t.Error("view should show the month under review")
+	}
+
+	keys := []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune{'k'}},
+		{Type: tea.KeyRunes, Runes: []rune{'f'}},
+	}
+	for _, r := range "someday" {
+		keys = append(keys, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	keys = append(keys,
+		tea.KeyMsg{Type: tea.KeyEnter},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'l'}},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'1'}},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'m'}},
+	)
+	for _, k := range keys {
+		newModel, cmd = model.Update(k)
+		model = newModel.(Model)
+	}
+
+	if cmd == nil || !model.monthReviewMode.applying {
+		t.Fatal("expected the review to be applied after the last item")
+	}
+	newModel, _ = model.Update(cmd())
+	model = newModel.(Model)
+
+	summary := model.monthReviewMode.summary
+	if summary == nil {
+		t.Fatal("expected a summary after applying the review")
+	}
+	if summary.Kept != 1 || summary.FutureLog != 1 || summary.MovedToList != 1 || summary.Migrated != 1 {
+		t.Errorf("unexpected summary: %+v", *summary)
+	}
+	if !strings.Contains(model.View(), "Moved to list: 1") {
+		t.Error("view should show the review summary")
+	}
+
+	febGoals, err := goalSvc.GetGoalsForMonth(ctx, january.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("failed to get goals: %v", err)
+	}
+	if len(febGoals) != 1 {
+		t.Errorf("expected goal migrated to February, got %d goals", len(febGoals))
+	}
+
+	newModel, _ = model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	model = newModel.(Model)
+	if model.monthReviewMode.active {
+		t.Error("expected any key to close the review summary")
+	}
+}
+
 func TestIntegration_HabitsView_LogHabitIncrementsCount(t *testing.T) {
 	bujoSvc, habitSvc, listSvc, _ := setupTestServices(t)
 	ctx := context.Background()
@@ -926,3 +1071,91 @@ func TestIntegration_HabitsView_LogHabitIncrementsCount(t *testing.T) {
 		t.Errorf("expected count to increase from %d to %d, got %d", initialCount, initialCount+1, newCount)
 	}
 }
+
+func TestIntegration_UndoAndRedoUseOperationJournal(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	if err != nil {
+		t.Fatalf("failed to open test db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := context.Background()
+
+	journal := service.NewOperationJournal(sqlite.NewOperationLogRepository(db))
+	habitSvc := service.NewHabitService(sqlite.NewHabitRepository(db), sqlite.NewHabitLogRepository(db)).
+		WithOperationJournal(journal)
+	if _, err := habitSvc.CreateHabit(ctx, "Meditation"); err != nil {
+		t.Fatalf("failed to create habit: %v", err)
+	}
+
+	model := NewWithConfig(Config{HabitService: habitSvc, Journal: journal})
+	model.currentView = ViewTypeHabits
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	model = newModel.(Model)
+	if cmd == nil {
+		t.Fatal("expected a command to undo")
+	}
+	if _, ok := cmd().(operationRevertedMsg); !ok {
+		t.Fatal("expected operationRevertedMsg after undo")
+	}
+	habits, err := habitSvc.GetTrackerStatus(ctx, time.Now(), 7)
+	if err != nil {
+		t.Fatalf("failed to load habits: %v", err)
+	}
+	if len(habits.Habits) != 0 {
+		t.Errorf("expected habit creation to be undone, got %d habits", len(habits.Habits))
+	}
+
+	_, cmd = model.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if cmd == nil {
+		t.Fatal("expected a command to redo")
+	}
+	if _, ok := cmd().(operationRevertedMsg); !ok {
+		t.Fatal("expected operationRevertedMsg after redo")
+	}
+	habits, err = habitSvc.GetTrackerStatus(ctx, time.Now(), 7)
+	if err != nil {
+		t.Fatalf("failed to load habits: %v", err)
+	}
+	if len(habits.Habits) != 1 {
+		t.Errorf("expected habit creation to be redone, got %d habits", len(habits.Habits))
+	}
+}
+
+func TestIntegration_UndoKeyIsTypedIntoFocusedSearch(t *testing.T) {
+	db, err := sqlite.OpenAndMigrate(":memory:")
+	if err != nil {
+		t.Fatalf("failed to open test db: %v", err)
+	}
+	t.Cleanup(func() { _ = db.Close() })
+	ctx := context.Background()
+
+	journal := service.NewOperationJournal(sqlite.NewOperationLogRepository(db))
+	habitSvc := service.NewHabitService(sqlite.NewHabitRepository(db), sqlite.NewHabitLogRepository(db)).
+		WithOperationJournal(journal)
+	if _, err := habitSvc.CreateHabit(ctx, "Meditation"); err != nil {
+		t.Fatalf("failed to create habit: %v", err)
+	}
+
+	model := NewWithConfig(Config{HabitService: habitSvc, Journal: journal})
+	model.currentView = ViewTypeSearch
+	model.searchView.input.Focus()
+
+	newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'u'}})
+	model = newModel.(Model)
+	if cmd != nil {
+		if _, ok := cmd().(operationRevertedMsg); ok {
+			t.Fatal("typing u into the search input should not undo")
+		}
+	}
+	if got := model.searchView.input.Value(); got != "u" {
+		t.Errorf("expected search query %q, got %q", "u", got)
+	}
+	habits, err := habitSvc.GetTrackerStatus(ctx, time.Now(), 7)
+	if err != nil {
+		t.Fatalf("failed to load habits: %v", err)
+	}
+	if len(habits.Habits) != 1 {
+		t.Errorf("expected habit to remain, got %d habits", len(habits.Habits))
+	}
+}
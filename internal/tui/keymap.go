@@ -17,7 +17,12 @@ type KeyMap struct {
 	AddRoot              key.Binding
 	Migrate              key.Binding
 	MigrateToGoal        key.Binding
+	Repeat               key.Binding
 	MoveListItem         key.Binding
+	IndentItem           key.Binding
+	OutdentItem          key.Binding
+	MoveItemUp           key.Binding
+	MoveItemDown         key.Binding
 	MoveToList           key.Binding
 	MoveToRoot           key.Binding
 	Priority             key.Binding
@@ -29,6 +34,7 @@ type KeyMap struct {
 	UncancelEntry        key.Binding
 	Retype               key.Binding
 	Undo                 key.Binding
+	Redo                 key.Binding
 	Quit                 key.Binding
 	Back                 key.Binding
 	Help                 key.Binding
@@ -43,6 +49,7 @@ type KeyMap struct {
 	ViewStats            key.Binding
 	ViewSettings         key.Binding
 	ViewInsights         key.Binding
+	ViewFutureLog        key.Binding
 	CommandPalette       key.Binding
 	LogHabit             key.Binding
 	RemoveHabitLog       key.Binding
@@ -118,10 +125,30 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("M"),
 			key.WithHelp("M", "to goal"),
 		),
+		Repeat: key.NewBinding(
+			key.WithKeys("E"),
+			key.WithHelp("E", "repeat"),
+		),
 		MoveListItem: key.NewBinding(
 			key.WithKeys("M"),
 			key.WithHelp("M", "move"),
 		),
+		IndentItem: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "indent"),
+		),
+		OutdentItem: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "outdent"),
+		),
+		MoveItemUp: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "move up"),
+		),
+		MoveItemDown: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "move down"),
+		),
 		MoveToList: key.NewBinding(
 			key.WithKeys("L"),
 			key.WithHelp("L", "to list"),
@@ -166,6 +193,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("u"),
 			key.WithHelp("u", "undo"),
 		),
+		Redo: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "redo"),
+		),
 		Quit: key.NewBinding(
 			key.WithKeys("q"),
 			key.WithHelp("q", "quit"),
@@ -222,6 +253,10 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("i"),
 			key.WithHelp("i", "insights"),
 		),
+		ViewFutureLog: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "future log"),
+		),
 		CommandPalette: key.NewBinding(
 			key.WithKeys("ctrl+p", ":"),
 			key.WithHelp("ctrl+p/:", "commands"),
@@ -293,7 +328,7 @@ func (k KeyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Top, k.Bottom},
 		{k.Done, k.CancelEntry, k.UncancelEntry, k.Edit, k.Add, k.AddChild, k.AddRoot, k.Delete},
-		{k.Migrate, k.MoveToList, k.Retype, k.Priority, k.Answer, k.Capture, k.Undo},
+		{k.Migrate, k.Repeat, k.MoveToList, k.Retype, k.Priority, k.Answer, k.Capture, k.Undo, k.Redo},
 		{k.ToggleView, k.GotoDate, k.GotoToday, k.Quit, k.Help},
 	}
 }
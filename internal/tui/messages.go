@@ -19,6 +19,8 @@ type entryUpdatedMsg struct {
 	id int64
 }
 
+type operationRevertedMsg struct{}
+
 type entryDeletedMsg struct {
 	id int64
 }
@@ -37,7 +39,8 @@ type gotoDateMsg struct {
 }
 
 type habitsLoadedMsg struct {
-	habits []service.HabitStatus
+	habits   []service.HabitStatus
+	heatmaps []service.HabitHeatmap
 }
 
 type habitLoggedMsg struct {
@@ -89,13 +92,20 @@ type listItemEditedMsg struct {
 	listID int64
 }
 
+type listItemRearrangedMsg struct {
+	listID   int64
+	entityID domain.EntityID
+}
+
 type listItemMovedMsg struct {
 	fromListID int64
 	toListID   int64
 }
 
 type goalsLoadedMsg struct {
-	goals []domain.Goal
+	nodes      []service.GoalNode
+	tasks      map[domain.EntityID][]domain.Entry
+	keyResults map[domain.EntityID][]domain.KeyResult
 }
 
 type goalToggledMsg struct {
@@ -120,14 +130,19 @@ type journalGoalsLoadedMsg struct {
 	goals []domain.Goal
 }
 
+type journalOverdueItemsLoadedMsg struct {
+	items []service.OverdueListItem
+}
+
 type entryMigratedToGoalMsg struct {
 	entryID int64
 	goalID  int64
 }
 
 type searchResultsMsg struct {
-	results []domain.Entry
-	query   string
+	results  []domain.Entry
+	snippets map[int64]string
+	query    string
 }
 
 type pendingTasksLoadedMsg struct {
@@ -143,6 +158,19 @@ type questionsLoadedMsg struct {
 	entries []domain.Entry
 }
 
+type futureLogLoadedMsg struct {
+	log *service.FutureLog
+}
+
+type monthReviewLoadedMsg struct {
+	review *service.MonthReview
+	lists  []domain.List
+}
+
+type monthReviewAppliedMsg struct {
+	summary *service.MonthReviewSummary
+}
+
 type statsLoadedMsg struct {
 	stats *domain.Stats
 }
@@ -49,18 +49,22 @@ type InsightsReader interface {
 }
 
 type Config struct {
-	BujoService     *service.BujoService
-	HabitService    *service.HabitService
-	ListService     *service.ListService
-	GoalService     *service.GoalService
-	StatsService    *service.StatsService
-	InsightsReader  InsightsReader
-	ChangeDetection ChangeDetector
-	Theme           string
-	Version         string
-	Commit          string
-	Date            string
-	DBPath          string
+	BujoService       *service.BujoService
+	HabitService      *service.HabitService
+	ListService       *service.ListService
+	GoalService       *service.GoalService
+	RecurrenceService *service.RecurrenceService
+	SearchService     *service.SearchService
+	StatsService      *service.StatsService
+	Journal           *service.OperationJournal
+	MonthReview       *service.MonthReviewService
+	InsightsReader    InsightsReader
+	ChangeDetection   ChangeDetector
+	Theme             string
+	Version           string
+	Commit            string
+	Date              string
+	DBPath            string
 }
 
 type Model struct {
@@ -68,11 +72,16 @@ type Model struct {
 	habitService             *service.HabitService
 	listService              *service.ListService
 	goalService              *service.GoalService
+	recurrenceService        *service.RecurrenceService
+	searchService            *service.SearchService
 	statsService             *service.StatsService
+	journal                  *service.OperationJournal
+	monthReviewService       *service.MonthReviewService
 	changeDetection          ChangeDetector
 	lastCheckedModified      time.Time
 	days                     []service.DayEntries
 	journalGoals             []domain.Goal
+	journalOverdueItems      []service.OverdueListItem
 	entries                  []EntryItem
 	collapsed                map[domain.EntityID]bool
 	selectedIdx              int
@@ -87,6 +96,7 @@ type Model struct {
 	answerMode               answerState
 	addMode                  addState
 	migrateMode              migrateState
+	repeatMode               repeatState
 	gotoMode                 gotoState
 	searchMode               searchState
 	searchView               searchViewState
@@ -113,6 +123,8 @@ type Model struct {
 	commandRegistry          *CommandRegistry
 	pendingTasksState        pendingTasksState
 	questionsState           questionsState
+	futureLogState           futureLogState
+	monthReviewMode          monthReviewState
 	undoState                undoState
 	appVersion               string
 	appCommit                string
@@ -135,6 +147,7 @@ type searchState struct {
 type searchViewState struct {
 	query       string
 	results     []domain.Entry
+	snippets    map[int64]string
 	selectedIdx int
 	loading     bool
 	input       textinput.Model
@@ -174,6 +187,9 @@ type migrateState struct {
 	entryID  int64
 	fromDate time.Time
 	input    textinput.Model
+	// listItem is set when a list item is being scheduled onto a day
+	// rather than an entry migrated.
+	listItem *domain.ListItem
 }
 
 type gotoState struct {
@@ -219,6 +235,7 @@ type habitState struct {
 	dayIdxInited   bool
 	viewMode       HabitViewMode
 	weekOffset     int
+	heatmaps       []service.HabitHeatmap
 }
 
 type addHabitState struct {
@@ -238,6 +255,9 @@ type listState struct {
 	selectedListIdx int
 	selectedItemIdx int
 	currentListID   int64
+	// selectEntityID keeps the selection on an item that was rearranged,
+	// since its position and row ID change when the items reload.
+	selectEntityID domain.EntityID
 }
 
 type moveListItemState struct {
@@ -261,6 +281,9 @@ type moveToListState struct {
 
 type goalState struct {
 	goals       []domain.Goal
+	nodes       []service.GoalNode
+	tasks       map[domain.EntityID][]domain.Entry
+	keyResults  map[domain.EntityID][]domain.KeyResult
 	selectedIdx int
 	viewMonth   time.Time
 }
@@ -287,6 +310,13 @@ type moveGoalState struct {
 	input  textinput.Model
 }
 
+type repeatState struct {
+	active  bool
+	entryID int64
+	content string
+	input   textinput.Model
+}
+
 type migrateToGoalState struct {
 	active  bool
 	entryID int64
@@ -355,6 +385,39 @@ type questionsState struct {
 	loading     bool
 }
 
+// futureLogState keeps the entries of the future log flattened in display
+// order so selection can move across months.
+type futureLogState struct {
+	log         *service.FutureLog
+	entries     []domain.Entry
+	selectedIdx int
+	loading     bool
+}
+
+// monthReviewState walks the open items of a month one at a time and
+// collects a decision for each; nothing is changed until the last item is
+// decided. stage is the prompt shown for the current item.
+type monthReviewState struct {
+	active    bool
+	review    *service.MonthReview
+	lists     []domain.List
+	idx       int
+	listIdx   int
+	decisions []service.MonthReviewDecision
+	stage     monthReviewStage
+	input     textinput.Model
+	applying  bool
+	summary   *service.MonthReviewSummary
+}
+
+type monthReviewStage int
+
+const (
+	monthReviewStageAction monthReviewStage = iota
+	monthReviewStageFutureLog
+	monthReviewStageList
+)
+
 type ViewMode int
 
 const (
@@ -376,6 +439,7 @@ const (
 	ViewTypeStats                        // key 9
 	ViewTypeSettings                     // key 0
 	ViewTypeInsights                     // key i
+	ViewTypeFutureLog                    // key F
 	ViewTypeListItems                    // internal (accessed via Lists)
 )
 
@@ -385,6 +449,7 @@ const (
 	HabitViewModeWeek HabitViewMode = iota
 	HabitViewModeMonth
 	HabitViewModeQuarter
+	HabitViewModeYear
 )
 
 const (
@@ -453,34 +518,38 @@ func NewWithConfig(cfg Config) Model {
 	currentMonday := today.AddDate(0, 0, -daysFromMonday)
 
 	return Model{
-		bujoService:       cfg.BujoService,
-		habitService:      cfg.HabitService,
-		listService:       cfg.ListService,
-		goalService:       cfg.GoalService,
-		statsService:      cfg.StatsService,
-		changeDetection:   cfg.ChangeDetection,
-		collapsed:         make(map[domain.EntityID]bool),
-		viewMode:          ViewModeDay,
-		viewDate:          today,
-		currentView:       ViewTypeJournal,
-		commandRegistry:   DefaultCommands(),
-		help:              help.New(),
-		keyMap:            DefaultKeyMap(),
-		draftPath:         DraftPath(),
-		editMode:          editState{input: editInput},
-		addMode:           addState{input: addInput},
-		migrateMode:       migrateState{input: migrateInput},
-		gotoMode:          gotoState{input: gotoInput},
-		goalState:         goalState{viewMonth: currentMonth},
-		migrateToGoalMode: migrateToGoalState{input: migrateToGoalInput},
-		searchView:        searchViewState{input: searchInput},
-		statsViewState:    statsState{from: statsFrom, to: statsTo},
-		insightsReader:    cfg.InsightsReader,
-		insightsState:     insightsState{weekAnchor: currentMonday},
-		appVersion:        cfg.Version,
-		appCommit:         cfg.Commit,
-		appDate:           cfg.Date,
-		appDBPath:         cfg.DBPath,
+		bujoService:        cfg.BujoService,
+		habitService:       cfg.HabitService,
+		listService:        cfg.ListService,
+		goalService:        cfg.GoalService,
+		recurrenceService:  cfg.RecurrenceService,
+		searchService:      cfg.SearchService,
+		statsService:       cfg.StatsService,
+		journal:            cfg.Journal,
+		monthReviewService: cfg.MonthReview,
+		changeDetection:    cfg.ChangeDetection,
+		collapsed:          make(map[domain.EntityID]bool),
+		viewMode:           ViewModeDay,
+		viewDate:           today,
+		currentView:        ViewTypeJournal,
+		commandRegistry:    DefaultCommands(),
+		help:               help.New(),
+		keyMap:             DefaultKeyMap(),
+		draftPath:          DraftPath(),
+		editMode:           editState{input: editInput},
+		addMode:            addState{input: addInput},
+		migrateMode:        migrateState{input: migrateInput},
+		gotoMode:           gotoState{input: gotoInput},
+		goalState:          goalState{viewMonth: currentMonth},
+		migrateToGoalMode:  migrateToGoalState{input: migrateToGoalInput},
+		searchView:         searchViewState{input: searchInput},
+		statsViewState:     statsState{from: statsFrom, to: statsTo},
+		insightsReader:     cfg.InsightsReader,
+		insightsState:      insightsState{weekAnchor: currentMonday},
+		appVersion:         cfg.Version,
+		appCommit:          cfg.Commit,
+		appDate:            cfg.Date,
+		appDBPath:          cfg.DBPath,
 	}
 }
 
@@ -642,6 +711,22 @@ func (m Model) loadJournalGoalsCmd() tea.Cmd {
 	}
 }
 
+func (m Model) loadJournalOverdueItemsCmd() tea.Cmd {
+	return func() tea.Msg {
+		if m.listService == nil {
+			return journalOverdueItemsLoadedMsg{items: nil}
+		}
+		ctx := context.Background()
+		now := time.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+		items, err := m.listService.GetOverdueItems(ctx, today)
+		if err != nil {
+			return journalOverdueItemsLoadedMsg{items: nil}
+		}
+		return journalOverdueItemsLoadedMsg{items: items}
+	}
+}
+
 func (m Model) logHabitForDateCmd(habitID int64, date time.Time) tea.Cmd {
 	return func() tea.Msg {
 		if m.habitService == nil {
@@ -674,25 +759,37 @@ func (m Model) removeHabitLogForDateCmd(habitID int64, date time.Time) tea.Cmd {
 }
 
 func (m Model) getHabitReferenceDate() time.Time {
-	days := HabitDaysWeek
-	switch m.habitState.viewMode {
-	case HabitViewModeMonth:
-		days = HabitDaysMonth
-	case HabitViewModeQuarter:
-		days = HabitDaysQuarter
+	now := time.Now()
+	if m.habitState.viewMode == HabitViewModeYear {
+		// The year view runs from January 1 up to today, or to December 31
+		// for past years.
+		if m.habitState.weekOffset == 0 {
+			return now
+		}
+		return time.Date(now.Year()-m.habitState.weekOffset, time.December, 31, 0, 0, 0, 0, now.Location())
 	}
-	return time.Now().AddDate(0, 0, -m.habitState.weekOffset*days)
+	return now.AddDate(0, 0, -m.habitState.weekOffset*m.habitDays())
 }
 
-func (m Model) loadHabitsCmd() tea.Cmd {
-	days := HabitDaysWeek
+// habitDays is the number of days the habit view shows, ending on the
+// reference date.
+func (m Model) habitDays() int {
 	switch m.habitState.viewMode {
 	case HabitViewModeMonth:
-		days = HabitDaysMonth
+		return HabitDaysMonth
 	case HabitViewModeQuarter:
-		days = HabitDaysQuarter
+		return HabitDaysQuarter
+	case HabitViewModeYear:
+		return m.getHabitReferenceDate().YearDay()
+	default:
+		return HabitDaysWeek
 	}
+}
+
+func (m Model) loadHabitsCmd() tea.Cmd {
+	days := m.habitDays()
 	referenceDate := m.getHabitReferenceDate()
+	yearView := m.habitState.viewMode == HabitViewModeYear
 	return func() tea.Msg {
 		if m.habitService == nil {
 			return errMsg{fmt.Errorf("habit service not available")}
@@ -702,7 +799,14 @@ func (m Model) loadHabitsCmd() tea.Cmd {
 		if err != nil {
 			return errMsg{err}
 		}
-		return habitsLoadedMsg{status.Habits}
+		if !yearView {
+			return habitsLoadedMsg{habits: status.Habits}
+		}
+		heatmaps, err := m.habitService.GetHabitHeatmaps(ctx, referenceDate.Year(), time.Now())
+		if err != nil {
+			return errMsg{err}
+		}
+		return habitsLoadedMsg{habits: status.Habits, heatmaps: heatmaps}
 	}
 }
 
@@ -821,6 +925,35 @@ func (m Model) toggleListItemCmd(item domain.ListItem) tea.Cmd {
 	}
 }
 
+// toggleSmartListItemCmd ticks off, or reopens, the journal entry that a
+// smart list shows as the item.
+func (m Model) toggleSmartListItemCmd(item domain.ListItem) tea.Cmd {
+	return func() tea.Msg {
+		entry, err := m.bujoService.GetEntry(context.Background(), item.EntryID)
+		if err != nil {
+			return errMsg{err}
+		}
+		toggle := m.toggleDoneForEntryCmd(*entry)
+		if toggle == nil {
+			return nil
+		}
+		if msg, ok := toggle().(errMsg); ok {
+			return msg
+		}
+		return listItemToggledMsg{item.EntryID}
+	}
+}
+
+// currentList returns the list whose items are being shown.
+func (m Model) currentList() (domain.List, bool) {
+	for _, list := range m.listState.lists {
+		if list.ID == m.listState.currentListID {
+			return list, true
+		}
+	}
+	return domain.List{}, false
+}
+
 func (m Model) addListItemCmd(content string) tea.Cmd {
 	listID := m.listState.currentListID
 	return func() tea.Msg {
@@ -866,6 +999,33 @@ func (m Model) editListItemCmd(itemID int64, content string) tea.Cmd {
 	}
 }
 
+// rearrangeListItemCmd runs a list service change that moves or reprioritises
+// item, and keeps the item selected once the list reloads.
+func (m Model) rearrangeListItemCmd(item domain.ListItem, change func(s *service.ListService, ctx context.Context, itemID int64) error) tea.Cmd {
+	listID := m.listState.currentListID
+	return func() tea.Msg {
+		if m.listService == nil {
+			return errMsg{fmt.Errorf("list service not available")}
+		}
+		if err := change(m.listService, context.Background(), item.RowID); err != nil {
+			return errMsg{err}
+		}
+		return listItemRearrangedMsg{listID: listID, entityID: item.EntityID}
+	}
+}
+
+// scheduleListItemCmd moves item from its list onto the day dateStr names.
+func (m Model) scheduleListItemCmd(item domain.ListItem, dateStr string) tea.Cmd {
+	toDate, err := parseDate(dateStr)
+	if err != nil {
+		return func() tea.Msg { return errMsg{err} }
+	}
+	return m.rearrangeListItemCmd(item, func(s *service.ListService, ctx context.Context, itemID int64) error {
+		_, err := s.ScheduleItem(ctx, itemID, toDate)
+		return err
+	})
+}
+
 func (m Model) moveListItemCmd(itemID int64, targetListID int64, fromListID int64) tea.Cmd {
 	return func() tea.Msg {
 		if m.listService == nil {
@@ -920,11 +1080,24 @@ func (m Model) loadGoalsCmd() tea.Cmd {
 			return errMsg{fmt.Errorf("goal service not available")}
 		}
 		ctx := context.Background()
-		goals, err := m.goalService.GetGoalsForMonth(ctx, viewMonth)
+		tree, err := m.goalService.GetGoalTree(ctx, domain.GoalHorizonMonth, viewMonth)
+		if err != nil {
+			return errMsg{err}
+		}
+		nodes := service.FlattenGoalTree(tree)
+		goals := make([]domain.Goal, len(nodes))
+		for i, node := range nodes {
+			goals[i] = node.Goal
+		}
+		tasks, err := m.goalService.GetGoalTasks(ctx, goals)
 		if err != nil {
 			return errMsg{err}
 		}
-		return goalsLoadedMsg{goals}
+		keyResults, err := m.goalService.GetKeyResults(ctx, goals)
+		if err != nil {
+			return errMsg{err}
+		}
+		return goalsLoadedMsg{nodes, tasks, keyResults}
 	}
 }
 
@@ -1038,11 +1211,28 @@ func (m Model) searchEntriesCmd(query string) tea.Cmd {
 		}
 		ctx := context.Background()
 		opts := domain.NewSearchOptions(query)
-		results, err := m.bujoService.SearchEntries(ctx, opts)
+		if m.searchService == nil {
+			results, err := m.bujoService.SearchEntries(ctx, opts)
+			if err != nil {
+				return errMsg{err}
+			}
+			return searchResultsMsg{results: results, query: query}
+		}
+
+		ranked, err := m.searchService.Search(ctx, opts)
 		if err != nil {
 			return errMsg{err}
 		}
-		return searchResultsMsg{results: results, query: query}
+		var results []domain.Entry
+		snippets := make(map[int64]string)
+		for _, r := range ranked {
+			if r.Entry == nil {
+				continue
+			}
+			results = append(results, *r.Entry)
+			snippets[r.Entry.ID] = r.Snippet
+		}
+		return searchResultsMsg{results: results, snippets: snippets, query: query}
 	}
 }
 
@@ -1078,6 +1268,40 @@ func (m Model) loadPendingTasksCmd() tea.Cmd {
 	}
 }
 
+func (m Model) loadFutureLogCmd() tea.Cmd {
+	return func() tea.Msg {
+		if m.bujoService == nil {
+			return errMsg{fmt.Errorf("bujo service not available")}
+		}
+		log, err := m.bujoService.GetFutureLog(context.Background())
+		if err != nil {
+			return errMsg{err}
+		}
+		return futureLogLoadedMsg{log: log}
+	}
+}
+
+func (m Model) loadMonthReviewCmd(month time.Time) tea.Cmd {
+	return func() tea.Msg {
+		if m.monthReviewService == nil {
+			return errMsg{fmt.Errorf("month review not available")}
+		}
+		ctx := context.Background()
+		review, err := m.monthReviewService.GetReview(ctx, month)
+		if err != nil {
+			return errMsg{err}
+		}
+		var lists []domain.List
+		if m.listService != nil {
+			lists, err = m.listService.GetAllLists(ctx)
+			if err != nil {
+				return errMsg{err}
+			}
+		}
+		return monthReviewLoadedMsg{review: review, lists: lists}
+	}
+}
+
 func (m Model) loadQuestionsCmd() tea.Cmd {
 	return func() tea.Msg {
 		if m.bujoService == nil {
@@ -38,6 +38,10 @@ var (
 			Foreground(DimColor).
 			Strikethrough(true)
 
+	ProjectedStyle = lipgloss.NewStyle().
+			Foreground(DimColor).
+			Italic(true)
+
 	IDStyle = lipgloss.NewStyle().
 		Foreground(DimColor)
 
@@ -251,3 +251,20 @@ func TestModel_AddRoot_UsesViewDate(t *testing.T) {
 // Draft tests
 
 // Day View Search Tests
+
+func TestModel_Update_ProjectedEntryIsReadOnly(t *testing.T) {
+	model := New(nil)
+	model.days = []service.DayEntries{}
+	model.entries = []EntryItem{
+		{Entry: domain.Entry{Content: "Water plants", Type: domain.EntryTypeTask, Projected: true}},
+	}
+
+	for _, r := range []rune{' ', 'e', 'd'} {
+		newModel, cmd := model.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m := newModel.(Model)
+
+		if cmd != nil || m.editMode.active || m.confirmMode.active {
+			t.Errorf("pressing %q on a projected entry should do nothing", r)
+		}
+	}
+}
@@ -104,6 +104,7 @@ func TestDefaultKeyMap_ViewKeyBindings(t *testing.T) {
 		{"Search", "8", km.ViewSearch},
 		{"Stats", "9", km.ViewStats},
 		{"Settings", "0", km.ViewSettings},
+		{"Future Log", "F", km.ViewFutureLog},
 	}
 
 	for _, tt := range tests {
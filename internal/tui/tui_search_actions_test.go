@@ -1,6 +1,7 @@
 package tui
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -290,3 +291,21 @@ func TestSearch_ActionsNoOpWhenInputFocused(t *testing.T) {
 		t.Error("actions should not fire when search input is focused")
 	}
 }
+
+func TestSearch_RendersHighlightedSnippet(t *testing.T) {
+	model := newSearchViewModel([]domain.Entry{
+		{ID: 1, Content: "Buy milk and bread", Type: domain.EntryTypeTask, ScheduledDate: scheduledDate(2026, 1, 1)},
+	})
+	model.searchView.snippets = map[int64]string{
+		1: "Buy " + domain.SnippetMarkStart + "milk" + domain.SnippetMarkEnd + " and bread",
+	}
+
+	line := model.renderSearchResultLine(model.searchView.results[0], false)
+
+	if strings.Contains(line, domain.SnippetMarkStart) || strings.Contains(line, domain.SnippetMarkEnd) {
+		t.Errorf("expected snippet markers to be replaced, got %q", line)
+	}
+	if !strings.Contains(line, "milk") {
+		t.Errorf("expected snippet content in line, got %q", line)
+	}
+}
@@ -3,6 +3,7 @@ package tui
 import (
 	"strings"
 	"testing"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/typingincolor/bujo/internal/domain"
@@ -195,11 +196,56 @@ func TestModel_HabitsView_ToggleMonthView_W(t *testing.T) {
 		t.Errorf("expected HabitViewModeQuarter after second 'w', got %v", m.habitState.viewMode)
 	}
 
-	// Third press: Quarter -> Week (cycle back)
+	// Third press: Quarter -> Year
+	newModel, _ = m.Update(msg)
+	m = newModel.(Model)
+	if m.habitState.viewMode != HabitViewModeYear {
+		t.Errorf("expected HabitViewModeYear after third 'w', got %v", m.habitState.viewMode)
+	}
+	if m.habitState.selectedDayIdx != time.Now().YearDay()-1 {
+		t.Errorf("expected today selected in year view, got day %d", m.habitState.selectedDayIdx)
+	}
+
+	// Fourth press: Year -> Week (cycle back)
 	newModel, _ = m.Update(msg)
 	m = newModel.(Model)
 	if m.habitState.viewMode != HabitViewModeWeek {
-		t.Errorf("expected HabitViewModeWeek after third 'w', got %v", m.habitState.viewMode)
+		t.Errorf("expected HabitViewModeWeek after fourth 'w', got %v", m.habitState.viewMode)
+	}
+}
+
+func TestModel_HabitsView_YearViewRendersHeatmap(t *testing.T) {
+	now := time.Now()
+	start := time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, now.Location())
+	heatmap := service.HabitHeatmap{HabitID: 1, Name: "Exercise", Year: now.Year()}
+	for day := start; !day.After(now); day = day.AddDate(0, 0, 1) {
+		heatmap.Days = append(heatmap.Days, service.HeatmapDay{Date: day, Intensity: 1})
+	}
+	heatmap.LongestStreak = len(heatmap.Days)
+	heatmap.LongestStreakStart = start
+
+	model := New(nil)
+	model.width = 200
+	model.height = 40
+	model.currentView = ViewTypeHabits
+	model.habitState = habitState{
+		habits:   []service.HabitStatus{{ID: 1, Name: "Exercise"}},
+		heatmaps: []service.HabitHeatmap{heatmap},
+		viewMode: HabitViewModeYear,
+	}
+
+	view := model.renderHabitsContent()
+	if !strings.Contains(view, "All habits") {
+		t.Errorf("expected all-habits overlay, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Jan") || !strings.Contains(view, "Mon") {
+		t.Errorf("expected month and weekday labels, got:\n%s", view)
+	}
+	if !strings.Contains(view, "█") {
+		t.Errorf("expected met days shaded, got:\n%s", view)
+	}
+	if !strings.Contains(view, "Longest streak:") {
+		t.Errorf("expected longest streak, got:\n%s", view)
 	}
 }
 
@@ -59,13 +59,23 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case journalGoalsLoadedMsg:
 		m.journalGoals = msg.goals
+		return m, m.loadJournalOverdueItemsCmd()
+
+	case journalOverdueItemsLoadedMsg:
+		m.journalOverdueItems = msg.items
 		return m, nil
 
 	case errMsg:
 		m.err = msg.err
 		m.undoState = undoState{}
+		if m.monthReviewMode.applying {
+			m.monthReviewMode = monthReviewState{}
+		}
 		return m, nil
 
+	case operationRevertedMsg:
+		return m, m.reloadCurrentViewCmd()
+
 	case entryUpdatedMsg, entryDeletedMsg, entryMovedToListMsg, agendaReloadNeededMsg:
 		return m, m.loadDaysCmd()
 
@@ -84,20 +94,18 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case habitsLoadedMsg:
 		m.habitState.habits = msg.habits
+		m.habitState.heatmaps = msg.heatmaps
 		if m.habitState.selectedIdx >= len(m.habitState.habits) {
 			m.habitState.selectedIdx = 0
 		}
+		days := m.habitDays()
 		if !m.habitState.dayIdxInited {
-			days := HabitDaysWeek
-			switch m.habitState.viewMode {
-			case HabitViewModeMonth:
-				days = HabitDaysMonth
-			case HabitViewModeQuarter:
-				days = HabitDaysQuarter
-			}
 			m.habitState.selectedDayIdx = days - 1
 			m.habitState.dayIdxInited = true
 		}
+		if m.habitState.selectedDayIdx >= days {
+			m.habitState.selectedDayIdx = days - 1
+		}
 		return m, nil
 
 	case habitLoggedMsg:
@@ -132,13 +140,44 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case monthReviewLoadedMsg:
+		input := textinput.New()
+		input.Placeholder = "Month (YYYY-MM) or someday..."
+		m.monthReviewMode = monthReviewState{
+			active: true,
+			review: msg.review,
+			lists:  msg.lists,
+			input:  input,
+		}
+		if len(msg.review.Items) == 0 {
+			m.monthReviewMode.summary = &service.MonthReviewSummary{}
+		}
+		return m, nil
+
+	case monthReviewAppliedMsg:
+		m.monthReviewMode.applying = false
+		m.monthReviewMode.summary = msg.summary
+		return m, nil
+
 	case listItemsLoadedMsg:
 		m.listState.items = msg.items
+		if m.listState.selectEntityID != "" {
+			for i, item := range m.listState.items {
+				if item.EntityID == m.listState.selectEntityID {
+					m.listState.selectedItemIdx = i
+				}
+			}
+			m.listState.selectEntityID = ""
+		}
 		if m.listState.selectedItemIdx >= len(m.listState.items) {
 			m.listState.selectedItemIdx = 0
 		}
 		return m, nil
 
+	case listItemRearrangedMsg:
+		m.listState.selectEntityID = msg.entityID
+		return m, m.loadListItemsCmd(msg.listID)
+
 	case listItemToggledMsg:
 		return m, m.loadListItemsCmd(m.listState.currentListID)
 
@@ -155,7 +194,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.loadListItemsCmd(msg.fromListID)
 
 	case goalsLoadedMsg:
-		m.goalState.goals = msg.goals
+		m.goalState.nodes = msg.nodes
+		m.goalState.goals = make([]domain.Goal, len(msg.nodes))
+		for i, node := range msg.nodes {
+			m.goalState.goals[i] = node.Goal
+		}
+		m.goalState.tasks = msg.tasks
+		m.goalState.keyResults = msg.keyResults
 		if m.goalState.selectedIdx >= len(m.goalState.goals) {
 			m.goalState.selectedIdx = 0
 		}
@@ -258,6 +303,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case searchResultsMsg:
 		m.searchView.loading = false
 		m.searchView.results = msg.results
+		m.searchView.snippets = msg.snippets
 		m.searchView.query = msg.query
 		m.searchView.selectedIdx = 0
 		return m, nil
@@ -284,6 +330,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.questionsState.selectedIdx = 0
 		return m, nil
 
+	case futureLogLoadedMsg:
+		m.futureLogState.loading = false
+		m.futureLogState.log = msg.log
+		m.futureLogState.entries = nil
+		for _, month := range msg.log.Months {
+			m.futureLogState.entries = append(m.futureLogState.entries, month.Entries...)
+		}
+		m.futureLogState.entries = append(m.futureLogState.entries, msg.log.Someday...)
+		if m.futureLogState.selectedIdx >= len(m.futureLogState.entries) {
+			m.futureLogState.selectedIdx = max(len(m.futureLogState.entries)-1, 0)
+		}
+		return m, nil
+
 	case locationsLoadedMsg:
 		m.presetPicker.items = msg.locations
 		return m, nil
@@ -335,6 +394,9 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.migrateMode.active {
 			return m.handleMigrateMode(msg)
 		}
+		if m.repeatMode.active {
+			return m.handleRepeatMode(msg)
+		}
 		if m.confirmMode.active {
 			return m.handleConfirmMode(msg)
 		}
@@ -383,6 +445,19 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.moveToListMode.active {
 			return m.handleMoveToListMode(msg)
 		}
+		if m.monthReviewMode.active {
+			return m.handleMonthReviewMode(msg)
+		}
+
+		if m.journal != nil && !m.textInputFocused() {
+			switch {
+			case key.Matches(msg, m.keyMap.Undo):
+				m.undoState = undoState{}
+				return m, m.undoOperationCmd()
+			case key.Matches(msg, m.keyMap.Redo):
+				return m, m.redoOperationCmd()
+			}
+		}
 
 		if key.Matches(msg, m.keyMap.CommandPalette) {
 			m.commandPalette.active = true
@@ -409,6 +484,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m.handlePendingTasksMode(msg)
 		case ViewTypeQuestions:
 			return m.handleQuestionsMode(msg)
+		case ViewTypeFutureLog:
+			return m.handleFutureLogMode(msg)
 		default:
 			return m.handleNormalMode(msg)
 		}
@@ -421,6 +498,9 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if handled, newModel, cmd := m.handleViewSwitch(msg); handled {
 		return newModel, cmd
 	}
+	if m.actsOnProjectedEntry(msg) {
+		return m, nil
+	}
 
 	switch {
 	case key.Matches(msg, m.keyMap.ExpandAll):
@@ -665,7 +745,7 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 		ti := textinput.New()
-		ti.Placeholder = "tomorrow, next monday, 2026-01-15"
+		ti.Placeholder = "tomorrow, next monday, 2026-01-15, 2026-03, someday"
 		ti.Focus()
 		ti.CharLimit = 64
 		ti.Width = m.width - 10
@@ -681,6 +761,27 @@ func (m Model) handleNormalMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case key.Matches(msg, m.keyMap.Repeat):
+		if len(m.entries) == 0 || m.recurrenceService == nil {
+			return m, nil
+		}
+		entry := m.entries[m.selectedIdx].Entry
+		if entry.Type != domain.EntryTypeTask && entry.Type != domain.EntryTypeNote && entry.Type != domain.EntryTypeEvent {
+			return m, nil
+		}
+		ti := textinput.New()
+		ti.Placeholder = "daily, mon,wed, 15th, 2nd tue"
+		ti.Focus()
+		ti.CharLimit = 64
+		ti.Width = m.width - 10
+		m.repeatMode = repeatState{
+			active:  true,
+			entryID: entry.ID,
+			content: entry.Content,
+			input:   ti,
+		}
+		return m, nil
+
 	case key.Matches(msg, m.keyMap.MigrateToGoal):
 		if len(m.entries) == 0 {
 			return m, nil
@@ -1095,9 +1196,12 @@ func (m Model) handleMigrateMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.migrateMode.active = false
 			return m, nil
 		}
+		m.migrateMode.active = false
+		if item := m.migrateMode.listItem; item != nil {
+			return m, m.scheduleListItemCmd(*item, dateStr)
+		}
 		entryID := m.migrateMode.entryID
 		fromDate := m.migrateMode.fromDate
-		m.migrateMode.active = false
 		return m, m.migrateEntryCmd(entryID, dateStr, fromDate)
 	}
 
@@ -1106,6 +1210,28 @@ func (m Model) handleMigrateMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+func (m Model) handleRepeatMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.repeatMode.active = false
+		return m, nil
+
+	case tea.KeyEnter:
+		every := strings.TrimSpace(m.repeatMode.input.Value())
+		if every == "" {
+			m.repeatMode.active = false
+			return m, nil
+		}
+		entryID := m.repeatMode.entryID
+		m.repeatMode.active = false
+		return m, m.repeatEntryCmd(entryID, every)
+	}
+
+	var cmd tea.Cmd
+	m.repeatMode.input, cmd = m.repeatMode.input.Update(msg)
+	return m, cmd
+}
+
 func (m Model) handleGotoMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.Type {
 	case tea.KeyEsc:
@@ -1335,11 +1461,20 @@ func (m Model) handleRetypeMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 func (m Model) migrateEntryCmd(id int64, dateStr string, fromDate time.Time) tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
-		toDate, err := parseDate(dateStr)
-		if err != nil {
-			return errMsg{err}
+		var newID int64
+		var err error
+		if strings.EqualFold(strings.TrimSpace(dateStr), "someday") {
+			newID, err = m.bujoService.MigrateEntryToSomeday(ctx, id)
+		} else if month, monthErr := dateutil.ParseMonth(strings.TrimSpace(dateStr)); monthErr == nil {
+			newID, err = m.bujoService.MigrateEntryToMonth(ctx, id, month)
+		} else {
+			var toDate time.Time
+			toDate, err = parseDate(dateStr)
+			if err != nil {
+				return errMsg{err}
+			}
+			newID, err = m.bujoService.MigrateEntry(ctx, id, toDate)
 		}
-		newID, err := m.bujoService.MigrateEntry(ctx, id, toDate)
 		if err != nil {
 			return errMsg{err}
 		}
@@ -1347,6 +1482,16 @@ func (m Model) migrateEntryCmd(id int64, dateStr string, fromDate time.Time) tea
 	}
 }
 
+func (m Model) repeatEntryCmd(id int64, every string) tea.Cmd {
+	return func() tea.Msg {
+		ctx := context.Background()
+		if _, err := m.recurrenceService.CreateFromEntry(ctx, id, every); err != nil {
+			return errMsg{err}
+		}
+		return entryUpdatedMsg{id}
+	}
+}
+
 func (m Model) toggleDoneForEntryCmd(entry domain.Entry) tea.Cmd {
 	validTypes := entry.Type == domain.EntryTypeTask ||
 		entry.Type == domain.EntryTypeDone ||
@@ -1483,13 +1628,7 @@ func (m Model) handleHabitsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case key.Matches(msg, m.keyMap.Done):
 		if len(m.habitState.habits) > 0 && m.habitState.selectedIdx < len(m.habitState.habits) {
-			days := HabitDaysWeek
-			switch m.habitState.viewMode {
-			case HabitViewModeMonth:
-				days = HabitDaysMonth
-			case HabitViewModeQuarter:
-				days = HabitDaysQuarter
-			}
+			days := m.habitDays()
 			daysAgo := days - 1 - m.habitState.selectedDayIdx
 			logDate := m.getHabitReferenceDate().AddDate(0, 0, -daysAgo)
 			return m, m.logHabitForDateCmd(m.habitState.habits[m.habitState.selectedIdx].ID, logDate)
@@ -1503,13 +1642,7 @@ func (m Model) handleHabitsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return m, nil
 
 	case key.Matches(msg, m.keyMap.DayRight):
-		days := HabitDaysWeek
-		switch m.habitState.viewMode {
-		case HabitViewModeMonth:
-			days = HabitDaysMonth
-		case HabitViewModeQuarter:
-			days = HabitDaysQuarter
-		}
+		days := m.habitDays()
 		if m.habitState.selectedDayIdx < days-1 {
 			m.habitState.selectedDayIdx++
 		}
@@ -1517,13 +1650,7 @@ func (m Model) handleHabitsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 
 	case key.Matches(msg, m.keyMap.RemoveHabitLog):
 		if len(m.habitState.habits) > 0 && m.habitState.selectedIdx < len(m.habitState.habits) {
-			days := HabitDaysWeek
-			switch m.habitState.viewMode {
-			case HabitViewModeMonth:
-				days = HabitDaysMonth
-			case HabitViewModeQuarter:
-				days = HabitDaysQuarter
-			}
+			days := m.habitDays()
 			daysAgo := days - 1 - m.habitState.selectedDayIdx
 			removeDate := m.getHabitReferenceDate().AddDate(0, 0, -daysAgo)
 			return m, m.removeHabitLogForDateCmd(m.habitState.habits[m.habitState.selectedIdx].ID, removeDate)
@@ -1548,12 +1675,17 @@ func (m Model) handleHabitsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		case HabitViewModeMonth:
 			m.habitState.viewMode = HabitViewModeQuarter
 		case HabitViewModeQuarter:
+			m.habitState.viewMode = HabitViewModeYear
+		case HabitViewModeYear:
 			m.habitState.viewMode = HabitViewModeWeek
 		}
 		// Reset weekOffset when switching view modes because the offset represents
 		// different absolute time spans in each mode (7 days/week vs 30 days/month).
 		// Without resetting, users would see unexpected historical data when switching modes.
 		m.habitState.weekOffset = 0
+		if m.habitState.viewMode == HabitViewModeYear {
+			m.habitState.selectedDayIdx = m.habitDays() - 1
+		}
 		return m, m.loadHabitsCmd()
 
 	case key.Matches(msg, m.keyMap.Add):
@@ -1718,7 +1850,7 @@ func (m Model) handlePendingTasksMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			ti := textinput.New()
-			ti.Placeholder = "tomorrow, next monday, 2026-01-15"
+			ti.Placeholder = "tomorrow, next monday, 2026-01-15, 2026-03, someday"
 			ti.Focus()
 			ti.CharLimit = 64
 			ti.Width = m.width - 10
@@ -1806,6 +1938,66 @@ func (m Model) handleQuestionsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+func (m Model) handleFutureLogMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if handled, newModel, cmd := m.handleViewSwitch(msg); handled {
+		return newModel, cmd
+	}
+
+	switch {
+	case key.Matches(msg, m.keyMap.Quit):
+		return m.handleQuit()
+
+	case key.Matches(msg, m.keyMap.Back):
+		return m.handleBack()
+
+	case key.Matches(msg, m.keyMap.Down):
+		if m.futureLogState.selectedIdx < len(m.futureLogState.entries)-1 {
+			m.futureLogState.selectedIdx++
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Up):
+		if m.futureLogState.selectedIdx > 0 {
+			m.futureLogState.selectedIdx--
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Top):
+		m.futureLogState.selectedIdx = 0
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Bottom):
+		if len(m.futureLogState.entries) > 0 {
+			m.futureLogState.selectedIdx = len(m.futureLogState.entries) - 1
+		}
+		return m, nil
+	}
+
+	if len(m.futureLogState.entries) > 0 {
+		entry := m.futureLogState.entries[m.futureLogState.selectedIdx]
+		if newM, cmd, handled := m.handleEntryActions(entry, msg); handled {
+			return newM, cmd
+		}
+
+		if key.Matches(msg, m.keyMap.Migrate) && entry.Type == domain.EntryTypeTask {
+			ti := textinput.New()
+			ti.Placeholder = "tomorrow, next monday, 2026-01-15, 2026-03, someday"
+			ti.Focus()
+			ti.CharLimit = 64
+			ti.Width = m.width - 10
+			m.migrateMode = migrateState{
+				active:   true,
+				entryID:  entry.ID,
+				fromDate: *entry.ScheduledDate,
+				input:    ti,
+			}
+			return m, nil
+		}
+	}
+
+	return m, nil
+}
+
 func (m Model) ensurePendingTaskVisible() Model {
 	maxLines := m.pendingTasksVisibleRows()
 	if maxLines <= 0 {
@@ -2139,6 +2331,11 @@ func (m Model) handleListItemsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		return newModel, cmd
 	}
 
+	// A smart list's items are the journal entries its query picks out, so
+	// the only change made from here is ticking them off.
+	list, _ := m.currentList()
+	smart := list.IsSmart()
+
 	switch {
 	case key.Matches(msg, m.keyMap.Quit):
 		return m.handleQuit()
@@ -2164,10 +2361,16 @@ func (m Model) handleListItemsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	case key.Matches(msg, m.keyMap.Done):
 		if len(m.listState.items) > 0 && m.listState.selectedItemIdx < len(m.listState.items) {
 			item := m.listState.items[m.listState.selectedItemIdx]
+			if smart {
+				return m, m.toggleSmartListItemCmd(item)
+			}
 			return m, m.toggleListItemCmd(item)
 		}
 		return m, nil
 
+	case smart:
+		return m, nil
+
 	case key.Matches(msg, m.keyMap.Add):
 		m.addMode.active = true
 		m.addMode.input.Reset()
@@ -2198,12 +2401,60 @@ func (m Model) handleListItemsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case key.Matches(msg, m.keyMap.IndentItem):
+		if item, ok := m.selectedListItem(); ok && item.Depth < listItemMaxDepth(m.listState.items, m.listState.selectedItemIdx) {
+			return m, m.rearrangeListItemCmd(item, (*service.ListService).IndentItem)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.OutdentItem):
+		if item, ok := m.selectedListItem(); ok && item.Depth > 0 {
+			return m, m.rearrangeListItemCmd(item, (*service.ListService).OutdentItem)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.MoveItemUp):
+		if item, ok := m.selectedListItem(); ok {
+			return m, m.rearrangeListItemCmd(item, (*service.ListService).MoveItemUp)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.MoveItemDown):
+		if item, ok := m.selectedListItem(); ok {
+			return m, m.rearrangeListItemCmd(item, (*service.ListService).MoveItemDown)
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Priority):
+		if item, ok := m.selectedListItem(); ok {
+			priority := item.Priority.Cycle()
+			return m, m.rearrangeListItemCmd(item, func(s *service.ListService, ctx context.Context, itemID int64) error {
+				return s.SetItemPriority(ctx, itemID, priority)
+			})
+		}
+		return m, nil
+
+	case key.Matches(msg, m.keyMap.Migrate):
+		if item, ok := m.selectedListItem(); ok && item.CanSchedule() {
+			ti := textinput.New()
+			ti.Placeholder = "today, tomorrow, next monday, 2026-01-15"
+			ti.Focus()
+			ti.CharLimit = 64
+			ti.Width = m.width - 10
+			m.migrateMode = migrateState{
+				active:   true,
+				input:    ti,
+				listItem: &item,
+			}
+		}
+		return m, nil
+
 	case key.Matches(msg, m.keyMap.MoveListItem):
 		if len(m.listState.items) > 0 && m.listState.selectedItemIdx < len(m.listState.items) {
 			item := m.listState.items[m.listState.selectedItemIdx]
 			targetLists := make([]domain.List, 0, len(m.listState.lists)-1)
 			for _, list := range m.listState.lists {
-				if list.ID != m.listState.currentListID {
+				if list.ID != m.listState.currentListID && !list.IsSmart() {
 					targetLists = append(targetLists, list)
 				}
 			}
@@ -2222,6 +2473,22 @@ func (m Model) handleListItemsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+func (m Model) selectedListItem() (domain.ListItem, bool) {
+	if m.listState.selectedItemIdx < 0 || m.listState.selectedItemIdx >= len(m.listState.items) {
+		return domain.ListItem{}, false
+	}
+	return m.listState.items[m.listState.selectedItemIdx], true
+}
+
+// listItemMaxDepth is the deepest an ordered item can be indented to: one
+// level below the item above it. The first item cannot be indented.
+func listItemMaxDepth(items []domain.ListItem, idx int) int {
+	if idx <= 0 {
+		return 0
+	}
+	return items[idx-1].Depth + 1
+}
+
 func (m Model) handleMoveListItemMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch msg.Type {
 	case tea.KeyEsc:
@@ -2335,6 +2602,184 @@ func (m Model) handleMoveToListMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+func (m Model) handleMonthReviewMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.monthReviewMode.applying {
+		return m, nil
+	}
+	if m.monthReviewMode.summary != nil {
+		m.monthReviewMode = monthReviewState{}
+		return m, m.reloadCurrentViewCmd()
+	}
+
+	switch m.monthReviewMode.stage {
+	case monthReviewStageFutureLog:
+		return m.handleMonthReviewFutureLog(msg)
+	case monthReviewStageList:
+		return m.handleMonthReviewList(msg)
+	}
+
+	item := m.monthReviewMode.review.Items[m.monthReviewMode.idx]
+	if msg.Type == tea.KeyEsc {
+		m.monthReviewMode = monthReviewState{}
+		return m, nil
+	}
+	if msg.Type == tea.KeyBackspace && m.monthReviewMode.idx > 0 {
+		m.monthReviewMode.idx--
+		m.monthReviewMode.decisions = m.monthReviewMode.decisions[:m.monthReviewMode.idx]
+		return m, nil
+	}
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 {
+		return m, nil
+	}
+
+	var action service.MonthReviewAction
+	switch msg.Runes[0] {
+	case 'k':
+		action = service.MonthReviewKeep
+	case 'm':
+		action = service.MonthReviewMigrate
+	case 'f':
+		action = service.MonthReviewFutureLog
+	case 'l':
+		action = service.MonthReviewMoveList
+	case 'c':
+		action = service.MonthReviewCancel
+	default:
+		return m, nil
+	}
+	if !monthReviewAllows(item, action) {
+		return m, nil
+	}
+
+	switch action {
+	case service.MonthReviewFutureLog:
+		m.monthReviewMode.stage = monthReviewStageFutureLog
+		m.monthReviewMode.input.SetValue("")
+		m.monthReviewMode.input.Focus()
+		return m, nil
+	case service.MonthReviewMoveList:
+		if len(m.monthReviewMode.lists) == 0 {
+			return m, nil
+		}
+		m.monthReviewMode.stage = monthReviewStageList
+		return m, nil
+	case service.MonthReviewMigrate:
+		month := m.monthReviewMode.review.Month
+		nextMonth := time.Date(month.Year(), month.Month()+1, 1, 0, 0, 0, 0, month.Location())
+		return m.decideMonthReviewItem(service.MonthReviewDecision{Item: item, Action: action, Date: nextMonth})
+	}
+	return m.decideMonthReviewItem(service.MonthReviewDecision{Item: item, Action: action})
+}
+
+func (m Model) handleMonthReviewFutureLog(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.monthReviewMode.stage = monthReviewStageAction
+		m.monthReviewMode.input.Blur()
+		return m, nil
+
+	case tea.KeyEnter:
+		value := strings.TrimSpace(m.monthReviewMode.input.Value())
+		decision := service.MonthReviewDecision{
+			Item:   m.monthReviewMode.review.Items[m.monthReviewMode.idx],
+			Action: service.MonthReviewFutureLog,
+		}
+		if strings.EqualFold(value, "someday") {
+			decision.Granularity = domain.ScheduleSomeday
+		} else {
+			month, err := dateutil.ParseMonth(value)
+			if err != nil {
+				m.err = err
+				return m, nil
+			}
+			decision.Granularity = domain.ScheduleMonth
+			decision.Date = month
+		}
+		m.err = nil
+		m.monthReviewMode.input.Blur()
+		return m.decideMonthReviewItem(decision)
+	}
+
+	var cmd tea.Cmd
+	m.monthReviewMode.input, cmd = m.monthReviewMode.input.Update(msg)
+	return m, cmd
+}
+
+func (m Model) handleMonthReviewList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	lists := m.monthReviewMode.lists
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.monthReviewMode.stage = monthReviewStageAction
+		return m, nil
+
+	case tea.KeyUp:
+		if m.monthReviewMode.listIdx > 0 {
+			m.monthReviewMode.listIdx--
+		}
+		return m, nil
+
+	case tea.KeyDown:
+		if m.monthReviewMode.listIdx < len(lists)-1 {
+			m.monthReviewMode.listIdx++
+		}
+		return m, nil
+
+	case tea.KeyEnter:
+		return m.decideMonthReviewList(lists[m.monthReviewMode.listIdx])
+
+	case tea.KeyRunes:
+		if len(msg.Runes) == 1 {
+			r := msg.Runes[0]
+			if r >= '1' && r <= '9' && int(r-'1') < len(lists) {
+				return m.decideMonthReviewList(lists[r-'1'])
+			}
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) decideMonthReviewList(list domain.List) (tea.Model, tea.Cmd) {
+	return m.decideMonthReviewItem(service.MonthReviewDecision{
+		Item:   m.monthReviewMode.review.Items[m.monthReviewMode.idx],
+		Action: service.MonthReviewMoveList,
+		ListID: list.ID,
+	})
+}
+
+// decideMonthReviewItem records the decision and moves on to the next item,
+// applying the whole review once every item has been decided.
+func (m Model) decideMonthReviewItem(decision service.MonthReviewDecision) (tea.Model, tea.Cmd) {
+	m.monthReviewMode.decisions = append(m.monthReviewMode.decisions, decision)
+	m.monthReviewMode.idx++
+	m.monthReviewMode.stage = monthReviewStageAction
+	m.monthReviewMode.listIdx = 0
+	if m.monthReviewMode.idx < len(m.monthReviewMode.review.Items) {
+		return m, nil
+	}
+	m.monthReviewMode.applying = true
+	return m, m.applyMonthReviewCmd(m.monthReviewMode.review.Month, m.monthReviewMode.decisions)
+}
+
+func monthReviewAllows(item service.MonthReviewItem, action service.MonthReviewAction) bool {
+	for _, a := range item.Actions() {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+func (m Model) applyMonthReviewCmd(month time.Time, decisions []service.MonthReviewDecision) tea.Cmd {
+	return func() tea.Msg {
+		summary, err := m.monthReviewService.Apply(context.Background(), month, decisions)
+		if err != nil {
+			return errMsg{err}
+		}
+		return monthReviewAppliedMsg{summary: summary}
+	}
+}
+
 func (m Model) handleCommandPaletteMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	switch {
 	case msg.Type == tea.KeyEscape:
@@ -2531,6 +2976,14 @@ func (m Model) handleInsightsMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// textInputFocused reports whether keys are being typed into a text input
+// that is not part of a mode, such as the search view's query, so they
+// must not trigger global shortcuts. Modes with inputs handle their keys
+// before any shortcut is checked.
+func (m Model) textInputFocused() bool {
+	return m.currentView == ViewTypeSearch && m.searchView.input.Focused()
+}
+
 func (m Model) handleSearchViewMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	if handled, newModel, cmd := m.handleViewSwitch(msg); handled {
 		return newModel, cmd
@@ -2561,6 +3014,7 @@ func (m Model) handleSearchViewMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.searchView.input.SetValue("")
 		m.searchView.query = ""
 		m.searchView.results = nil
+		m.searchView.snippets = nil
 		m.searchView.selectedIdx = 0
 		return m, nil
 
@@ -2668,6 +3122,12 @@ func (m Model) handleViewSwitch(msg tea.KeyMsg) (bool, Model, tea.Cmd) {
 		m.insightsState.loading = true
 		cmd = m.loadInsightsDashboardCmd()
 		switched = true
+
+	case key.Matches(msg, m.keyMap.ViewFutureLog):
+		newView = ViewTypeFutureLog
+		m.futureLogState.loading = true
+		cmd = m.loadFutureLogCmd()
+		switched = true
 	}
 
 	if switched && newView != m.currentView {
@@ -2725,6 +3185,24 @@ func (m Model) handleQuitConfirmMode(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+func (m Model) undoOperationCmd() tea.Cmd {
+	return func() tea.Msg {
+		if _, err := m.journal.Undo(context.Background()); err != nil {
+			return errMsg{err}
+		}
+		return operationRevertedMsg{}
+	}
+}
+
+func (m Model) redoOperationCmd() tea.Cmd {
+	return func() tea.Msg {
+		if _, err := m.journal.Redo(context.Background()); err != nil {
+			return errMsg{err}
+		}
+		return operationRevertedMsg{}
+	}
+}
+
 func (m Model) undoCmd() tea.Cmd {
 	return func() tea.Msg {
 		ctx := context.Background()
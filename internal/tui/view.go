@@ -48,6 +48,8 @@ func (m Model) View() string {
 		sb.WriteString(m.renderPendingTasksContent())
 	case ViewTypeQuestions:
 		sb.WriteString(m.renderQuestionsContent())
+	case ViewTypeFutureLog:
+		sb.WriteString(m.renderFutureLogContent())
 	default:
 		sb.WriteString(m.renderJournalContent())
 	}
@@ -68,6 +70,10 @@ func (m Model) View() string {
 		sb.WriteString("\n")
 		sb.WriteString(m.renderMigrateInput())
 		sb.WriteString("\n")
+	} else if m.repeatMode.active {
+		sb.WriteString("\n")
+		sb.WriteString(m.renderRepeatInput())
+		sb.WriteString("\n")
 	} else if m.confirmMode.active {
 		sb.WriteString("\n")
 		sb.WriteString(m.renderConfirmDialog())
@@ -120,6 +126,10 @@ func (m Model) View() string {
 		sb.WriteString("\n")
 		sb.WriteString(m.renderMoveToListModal())
 		sb.WriteString("\n")
+	} else if m.monthReviewMode.active {
+		sb.WriteString("\n")
+		sb.WriteString(m.renderMonthReviewModal())
+		sb.WriteString("\n")
 	} else if m.addHabitMode.active {
 		sb.WriteString("\n")
 		sb.WriteString(m.renderAddHabitInput())
@@ -143,7 +153,10 @@ func (m Model) renderContextHelp() string {
 	case ViewTypeLists:
 		return "j/k: navigate  enter: open  a: add list  esc: back  q: quit"
 	case ViewTypeListItems:
-		return "j/k: navigate  space: toggle  a: add  e: edit  d: delete  M: move  esc: back  q: quit"
+		if list, _ := m.currentList(); list.IsSmart() {
+			return "j/k: navigate  space: toggle  esc: back  q: quit"
+		}
+		return "j/k: navigate  space: toggle  a: add  e: edit  d: delete  tab/shift+tab: indent  K/J: reorder  !: priority  >: schedule  M: move  esc: back  q: quit"
 	case ViewTypeGoals:
 		return "j/k: navigate  h/l: month  space: toggle  a: add  e: edit  d: delete  >: move  esc: back  q: quit"
 	case ViewTypeSearch:
@@ -156,6 +169,8 @@ func (m Model) renderContextHelp() string {
 		return "j/k: navigate  enter: go to  space: done  x: cancel  e: edit  d: delete  >: migrate  t: retype  !: priority  L: list  esc: back  q: quit"
 	case ViewTypeQuestions:
 		return "j/k: navigate  enter: go to  space: done  x: cancel  R: answer  e: edit  d: delete  esc: back  q: quit"
+	case ViewTypeFutureLog:
+		return "j/k: navigate  space: done  x: cancel  e: edit  d: delete  >: migrate  !: priority  esc: back  q: quit"
 	default:
 		return m.help.View(m.keyMap)
 	}
@@ -240,6 +255,18 @@ func (m Model) renderJournalContent() string {
 		}
 	}
 
+	if len(m.journalOverdueItems) > 0 {
+		sb.WriteString("\n")
+		sb.WriteString(OverdueHeaderStyle.Render("📋 Overdue List Items"))
+		sb.WriteString("\n")
+		today := time.Now()
+		for _, item := range m.journalOverdueItems {
+			line := fmt.Sprintf("  %s %s  (%s, %s)", item.Type.Symbol(), item.Content, item.ListName, listItemDueLabel(item.ListItem, today))
+			sb.WriteString(OverdueStyle.Render(line))
+			sb.WriteString("\n")
+		}
+	}
+
 	if len(m.journalGoals) > 0 {
 		sb.WriteString("\n")
 		now := time.Now()
@@ -278,39 +305,56 @@ func (m Model) renderHabitsContent() string {
 		return sb.String()
 	}
 
-	days := HabitDaysWeek
-	switch m.habitState.viewMode {
-	case HabitViewModeMonth:
-		days = HabitDaysMonth
-	case HabitViewModeQuarter:
-		days = HabitDaysQuarter
+	days := m.habitDays()
+	yearView := m.habitState.viewMode == HabitViewModeYear
+
+	if yearView && len(m.habitState.heatmaps) > 0 {
+		overlay := service.OverlayHeatmaps(m.habitState.heatmaps, m.getHabitReferenceDate().Year())
+		sb.WriteString(fmt.Sprintf("%s %d\n", overlay.Name, overlay.Year))
+		sb.WriteString(m.renderHeatmap(overlay, false))
+		sb.WriteString("\n")
 	}
 
 	for i, habit := range m.habitState.habits {
-		streakText := "day"
-		if habit.CurrentStreak != 1 {
-			streakText = "days"
-		}
-		nameLine := fmt.Sprintf("%s (%d %s streak)", habit.Name, habit.CurrentStreak, streakText)
+		nameLine := fmt.Sprintf("%s (%s streak)", habit.Name, habit.StreakPeriod.Format(habit.CurrentStreak))
 		if i == m.habitState.selectedIdx {
 			nameLine = SelectedStyle.Render(nameLine)
 		}
 		sb.WriteString(nameLine)
 		sb.WriteString("\n")
 
-		sparkline := m.renderSparkline(habit.DayHistory, i == m.habitState.selectedIdx)
-		sb.WriteString("  " + sparkline)
-		sb.WriteString("\n")
+		if heatmap, ok := m.habitHeatmap(habit.ID); yearView && ok {
+			sb.WriteString(m.renderHeatmap(heatmap, i == m.habitState.selectedIdx))
+		} else {
+			sparkline := m.renderSparkline(habit.DayHistory, i == m.habitState.selectedIdx)
+			sb.WriteString("  " + sparkline)
+			sb.WriteString("\n")
 
-		dayLabels := m.renderDayLabels(days)
-		sb.WriteString("  " + HelpStyle.Render(dayLabels))
-		sb.WriteString("\n")
+			dayLabels := m.renderDayLabels(days)
+			sb.WriteString("  " + HelpStyle.Render(dayLabels))
+			sb.WriteString("\n")
+		}
 
-		todayInfo := fmt.Sprintf("  %d/%d today | %.0f%% completion", habit.TodayCount, habit.GoalPerDay, habit.CompletionPercent)
+		today := fmt.Sprintf("%d/%d today", habit.TodayCount, habit.GoalPerDay)
+		completion := "completion"
+		switch {
+		case habit.TargetType == domain.HabitTargetAtMost:
+			today = fmt.Sprintf("%s/%s %s", domain.FormatHabitValue(habit.PeriodTotal, ""), domain.FormatHabitValue(habit.Ceiling, habit.Unit), limitPeriod(habit.StreakPeriod))
+			if habit.OverLimit {
+				today += " (over limit)"
+			}
+			completion = "clean"
+		case habit.Kind == domain.HabitKindMeasured:
+			today = fmt.Sprintf("%s/%s today", domain.FormatHabitValue(habit.TodayValue, ""), domain.FormatHabitValue(habit.Target, habit.Unit))
+		}
+		todayInfo := fmt.Sprintf("  %s | %.0f%% %s", today, habit.CompletionPercent, completion)
+		if habit.Schedule != "" && habit.Schedule != "daily" {
+			todayInfo += " | " + habit.Schedule
+		}
 		sb.WriteString(HelpStyle.Render(todayInfo))
 		sb.WriteString("\n")
 
-		if habit.GoalPerWeek > 0 || habit.GoalPerMonth > 0 {
+		if (habit.GoalPerWeek > 0 || habit.GoalPerMonth > 0) && habit.TargetType != domain.HabitTargetAtMost {
 			var progressParts []string
 			if habit.GoalPerWeek > 0 {
 				progressParts = append(progressParts, fmt.Sprintf("Week: %.0f%%", habit.WeeklyProgress))
@@ -339,8 +383,14 @@ func (m Model) renderSparkline(history []service.DayStatus, isSelected bool) str
 		selected := isSelected && displayPos == m.habitState.selectedDayIdx
 
 		char := "○"
-		if day.Completed {
+		if day.Over {
+			char = OverdueStyle.Render("✗")
+		} else if day.Completed {
 			char = "●"
+		} else if day.Paused {
+			char = "~"
+		} else if day.Rest {
+			char = "-"
 		}
 
 		if selected {
@@ -351,6 +401,86 @@ func (m Model) renderSparkline(history []service.DayStatus, isSelected bool) str
 	return strings.Join(parts, " ")
 }
 
+func (m Model) habitHeatmap(habitID int64) (service.HabitHeatmap, bool) {
+	for _, heatmap := range m.habitState.heatmaps {
+		if heatmap.HabitID == habitID {
+			return heatmap, true
+		}
+	}
+	return service.HabitHeatmap{}, false
+}
+
+var heatmapShades = []string{"·", "░", "▒", "▓", "█"}
+
+// renderHeatmap draws a year as a contribution graph of weekday rows and a
+// column per week, shaded by how much of the daily goal was met. On the
+// selected habit the selected day is highlighted.
+func (m Model) renderHeatmap(heatmap service.HabitHeatmap, isSelected bool) string {
+	var sb strings.Builder
+
+	var selectedDate time.Time
+	if isSelected {
+		reference := m.getHabitReferenceDate()
+		selectedDate = time.Date(reference.Year(), time.January, 1+m.habitState.selectedDayIdx, 0, 0, 0, 0, reference.Location())
+	}
+
+	sb.WriteString("      " + HelpStyle.Render(heatmap.MonthLabels()) + "\n")
+	for row, label := range []string{"Mon", "", "Wed", "", "Fri", "", "Sun"} {
+		sb.WriteString(fmt.Sprintf("  %-4s", label))
+		for _, week := range heatmap.Weeks() {
+			day := week[row]
+			char := " "
+			switch {
+			case day.Date.IsZero() || day.Inactive:
+			case day.Over:
+				char = OverdueStyle.Render("✗")
+			case day.Level() > 0:
+				char = heatmapShades[day.Level()]
+			case day.Paused:
+				char = "~"
+			case day.Rest:
+				char = "-"
+			default:
+				char = HelpStyle.Render(heatmapShades[0])
+			}
+			if !selectedDate.IsZero() && day.Date.Equal(selectedDate) {
+				if char == " " {
+					char = "·"
+				}
+				char = HabitSelectedStyle.Render(char)
+			}
+			sb.WriteString(char)
+		}
+		sb.WriteString("\n")
+	}
+
+	streak := "  No streak this year"
+	if heatmap.LongestStreak > 0 {
+		streak = fmt.Sprintf("  Longest streak: %s (%s – %s)",
+			domain.StreakPeriodDay.Format(heatmap.LongestStreak),
+			heatmap.LongestStreakStart.Format("Jan 2"),
+			heatmap.LongestStreakEnd.Format("Jan 2"))
+	}
+	if !selectedDate.IsZero() {
+		streak += " | " + selectedDate.Format("Mon Jan 2")
+	}
+	sb.WriteString(HelpStyle.Render(streak) + "\n")
+
+	return sb.String()
+}
+
+// limitPeriod names the period a limit's usage so far covers.
+func limitPeriod(period domain.StreakPeriod) string {
+	switch period {
+	case domain.StreakPeriodWeek:
+		return "this week"
+	case domain.StreakPeriodMonth:
+		return "this month"
+	default:
+		return "today"
+	}
+}
+
 func (m Model) renderDayLabels(days int) string {
 	referenceDate := m.getHabitReferenceDate()
 
@@ -443,6 +573,9 @@ func (m Model) renderListsOverview() string {
 		}
 
 		line := fmt.Sprintf("📋 %-20s  %s", list.Name, progress)
+		if list.IsSmart() {
+			line = fmt.Sprintf("🔍 %-20s  %s  %s", list.Name, progress, list.Query)
+		}
 
 		if i == m.listState.selectedListIdx {
 			line = SelectedStyle.Render(line)
@@ -459,29 +592,46 @@ func (m Model) renderListsOverview() string {
 func (m Model) renderListItemsContent() string {
 	var sb strings.Builder
 
-	var listName string
-	for _, list := range m.listState.lists {
-		if list.ID == m.listState.currentListID {
-			listName = list.Name
-			break
-		}
+	list, _ := m.currentList()
+	if list.IsSmart() {
+		fmt.Fprintf(&sb, "🔍 %s  %s\n", list.Name, HelpStyle.Render(list.Query))
+	} else {
+		fmt.Fprintf(&sb, "📋 %s\n", list.Name)
 	}
-	fmt.Fprintf(&sb, "📋 %s\n", listName)
 	sb.WriteString("────────────────────────────────────────\n")
 
+	if len(m.listState.items) == 0 && list.IsSmart() {
+		sb.WriteString(HelpStyle.Render("No entries match this list's query."))
+		sb.WriteString("\n\n")
+		return sb.String()
+	}
 	if len(m.listState.items) == 0 {
 		sb.WriteString(HelpStyle.Render("No items yet. List is empty. Press 'a' to add an item."))
 		sb.WriteString("\n\n")
 		return sb.String()
 	}
 
+	today := time.Now()
 	for i, item := range m.listState.items {
+		indent := strings.Repeat("  ", item.Depth)
 		symbol := item.Type.Symbol()
-		line := fmt.Sprintf("%s %s", symbol, item.Content)
+		line := fmt.Sprintf("%s%s %s", indent, symbol, item.Content)
+		if prioritySymbol := item.Priority.Symbol(); prioritySymbol != "" {
+			line = fmt.Sprintf("%s%s %s %s", indent, symbol, prioritySymbol, item.Content)
+		}
+		if due := listItemDueLabel(item, today); due != "" {
+			line = fmt.Sprintf("%s  (%s)", line, due)
+		}
 
 		if item.Type == domain.ListItemTypeDone {
 			line = DoneStyle.Render(line)
 		}
+		if item.IsScheduled() {
+			line = MigratedStyle.Render(line)
+		}
+		if item.IsOverdue(today) {
+			line = OverdueStyle.Render(line)
+		}
 
 		if i == m.listState.selectedItemIdx {
 			line = SelectedStyle.Render(line)
@@ -495,6 +645,21 @@ func (m Model) renderListItemsContent() string {
 	return sb.String()
 }
 
+// listItemDueLabel describes when a list item is due, or is empty if it
+// has no due date.
+func listItemDueLabel(item domain.ListItem, today time.Time) string {
+	switch {
+	case item.DueDate == nil:
+		return ""
+	case item.IsOverdue(today):
+		return "overdue, due " + item.DueDate.Format("Jan 2")
+	case item.IsDue(today):
+		return "due today"
+	default:
+		return "due " + item.DueDate.Format("Jan 2")
+	}
+}
+
 func (m Model) renderEntry(item EntryItem, selected bool) string {
 	entry := item.Entry
 	indent := strings.Repeat("  ", item.Indent)
@@ -512,22 +677,30 @@ func (m Model) renderEntry(item EntryItem, selected bool) string {
 	prioritySymbol := entry.Priority.Symbol()
 	content := entry.Content
 
-	hiddenSuffix := ""
+	suffix := ""
 	if item.HiddenChildCount > 0 {
-		hiddenSuffix = fmt.Sprintf(" [%d hidden]", item.HiddenChildCount)
+		suffix = fmt.Sprintf(" [%d hidden]", item.HiddenChildCount)
+	}
+	if entry.Projected {
+		suffix += " (upcoming)"
 	}
 
 	var base string
 	if prioritySymbol != "" {
-		base = fmt.Sprintf("%s%s%s %s %s%s", indent, collapseIndicator, symbol, prioritySymbol, content, hiddenSuffix)
+		base = fmt.Sprintf("%s%s%s %s %s%s", indent, collapseIndicator, symbol, prioritySymbol, content, suffix)
 	} else {
-		base = fmt.Sprintf("%s%s%s %s%s", indent, collapseIndicator, symbol, content, hiddenSuffix)
+		base = fmt.Sprintf("%s%s%s %s%s", indent, collapseIndicator, symbol, content, suffix)
 	}
 
 	if selected {
 		return SelectedStyle.Render(base)
 	}
 
+	// Projected recurrence entries are read-only until their day comes.
+	if entry.Projected {
+		return ProjectedStyle.Render(base)
+	}
+
 	switch entry.Type {
 	case domain.EntryTypeDone, domain.EntryTypeAnswered:
 		return DoneStyle.Render(base)
@@ -606,12 +779,26 @@ func (m Model) renderAddInput() string {
 
 func (m Model) renderMigrateInput() string {
 	var sb strings.Builder
+	if m.migrateMode.listItem != nil {
+		sb.WriteString("Schedule on date:\n")
+		sb.WriteString(m.migrateMode.input.View())
+		sb.WriteString("\n\nEnter to schedule, Esc to cancel")
+		return ConfirmStyle.Render(sb.String())
+	}
 	sb.WriteString("Migrate to date:\n")
 	sb.WriteString(m.migrateMode.input.View())
 	sb.WriteString("\n\nEnter to migrate, Esc to cancel")
 	return ConfirmStyle.Render(sb.String())
 }
 
+func (m Model) renderRepeatInput() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Repeat: %s\n", m.repeatMode.content)
+	sb.WriteString(m.repeatMode.input.View())
+	sb.WriteString("\n\nEnter to repeat, Esc to cancel")
+	return ConfirmStyle.Render(sb.String())
+}
+
 func (m Model) renderGotoInput() string {
 	var sb strings.Builder
 	sb.WriteString("Go to date:\n")
@@ -754,6 +941,8 @@ func (m Model) renderToolbar() string {
 		viewTypeStr = "Settings"
 	case ViewTypeInsights:
 		viewTypeStr = "Insights"
+	case ViewTypeFutureLog:
+		viewTypeStr = "Future Log"
 	default:
 		viewTypeStr = "Journal"
 	}
@@ -880,6 +1069,11 @@ func (m Model) renderSearchResultLine(entry domain.Entry, selected bool) string
 
 	symbol := entry.Type.Symbol()
 	content := entry.Content
+	if snippet, ok := m.searchView.snippets[entry.ID]; ok {
+		content = domain.HighlightSnippet(snippet, func(s string) string {
+			return SearchHighlightStyle.Render(s)
+		})
+	}
 	idStr := fmt.Sprintf("(%d)", entry.ID)
 
 	switch entry.Type {
@@ -979,15 +1173,20 @@ func (m Model) renderStatsContent() string {
 		if stats.HabitStats.Active > 0 {
 			fmt.Fprintf(&sb, "\nHabits: %d active\n", stats.HabitStats.Active)
 			if stats.HabitStats.BestStreak.Days > 0 {
-				fmt.Fprintf(&sb, "  Best streak: %s (%d days)\n",
+				fmt.Fprintf(&sb, "  Best streak: %s (%s)\n",
 					stats.HabitStats.BestStreak.HabitName,
-					stats.HabitStats.BestStreak.Days)
+					stats.HabitStats.BestStreak.Period.Format(stats.HabitStats.BestStreak.Days))
 			}
 			if stats.HabitStats.MostLogged.Count > 0 {
 				fmt.Fprintf(&sb, "  Most logged: %s (%d logs)\n",
 					stats.HabitStats.MostLogged.HabitName,
 					stats.HabitStats.MostLogged.Count)
 			}
+			for _, m := range stats.HabitStats.Measured {
+				if m.Values.Days > 0 {
+					fmt.Fprintf(&sb, "  %s: %s\n", m.HabitName, m.Values.Format(m.Unit))
+				}
+			}
 		}
 		sb.WriteString("\n")
 	}
@@ -1005,13 +1204,36 @@ func (m Model) renderGoalsContent() string {
 		sb.WriteString(HelpStyle.Render("No goals for this month. Press 'a' to add one."))
 		sb.WriteString("\n\n")
 	} else {
-		for i, goal := range m.goalState.goals {
+		for i, node := range m.goalState.nodes {
+			goal := node.Goal
+			indent := strings.Repeat("  ", node.Depth)
 			status := "  "
 			if goal.IsDone() {
 				status = "✓ "
 			}
 
-			line := fmt.Sprintf("%s#%-3d %s", status, goal.ID, goal.Content)
+			line := fmt.Sprintf("%s%s#%-3d %s", indent, status, goal.ID, goal.Content)
+			if goal.GetHorizon() != domain.GoalHorizonMonth {
+				line += fmt.Sprintf(" [%s]", goal.PeriodLabel())
+			}
+
+			var progress []string
+			keyResults := m.goalState.keyResults[goal.EntityID]
+			if len(keyResults) > 0 {
+				progress = append(progress, domain.FormatPercent(domain.KeyResultsProgress(keyResults)))
+			}
+			if len(node.Children) > 0 {
+				rollup := node.Progress()
+				progress = append(progress, fmt.Sprintf("%d/%d goals", rollup.Done, rollup.Total))
+			}
+			tasks := m.goalState.tasks[goal.EntityID]
+			if len(tasks) > 0 {
+				done := domain.CalculateGoalProgress(tasks)
+				progress = append(progress, fmt.Sprintf("%d/%d tasks", done.Done, done.Total))
+			}
+			if len(progress) > 0 {
+				line += fmt.Sprintf(" (%s)", strings.Join(progress, ", "))
+			}
 
 			if goal.IsDone() {
 				line = DoneStyle.Render(line)
@@ -1023,6 +1245,24 @@ func (m Model) renderGoalsContent() string {
 
 			sb.WriteString(line)
 			sb.WriteString("\n")
+
+			for _, kr := range keyResults {
+				fmt.Fprintf(&sb, "%s       %s %4s  %s ", indent,
+					domain.ProgressBar(kr.Progress(), 10), domain.FormatPercent(kr.Progress()), kr.Name)
+				sb.WriteString(HelpStyle.Render(kr.Describe()))
+				sb.WriteString("\n")
+			}
+
+			for _, task := range tasks {
+				taskLine := fmt.Sprintf("%s       %s %s", indent, task.Type.Symbol(), task.Content)
+				if task.Type == domain.EntryTypeDone {
+					taskLine = DoneStyle.Render(taskLine)
+				} else {
+					taskLine = HelpStyle.Render(taskLine)
+				}
+				sb.WriteString(taskLine)
+				sb.WriteString("\n")
+			}
 		}
 		sb.WriteString("\n")
 	}
@@ -1641,6 +1881,49 @@ func (m Model) renderQuestionsContent() string {
 	return sb.String()
 }
 
+func (m Model) renderFutureLogContent() string {
+	var sb strings.Builder
+
+	sb.WriteString("📆 Future Log\n\n")
+
+	if m.futureLogState.loading || m.futureLogState.log == nil {
+		sb.WriteString("Loading...")
+		return sb.String()
+	}
+
+	if len(m.futureLogState.entries) == 0 {
+		sb.WriteString(HelpStyle.Render("Nothing in the future log. Migrate a task to a month or to someday with >."))
+		sb.WriteString("\n\n")
+		return sb.String()
+	}
+
+	idx := 0
+	renderSection := func(title string, entries []domain.Entry) {
+		sb.WriteString(TitleStyle.Render(title))
+		sb.WriteString("\n")
+		for _, entry := range entries {
+			line := fmt.Sprintf("%s%s %s", strings.Repeat("  ", entry.Depth), entry.Type.Symbol(), entry.Content)
+			if idx == m.futureLogState.selectedIdx {
+				sb.WriteString(SelectedStyle.Render("> " + line))
+			} else {
+				sb.WriteString("  " + line)
+			}
+			sb.WriteString("\n")
+			idx++
+		}
+		sb.WriteString("\n")
+	}
+
+	for _, month := range m.futureLogState.log.Months {
+		renderSection(month.Month.Format("January 2006"), month.Entries)
+	}
+	if len(m.futureLogState.log.Someday) > 0 {
+		renderSection("Someday", m.futureLogState.log.Someday)
+	}
+
+	return sb.String()
+}
+
 func (m Model) renderAddGoalInput() string {
 	var sb strings.Builder
 	sb.WriteString("Add goal:\n")
@@ -1705,6 +1988,88 @@ func (m Model) renderMoveListItemModal() string {
 	return ConfirmStyle.Render(sb.String())
 }
 
+func (m Model) renderMonthReviewModal() string {
+	review := m.monthReviewMode.review
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Review %s\n\n", review.Month.Format("January 2006"))
+
+	if m.monthReviewMode.applying {
+		sb.WriteString("Applying review...")
+		return ConfirmStyle.Render(sb.String())
+	}
+
+	if summary := m.monthReviewMode.summary; summary != nil {
+		if len(review.Items) == 0 {
+			sb.WriteString("Nothing left open this month.\n")
+		} else {
+			sb.WriteString(renderMonthReviewSummary(*summary))
+		}
+		sb.WriteString("\nPress any key to close")
+		return ConfirmStyle.Render(sb.String())
+	}
+
+	item := review.Items[m.monthReviewMode.idx]
+	kind := "Task"
+	if item.Goal != nil {
+		kind = "Goal"
+	}
+	fmt.Fprintf(&sb, "%d/%d %s: %s\n\n", m.monthReviewMode.idx+1, len(review.Items), kind, item.Content())
+
+	switch m.monthReviewMode.stage {
+	case monthReviewStageFutureLog:
+		sb.WriteString("Schedule to future log:\n")
+		sb.WriteString(m.monthReviewMode.input.View())
+		sb.WriteString("\n\nEnter to schedule, Esc to go back")
+	case monthReviewStageList:
+		sb.WriteString("Move to list:\n\n")
+		for i, list := range m.monthReviewMode.lists {
+			prefix := "  "
+			if i == m.monthReviewMode.listIdx {
+				prefix = "> "
+			}
+			if i < 9 {
+				fmt.Fprintf(&sb, "%s%d. %s\n", prefix, i+1, list.Name)
+			} else {
+				fmt.Fprintf(&sb, "%s   %s\n", prefix, list.Name)
+			}
+		}
+		sb.WriteString("\n1-9 or Enter to move, Esc to go back")
+	default:
+		var options []string
+		for _, action := range item.Actions() {
+			if action == service.MonthReviewMoveList && len(m.monthReviewMode.lists) == 0 {
+				continue
+			}
+			options = append(options, monthReviewActionLabels[action])
+		}
+		sb.WriteString(strings.Join(options, "  "))
+		sb.WriteString("\n\n⌫ to go back, Esc to abandon the review")
+	}
+
+	return ConfirmStyle.Render(sb.String())
+}
+
+var monthReviewActionLabels = map[service.MonthReviewAction]string{
+	service.MonthReviewKeep:      "k: keep",
+	service.MonthReviewMigrate:   "m: migrate",
+	service.MonthReviewFutureLog: "f: future log",
+	service.MonthReviewMoveList:  "l: list",
+	service.MonthReviewCancel:    "c: cancel",
+}
+
+func renderMonthReviewSummary(summary service.MonthReviewSummary) string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Kept:          %d\n", summary.Kept)
+	fmt.Fprintf(&sb, "Migrated:      %d\n", summary.Migrated)
+	fmt.Fprintf(&sb, "Future log:    %d\n", summary.FutureLog)
+	fmt.Fprintf(&sb, "Moved to list: %d\n", summary.MovedToList)
+	fmt.Fprintf(&sb, "Cancelled:     %d\n", summary.Cancelled)
+	for _, failure := range summary.Failed {
+		fmt.Fprintf(&sb, "Failed: %s (%v)\n", failure.Item.Content(), failure.Err)
+	}
+	return sb.String()
+}
+
 func (m Model) renderCreateListInput() string {
 	var sb strings.Builder
 	sb.WriteString("Create new list:\n")